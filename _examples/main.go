@@ -171,6 +171,8 @@ func registerCustom(fil *os.File) error {
 	// Parse fmt and LIST chunk(s).
 	reg.Register(riff.IDfmt, riff.FMTMake)
 	reg.Register(riff.IDLIST, riff.LISTMake(riff.LoadData, reg))
+	// Parse INFO list sub-chunks.
+	reg.RegisterListDefault(riff.IDINFO, riff.INFOMake(riff.LoadData))
 	// Skip reading data in data chunk.
 	reg.Register(riff.IDdata, riff.DATAMake(riff.SkipData))
 
@@ -0,0 +1,433 @@
+package riff
+
+import (
+	"fmt"
+	"math"
+	"slices"
+)
+
+// IDFVER represents "FVER" (format version) chunk ID of the AIFF-C file.
+const IDFVER uint32 = 0x46564552
+
+// AIFCVersion1 represents the AIFF-C format version stored in the "FVER"
+// chunk.
+const AIFCVersion1 uint32 = 0xa2805140
+
+// aiffInfo maps the AIFF text chunk IDs to the "LIST INFO" sub-chunk IDs.
+var aiffInfo = []struct{ aiff, info uint32 }{
+	{IDNAME, LabINAM},
+	{IDAUTH, LabIART},
+	{IDCopyright, LabICOP},
+	{IDANNO, LabICMT},
+}
+
+// AIFFToWAVE returns the "RIFF WAVE" file with the sound from the AIFF or
+// AIFF-C file. The chunks are mapped as follows:
+//
+//   - COMM to fmt,
+//   - SSND to data,
+//   - MARK to cue and "LIST adtl" labels,
+//   - INST to smpl (the sustain and release loops) and inst,
+//   - NAME, AUTH, "(c) " and ANNO to "LIST INFO".
+//
+// Other chunks are not carried over. The uncompressed PCM ("NONE", "twos"
+// and "sowt") and IEEE floating point ("fl32" and "fl64") sound data is
+// supported.
+func AIFFToWAVE(rif *RIFF) (*RIFF, error) {
+	if rif.Type() != TypeAIFF && rif.Type() != TypeAIFC {
+		return nil, fmt.Errorf(
+			"expected %s or %s form got %s",
+			Uint32(TypeAIFF),
+			Uint32(TypeAIFC),
+			Uint32(rif.Type()),
+		)
+	}
+	comm, ok := rif.Chunks().First(IDCOMM).(*ChunkCOMM)
+	if !ok {
+		return nil, fmt.Errorf("missing %s chunk: %w", Uint32(IDCOMM), ErrUnsupportedFormat)
+	}
+	ssnd, ok := rif.Chunks().First(IDSSND).(*ChunkSSND)
+	if !ok {
+		return nil, fmt.Errorf("missing %s chunk: %w", Uint32(IDSSND), ErrUnsupportedFormat)
+	}
+	if ssnd.data == nil {
+		return nil, ErrSkipDataMode
+	}
+
+	cf := FMT()
+	cf.CompCode = CompPCM
+	cf.BitsPerSample = comm.BitsPerSample
+	swap := true
+	switch comm.Compression {
+	case 0, AIFCNone, AIFCTwos:
+	case AIFCSowt:
+		swap = false
+	case AIFCFl32, AIFCFL32:
+		cf.CompCode = CompFloat
+		cf.BitsPerSample = 32
+	case AIFCFl64, AIFCFL64:
+		cf.CompCode = CompFloat
+		cf.BitsPerSample = 64
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedFormat, Uint32(comm.Compression))
+	}
+	width := int(cf.BitsPerSample+7) / 8
+	if width == 0 || comm.ChannelCnt == 0 {
+		return nil, fmt.Errorf(
+			"%d channels %d bits: %w",
+			comm.ChannelCnt,
+			comm.BitsPerSample,
+			ErrUnsupportedFormat,
+		)
+	}
+	cf.ChannelCnt = comm.ChannelCnt
+	cf.SampleRate = uint32(math.Round(comm.SampleRate()))
+	cf.BlockAlign = uint16(width) * cf.ChannelCnt
+	cf.AvgByteRate = cf.SampleRate * uint32(cf.BlockAlign)
+
+	pcm := ssnd.Samples()
+	size := uint64(comm.FrameCnt) * uint64(cf.BlockAlign)
+	pcm = pcm[:min(uint64(len(pcm)), size)]
+	data := DATA(LoadData)
+	// Never fails in LoadData mode.
+	_ = data.SetData(aiffSamples(pcm, width, swap, cf.CompCode == CompPCM))
+
+	chs := Chunks{cf}
+
+	var infos Chunks
+	for _, ch := range rif.Chunks() {
+		txt, ok := ch.(*ChunkTEXT)
+		if !ok {
+			continue
+		}
+		for _, m := range aiffInfo {
+			if m.aiff == txt.ID() {
+				infos = append(infos, infoText(m.info, append(txt.text[:len(txt.text):len(txt.text)], 0)))
+			}
+		}
+	}
+	if len(infos) > 0 {
+		info := LIST(LoadData, NewRegistry(RAWCMake(LoadData)))
+		info.ListType = IDINFO
+		info.Modify(infos)
+		chs = append(chs, info)
+	}
+
+	mk, _ := rif.Chunks().First(IDMARK).(*ChunkMARK)
+	if mk != nil && len(mk.Markers) > 0 {
+		cue := CUE()
+		var labels Chunks
+		for _, m := range mk.Markers {
+			cue.Points = append(cue.Points, CuePoint{
+				ID:           uint32(m.ID),
+				Position:     m.Position,
+				DataChunkID:  IDdata,
+				SampleOffset: m.Position,
+			})
+			if m.Name != "" {
+				lab := LABL()
+				lab.CuePointID = uint32(m.ID)
+				lab.label = append([]byte(m.Name), 0)
+				lab.size = LABLChunkSize + uint32(len(lab.label))
+				labels = append(labels, lab)
+			}
+		}
+		chs = append(chs, cue)
+		if len(labels) > 0 {
+			adtl := LIST(LoadData, NewRegistry(RAWCMake(LoadData)))
+			adtl.ListType = IDadtl
+			adtl.Modify(labels)
+			chs = append(chs, adtl)
+		}
+	}
+
+	if in, ok := rif.Chunks().First(IDINST).(*ChunkAIFFINST); ok {
+		sm := SMPL()
+		if cf.SampleRate > 0 {
+			sm.SamplePeriod = uint32(1e9 / float64(cf.SampleRate))
+		}
+		sm.setPitch(in.BaseNote, in.Detune)
+		for _, lp := range []AIFFLoop{in.SustainLoop, in.ReleaseLoop} {
+			if sl := aiffLoop(mk, lp); sl != nil {
+				sm.SampleLoops = append(sm.SampleLoops, sl)
+			}
+		}
+		sm.SampleLoopCnt = uint32(len(sm.SampleLoops))
+		sm.SamplerDataCnt = sm.SampleLoopCnt * SampleLoopCntSize
+		sm.size = SMPLChunkSize + sm.SamplerDataCnt
+
+		chs = append(chs, sm, &ChunkWaveINST{
+			UnshiftedNote: in.BaseNote,
+			FineTune:      in.Detune,
+			Gain:          int8(min(max(in.Gain, math.MinInt8), math.MaxInt8)),
+			LowNote:       in.LowNote,
+			HighNote:      in.HighNote,
+			LowVelocity:   in.LowVelocity,
+			HighVelocity:  in.HighVelocity,
+		})
+	}
+
+	chs = append(chs, data)
+	wav := Compose(chs)
+	wav.SetType(TypeWAVE)
+	return wav, nil
+}
+
+// WAVEToAIFF returns the AIFF file with the sound from the "RIFF WAVE" or
+// "RIFX WAVE" file. It is the reverse of [AIFFToWAVE]: the uncompressed PCM sound is
+// written to the AIFF file and the IEEE floating point sound to the AIFF-C
+// file. The first two smpl loops become the sustain and release loops,
+// the markers for their boundaries are added when not present.
+func WAVEToAIFF(rif *RIFF) (*RIFF, error) {
+	if rif.Type() != TypeWAVE {
+		return nil, fmt.Errorf("expected %s form got %s", Uint32(TypeWAVE), Uint32(rif.Type()))
+	}
+	cf, ok := rif.Chunks().First(IDfmt).(*ChunkFMT)
+	if !ok {
+		return nil, fmt.Errorf("missing %s chunk: %w", Uint32(IDfmt), ErrUnsupportedFormat)
+	}
+	data, ok := rif.Chunks().First(IDdata).(*ChunkDATA)
+	if !ok {
+		return nil, fmt.Errorf("missing %s chunk: %w", Uint32(IDdata), ErrUnsupportedFormat)
+	}
+	if data.data == nil {
+		return nil, ErrSkipDataMode
+	}
+
+	code := cf.CompCode
+	if code == CompExtensible && len(cf.extra) >= 8 {
+		// The first two bytes of the sub-format GUID.
+		code = rif.ByteOrder().Uint16(cf.extra[6:])
+	}
+
+	comm := COMM()
+	comm.ChannelCnt = cf.ChannelCnt
+	comm.BitsPerSample = cf.BitsPerSample
+	comm.SetSampleRate(float64(cf.SampleRate))
+	typ := TypeAIFF
+	switch {
+	case code == CompNone || code == CompPCM || code == CompExtensible:
+	case code == CompFloat && cf.BitsPerSample == 32:
+		typ = TypeAIFC
+		comm.Compression = AIFCFl32
+		comm.CompressionName = "32-bit floating point"
+	case code == CompFloat && cf.BitsPerSample == 64:
+		typ = TypeAIFC
+		comm.Compression = AIFCFl64
+		comm.CompressionName = "64-bit floating point"
+	default:
+		return nil, fmt.Errorf("%w: 0x%04x", ErrUnsupportedFormat, code)
+	}
+	width := int(cf.BitsPerSample+7) / 8
+	if width == 0 || cf.ChannelCnt == 0 {
+		return nil, fmt.Errorf(
+			"%d channels %d bits: %w",
+			cf.ChannelCnt,
+			cf.BitsPerSample,
+			ErrUnsupportedFormat,
+		)
+	}
+
+	block := width * int(cf.ChannelCnt)
+	pcm := data.data[:len(data.data)/block*block]
+	comm.FrameCnt = uint32(len(pcm) / block)
+	ssnd := SSND(LoadData)
+	// Never fails in LoadData mode.
+	_ = ssnd.SetData(aiffSamples(pcm, width, rif.ByteOrder() == le, typ == TypeAIFF))
+
+	chs := Chunks{comm}
+	if typ == TypeAIFC {
+		fver := RAWC(IDFVER, LoadData)
+		fver.data = be.AppendUint32(fver.data[:0], AIFCVersion1)
+		fver.size = 4
+		chs = Chunks{fver, comm}
+	}
+
+	mk := MARK()
+	if cue, ok := rif.Chunks().First(IDcue).(*ChunkCUE); ok {
+		labels := make(map[uint32]string)
+		for _, lst := range lists(rif.Chunks()) {
+			if lst.Type() != IDadtl {
+				continue
+			}
+			for _, ch := range lst.Chunks() {
+				if lab, ok := ch.(*ChunkLABL); ok {
+					labels[lab.CuePointID] = string(TrimZeroRight(lab.label))
+				}
+			}
+		}
+		for _, cp := range cue.Points {
+			if cp.ID == 0 || cp.ID > math.MaxInt16 {
+				return nil, fmt.Errorf("cue point ID %d: %w", cp.ID, ErrOutOfRange)
+			}
+			mk.Markers = append(mk.Markers, AIFFMarker{
+				ID:       uint16(cp.ID),
+				Position: cp.Position,
+				Name:     labels[cp.ID],
+			})
+		}
+	}
+
+	sm, _ := rif.Chunks().First(IDsmpl).(*ChunkSMPL)
+	wi, _ := rif.Chunks().First(IDinst).(*ChunkWaveINST)
+	var in *ChunkAIFFINST
+	if sm != nil || wi != nil {
+		in = AIFFINST()
+		in.BaseNote = 60
+		in.HighNote = 127
+		in.LowVelocity = 1
+		in.HighVelocity = 127
+	}
+	if sm != nil {
+		in.BaseNote, in.Detune = sm.pitch()
+		for i, sl := range sm.SampleLoops[:min(len(sm.SampleLoops), 2)] {
+			lp := AIFFLoop{
+				PlayMode: uint16(min(sl.Type, 1)) + AIFFForwardLooping,
+				Begin:    aiffMarker(mk, sl.Start, sl.CuePointID),
+				End:      aiffMarker(mk, sl.End+1, 0),
+			}
+			if i == 0 {
+				in.SustainLoop = lp
+			} else {
+				in.ReleaseLoop = lp
+			}
+		}
+	}
+	if wi != nil {
+		in.BaseNote = wi.UnshiftedNote
+		in.Detune = wi.FineTune
+		in.Gain = int16(wi.Gain)
+		in.LowNote = wi.LowNote
+		in.HighNote = wi.HighNote
+		in.LowVelocity = wi.LowVelocity
+		in.HighVelocity = wi.HighVelocity
+	}
+
+	if len(mk.Markers) > 0 {
+		chs = append(chs, mk)
+	}
+	if in != nil {
+		chs = append(chs, in)
+	}
+
+	seen := make(map[uint32]bool)
+	for _, lst := range lists(rif.Chunks()) {
+		if lst.Type() != IDINFO {
+			continue
+		}
+		for _, ch := range lst.Chunks() {
+			info, ok := ch.(*ChunkINFO)
+			if !ok {
+				continue
+			}
+			for _, m := range aiffInfo {
+				if m.info != info.ID() || (seen[m.aiff] && m.aiff != IDANNO) {
+					continue
+				}
+				seen[m.aiff] = true
+				txt := TEXT(m.aiff)
+				txt.SetText(string(TrimZeroRight(info.text)))
+				chs = append(chs, txt)
+			}
+		}
+	}
+
+	chs = append(chs, ssnd)
+	aif := Compose(chs)
+	aif.SetType(typ)
+	aif.SetIFF(true)
+	return aif, nil
+}
+
+// aiffLoop returns the smpl loop for the AIFF loop with the boundaries
+// from the markers. It returns nil if the loop is not playing or its
+// markers are not valid.
+func aiffLoop(mk *ChunkMARK, lp AIFFLoop) *SampleLoop {
+	if mk == nil || lp.PlayMode == AIFFNoLooping {
+		return nil
+	}
+	begin, ok := mk.Marker(lp.Begin)
+	if !ok {
+		return nil
+	}
+	end, ok := mk.Marker(lp.End)
+	if !ok || end.Position <= begin.Position {
+		return nil
+	}
+	return &SampleLoop{
+		CuePointID: uint32(lp.Begin),
+		Type:       uint32(lp.PlayMode - AIFFForwardLooping),
+		Start:      begin.Position,
+		End:        end.Position - 1,
+	}
+}
+
+// aiffMarker returns the ID of the marker at the position. The marker with
+// the preferred ID is used when it's at the position. A new marker is added
+// when there is no marker at the position.
+func aiffMarker(mk *ChunkMARK, pos, prefer uint32) uint16 {
+	var id, last uint16
+	for _, m := range mk.Markers {
+		if m.Position == pos && (id == 0 || uint32(m.ID) == prefer) {
+			id = m.ID
+		}
+		last = max(last, m.ID)
+	}
+	if id != 0 {
+		return id
+	}
+	mk.Markers = append(mk.Markers, AIFFMarker{ID: last + 1, Position: pos})
+	return last + 1
+}
+
+// aiffSamples returns a copy of the sample points converted between the
+// AIFF and WAVE layouts. The conversion is its own inverse. When swap is
+// true the byte order of the samples wider than one byte is reversed, when
+// pcm is true the 8-bit samples are converted between signed (AIFF) and
+// unsigned (WAVE).
+func aiffSamples(data []byte, width int, swap, pcm bool) []byte {
+	out := slices.Clone(data)
+	switch {
+	case width == 1 && pcm:
+		for i := range out {
+			out[i] ^= 0x80
+		}
+	case width > 1 && swap:
+		for i := 0; i+width <= len(out); i += width {
+			slices.Reverse(out[i : i+width])
+		}
+	}
+	return out
+}
+
+// pstringSize returns the size of the Pascal-style string in bytes
+// including the count byte and the padding byte.
+func pstringSize(s string) uint32 {
+	return RealSize(1 + uint32(min(len(s), math.MaxUint8)))
+}
+
+// appendPString appends the Pascal-style string, the count byte followed
+// by the text padded to even length, to b. The text longer than 255 bytes
+// is truncated.
+func appendPString(b []byte, s string) []byte {
+	s = s[:min(len(s), math.MaxUint8)]
+	b = append(b, byte(len(s)))
+	b = append(b, s...)
+	if len(s)%2 == 0 {
+		b = append(b, 0)
+	}
+	return b
+}
+
+// readPString reads the Pascal-style string from b. It returns the string
+// and the number of bytes used including the padding byte.
+func readPString(b []byte) (string, int, error) {
+	if len(b) == 0 {
+		return "", 0, ErrTooShort
+	}
+	l := int(b[0])
+	if len(b) < 1+l {
+		return "", 0, ErrTooShort
+	}
+	return string(b[1 : 1+l]), min(int(RealSize(uint32(1+l))), len(b)), nil
+}
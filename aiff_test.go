@@ -0,0 +1,404 @@
+package riff
+
+import (
+	"bytes"
+	"os"
+	"testing"
+
+	"github.com/ctx42/testing/pkg/assert"
+	"github.com/ctx42/testing/pkg/must"
+)
+
+func Test_RIFF_ReadFrom_AIFF(t *testing.T) {
+	// --- Given ---
+	src := must.Value(os.ReadFile("testdata/bloop.aif"))
+
+	// --- When ---
+	rif := New(LoadData)
+	n, err := rif.ReadFrom(bytes.NewReader(src))
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, int64(len(src)), n)
+	assert.Equal(t, IDFORM, rif.ID())
+	assert.True(t, rif.IFF())
+	assert.Equal(t, be, rif.ByteOrder())
+	assert.Equal(t, TypeAIFF, rif.Type())
+	assert.Len(t, 2, rif.Chunks())
+
+	comm := rif.Chunks().First(IDCOMM).(*ChunkCOMM)
+	assert.Equal(t, uint16(2), comm.ChannelCnt)
+	assert.Equal(t, uint32(7629), comm.FrameCnt)
+	assert.Equal(t, uint16(16), comm.BitsPerSample)
+	assert.Equal(t, 44100.0, comm.SampleRate())
+
+	ssnd := rif.Chunks().First(IDSSND).(*ChunkSSND)
+	assert.Len(t, 7629*4, ssnd.Samples())
+
+	dst := &bytes.Buffer{}
+	must.Value(rif.WriteTo(dst))
+	assert.Equal(t, src, dst.Bytes())
+}
+
+func Test_RIFF_SetIFF(t *testing.T) {
+	// --- Given ---
+	rif := New(LoadData)
+	must.Value(rif.ReadFrom(must.Value(os.Open("testdata/bloop.aif"))))
+
+	// --- When ---
+	rif.SetIFF(false)
+
+	// --- Then ---
+	assert.False(t, rif.IFF())
+	assert.Equal(t, IDRIFX, rif.ID())
+	assert.Equal(t, be, rif.ByteOrder())
+
+	rif.SetIFF(true)
+	assert.Equal(t, IDFORM, rif.ID())
+
+	rif.SetByteOrder(le)
+	assert.False(t, rif.IFF())
+	assert.Equal(t, IDRIFF, rif.ID())
+}
+
+func Test_AIFFToWAVE(t *testing.T) {
+	t.Run("bloop", func(t *testing.T) {
+		// --- Given ---
+		aif := New(LoadData)
+		must.Value(aif.ReadFrom(must.Value(os.Open("testdata/bloop.aif"))))
+		ssnd := aif.Chunks().First(IDSSND).(*ChunkSSND)
+
+		// --- When ---
+		wav, err := AIFFToWAVE(aif)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, IDRIFF, wav.ID())
+		assert.Equal(t, TypeWAVE, wav.Type())
+
+		cf := wav.Chunks().First(IDfmt).(*ChunkFMT)
+		assert.Equal(t, CompPCM, cf.CompCode)
+		assert.Equal(t, uint16(2), cf.ChannelCnt)
+		assert.Equal(t, uint32(44100), cf.SampleRate)
+		assert.Equal(t, uint32(176400), cf.AvgByteRate)
+		assert.Equal(t, uint16(4), cf.BlockAlign)
+		assert.Equal(t, uint16(16), cf.BitsPerSample)
+
+		data := wav.Chunks().First(IDdata).(*ChunkDATA)
+		assert.Len(t, 7629*4, data.data)
+		// Big-endian 0xffea becomes little-endian.
+		assert.Equal(t, ssnd.Samples()[:2], []byte{0xff, 0xea})
+		assert.Equal(t, []byte{0xea, 0xff}, data.data[:2])
+	})
+
+	t.Run("round trip", func(t *testing.T) {
+		// --- Given ---
+		src := must.Value(os.ReadFile("testdata/bloop.aif"))
+		aif := New(LoadData)
+		must.Value(aif.ReadFrom(bytes.NewReader(src)))
+
+		// --- When ---
+		wav, err := AIFFToWAVE(aif)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		have, err := WAVEToAIFF(wav)
+		assert.NoError(t, err)
+		dst := &bytes.Buffer{}
+		must.Value(have.WriteTo(dst))
+		assert.Equal(t, src, dst.Bytes())
+	})
+
+	t.Run("markers, instrument and text", func(t *testing.T) {
+		// --- Given ---
+		comm := COMM()
+		comm.ChannelCnt = 1
+		comm.FrameCnt = 4
+		comm.BitsPerSample = 8
+		comm.SetSampleRate(8000)
+
+		ssnd := SSND(LoadData)
+		must.Nil(ssnd.SetData([]byte{0x00, 0x7f, 0x80, 0xff}))
+
+		mk := MARK()
+		mk.Markers = []AIFFMarker{
+			{ID: 1, Position: 1, Name: "begin"},
+			{ID: 2, Position: 3, Name: "end"},
+		}
+
+		in := AIFFINST()
+		in.BaseNote = 60
+		in.Detune = 10
+		in.HighNote = 127
+		in.LowVelocity = 1
+		in.HighVelocity = 127
+		in.SustainLoop = AIFFLoop{PlayMode: AIFFForwardLooping, Begin: 1, End: 2}
+
+		name := TEXT(IDNAME)
+		name.SetText("bloop")
+
+		aif := Compose(Chunks{comm, mk, in, name, ssnd})
+		aif.SetType(TypeAIFF)
+		aif.SetIFF(true)
+
+		// --- When ---
+		wav, err := AIFFToWAVE(aif)
+
+		// --- Then ---
+		assert.NoError(t, err)
+
+		data := wav.Chunks().First(IDdata).(*ChunkDATA)
+		assert.Equal(t, []byte{0x80, 0xff, 0x00, 0x7f}, data.data)
+
+		cue := wav.Chunks().First(IDcue).(*ChunkCUE)
+		assert.Len(t, 2, cue.Points)
+		assert.Equal(t, uint32(1), cue.Points[0].ID)
+		assert.Equal(t, uint32(3), cue.Points[1].Position)
+
+		sm := wav.Chunks().First(IDsmpl).(*ChunkSMPL)
+		assert.Equal(t, uint32(59), sm.MIDIUnityNote)
+		assert.Len(t, 1, sm.SampleLoops)
+		assert.Equal(t, uint32(1), sm.SampleLoops[0].Start)
+		assert.Equal(t, uint32(2), sm.SampleLoops[0].End)
+
+		wi := wav.Chunks().First(IDinst).(*ChunkWaveINST)
+		assert.Equal(t, uint8(60), wi.UnshiftedNote)
+		assert.Equal(t, int8(10), wi.FineTune)
+
+		back, err := WAVEToAIFF(wav)
+		assert.NoError(t, err)
+		assert.Equal(t, mk, back.Chunks().First(IDMARK))
+		assert.Equal(t, in, back.Chunks().First(IDINST))
+		assert.Equal(t, "bloop", back.Chunks().First(IDNAME).(*ChunkTEXT).Text())
+		assert.Equal(t, ssnd.Samples(), back.Chunks().First(IDSSND).(*ChunkSSND).Samples())
+	})
+
+	t.Run("reuse", func(t *testing.T) {
+		// --- Given ---
+		comm := COMM()
+		comm.ChannelCnt = 1
+		comm.FrameCnt = 2
+		comm.BitsPerSample = 8
+		comm.SetSampleRate(8000)
+
+		ssnd := SSND(LoadData)
+		must.Nil(ssnd.SetData([]byte{0x00, 0x7f}))
+
+		mk := MARK()
+		mk.Markers = []AIFFMarker{{ID: 1, Position: 1, Name: "begin"}}
+
+		name := TEXT(IDNAME)
+		name.SetText("bloop")
+
+		aif := Compose(Chunks{comm, mk, name, ssnd})
+		aif.SetType(TypeAIFF)
+		aif.SetIFF(true)
+
+		wav := must.Value(AIFFToWAVE(aif))
+		buf := &bytes.Buffer{}
+		must.Value(wav.WriteTo(buf))
+		src := buf.Bytes()
+
+		// --- When ---
+		_, err := wav.ReadFrom(bytes.NewReader(src))
+
+		// --- Then ---
+		assert.NoError(t, err)
+		dst := &bytes.Buffer{}
+		must.Value(wav.WriteTo(dst))
+		assert.Equal(t, src, dst.Bytes())
+	})
+
+	t.Run("float", func(t *testing.T) {
+		// --- Given ---
+		comm := COMM()
+		comm.ChannelCnt = 1
+		comm.FrameCnt = 1
+		comm.BitsPerSample = 32
+		comm.SetSampleRate(8000)
+		comm.Compression = AIFCFl32
+
+		ssnd := SSND(LoadData)
+		must.Nil(ssnd.SetData([]byte{0x3f, 0x80, 0, 0}))
+
+		aif := Compose(Chunks{comm, ssnd})
+		aif.SetType(TypeAIFC)
+
+		// --- When ---
+		wav, err := AIFFToWAVE(aif)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		cf := wav.Chunks().First(IDfmt).(*ChunkFMT)
+		assert.Equal(t, CompFloat, cf.CompCode)
+		data := wav.Chunks().First(IDdata).(*ChunkDATA)
+		assert.Equal(t, []byte{0, 0, 0x80, 0x3f}, data.data)
+
+		back, err := WAVEToAIFF(wav)
+		assert.NoError(t, err)
+		assert.Equal(t, TypeAIFC, back.Type())
+		assert.Equal(t, AIFCFl32, back.Chunks().First(IDCOMM).(*ChunkCOMM).Compression)
+		assert.Equal(t, 1, back.Chunks().Count(IDFVER))
+	})
+}
+
+func Test_AIFFToWAVE_Errors(t *testing.T) {
+	t.Run("not AIFF", func(t *testing.T) {
+		// --- Given ---
+		rif := Compose(nil)
+		rif.SetType(TypeWAVE)
+
+		// --- When ---
+		_, err := AIFFToWAVE(rif)
+
+		// --- Then ---
+		assert.Error(t, err)
+	})
+
+	t.Run("missing COMM", func(t *testing.T) {
+		// --- Given ---
+		rif := Compose(Chunks{SSND(LoadData)})
+		rif.SetType(TypeAIFF)
+
+		// --- When ---
+		_, err := AIFFToWAVE(rif)
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrUnsupportedFormat, err)
+	})
+
+	t.Run("skip data", func(t *testing.T) {
+		// --- Given ---
+		rif := Compose(Chunks{COMM(), SSND(SkipData)})
+		rif.SetType(TypeAIFF)
+
+		// --- When ---
+		_, err := AIFFToWAVE(rif)
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrSkipDataMode, err)
+	})
+
+	t.Run("compressed", func(t *testing.T) {
+		// --- Given ---
+		comm := COMM()
+		comm.ChannelCnt = 1
+		comm.BitsPerSample = 8
+		comm.Compression = AIFCULaw
+		rif := Compose(Chunks{comm, SSND(LoadData)})
+		rif.SetType(TypeAIFC)
+
+		// --- When ---
+		_, err := AIFFToWAVE(rif)
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrUnsupportedFormat, err)
+	})
+}
+
+func Test_WAVEToAIFF(t *testing.T) {
+	// --- Given ---
+	wav := New(LoadData)
+	must.Value(wav.ReadFrom(must.Value(os.Open("testdata/kick-16b441k.wav"))))
+	data := wav.Chunks().First(IDdata).(*ChunkDATA)
+
+	// --- When ---
+	aif, err := WAVEToAIFF(wav)
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, IDFORM, aif.ID())
+	assert.Equal(t, TypeAIFF, aif.Type())
+
+	dst := &bytes.Buffer{}
+	must.Value(aif.WriteTo(dst))
+	got := New(LoadData)
+	must.Value(got.ReadFrom(dst))
+	back, err := AIFFToWAVE(got)
+	assert.NoError(t, err)
+	assert.Equal(t, data.data, back.Chunks().First(IDdata).(*ChunkDATA).data)
+}
+
+func Test_WAVEToAIFF_RIFX(t *testing.T) {
+	// --- Given ---
+	cf := FMT()
+	cf.CompCode = CompExtensible
+	cf.ChannelCnt = 1
+	cf.SampleRate = 8000
+	cf.AvgByteRate = 16000
+	cf.BlockAlign = 2
+	cf.BitsPerSample = 16
+	extra := make([]byte, 22)
+	be.PutUint16(extra[6:], CompPCM) // Sub-format GUID in file byte order.
+	cf.SetExtra(extra)
+
+	data := DATA(LoadData)
+	must.Nil(data.SetData([]byte{0x12, 0x34, 0xff, 0xfe}))
+
+	wav := Compose(Chunks{cf, data})
+	wav.SetType(TypeWAVE)
+	wav.SetByteOrder(be)
+
+	// --- When ---
+	aif, err := WAVEToAIFF(wav)
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, TypeAIFF, aif.Type())
+	ssnd := aif.Chunks().First(IDSSND).(*ChunkSSND)
+	assert.Equal(t, []byte{0x12, 0x34, 0xff, 0xfe}, ssnd.Samples())
+}
+
+func Test_WAVEToAIFF_Errors(t *testing.T) {
+	t.Run("not WAVE", func(t *testing.T) {
+		// --- Given ---
+		rif := Compose(nil)
+		rif.SetType(TypeAIFF)
+
+		// --- When ---
+		_, err := WAVEToAIFF(rif)
+
+		// --- Then ---
+		assert.Error(t, err)
+	})
+
+	t.Run("compressed", func(t *testing.T) {
+		// --- Given ---
+		wav := New(LoadData)
+		must.Value(wav.ReadFrom(must.Value(os.Open("testdata/8kulaw.wav"))))
+
+		// --- When ---
+		_, err := WAVEToAIFF(wav)
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrUnsupportedFormat, err)
+	})
+}
+
+func Test_pstring(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		s   string
+		exp []byte
+	}{
+		{"empty", "", []byte{0, 0}},
+		{"odd", "a", []byte{1, 'a'}},
+		{"even", "ab", []byte{2, 'a', 'b', 0}},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			have := appendPString(nil, tc.s)
+
+			// --- Then ---
+			assert.Equal(t, tc.exp, have)
+			assert.Equal(t, uint32(len(tc.exp)), pstringSize(tc.s))
+			s, n, err := readPString(have)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.s, s)
+			assert.Equal(t, len(tc.exp), n)
+		})
+	}
+}
@@ -0,0 +1,173 @@
+package riff
+
+import (
+	"fmt"
+	"math"
+	"time"
+)
+
+// ANIDefaultJifRate represents the default display rate of the animation
+// step in jiffies used by [ComposeACON].
+const ANIDefaultJifRate uint32 = 10
+
+// ANIStep represents a single step of the cursor animation.
+type ANIStep struct {
+	// Index of the frame displayed in the step.
+	Frame int
+
+	// Display duration of the step.
+	Duration time.Duration
+}
+
+// ANI represents decoded chunks of the "RIFF ACON" (animated cursor) file.
+type ANI struct {
+	// Animated cursor header.
+	Header *ChunkANIH
+
+	// Display rates of the steps. Nil if the "rate" chunk is missing.
+	Rate *ChunkRATE
+
+	// Frame sequence. Nil if the "seq " chunk is missing.
+	Seq *ChunkSEQ
+
+	// Frames from the "LIST fram" chunk.
+	Icons []*ChunkICON
+
+	// The "LIST INFO" chunk of the file. Nil if the file doesn't have one.
+	Info *ChunkLIST
+}
+
+// NewANI returns chunks decoded from the animated cursor file.
+func NewANI(rif *RIFF) (*ANI, error) {
+	if rif.Type() != TypeACON {
+		return nil, fmt.Errorf("expected %s form got %s", Uint32(TypeACON), Uint32(rif.Type()))
+	}
+
+	anih, ok := rif.Chunks().First(IDanih).(*ChunkANIH)
+	if !ok {
+		return nil, fmt.Errorf("missing %s chunk: %w", Uint32(IDanih), ErrACONInvalid)
+	}
+
+	ani := &ANI{Header: anih}
+	ani.Rate, _ = rif.Chunks().First(IDrate).(*ChunkRATE)
+	ani.Seq, _ = rif.Chunks().First(IDseq).(*ChunkSEQ)
+	for _, ch := range rif.Chunks() {
+		lst, ok := ch.(*ChunkLIST)
+		if !ok {
+			continue
+		}
+		switch lst.Type() {
+		case IDfram:
+			for _, sub := range lst.Chunks() {
+				if icon, ok := sub.(*ChunkICON); ok {
+					ani.Icons = append(ani.Icons, icon)
+				}
+			}
+		case IDINFO:
+			if ani.Info == nil {
+				ani.Info = lst
+			}
+		}
+	}
+
+	return ani, nil
+}
+
+// Steps returns the animation steps in the display order. The order is
+// defined by the "seq " chunk and the durations by the "rate" chunk. When
+// the chunks are missing the frames are displayed in order with the
+// default rate from the header.
+func (ani *ANI) Steps() ([]ANIStep, error) {
+	cnt := int(ani.Header.Steps)
+	if ani.Seq != nil && len(ani.Seq.Frames) != cnt {
+		return nil, fmt.Errorf("expected %d sequence entries got %d: %w", cnt, len(ani.Seq.Frames), ErrACONInvalid)
+	}
+	if ani.Rate != nil && len(ani.Rate.Rates) != cnt {
+		return nil, fmt.Errorf("expected %d rate entries got %d: %w", cnt, len(ani.Rate.Rates), ErrACONInvalid)
+	}
+
+	if ani.Seq == nil && cnt > len(ani.Icons) {
+		return nil, fmt.Errorf("%d steps for %d frames: %w", cnt, len(ani.Icons), ErrOutOfRange)
+	}
+
+	steps := make([]ANIStep, cnt)
+	for i := range steps {
+		st := ANIStep{Frame: i, Duration: time.Duration(ani.Header.JifRate) * ANIJiffy}
+		if ani.Seq != nil {
+			st.Frame = int(ani.Seq.Frames[i])
+		}
+		if ani.Rate != nil {
+			st.Duration = time.Duration(ani.Rate.Rates[i]) * ANIJiffy
+		}
+		if st.Frame >= len(ani.Icons) {
+			return nil, fmt.Errorf("step %d frame %d: %w", i, st.Frame, ErrOutOfRange)
+		}
+		steps[i] = st
+	}
+	return steps, nil
+}
+
+// ComposeACON returns the animated cursor file with given frames in the
+// ICO or CUR file format. The steps define the display order and
+// durations (rounded to jiffies). When no steps are given each frame is
+// displayed once with [ANIDefaultJifRate]. The "rate" and "seq " chunks
+// are added only when needed. The chs (e.g. "LIST INFO") are placed
+// before the header.
+func ComposeACON(icons [][]byte, steps []ANIStep, chs ...Chunk) (*RIFF, error) {
+	if len(icons) == 0 {
+		return nil, fmt.Errorf("no frames: %w", ErrACONInvalid)
+	}
+	if len(steps) == 0 {
+		for i := range icons {
+			dur := time.Duration(ANIDefaultJifRate) * ANIJiffy
+			steps = append(steps, ANIStep{Frame: i, Duration: dur})
+		}
+	}
+
+	fram := LIST(LoadData, NewRegistry(RAWCMake(LoadData)))
+	fram.ListType = IDfram
+	var frames Chunks
+	for i, b := range icons {
+		icon := ICON(LoadData)
+		if err := icon.SetData(b); err != nil {
+			return nil, fmt.Errorf("frame %d: %w", i, err)
+		}
+		frames = append(frames, icon)
+	}
+	fram.Modify(frames)
+
+	rate, seq := RATE(), SEQ()
+	var varRate, varSeq bool
+	for i, st := range steps {
+		if st.Frame < 0 || st.Frame >= len(icons) {
+			return nil, fmt.Errorf("step %d frame %d: %w", i, st.Frame, ErrOutOfRange)
+		}
+		jif := uint32(math.Round(float64(st.Duration) / float64(ANIJiffy)))
+		rate.Rates = append(rate.Rates, jif)
+		seq.Frames = append(seq.Frames, uint32(st.Frame))
+		varRate = varRate || jif != rate.Rates[0]
+		varSeq = varSeq || st.Frame != i
+	}
+	varSeq = varSeq || len(steps) != len(icons)
+
+	anih := ANIH()
+	anih.Frames = uint32(len(icons))
+	anih.Steps = uint32(len(steps))
+	anih.JifRate = rate.Rates[0]
+	anih.Flags = ANIFIcon
+
+	all := append(Chunks{}, chs...)
+	all = append(all, anih)
+	if varRate {
+		all = append(all, rate)
+	}
+	if varSeq {
+		anih.Flags |= ANIFSequence
+		all = append(all, seq)
+	}
+	all = append(all, fram)
+
+	rif := Compose(all)
+	rif.SetType(TypeACON)
+	return rif, nil
+}
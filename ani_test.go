@@ -0,0 +1,219 @@
+package riff
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/ctx42/testing/pkg/assert"
+	"github.com/ctx42/testing/pkg/must"
+)
+
+// readANI decodes the animated cursor file.
+func readANI(t *testing.T, rif *RIFF) *ANI {
+	t.Helper()
+	buf := &bytes.Buffer{}
+	must.Value(rif.WriteTo(buf))
+	dec := New(LoadData)
+	must.Value(dec.ReadFrom(buf))
+	return must.Value(NewANI(dec))
+}
+
+func Test_NewANI(t *testing.T) {
+	// --- Given ---
+	inam := INFO(LabINAM)
+	inam.text, inam.size = []byte("Cursor\x00"), 7
+	info := LIST(LoadData, nil)
+	info.ListType = IDINFO
+	info.Modify(Chunks{inam})
+	rif := must.Value(ComposeACON([][]byte{icoFile(1), icoFile(2)}, nil, info))
+
+	// --- When ---
+	ani := readANI(t, rif)
+
+	// --- Then ---
+	assert.Equal(t, uint32(2), ani.Header.Frames)
+	assert.Equal(t, uint32(2), ani.Header.Steps)
+	assert.Equal(t, ANIDefaultJifRate, ani.Header.JifRate)
+	assert.Equal(t, ANIFIcon, ani.Header.Flags)
+	assert.Nil(t, ani.Rate)
+	assert.Nil(t, ani.Seq)
+	assert.Len(t, 2, ani.Icons)
+	assert.Equal(t, icoFile(1), ani.Icons[0].Data())
+	assert.Equal(t, icoFile(2), ani.Icons[1].Data())
+	assert.NotNil(t, ani.Info)
+	assert.Equal(t, LabINAM, ani.Info.Chunks()[0].ID())
+}
+
+func Test_NewANI_Errors(t *testing.T) {
+	t.Run("form type", func(t *testing.T) {
+		// --- Given ---
+		rif := Compose(nil)
+		rif.SetType(TypeWAVE)
+
+		// --- When ---
+		ani, err := NewANI(rif)
+
+		// --- Then ---
+		assert.ErrorEqual(t, "expected ACON form got WAVE", err)
+		assert.Nil(t, ani)
+	})
+
+	t.Run("missing header", func(t *testing.T) {
+		// --- Given ---
+		rif := Compose(nil)
+		rif.SetType(TypeACON)
+
+		// --- When ---
+		ani, err := NewANI(rif)
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrACONInvalid, err)
+		assert.Nil(t, ani)
+	})
+}
+
+func Test_ANI_Steps(t *testing.T) {
+	t.Run("default", func(t *testing.T) {
+		// --- Given ---
+		icons := [][]byte{icoFile(1), icoFile(2)}
+		ani := readANI(t, must.Value(ComposeACON(icons, nil)))
+
+		// --- When ---
+		have, err := ani.Steps()
+
+		// --- Then ---
+		assert.NoError(t, err)
+		exp := []ANIStep{
+			{Frame: 0, Duration: 10 * ANIJiffy},
+			{Frame: 1, Duration: 10 * ANIJiffy},
+		}
+		assert.Equal(t, exp, have)
+	})
+
+	t.Run("rate and sequence", func(t *testing.T) {
+		// --- Given ---
+		icons := [][]byte{icoFile(1), icoFile(2)}
+		steps := []ANIStep{
+			{Frame: 1, Duration: 100 * time.Millisecond},
+			{Frame: 0, Duration: 200 * time.Millisecond},
+			{Frame: 1, Duration: 100 * time.Millisecond},
+		}
+		ani := readANI(t, must.Value(ComposeACON(icons, steps)))
+
+		// --- When ---
+		have, err := ani.Steps()
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, ANIFIcon|ANIFSequence, ani.Header.Flags)
+		assert.Equal(t, []uint32{6, 12, 6}, ani.Rate.Rates)
+		assert.Equal(t, []uint32{1, 0, 1}, ani.Seq.Frames)
+		exp := []ANIStep{
+			{Frame: 1, Duration: 6 * ANIJiffy},
+			{Frame: 0, Duration: 12 * ANIJiffy},
+			{Frame: 1, Duration: 6 * ANIJiffy},
+		}
+		assert.Equal(t, exp, have)
+	})
+}
+
+func Test_ANI_Steps_Errors(t *testing.T) {
+	t.Run("sequence length", func(t *testing.T) {
+		// --- Given ---
+		ani := &ANI{Header: ANIH(), Seq: SEQ()}
+		ani.Header.Steps = 1
+
+		// --- When ---
+		have, err := ani.Steps()
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrACONInvalid, err)
+		assert.Nil(t, have)
+	})
+
+	t.Run("rate length", func(t *testing.T) {
+		// --- Given ---
+		ani := &ANI{Header: ANIH(), Rate: RATE()}
+		ani.Header.Steps = 1
+
+		// --- When ---
+		have, err := ani.Steps()
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrACONInvalid, err)
+		assert.Nil(t, have)
+	})
+
+	t.Run("frame out of range", func(t *testing.T) {
+		// --- Given ---
+		ani := &ANI{Header: ANIH(), Icons: []*ChunkICON{ICON(LoadData)}}
+		ani.Header.Steps = 2
+
+		// --- When ---
+		have, err := ani.Steps()
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrOutOfRange, err)
+		assert.Nil(t, have)
+	})
+
+	t.Run("steps out of range without sequence", func(t *testing.T) {
+		// --- Given ---
+		ani := &ANI{Header: ANIH(), Icons: []*ChunkICON{ICON(LoadData)}}
+		ani.Header.Steps = 0xFFFFFFFF
+
+		// --- When ---
+		have, err := ani.Steps()
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrOutOfRange, err)
+		assert.Nil(t, have)
+	})
+
+	t.Run("frame out of range in sequence", func(t *testing.T) {
+		// --- Given ---
+		ani := &ANI{Header: ANIH(), Seq: SEQ(), Icons: []*ChunkICON{ICON(LoadData)}}
+		ani.Header.Steps = 1
+		ani.Seq.Frames = []uint32{1}
+
+		// --- When ---
+		have, err := ani.Steps()
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrOutOfRange, err)
+		assert.Nil(t, have)
+	})
+}
+
+func Test_ComposeACON_Errors(t *testing.T) {
+	t.Run("no frames", func(t *testing.T) {
+		// --- When ---
+		rif, err := ComposeACON(nil, nil)
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrACONInvalid, err)
+		assert.Nil(t, rif)
+	})
+
+	t.Run("invalid frame", func(t *testing.T) {
+		// --- When ---
+		rif, err := ComposeACON([][]byte{{1, 2}}, nil)
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrACONInvalid, err)
+		assert.Nil(t, rif)
+	})
+
+	t.Run("step frame out of range", func(t *testing.T) {
+		// --- Given ---
+		steps := []ANIStep{{Frame: 1, Duration: time.Second}}
+
+		// --- When ---
+		rif, err := ComposeACON([][]byte{icoFile(1)}, steps)
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrOutOfRange, err)
+		assert.Nil(t, rif)
+	})
+}
@@ -0,0 +1,118 @@
+package riff
+
+import (
+	"fmt"
+)
+
+// AVIStream represents a stream of the AVI file described by the
+// "LIST strl" chunk.
+type AVIStream struct {
+	// Stream header.
+	Header *ChunkSTRH
+
+	// Stream format. Nil if the "strf" chunk is missing.
+	Format *ChunkSTRF
+
+	// Stream name. Empty if the "strn" chunk is missing.
+	Name string
+
+	// OpenDML super index. Nil if the "indx" chunk is missing.
+	Index *ChunkINDX
+}
+
+// Codec returns the FourCC of the stream codec. For video streams it's the
+// compression from the format, for other streams the handler from the
+// stream header.
+func (st AVIStream) Codec() uint32 {
+	if st.Format != nil && st.Format.Video != nil && st.Format.Video.Compression != 0 {
+		return st.Format.Video.Compression
+	}
+	return st.Header.FccHandler
+}
+
+// AVI represents decoded headers of the "RIFF AVI " file.
+type AVI struct {
+	// Main AVI header.
+	Header *ChunkAVIH
+
+	// Streams in the order they are defined in the file.
+	Streams []AVIStream
+
+	// OpenDML extended header. Nil if the "LIST odml" chunk is missing.
+	ODML *ChunkDMLH
+
+	// The "LIST INFO" chunk of the file. Nil if the file doesn't have one.
+	Info *ChunkLIST
+}
+
+// TotalFrames returns the total number of frames in the file. For OpenDML
+// files it's the number of frames in all the RIFF forms.
+func (avi *AVI) TotalFrames() uint32 {
+	if avi.ODML != nil {
+		return avi.ODML.TotalFrames
+	}
+	return avi.Header.TotalFrames
+}
+
+// NewAVI returns headers decoded from the "LIST hdrl" chunk of the AVI file.
+func NewAVI(rif *RIFF) (*AVI, error) {
+	if rif.Type() != TypeAVI {
+		return nil, fmt.Errorf("expected %s form got %s", Uint32(TypeAVI), Uint32(rif.Type()))
+	}
+
+	var hdrl Chunk
+	var info *ChunkLIST
+	for _, ch := range rif.Chunks() {
+		if ch.ID() != IDLIST {
+			continue
+		}
+		if ch.Type() == IDhdrl && hdrl == nil {
+			hdrl = ch
+		}
+		if lst, ok := ch.(*ChunkLIST); ok && ch.Type() == IDINFO && info == nil {
+			info = lst
+		}
+	}
+	if hdrl == nil {
+		return nil, fmt.Errorf("missing %s list: %w", Uint32(IDhdrl), ErrAVIInvalid)
+	}
+
+	avih, ok := hdrl.Chunks().First(IDavih).(*ChunkAVIH)
+	if !ok {
+		return nil, fmt.Errorf("missing %s chunk: %w", Uint32(IDavih), ErrAVIInvalid)
+	}
+
+	avi := &AVI{Header: avih, Info: info}
+	for _, ch := range hdrl.Chunks() {
+		if ch.ID() == IDLIST && ch.Type() == IDodml {
+			avi.ODML, _ = ch.Chunks().First(IDdmlh).(*ChunkDMLH)
+		}
+		if ch.ID() != IDLIST || ch.Type() != IDstrl {
+			continue
+		}
+		strh, ok := ch.Chunks().First(IDstrh).(*ChunkSTRH)
+		if !ok {
+			return nil, fmt.Errorf("missing %s chunk: %w", Uint32(IDstrh), ErrAVIInvalid)
+		}
+		st := AVIStream{Header: strh}
+		st.Format, _ = ch.Chunks().First(IDstrf).(*ChunkSTRF)
+		if strn, ok := ch.Chunks().First(IDstrn).(*ChunkSTRN); ok {
+			st.Name = strn.Name()
+		}
+		st.Index, _ = ch.Chunks().First(IDindx).(*ChunkINDX)
+		avi.Streams = append(avi.Streams, st)
+	}
+
+	return avi, nil
+}
+
+// Stream returns the first stream of given type (see Stream* constants)
+// and its index. Returns nil and -1 if there is no such stream.
+func (avi *AVI) Stream(streamType uint32) (*AVIStream, int) {
+	for i := range avi.Streams {
+		if avi.Streams[i].Header.FccType == streamType {
+			return &avi.Streams[i], i
+		}
+	}
+	return nil, -1
+}
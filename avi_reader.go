@@ -0,0 +1,244 @@
+package riff
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"time"
+)
+
+// IDmovi represents "movi" (AVI data) type of the LIST chunk.
+const IDmovi uint32 = 0x6d6f7669
+
+// AVIFrame represents data chunk of the AVI stream.
+type AVIFrame struct {
+	// ID of the data chunk (e.g. "00dc" or "01wb").
+	ChunkID uint32
+
+	// Bitwise combination of AVIIF* flags.
+	Flags uint32
+
+	// Offset of the chunk data from the beginning of the file.
+	Offset int64
+
+	// Size of the chunk data in bytes.
+	Size uint32
+}
+
+// IsKeyframe returns true if the frame is marked as key frame.
+func (f AVIFrame) IsKeyframe() bool {
+	return f.Flags&AVIIFKeyframe != 0
+}
+
+// AVIReader provides random access to the data chunks of the AVI file.
+// For OpenDML files the data chunks from all the RIFF forms are accessible
+// using the super index of the stream.
+type AVIReader struct {
+	// Decoded AVI headers.
+	*AVI
+
+	// The "idx1" index as read from the file or rebuilt from the
+	// "LIST movi" chunk when the file doesn't have one.
+	Index *ChunkIDX1
+
+	// Source of the file.
+	ra io.ReaderAt
+
+	// Offset of the "movi" list type from the beginning of the file.
+	movi int64
+
+	// Data chunks of each stream in the index order.
+	frames [][]AVIFrame
+}
+
+// NewAVIReader returns a new instance of [AVIReader] for the AVI file
+// read from ra. The chunk data is not loaded to memory.
+func NewAVIReader(ra io.ReaderAt) (*AVIReader, error) {
+	fs, err := ReadForms(io.NewSectionReader(ra, 0, math.MaxInt64), SkipData)
+	if err != nil {
+		return nil, err
+	}
+	rif := fs[0]
+
+	avi, err := NewAVI(rif)
+	if err != nil {
+		return nil, err
+	}
+	rd := &AVIReader{
+		AVI:    avi,
+		ra:     ra,
+		movi:   -1,
+		frames: make([][]AVIFrame, len(avi.Streams)),
+	}
+
+	var movi Chunk
+	off := int64(12) // RIFF ID, size and form type.
+	for _, ch := range rif.Chunks() {
+		if ch.ID() == IDLIST && ch.Type() == IDmovi {
+			movi = ch
+			rd.movi = off + 8
+			break
+		}
+		off += 8 + int64(RealSize(ch.Size()))
+	}
+	if movi == nil {
+		return nil, fmt.Errorf("missing %s list: %w", Uint32(IDmovi), ErrAVIInvalid)
+	}
+
+	var ok bool
+	if rd.Index, ok = rif.Chunks().First(IDidx1).(*ChunkIDX1); !ok {
+		if rd.Index, err = IndexMovi(movi); err != nil {
+			return nil, err
+		}
+	}
+	if err = rd.resolve(); err != nil {
+		return nil, err
+	}
+	for i := range rd.Streams {
+		if err = rd.resolveSuper(i); err != nil {
+			return nil, err
+		}
+	}
+	return rd, nil
+}
+
+// resolve resolves offsets of the index entries and groups them by
+// the stream.
+func (rd *AVIReader) resolve() error {
+	base := rd.movi
+	for _, e := range rd.Index.Entries {
+		if e.Stream() < 0 {
+			continue
+		}
+		// Detect if the offsets are relative to the beginning of the file
+		// by checking the chunk ID at the first entry offset.
+		var err error
+		if base, err = rd.base(e); err != nil {
+			return err
+		}
+		break
+	}
+
+	for _, e := range rd.Index.Entries {
+		st := e.Stream()
+		if st < 0 || st >= len(rd.frames) {
+			continue
+		}
+		rd.frames[st] = append(rd.frames[st], AVIFrame{
+			ChunkID: e.ChunkID,
+			Flags:   e.Flags,
+			Offset:  base + int64(e.Offset) + 8,
+			Size:    e.Size,
+		})
+	}
+	return nil
+}
+
+// resolveSuper replaces the data chunks of the stream with the ones
+// referenced by the OpenDML super index. Does nothing if the stream
+// doesn't have the super index.
+func (rd *AVIReader) resolveSuper(stream int) error {
+	sup := rd.Streams[stream].Index
+	if sup == nil || sup.IndexType != AVIIndexOfIndexes || len(sup.Super) == 0 {
+		return nil
+	}
+
+	var frs []AVIFrame
+	for _, se := range sup.Super {
+		src := io.NewSectionReader(rd.ra, int64(se.Offset), int64(se.Size))
+		var id uint32
+		if err := ReadChunkID(src, &id); err != nil {
+			return fmt.Errorf("stream %d index: %w", stream, err)
+		}
+		if !IsStdIndexID(id) {
+			return fmt.Errorf("stream %d index points to %s: %w", stream, Uint32(id), ErrAVIInvalid)
+		}
+		ix := INDX(id)
+		if _, err := ix.ReadFrom(src); err != nil {
+			return fmt.Errorf("stream %d index: %w", stream, err)
+		}
+		for _, e := range ix.Std {
+			fr := AVIFrame{
+				ChunkID: ix.ChunkID,
+				Offset:  int64(ix.BaseOffset) + int64(e.Offset),
+				Size:    e.DataSize(),
+			}
+			if e.IsKeyframe() {
+				fr.Flags = AVIIFKeyframe
+			}
+			frs = append(frs, fr)
+		}
+	}
+	rd.frames[stream] = frs
+	return nil
+}
+
+// base returns the base offset for index entry offsets.
+func (rd *AVIReader) base(e AVIIndexEntry) (int64, error) {
+	for _, base := range []int64{rd.movi, 0} {
+		var id uint32
+		err := ReadChunkID(io.NewSectionReader(rd.ra, base+int64(e.Offset), 4), &id)
+		if err == nil && id == e.ChunkID {
+			return base, nil
+		}
+	}
+	return 0, fmt.Errorf("index entry %s doesn't point to a chunk: %w", Uint32(e.ChunkID), ErrAVIInvalid)
+}
+
+// Frames returns data chunks of the stream in the index order.
+func (rd *AVIReader) Frames(stream int) []AVIFrame {
+	if stream < 0 || stream >= len(rd.frames) {
+		return nil
+	}
+	return rd.frames[stream]
+}
+
+// Frame returns reader for the data of the n-th chunk of the stream.
+func (rd *AVIReader) Frame(stream, n int) (*io.SectionReader, error) {
+	frs := rd.Frames(stream)
+	if n < 0 || n >= len(frs) {
+		return nil, fmt.Errorf("frame %d of stream %d: %w", n, stream, ErrOutOfRange)
+	}
+	return io.NewSectionReader(rd.ra, frs[n].Offset, int64(frs[n].Size)), nil
+}
+
+// Keyframe returns the number of the nearest key frame at or before the
+// time at and the reader for its data. The sample at given time is
+// calculated using the stream rate and scale. For the streams with fixed
+// sample size (e.g. PCM audio) the data chunks hold many samples, the
+// chunk holding the sample is found by its size.
+func (rd *AVIReader) Keyframe(stream int, at time.Duration) (*io.SectionReader, int, error) {
+	frs := rd.Frames(stream)
+	if len(frs) == 0 {
+		return nil, -1, fmt.Errorf("stream %d: %w", stream, ErrOutOfRange)
+	}
+
+	strh := rd.Streams[stream].Header
+	n := len(frs) - 1
+	if strh.Scale != 0 {
+		pos := int64(at.Seconds()*strh.SampleRate()) - int64(strh.Start)
+		if strh.SampleSize == 0 {
+			n = min(n, int(pos))
+		} else if pos < 0 {
+			n = -1
+		} else {
+			var cnt int64
+			for i := range frs {
+				cnt += int64(frs[i].Size / strh.SampleSize)
+				if cnt > pos {
+					n = i
+					break
+				}
+			}
+		}
+	}
+
+	// Find the last key frame not after n.
+	for i := n; i >= 0; i-- {
+		if frs[i].IsKeyframe() {
+			sr, err := rd.Frame(stream, i)
+			return sr, i, err
+		}
+	}
+	return nil, -1, fmt.Errorf("no key frame at %s in stream %d: %w", at, stream, ErrOutOfRange)
+}
@@ -0,0 +1,375 @@
+package riff
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/ctx42/testing/pkg/assert"
+	"github.com/ctx42/testing/pkg/must"
+)
+
+// sampleAVI returns content of the testdata/sample.avi file. The "idx1"
+// chunk starts at offset 228752 and "movi" list type at offset 10248.
+func sampleAVI(t *testing.T) []byte {
+	t.Helper()
+	return must.Value(os.ReadFile("testdata/sample.avi"))
+}
+
+func Test_NewAVIReader(t *testing.T) {
+	// --- Given ---
+	fil := must.Value(os.Open("testdata/sample.avi"))
+	t.Cleanup(func() { _ = fil.Close() })
+
+	// --- When ---
+	rd, err := NewAVIReader(fil)
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Len(t, 2, rd.Streams)
+	assert.Len(t, 94, rd.Index.Entries)
+	assert.Len(t, 50, rd.Frames(0))
+	assert.Len(t, 44, rd.Frames(1))
+	assert.Nil(t, rd.Frames(2))
+
+	exp := AVIFrame{ChunkID: StrToID("00dc"), Flags: AVIIFKeyframe, Offset: 21294, Size: 2804}
+	assert.Equal(t, exp, rd.Frames(0)[0])
+	exp = AVIFrame{ChunkID: StrToID("01wb"), Flags: AVIIFKeyframe, Offset: 10260, Size: 11025}
+	assert.Equal(t, exp, rd.Frames(1)[0])
+}
+
+func Test_NewAVIReader_Errors(t *testing.T) {
+	t.Run("not AVI", func(t *testing.T) {
+		// --- Given ---
+		fil := must.Value(os.Open("testdata/kick.wav"))
+		t.Cleanup(func() { _ = fil.Close() })
+
+		// --- When ---
+		rd, err := NewAVIReader(fil)
+
+		// --- Then ---
+		assert.ErrorEqual(t, "expected AVI  form got WAVE", err)
+		assert.Nil(t, rd)
+	})
+
+	t.Run("not RIFF", func(t *testing.T) {
+		// --- When ---
+		rd, err := NewAVIReader(bytes.NewReader([]byte("FORM")))
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrNotRIFF, err)
+		assert.Nil(t, rd)
+	})
+
+	t.Run("missing movi", func(t *testing.T) {
+		// --- Given ---
+		b := sampleAVI(t)
+		copy(b[10248:], "xxxx")
+
+		// --- When ---
+		rd, err := NewAVIReader(bytes.NewReader(b))
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrAVIInvalid, err)
+		assert.ErrorContain(t, "missing movi list", err)
+		assert.Nil(t, rd)
+	})
+
+	t.Run("invalid index offset", func(t *testing.T) {
+		// --- Given ---
+		b := sampleAVI(t)
+		le.PutUint32(b[228760+8:], 5) // First entry offset.
+
+		// --- When ---
+		rd, err := NewAVIReader(bytes.NewReader(b))
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrAVIInvalid, err)
+		assert.Nil(t, rd)
+	})
+}
+
+func Test_NewAVIReader_AbsoluteOffsets(t *testing.T) {
+	// --- Given ---
+	b := sampleAVI(t)
+	for i := 228760; i < len(b); i += 16 {
+		le.PutUint32(b[i+8:], le.Uint32(b[i+8:])+10248)
+	}
+
+	// --- When ---
+	rd, err := NewAVIReader(bytes.NewReader(b))
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, int64(21294), rd.Frames(0)[0].Offset)
+	assert.Equal(t, int64(10260), rd.Frames(1)[0].Offset)
+}
+
+func Test_NewAVIReader_RebuildIndex(t *testing.T) {
+	// --- Given ---
+	b := sampleAVI(t)[:228752] // Cut the "idx1" chunk.
+	le.PutUint32(b[4:], uint32(len(b)-8))
+
+	// --- When ---
+	rd, err := NewAVIReader(bytes.NewReader(b))
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Len(t, 94, rd.Index.Entries)
+	assert.Len(t, 50, rd.Frames(0))
+	assert.Len(t, 44, rd.Frames(1))
+	assert.Equal(t, int64(21294), rd.Frames(0)[0].Offset)
+	assert.Equal(t, int64(10260), rd.Frames(1)[0].Offset)
+	assert.False(t, rd.Frames(0)[1].IsKeyframe())
+	assert.True(t, rd.Frames(1)[1].IsKeyframe())
+}
+
+func Test_AVIReader_Frame(t *testing.T) {
+	// --- Given ---
+	b := sampleAVI(t)
+	rd := must.Value(NewAVIReader(bytes.NewReader(b)))
+
+	// --- When ---
+	sr, err := rd.Frame(0, 0)
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2804), sr.Size())
+	assert.Equal(t, b[21294:21294+2804], must.Value(io.ReadAll(sr)))
+}
+
+func Test_AVIReader_Frame_Errors(t *testing.T) {
+	// --- Given ---
+	rd := must.Value(NewAVIReader(bytes.NewReader(sampleAVI(t))))
+
+	tt := []struct {
+		testN string
+
+		stream int
+		n      int
+	}{
+		{"negative frame", 0, -1},
+		{"frame out of range", 0, 50},
+		{"stream out of range", 2, 0},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			sr, err := rd.Frame(tc.stream, tc.n)
+
+			// --- Then ---
+			assert.ErrorIs(t, ErrOutOfRange, err)
+			assert.Nil(t, sr)
+		})
+	}
+}
+
+func Test_AVIReader_Keyframe(t *testing.T) {
+	// --- Given ---
+	rd := must.Value(NewAVIReader(bytes.NewReader(sampleAVI(t))))
+
+	tt := []struct {
+		testN string
+
+		at  time.Duration
+		exp int
+	}{
+		{"start", 0, 0},
+		{"before second key frame", 900 * time.Millisecond, 0},
+		{"at second key frame", 934 * time.Millisecond, 14},
+		{"after second key frame", 2 * time.Second, 14},
+		{"after the end", time.Hour, 14},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			sr, n, err := rd.Keyframe(0, tc.at)
+
+			// --- Then ---
+			assert.NoError(t, err)
+			assert.Equal(t, tc.exp, n)
+			assert.Equal(t, int64(rd.Frames(0)[n].Size), sr.Size())
+		})
+	}
+}
+
+func Test_AVIReader_Keyframe_Audio(t *testing.T) {
+	// --- Given ---
+	rd := must.Value(NewAVIReader(bytes.NewReader(sampleAVI(t))))
+
+	tt := []struct {
+		testN string
+
+		at  time.Duration
+		exp int
+	}{
+		{"start", 0, 0},
+		{"end of first chunk", 499 * time.Millisecond, 0},
+		{"second chunk", 500 * time.Millisecond, 1},
+		{"third chunk", 600 * time.Millisecond, 2},
+		{"after the end", time.Hour, 43},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			sr, n, err := rd.Keyframe(1, tc.at)
+
+			// --- Then ---
+			assert.NoError(t, err)
+			assert.Equal(t, tc.exp, n)
+			assert.Equal(t, int64(rd.Frames(1)[n].Size), sr.Size())
+		})
+	}
+}
+
+func Test_AVIReader_Keyframe_Errors(t *testing.T) {
+	// --- Given ---
+	rd := must.Value(NewAVIReader(bytes.NewReader(sampleAVI(t))))
+
+	t.Run("no frames", func(t *testing.T) {
+		// --- When ---
+		sr, n, err := rd.Keyframe(2, 0)
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrOutOfRange, err)
+		assert.Equal(t, -1, n)
+		assert.Nil(t, sr)
+	})
+
+	t.Run("no key frame", func(t *testing.T) {
+		// --- Given ---
+		rd.frames[0][0].Flags = 0
+
+		// --- When ---
+		sr, n, err := rd.Keyframe(0, 0)
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrOutOfRange, err)
+		assert.Equal(t, -1, n)
+		assert.Nil(t, sr)
+	})
+}
+
+// odmlAVI returns OpenDML AVI file with one video stream. The "RIFF AVI "
+// form has one frame and the "RIFF AVIX" form two frames. The frames are
+// indexed by the super index pointing to two "ix00" chunks.
+func odmlAVI(t *testing.T) []byte {
+	t.Helper()
+
+	list := func(typ uint32, chs ...Chunk) *ChunkLIST {
+		ch := LIST(LoadData, nil)
+		ch.ListType = typ
+		ch.Modify(chs)
+		return ch
+	}
+	frame := func(data string) *ChunkRAWC {
+		ch := RAWC(StrToID("00dc"), LoadData)
+		ch.data = []byte(data)
+		ch.size = uint32(len(data))
+		return ch
+	}
+	stdIndex := func(n int) *ChunkINDX {
+		ch := INDX(StrToID("ix00"))
+		ch.LongsPerEntry = 2
+		ch.IndexType = AVIIndexOfChunks
+		ch.ChunkID = StrToID("00dc")
+		ch.Std = make([]AVIStdIndexEntry, n)
+		ch.size = INDXChunkSize + uint32(8*n)
+		return ch
+	}
+
+	avih := AVIH()
+	avih.TotalFrames = 1
+	avih.Streams = 1
+	strh := STRH()
+	strh.FccType = StreamVideo
+	sup := INDX(IDindx)
+	sup.LongsPerEntry = 4
+	sup.IndexType = AVIIndexOfIndexes
+	sup.ChunkID = StrToID("00dc")
+	sup.Super = make([]AVISuperIndexEntry, 2)
+	sup.size = INDXChunkSize + 32
+	dmlh := DMLH()
+	dmlh.TotalFrames = 3
+
+	ix0, ix1 := stdIndex(1), stdIndex(2)
+	idx1 := IDX1()
+	idx1.Entries = []AVIIndexEntry{{ChunkID: StrToID("00dc"), Flags: AVIIFKeyframe, Offset: 4, Size: 7}}
+	idx1.size = AVIIndexEntrySize
+
+	avi := Compose(Chunks{
+		list(IDhdrl, avih, list(IDstrl, strh, sup), list(IDodml, dmlh)),
+		list(IDmovi, frame("frame-1"), ix0),
+		idx1,
+	})
+	avi.SetType(TypeAVI)
+	avix := Compose(Chunks{list(IDmovi, frame("frame-2x"), frame("frame-3yz"), ix1)})
+	avix.SetType(TypeAVIX)
+	fs := Forms{avi, avix}
+
+	// Fill in the offsets.
+	buf := &bytes.Buffer{}
+	must.Value(fs.WriteTo(buf))
+	b := buf.Bytes()
+	idx := func(s string, n int) int {
+		off := -1
+		for ; n >= 0; n-- {
+			off += 1 + bytes.Index(b[off+1:], []byte(s))
+		}
+		return off
+	}
+	movi0, movi1 := idx("movi", 0), idx("movi", 1)
+
+	sup.Super[0] = AVISuperIndexEntry{Offset: uint64(idx("ix00", 0)), Size: ix0.Size() + 8, Duration: 1}
+	sup.Super[1] = AVISuperIndexEntry{Offset: uint64(idx("ix00", 1)), Size: ix1.Size() + 8, Duration: 2}
+	ix0.BaseOffset = uint64(movi0)
+	ix0.Std[0] = AVIStdIndexEntry{Offset: uint32(idx("frame-1", 0) - movi0), Size: 7}
+	ix1.BaseOffset = uint64(movi1)
+	ix1.Std[0] = AVIStdIndexEntry{Offset: uint32(idx("frame-2x", 0) - movi1), Size: 8}
+	ix1.Std[1] = AVIStdIndexEntry{Offset: uint32(idx("frame-3yz", 0) - movi1), Size: 9 | avistdNotKeyframe}
+
+	buf.Reset()
+	must.Value(fs.WriteTo(buf))
+	return buf.Bytes()
+}
+
+func Test_NewAVIReader_OpenDML(t *testing.T) {
+	// --- Given ---
+	b := odmlAVI(t)
+
+	// --- When ---
+	rd, err := NewAVIReader(bytes.NewReader(b))
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(3), rd.TotalFrames())
+	assert.Len(t, 3, rd.Frames(0))
+	assert.True(t, rd.Frames(0)[0].IsKeyframe())
+	assert.True(t, rd.Frames(0)[1].IsKeyframe())
+	assert.False(t, rd.Frames(0)[2].IsKeyframe())
+
+	for i, exp := range []string{"frame-1", "frame-2x", "frame-3yz"} {
+		sr, err := rd.Frame(0, i)
+		assert.NoError(t, err)
+		assert.Equal(t, exp, string(must.Value(io.ReadAll(sr))))
+	}
+}
+
+func Test_NewAVIReader_OpenDML_BadSuperIndex(t *testing.T) {
+	// --- Given ---
+	b := odmlAVI(t)
+	i := bytes.Index(b, []byte("ix00"))
+	copy(b[i:], "JUNK")
+
+	// --- When ---
+	rd, err := NewAVIReader(bytes.NewReader(b))
+
+	// --- Then ---
+	assert.ErrorIs(t, ErrAVIInvalid, err)
+	assert.Nil(t, rd)
+}
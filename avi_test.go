@@ -0,0 +1,142 @@
+package riff
+
+import (
+	"bytes"
+	"os"
+	"testing"
+
+	"github.com/ctx42/testing/pkg/assert"
+	"github.com/ctx42/testing/pkg/must"
+)
+
+func Test_NewAVI(t *testing.T) {
+	// --- Given ---
+	rif := New(SkipData)
+	fil := must.Value(os.Open("testdata/sample.avi"))
+	t.Cleanup(func() { _ = fil.Close() })
+	must.Value(rif.ReadFrom(fil))
+
+	// --- When ---
+	avi, err := NewAVI(rif)
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Epsilon(t, 15.0, 0.001, avi.Header.FrameRate())
+	assert.Equal(t, uint32(80), avi.Header.Width)
+	assert.Equal(t, uint32(60), avi.Header.Height)
+	assert.Len(t, 2, avi.Streams)
+	assert.Nil(t, avi.Info)
+
+	vid, idx := avi.Stream(StreamVideo)
+	assert.Equal(t, 0, idx)
+	assert.Equal(t, "cvid", Uint32(vid.Codec()).String())
+	assert.Equal(t, int32(80), vid.Format.Video.Width)
+	assert.Equal(t, int32(60), vid.Format.Video.Height)
+	assert.Epsilon(t, 15.0, 0.001, vid.Header.SampleRate())
+
+	aud, idx := avi.Stream(StreamAudio)
+	assert.Equal(t, 1, idx)
+	assert.Equal(t, uint32(0), aud.Codec())
+	assert.Equal(t, CompPCM, aud.Format.Audio.CompCode)
+	assert.Equal(t, uint32(22050), aud.Format.Audio.SampleRate)
+	assert.Equal(t, "", aud.Name)
+
+	txt, idx := avi.Stream(StreamText)
+	assert.Nil(t, txt)
+	assert.Equal(t, -1, idx)
+}
+
+func Test_NewAVI_Errors(t *testing.T) {
+	t.Run("not AVI", func(t *testing.T) {
+		// --- Given ---
+		rif := Compose(nil)
+		rif.SetType(TypeWAVE)
+
+		// --- When ---
+		avi, err := NewAVI(rif)
+
+		// --- Then ---
+		assert.ErrorEqual(t, "expected AVI  form got WAVE", err)
+		assert.Nil(t, avi)
+	})
+
+	t.Run("missing hdrl", func(t *testing.T) {
+		// --- Given ---
+		rif := Compose(nil)
+		rif.SetType(TypeAVI)
+
+		// --- When ---
+		avi, err := NewAVI(rif)
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrAVIInvalid, err)
+		assert.Nil(t, avi)
+	})
+
+	t.Run("missing avih", func(t *testing.T) {
+		// --- Given ---
+		hdrl := LIST(LoadData, nil)
+		hdrl.ListType = IDhdrl
+		rif := Compose(Chunks{hdrl})
+		rif.SetType(TypeAVI)
+
+		// --- When ---
+		avi, err := NewAVI(rif)
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrAVIInvalid, err)
+		assert.ErrorContain(t, "avih", err)
+		assert.Nil(t, avi)
+	})
+
+	t.Run("missing strh", func(t *testing.T) {
+		// --- Given ---
+		strl := LIST(LoadData, nil)
+		strl.ListType = IDstrl
+		hdrl := LIST(LoadData, nil)
+		hdrl.ListType = IDhdrl
+		hdrl.Modify(Chunks{AVIH(), strl})
+		rif := Compose(Chunks{hdrl})
+		rif.SetType(TypeAVI)
+
+		// --- When ---
+		avi, err := NewAVI(rif)
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrAVIInvalid, err)
+		assert.ErrorContain(t, "strh", err)
+		assert.Nil(t, avi)
+	})
+}
+
+func Test_AVI_TotalFrames(t *testing.T) {
+	t.Run("dmlh", func(t *testing.T) {
+		// --- Given ---
+		rif := New(SkipData)
+		must.Value(rif.ReadFrom(bytes.NewReader(odmlAVI(t))))
+		avi := must.Value(NewAVI(rif))
+
+		// --- When ---
+		have := avi.TotalFrames()
+
+		// --- Then ---
+		assert.NotNil(t, avi.ODML)
+		assert.Equal(t, uint32(1), avi.Header.TotalFrames)
+		assert.Equal(t, uint32(3), have)
+		assert.NotNil(t, avi.Streams[0].Index)
+	})
+
+	t.Run("avih", func(t *testing.T) {
+		// --- Given ---
+		rif := New(SkipData)
+		must.Value(rif.ReadFrom(bytes.NewReader(sampleAVI(t))))
+		avi := must.Value(NewAVI(rif))
+		avi.ODML = nil
+
+		// --- When ---
+		have := avi.TotalFrames()
+
+		// --- Then ---
+		assert.Equal(t, avi.Header.TotalFrames, have)
+	})
+}
@@ -0,0 +1,80 @@
+package riff
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"math"
+)
+
+// AVIChunkWB represents the "wb" (waveform audio bytes) suffix of the AVI
+// audio data chunk IDs (e.g. "01wb").
+const AVIChunkWB uint32 = 0x7762
+
+// WAVE returns the audio stream as a "RIFF WAVE" file. The "fmt " chunk is
+// a copy of the stream format and the "data" chunk is all the "##wb" data
+// chunks of the stream concatenated in the index order. For compressed
+// formats the "fact" chunk with the sample length calculated from the
+// stream header is added. The "LIST INFO" chunk of the AVI file, if
+// present, is carried over to the returned WAVE file.
+func (rd *AVIReader) WAVE(stream int) (*RIFF, error) {
+	if stream < 0 || stream >= len(rd.Streams) {
+		return nil, fmt.Errorf("stream %d: %w", stream, ErrOutOfRange)
+	}
+	st := rd.Streams[stream]
+	if st.Header.FccType != StreamAudio {
+		return nil, fmt.Errorf(
+			"expected %s stream got %s: %w",
+			Uint32(StreamAudio),
+			Uint32(st.Header.FccType),
+			ErrUnsupportedFormat,
+		)
+	}
+	if st.Format == nil || st.Format.Audio == nil {
+		return nil, fmt.Errorf("stream %d missing audio format: %w", stream, ErrAVIInvalid)
+	}
+
+	buf := &bytes.Buffer{}
+	for _, fr := range rd.Frames(stream) {
+		if fr.ChunkID&0xffff != AVIChunkWB {
+			continue
+		}
+		sr := io.NewSectionReader(rd.ra, fr.Offset, int64(fr.Size))
+		if _, err := io.Copy(buf, sr); err != nil {
+			return nil, fmt.Errorf("stream %d data: %w", stream, err)
+		}
+	}
+
+	cf := FMT()
+	cf.fmtStatic = st.Format.Audio.fmtStatic
+	cf.WriteZeroExtra = st.Format.Audio.WriteZeroExtra
+	cf.SetExtra(st.Format.Audio.extra)
+
+	data := DATA(LoadData)
+	_ = data.SetData(buf.Bytes()) // Never fails in LoadData mode.
+
+	chs := Chunks{cf}
+	if cf.compressed() {
+		fact := FACT()
+		fact.SampleLength = st.sampleLength(cf)
+		chs = append(chs, fact)
+	}
+	chs = append(chs, data)
+	if rd.Info != nil {
+		chs = append(chs, copyINFO(rd.Info))
+	}
+
+	rif := Compose(chs)
+	rif.SetType(TypeWAVE)
+	return rif, nil
+}
+
+// sampleLength returns number of samples (per channel) in the stream
+// calculated from the stream duration and the sample rate in cf.
+func (st AVIStream) sampleLength(cf *ChunkFMT) uint32 {
+	if st.Header.Rate == 0 {
+		return 0
+	}
+	sec := float64(st.Header.Length) * float64(st.Header.Scale) / float64(st.Header.Rate)
+	return uint32(math.Round(sec * float64(cf.SampleRate)))
+}
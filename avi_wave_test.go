@@ -0,0 +1,122 @@
+package riff
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/ctx42/testing/pkg/assert"
+	"github.com/ctx42/testing/pkg/must"
+)
+
+func Test_AVIReader_WAVE(t *testing.T) {
+	// --- Given ---
+	b := sampleAVI(t)
+	rd := must.Value(NewAVIReader(bytes.NewReader(b)))
+
+	var exp []byte
+	for i := range rd.Frames(1) {
+		exp = append(exp, must.Value(io.ReadAll(must.Value(rd.Frame(1, i))))...)
+	}
+
+	// --- When ---
+	rif, err := rd.WAVE(1)
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, TypeWAVE, rif.Type())
+	assert.Equal(t, []uint32{IDfmt, IDdata}, rif.Chunks().IDs())
+
+	// Write and read it back.
+	buf := &bytes.Buffer{}
+	must.Value(rif.WriteTo(buf))
+	got := New(LoadData)
+	must.Value(got.ReadFrom(buf))
+
+	cf := got.Chunks().First(IDfmt).(*ChunkFMT)
+	assert.Equal(t, CompPCM, cf.CompCode)
+	assert.Equal(t, uint16(1), cf.ChannelCnt)
+	assert.Equal(t, uint32(22050), cf.SampleRate)
+	assert.Equal(t, uint16(8), cf.BitsPerSample)
+
+	data := got.Chunks().First(IDdata).(*ChunkDATA)
+	assert.Equal(t, exp, must.Value(io.ReadAll(data.Data())))
+}
+
+func Test_AVIReader_WAVE_Compressed(t *testing.T) {
+	// --- Given ---
+	b := sampleAVI(t)
+	le.PutUint16(b[4416+8:], CompMULaw) // Audio stream "strf" chunk.
+	rd := must.Value(NewAVIReader(bytes.NewReader(b)))
+
+	// --- When ---
+	rif, err := rd.WAVE(1)
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, []uint32{IDfmt, IDfact, IDdata}, rif.Chunks().IDs())
+	fact := rif.Chunks().First(IDfact).(*ChunkFACT)
+	exp := rd.Streams[1].Header.Duration().Seconds() * 22050
+	assert.Equal(t, uint32(exp), fact.SampleLength)
+}
+
+func Test_AVIReader_WAVE_Info(t *testing.T) {
+	// --- Given ---
+	b := sampleAVI(t)
+	b = append(b, must.Value(io.ReadAll(listChunkType_INFO(t)))...)
+	le.PutUint32(b[4:], uint32(len(b)-8))
+	rd := must.Value(NewAVIReader(bytes.NewReader(b)))
+
+	// --- When ---
+	rif, err := rd.WAVE(1)
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, []uint32{IDfmt, IDdata, IDLIST}, rif.Chunks().IDs())
+	assert.Equal(t, rd.Info.Chunks(), rif.Chunks()[2].Chunks())
+	assert.Equal(t, IDINFO, rif.Chunks()[2].Type())
+
+	buf := &bytes.Buffer{}
+	must.Value(rif.WriteTo(buf))
+	assert.True(t, bytes.HasSuffix(buf.Bytes(), b[len(b)-24:]))
+}
+
+func Test_AVIReader_WAVE_Errors(t *testing.T) {
+	t.Run("out of range", func(t *testing.T) {
+		// --- Given ---
+		rd := must.Value(NewAVIReader(bytes.NewReader(sampleAVI(t))))
+
+		// --- When ---
+		rif, err := rd.WAVE(2)
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrOutOfRange, err)
+		assert.Nil(t, rif)
+	})
+
+	t.Run("not audio", func(t *testing.T) {
+		// --- Given ---
+		rd := must.Value(NewAVIReader(bytes.NewReader(sampleAVI(t))))
+
+		// --- When ---
+		rif, err := rd.WAVE(0)
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrUnsupportedFormat, err)
+		assert.ErrorContain(t, "expected auds stream got vids", err)
+		assert.Nil(t, rif)
+	})
+
+	t.Run("missing format", func(t *testing.T) {
+		// --- Given ---
+		rd := must.Value(NewAVIReader(bytes.NewReader(sampleAVI(t))))
+		rd.Streams[1].Format = nil
+
+		// --- When ---
+		rif, err := rd.WAVE(1)
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrAVIInvalid, err)
+		assert.Nil(t, rif)
+	})
+}
@@ -52,6 +52,9 @@ const (
 
 	// IDID3 represents "ID3 " chunk ID.
 	IDID3 uint32 = 0x49443320
+
+	// IDid3 represents "id3 " chunk ID.
+	IDid3 uint32 = 0x69643320
 )
 
 // Convenience imports.
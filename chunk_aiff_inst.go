@@ -0,0 +1,122 @@
+package riff
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+)
+
+// IDINST represents "INST" (instrument) chunk ID of the AIFF file.
+const IDINST uint32 = 0x494e5354
+
+// AIFFINSTChunkSize represents the size of the AIFF "INST" chunk in bytes.
+const AIFFINSTChunkSize uint32 = 20
+
+// AIFF loop play modes.
+const (
+	AIFFNoLooping              uint16 = 0 // No looping.
+	AIFFForwardLooping         uint16 = 1 // Loop forward.
+	AIFFForwardBackwardLooping uint16 = 2 // Alternate forward and backward.
+)
+
+// AIFFLoop represents the loop of [ChunkAIFFINST].
+type AIFFLoop struct {
+	// Play mode (see AIFF*Looping constants).
+	PlayMode uint16
+
+	// ID of the marker at the beginning of the loop.
+	Begin uint16
+
+	// ID of the marker at the end of the loop. The loop ends before the
+	// sample frame the marker points to.
+	End uint16
+}
+
+// ChunkAIFFINST represents the "INST" chunk of the AIFF file describing
+// how the sound should be played as a sampled musical instrument.
+type ChunkAIFFINST struct {
+	// MIDI note number at which the instrument plays back the sound
+	// without pitch modification.
+	BaseNote uint8
+
+	// Pitch alteration, in cents (-50 to +50), applied on playback.
+	Detune int8
+
+	// Lowest MIDI note number the instrument should be played at.
+	LowNote uint8
+
+	// Highest MIDI note number the instrument should be played at.
+	HighNote uint8
+
+	// Lowest MIDI velocity the instrument should be played at.
+	LowVelocity uint8
+
+	// Highest MIDI velocity the instrument should be played at.
+	HighVelocity uint8
+
+	// Gain in decibels applied on playback.
+	Gain int16
+
+	// Loop played while the note is held.
+	SustainLoop AIFFLoop
+
+	// Loop played after the note is released.
+	ReleaseLoop AIFFLoop
+}
+
+// AIFFINSTMake is a [Maker] function for creating [ChunkAIFFINST]
+// instances.
+func AIFFINSTMake() Chunk { return AIFFINST() }
+
+// AIFFINST returns a new instance of [ChunkAIFFINST].
+func AIFFINST() *ChunkAIFFINST {
+	return &ChunkAIFFINST{}
+}
+
+func (ch *ChunkAIFFINST) ID() uint32     { return IDINST }
+func (ch *ChunkAIFFINST) Size() uint32   { return AIFFINSTChunkSize }
+func (ch *ChunkAIFFINST) Type() uint32   { return 0 }
+func (ch *ChunkAIFFINST) Multi() bool    { return false }
+func (ch *ChunkAIFFINST) Chunks() Chunks { return nil }
+func (ch *ChunkAIFFINST) Raw() bool      { return false }
+
+func (ch *ChunkAIFFINST) ReadFrom(r io.Reader) (int64, error) {
+	var sum int64
+	size, err := ReadChunkSize(r)
+	if err != nil {
+		return sum, fmt.Errorf(errFmtDecode, Uint32(IDINST), err)
+	}
+	sum += 4
+
+	if size != AIFFINSTChunkSize {
+		return sum, fmt.Errorf(errFmtDecode, Uint32(IDINST), ErrChunkSizeMismatch)
+	}
+
+	if err = binary.Read(r, ByteOrder(r), ch); err != nil {
+		return sum, fmt.Errorf(errFmtDecode, Uint32(IDINST), err)
+	}
+	sum += int64(AIFFINSTChunkSize)
+
+	return sum, nil
+}
+
+func (ch *ChunkAIFFINST) WriteTo(w io.Writer) (int64, error) {
+	var sum int64
+
+	n, err := WriteIDAndSize(w, IDINST, AIFFINSTChunkSize)
+	sum += n
+	if err != nil {
+		return sum, fmt.Errorf(errFmtEncode, Uint32(IDINST), err)
+	}
+
+	if err = binary.Write(w, ByteOrder(w), ch); err != nil {
+		return sum, fmt.Errorf(errFmtEncode, Uint32(IDINST), err)
+	}
+	sum += int64(AIFFINSTChunkSize)
+
+	return sum, nil
+}
+
+func (ch *ChunkAIFFINST) Reset() {
+	*ch = ChunkAIFFINST{}
+}
@@ -0,0 +1,138 @@
+package riff
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/ctx42/testing/pkg/assert"
+	"github.com/ctx42/testing/pkg/kit/iokit"
+	"github.com/ctx42/testing/pkg/must"
+
+	"github.com/rzajac/riff/internal/test"
+)
+
+func aiffInstChunk(t *testing.T) io.Reader {
+	src := &bytes.Buffer{}
+	test.ReadFrom(t, src, Uint32(IDINST))                     // ( 0) 4 - Chunk ID
+	test.WriteUint32BE(t, src, 20)                            // ( 4) 4 - Chunk size
+	test.WriteBytes(t, src, []byte{60, 0xfb, 0, 127, 1, 127}) // ( 8) 6 - Notes and velocities
+	test.WriteUint16BE(t, src, 0xfffd)                        // (14) 2 - Gain
+	test.WriteUint16BE(t, src, AIFFForwardLooping)            // (16) 2 - Sustain play mode
+	test.WriteUint16BE(t, src, 1)                             // (18) 2 - Sustain begin
+	test.WriteUint16BE(t, src, 2)                             // (20) 2 - Sustain end
+	test.WriteUint16BE(t, src, AIFFNoLooping)                 // (22) 2 - Release play mode
+	test.WriteUint16BE(t, src, 0)                             // (24) 2 - Release begin
+	test.WriteUint16BE(t, src, 0)                             // (26) 2 - Release end
+	// Total length: 8+20=28
+	return src
+}
+
+func Test_ChunkAIFFINST_AIFFINST(t *testing.T) {
+	// --- When ---
+	ch := AIFFINST()
+
+	// --- Then ---
+	assert.Equal(t, IDINST, ch.ID())
+	assert.Equal(t, AIFFINSTChunkSize, ch.Size())
+	assert.Equal(t, uint32(0), ch.Type())
+	assert.False(t, ch.Multi())
+	assert.Nil(t, ch.Chunks())
+	assert.False(t, ch.Raw())
+}
+
+func Test_ChunkAIFFINST_ReadFrom(t *testing.T) {
+	// --- Given ---
+	src := aiffInstChunk(t)
+	test.Skip4B(t, src) // Skip chunk ID.
+
+	// --- When ---
+	ch := AIFFINST()
+	n, err := ch.ReadFrom(OrderReader(src, be))
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, int64(24), n)
+	assert.Equal(t, uint8(60), ch.BaseNote)
+	assert.Equal(t, int8(-5), ch.Detune)
+	assert.Equal(t, uint8(0), ch.LowNote)
+	assert.Equal(t, uint8(127), ch.HighNote)
+	assert.Equal(t, uint8(1), ch.LowVelocity)
+	assert.Equal(t, uint8(127), ch.HighVelocity)
+	assert.Equal(t, int16(-3), ch.Gain)
+	assert.Equal(t, AIFFLoop{PlayMode: AIFFForwardLooping, Begin: 1, End: 2}, ch.SustainLoop)
+	assert.Equal(t, AIFFLoop{}, ch.ReleaseLoop)
+	assert.True(t, test.IsAllRead(src))
+}
+
+func Test_ChunkAIFFINST_ReadFrom_Errors(t *testing.T) {
+	// Reading less than 24 bytes should always result in an error.
+	for _, i := range []int{1, 4, 5, 12, 23} {
+		// --- Given ---
+		src := aiffInstChunk(t)
+		test.Skip4B(t, src) // Skip chunk ID.
+
+		// --- When ---
+		_, err := AIFFINST().ReadFrom(OrderReader(io.LimitReader(src, int64(i)), be))
+
+		// --- Then ---
+		assert.Error(t, err)
+	}
+}
+
+func Test_ChunkAIFFINST_ReadFrom_SizeMismatch(t *testing.T) {
+	// --- Given ---
+	src := &bytes.Buffer{}
+	test.WriteUint32BE(t, src, 19)
+
+	// --- When ---
+	_, err := AIFFINST().ReadFrom(OrderReader(src, be))
+
+	// --- Then ---
+	assert.ErrorIs(t, ErrChunkSizeMismatch, err)
+}
+
+func Test_ChunkAIFFINST_WriteTo(t *testing.T) {
+	// --- Given ---
+	src := aiffInstChunk(t)
+	test.Skip4B(t, src) // Skip chunk ID.
+
+	ch := AIFFINST()
+	_, err := ch.ReadFrom(OrderReader(src, be))
+	assert.NoError(t, err)
+
+	// --- When ---
+	dst := &bytes.Buffer{}
+	n, err := ch.WriteTo(OrderWriter(dst, be))
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, int64(28), n)
+	exp := must.Value(io.ReadAll(aiffInstChunk(t)))
+	assert.Equal(t, exp, dst.Bytes())
+}
+
+func Test_ChunkAIFFINST_WriteTo_Errors(t *testing.T) {
+	for _, i := range []int{1, 4, 8, 27} {
+		// --- When ---
+		_, err := AIFFINST().WriteTo(iokit.ErrWriter(&bytes.Buffer{}, i))
+
+		// --- Then ---
+		assert.Error(t, err)
+	}
+}
+
+func Test_ChunkAIFFINST_Reset(t *testing.T) {
+	// --- Given ---
+	src := aiffInstChunk(t)
+	test.Skip4B(t, src) // Skip chunk ID.
+	ch := AIFFINST()
+	_, err := ch.ReadFrom(OrderReader(src, be))
+	assert.NoError(t, err)
+
+	// --- When ---
+	ch.Reset()
+
+	// --- Then ---
+	assert.Equal(t, &ChunkAIFFINST{}, ch)
+}
@@ -0,0 +1,113 @@
+package riff
+
+import (
+	"fmt"
+	"io"
+)
+
+// IDALPH represents "ALPH" (WebP alpha channel) chunk ID.
+const IDALPH uint32 = 0x414c5048
+
+// ALPHHeaderSize represents the size of the ALPH chunk header in bytes.
+const ALPHHeaderSize uint32 = 1
+
+// Alpha channel compression methods.
+const (
+	// ALPHCompNone represents uncompressed alpha channel.
+	ALPHCompNone uint8 = 0
+
+	// ALPHCompLossless represents alpha channel compressed with the WebP
+	// lossless format.
+	ALPHCompLossless uint8 = 1
+)
+
+// ChunkALPH represents "ALPH" chunk holding the alpha channel of the lossy
+// WebP image. Only the header byte is decoded.
+type ChunkALPH struct {
+	// Chunk size in bytes.
+	// The ID and extra padding byte is not counted in the chunk size.
+	size uint32
+
+	// The header byte.
+	head [ALPHHeaderSize]byte
+
+	// The chunk body including the header. It's nil in SkipData mode.
+	data []byte
+}
+
+// ALPHMake returns [Maker] function for [ChunkALPH] instances.
+func ALPHMake(load bool) Maker {
+	return func() Chunk {
+		return ALPH(load)
+	}
+}
+
+// ALPH returns a new instance of [ChunkALPH]. If load is false the alpha
+// bitstream will not be loaded into memory.
+func ALPH(load bool) *ChunkALPH {
+	ch := &ChunkALPH{}
+	if load {
+		ch.data = make([]byte, 0, 1<<10)
+	}
+	return ch
+}
+
+func (ch *ChunkALPH) ID() uint32     { return IDALPH }
+func (ch *ChunkALPH) Size() uint32   { return ch.size }
+func (ch *ChunkALPH) Type() uint32   { return 0 }
+func (ch *ChunkALPH) Multi() bool    { return true }
+func (ch *ChunkALPH) Chunks() Chunks { return nil }
+func (ch *ChunkALPH) Raw() bool      { return false }
+
+// Compression returns the compression method (see ALPHComp* constants).
+func (ch *ChunkALPH) Compression() uint8 { return ch.head[0] & 0x03 }
+
+// Filtering returns the filtering method (0 - none, 1 - horizontal,
+// 2 - vertical, 3 - gradient).
+func (ch *ChunkALPH) Filtering() uint8 { return ch.head[0] >> 2 & 0x03 }
+
+// Preprocessing returns the pre-processing method (0 - none, 1 - level
+// reduction).
+func (ch *ChunkALPH) Preprocessing() uint8 { return ch.head[0] >> 4 & 0x03 }
+
+// Data returns the chunk body. It returns nil in SkipData mode.
+func (ch *ChunkALPH) Data() []byte { return ch.data }
+
+func (ch *ChunkALPH) ReadFrom(r io.Reader) (int64, error) {
+	var sum int64
+	var err error
+	if ch.size, err = ReadChunkSize(r); err != nil {
+		return sum, fmt.Errorf(errFmtDecode, Uint32(IDALPH), err)
+	}
+	sum += 4
+
+	if ch.size < ALPHHeaderSize {
+		return sum, fmt.Errorf(errFmtDecode, Uint32(IDALPH), ErrTooShort)
+	}
+
+	var n int64
+	ch.data, n, err = readBitstream(r, ch.size, ch.head[:], ch.data)
+	sum += n
+	if err != nil {
+		return sum, fmt.Errorf(errFmtDecode, Uint32(IDALPH), err)
+	}
+
+	return sum, nil
+}
+
+func (ch *ChunkALPH) WriteTo(w io.Writer) (int64, error) {
+	if ch.data == nil {
+		return 0, ErrSkipDataMode
+	}
+	n, err := writeBitstream(w, IDALPH, ch.data)
+	if err != nil {
+		return n, fmt.Errorf(errFmtEncode, Uint32(IDALPH), err)
+	}
+	return n, nil
+}
+
+func (ch *ChunkALPH) Reset() {
+	ch.size = 0
+	ch.head = [ALPHHeaderSize]byte{}
+	ch.data = ch.data[:0]
+}
@@ -0,0 +1,157 @@
+package riff
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/ctx42/testing/pkg/assert"
+	"github.com/ctx42/testing/pkg/kit/iokit"
+	"github.com/ctx42/testing/pkg/must"
+
+	"github.com/rzajac/riff/internal/test"
+)
+
+func alphChunk(t *testing.T) io.Reader {
+	src := &bytes.Buffer{}
+	test.ReadFrom(t, src, Uint32(IDALPH))     // ( 0) 4 - Chunk ID
+	test.WriteUint32LE(t, src, 3)             // ( 4) 4 - Chunk size
+	test.WriteByte(t, src, 0x15)              // ( 8) 1 - Header
+	test.WriteBytes(t, src, []byte{0xa, 0xb}) // ( 9) 2 - Bitstream
+	test.WriteByte(t, src, 0)                 // (11) 1 - Padding byte
+	// Total length: 8+4=12
+	return src
+}
+
+func Test_ChunkALPH_ALPH(t *testing.T) {
+	// --- When ---
+	ch := ALPH(LoadData)
+
+	// --- Then ---
+	assert.Equal(t, IDALPH, ch.ID())
+	assert.Equal(t, uint32(0), ch.Size())
+	assert.Equal(t, uint32(0), ch.Type())
+	assert.True(t, ch.Multi())
+	assert.Nil(t, ch.Chunks())
+	assert.False(t, ch.Raw())
+	assert.Nil(t, ALPH(SkipData).Data())
+}
+
+func Test_ChunkALPH_ReadFrom(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		load bool
+		exp  []byte
+	}{
+		{"load", LoadData, []byte{0x15, 0xa, 0xb}},
+		{"skip", SkipData, nil},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- Given ---
+			src := alphChunk(t)
+			test.Skip4B(t, src) // Skip chunk ID.
+
+			// --- When ---
+			ch := ALPH(tc.load)
+			n, err := ch.ReadFrom(src)
+
+			// --- Then ---
+			assert.NoError(t, err)
+			assert.Equal(t, int64(8), n)
+			assert.Equal(t, uint32(3), ch.Size())
+			assert.Equal(t, ALPHCompLossless, ch.Compression())
+			assert.Equal(t, uint8(1), ch.Filtering())
+			assert.Equal(t, uint8(1), ch.Preprocessing())
+			assert.Equal(t, tc.exp, ch.Data())
+			assert.True(t, test.IsAllRead(src))
+		})
+	}
+}
+
+func Test_ChunkALPH_ReadFrom_Errors(t *testing.T) {
+	// Reading less than 8 bytes should always result in an error.
+	for _, i := range []int{1, 4, 5, 7} {
+		// --- Given ---
+		src := alphChunk(t)
+		test.Skip4B(t, src) // Skip chunk ID.
+
+		// --- When ---
+		_, err := ALPH(LoadData).ReadFrom(io.LimitReader(src, int64(i)))
+
+		// --- Then ---
+		assert.Error(t, err)
+	}
+}
+
+func Test_ChunkALPH_ReadFrom_TooShortError(t *testing.T) {
+	// --- Given ---
+	src := &bytes.Buffer{}
+	test.WriteUint32LE(t, src, 0)
+
+	// --- When ---
+	_, err := ALPH(LoadData).ReadFrom(src)
+
+	// --- Then ---
+	assert.ErrorIs(t, ErrTooShort, err)
+}
+
+func Test_ChunkALPH_WriteTo(t *testing.T) {
+	// --- Given ---
+	src := alphChunk(t)
+	test.Skip4B(t, src) // Skip chunk ID.
+
+	ch := ALPH(LoadData)
+	_, err := ch.ReadFrom(src)
+	assert.NoError(t, err)
+
+	// --- When ---
+	dst := &bytes.Buffer{}
+	n, err := ch.WriteTo(dst)
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, int64(12), n)
+	exp := must.Value(io.ReadAll(alphChunk(t)))
+	assert.Equal(t, exp, dst.Bytes())
+}
+
+func Test_ChunkALPH_WriteTo_Errors(t *testing.T) {
+	for _, i := range []int{1, 4, 8, 11} {
+		// --- Given ---
+		src := alphChunk(t)
+		test.Skip4B(t, src) // Skip chunk ID.
+
+		ch := ALPH(LoadData)
+		_, err := ch.ReadFrom(src)
+		assert.NoError(t, err)
+
+		// --- When ---
+		_, err = ch.WriteTo(iokit.ErrWriter(&bytes.Buffer{}, i))
+
+		// --- Then ---
+		assert.Error(t, err)
+	}
+
+	_, err := ALPH(SkipData).WriteTo(&bytes.Buffer{})
+	assert.ErrorIs(t, ErrSkipDataMode, err)
+}
+
+func Test_ChunkALPH_Reset(t *testing.T) {
+	// --- Given ---
+	src := alphChunk(t)
+	test.Skip4B(t, src) // Skip chunk ID.
+	ch := ALPH(LoadData)
+	_, err := ch.ReadFrom(src)
+	assert.NoError(t, err)
+
+	// --- When ---
+	ch.Reset()
+
+	// --- Then ---
+	assert.Equal(t, uint32(0), ch.Size())
+	assert.Equal(t, uint8(0), ch.Compression())
+	assert.Len(t, 0, ch.Data())
+}
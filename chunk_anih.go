@@ -0,0 +1,161 @@
+package riff
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"time"
+)
+
+// IDanih represents "anih" (animated cursor header) chunk ID.
+const IDanih uint32 = 0x616e6968
+
+// ANIHChunkSize represents the size of anih chunk static part in bytes.
+const ANIHChunkSize uint32 = 36
+
+// ANIJiffy represents the unit of the animated cursor display rates.
+const ANIJiffy = time.Second / 60
+
+// Animated cursor header flags.
+const (
+	// ANIFIcon indicates the frames are stored as icons or cursors.
+	// Otherwise, the frames are stored as raw bitmaps.
+	ANIFIcon uint32 = 0x00000001
+
+	// ANIFSequence indicates the file has "seq " chunk.
+	ANIFSequence uint32 = 0x00000002
+)
+
+// anihStatic represents chunk static data (always there).
+// This struct is defined separately to allow for binary
+// decoding / encoding in one call to binary.Read / binary.Write.
+type anihStatic struct {
+	// Size of the header in bytes. Should be 36.
+	HeaderSize uint32
+
+	// Number of unique frames (icons) in the file.
+	Frames uint32
+
+	// Number of steps in the animation. It may be different from the
+	// number of frames when the "seq " chunk is present.
+	Steps uint32
+
+	// Width of the raw bitmap frames. Zero for icon frames.
+	Width uint32
+
+	// Height of the raw bitmap frames. Zero for icon frames.
+	Height uint32
+
+	// Color depth of the raw bitmap frames. Zero for icon frames.
+	BitCount uint32
+
+	// Number of planes of the raw bitmap frames. Zero for icon frames.
+	Planes uint32
+
+	// Default display rate of each step in jiffies (1/60 of a second).
+	JifRate uint32
+
+	// Bitwise combination of ANIF* flags.
+	Flags uint32
+}
+
+// ChunkANIH represents "anih" chunk (ANIHEADER) of the animated cursor
+// file.
+type ChunkANIH struct {
+	// Chunk size in bytes.
+	// The ID and extra padding byte is not counted in the chunk size.
+	size uint32
+
+	anihStatic
+
+	// Bytes following the static part.
+	extra []byte
+}
+
+// ANIHMake is a [Maker] function for creating [ChunkANIH] instances.
+func ANIHMake() Chunk { return ANIH() }
+
+// ANIH returns a new instance of [ChunkANIH].
+func ANIH() *ChunkANIH {
+	return &ChunkANIH{
+		size:       ANIHChunkSize,
+		anihStatic: anihStatic{HeaderSize: ANIHChunkSize},
+	}
+}
+
+func (ch *ChunkANIH) ID() uint32     { return IDanih }
+func (ch *ChunkANIH) Size() uint32   { return ch.size }
+func (ch *ChunkANIH) Type() uint32   { return 0 }
+func (ch *ChunkANIH) Multi() bool    { return false }
+func (ch *ChunkANIH) Chunks() Chunks { return nil }
+func (ch *ChunkANIH) Raw() bool      { return false }
+
+func (ch *ChunkANIH) ReadFrom(r io.Reader) (int64, error) {
+	bo := ByteOrder(r)
+	var sum int64
+	if err := binary.Read(r, bo, &ch.size); err != nil {
+		return sum, fmt.Errorf(errFmtDecode, Uint32(IDanih), err)
+	}
+	sum += 4
+
+	if ch.size < ANIHChunkSize {
+		return sum, fmt.Errorf(errFmtDecode, Uint32(IDanih), ErrTooShort)
+	}
+
+	if err := binary.Read(r, bo, &ch.anihStatic); err != nil {
+		return sum, fmt.Errorf(errFmtDecode, Uint32(IDanih), err)
+	}
+	sum += int64(ANIHChunkSize)
+
+	ch.extra = grow(ch.extra, int(ch.size-ANIHChunkSize))
+	in, err := io.ReadFull(r, ch.extra)
+	sum += int64(in)
+	if err != nil {
+		return sum, fmt.Errorf(errFmtDecode, Uint32(IDanih), err)
+	}
+
+	n, err := ReadPaddingIf(r, ch.size)
+	sum += n
+	if err != nil {
+		return sum, fmt.Errorf(errFmtDecode, Uint32(IDanih), err)
+	}
+
+	return sum, nil
+}
+
+func (ch *ChunkANIH) WriteTo(w io.Writer) (int64, error) {
+	var sum int64
+
+	ch.size = ANIHChunkSize + uint32(len(ch.extra))
+
+	n, err := WriteIDAndSize(w, IDanih, ch.size)
+	sum += n
+	if err != nil {
+		return sum, fmt.Errorf(errFmtEncode, Uint32(IDanih), err)
+	}
+
+	if err = binary.Write(w, ByteOrder(w), ch.anihStatic); err != nil {
+		return sum, fmt.Errorf(errFmtEncode, Uint32(IDanih), err)
+	}
+	sum += int64(ANIHChunkSize)
+
+	in, err := w.Write(ch.extra)
+	sum += int64(in)
+	if err != nil {
+		return sum, fmt.Errorf(errFmtEncode, Uint32(IDanih), err)
+	}
+
+	n, err = WritePaddingIf(w, ch.size)
+	sum += n
+	if err != nil {
+		return sum, fmt.Errorf(errFmtEncode, Uint32(IDanih), err)
+	}
+
+	return sum, nil
+}
+
+func (ch *ChunkANIH) Reset() {
+	ch.size = ANIHChunkSize
+	ch.anihStatic = anihStatic{HeaderSize: ANIHChunkSize}
+	ch.extra = ch.extra[:0]
+}
@@ -0,0 +1,138 @@
+package riff
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/ctx42/testing/pkg/assert"
+	"github.com/ctx42/testing/pkg/kit/iokit"
+	"github.com/ctx42/testing/pkg/must"
+
+	"github.com/rzajac/riff/internal/test"
+)
+
+func anihChunk(t *testing.T) io.Reader {
+	src := &bytes.Buffer{}
+	test.ReadFrom(t, src, Uint32(IDanih))             // ( 0) 4 - Chunk ID
+	test.WriteUint32LE(t, src, 36)                    // ( 4) 4 - Chunk size
+	test.WriteUint32LE(t, src, 36)                    // ( 8) 4 - HeaderSize
+	test.WriteUint32LE(t, src, 2)                     // (12) 4 - Frames
+	test.WriteUint32LE(t, src, 3)                     // (16) 4 - Steps
+	test.WriteUint32LE(t, src, 0)                     // (20) 4 - Width
+	test.WriteUint32LE(t, src, 0)                     // (24) 4 - Height
+	test.WriteUint32LE(t, src, 0)                     // (28) 4 - BitCount
+	test.WriteUint32LE(t, src, 0)                     // (32) 4 - Planes
+	test.WriteUint32LE(t, src, 6)                     // (36) 4 - JifRate
+	test.WriteUint32LE(t, src, ANIFIcon|ANIFSequence) // (40) 4 - Flags
+	// Total length: 8+36=44
+	return src
+}
+
+func Test_ChunkANIH_ANIH(t *testing.T) {
+	// --- When ---
+	ch := ANIH()
+
+	// --- Then ---
+	assert.Equal(t, IDanih, ch.ID())
+	assert.Equal(t, ANIHChunkSize, ch.Size())
+	assert.Equal(t, uint32(0), ch.Type())
+	assert.False(t, ch.Multi())
+	assert.Nil(t, ch.Chunks())
+	assert.False(t, ch.Raw())
+	assert.Equal(t, ANIHChunkSize, ch.HeaderSize)
+}
+
+func Test_ChunkANIH_ReadFrom(t *testing.T) {
+	// --- Given ---
+	src := anihChunk(t)
+	test.Skip4B(t, src) // Skip chunk ID.
+
+	// --- When ---
+	ch := ANIH()
+	n, err := ch.ReadFrom(src)
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, int64(40), n)
+	assert.Equal(t, ANIHChunkSize, ch.Size())
+	assert.Equal(t, uint32(2), ch.Frames)
+	assert.Equal(t, uint32(3), ch.Steps)
+	assert.Equal(t, uint32(6), ch.JifRate)
+	assert.Equal(t, ANIFIcon|ANIFSequence, ch.Flags)
+	assert.True(t, test.IsAllRead(src))
+}
+
+func Test_ChunkANIH_ReadFrom_Errors(t *testing.T) {
+	// Reading less than 40 bytes should always result in an error.
+	for _, i := range []int{1, 4, 5, 20, 39} {
+		// --- Given ---
+		src := anihChunk(t)
+		test.Skip4B(t, src) // Skip chunk ID.
+
+		// --- When ---
+		_, err := ANIH().ReadFrom(io.LimitReader(src, int64(i)))
+
+		// --- Then ---
+		assert.Error(t, err)
+	}
+}
+
+func Test_ChunkANIH_ReadFrom_TooShortError(t *testing.T) {
+	// --- Given ---
+	src := &bytes.Buffer{}
+	test.WriteUint32LE(t, src, 35)
+
+	// --- When ---
+	_, err := ANIH().ReadFrom(src)
+
+	// --- Then ---
+	assert.ErrorIs(t, ErrTooShort, err)
+}
+
+func Test_ChunkANIH_WriteTo(t *testing.T) {
+	// --- Given ---
+	src := anihChunk(t)
+	test.Skip4B(t, src) // Skip chunk ID.
+
+	ch := ANIH()
+	_, err := ch.ReadFrom(src)
+	assert.NoError(t, err)
+
+	// --- When ---
+	dst := &bytes.Buffer{}
+	n, err := ch.WriteTo(dst)
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, int64(44), n)
+	exp := must.Value(io.ReadAll(anihChunk(t)))
+	assert.Equal(t, exp, dst.Bytes())
+}
+
+func Test_ChunkANIH_WriteTo_Errors(t *testing.T) {
+	for _, i := range []int{1, 4, 8, 43} {
+		// --- When ---
+		_, err := ANIH().WriteTo(iokit.ErrWriter(&bytes.Buffer{}, i))
+
+		// --- Then ---
+		assert.Error(t, err)
+	}
+}
+
+func Test_ChunkANIH_Reset(t *testing.T) {
+	// --- Given ---
+	src := anihChunk(t)
+	test.Skip4B(t, src) // Skip chunk ID.
+	ch := ANIH()
+	_, err := ch.ReadFrom(src)
+	assert.NoError(t, err)
+
+	// --- When ---
+	ch.Reset()
+
+	// --- Then ---
+	assert.Equal(t, ANIHChunkSize, ch.Size())
+	assert.Equal(t, ANIHChunkSize, ch.HeaderSize)
+	assert.Equal(t, uint32(0), ch.Frames)
+}
@@ -0,0 +1,115 @@
+package riff
+
+import (
+	"fmt"
+	"image/color"
+	"io"
+)
+
+// IDANIM represents "ANIM" (WebP animation parameters) chunk ID.
+const IDANIM uint32 = 0x414e494d
+
+// ANIMChunkSize represents the size of ANIM chunk static part in bytes.
+const ANIMChunkSize uint32 = 6
+
+// ChunkANIM represents "ANIM" chunk of the animated WebP image.
+type ChunkANIM struct {
+	// Chunk size in bytes.
+	// The ID and extra padding byte is not counted in the chunk size.
+	size uint32
+
+	// Default background color of the canvas.
+	Background color.NRGBA
+
+	// Number of times to loop the animation. Zero means infinitely.
+	LoopCount uint16
+
+	// Bytes following the static part.
+	extra []byte
+}
+
+// ANIMMake is a [Maker] function for creating [ChunkANIM] instances.
+func ANIMMake() Chunk { return ANIM() }
+
+// ANIM returns a new instance of [ChunkANIM].
+func ANIM() *ChunkANIM {
+	return &ChunkANIM{size: ANIMChunkSize}
+}
+
+func (ch *ChunkANIM) ID() uint32     { return IDANIM }
+func (ch *ChunkANIM) Size() uint32   { return ch.size }
+func (ch *ChunkANIM) Type() uint32   { return 0 }
+func (ch *ChunkANIM) Multi() bool    { return false }
+func (ch *ChunkANIM) Chunks() Chunks { return nil }
+func (ch *ChunkANIM) Raw() bool      { return false }
+
+func (ch *ChunkANIM) ReadFrom(r io.Reader) (int64, error) {
+	var sum int64
+	var err error
+	if ch.size, err = ReadChunkSize(r); err != nil {
+		return sum, fmt.Errorf(errFmtDecode, Uint32(IDANIM), err)
+	}
+	sum += 4
+
+	if ch.size < ANIMChunkSize {
+		return sum, fmt.Errorf(errFmtDecode, Uint32(IDANIM), ErrTooShort)
+	}
+
+	buf := make([]byte, ch.size)
+	in, err := io.ReadFull(r, buf)
+	sum += int64(in)
+	if err != nil {
+		return sum, fmt.Errorf(errFmtDecode, Uint32(IDANIM), err)
+	}
+
+	// The color is stored in [Blue, Green, Red, Alpha] byte order.
+	ch.Background = color.NRGBA{B: buf[0], G: buf[1], R: buf[2], A: buf[3]}
+	ch.LoopCount = ByteOrder(r).Uint16(buf[4:])
+	ch.extra = grow(ch.extra, len(buf)-int(ANIMChunkSize))
+	copy(ch.extra, buf[ANIMChunkSize:])
+
+	n, err := ReadPaddingIf(r, ch.size)
+	sum += n
+	if err != nil {
+		return sum, fmt.Errorf(errFmtDecode, Uint32(IDANIM), err)
+	}
+
+	return sum, nil
+}
+
+func (ch *ChunkANIM) WriteTo(w io.Writer) (int64, error) {
+	var sum int64
+
+	bg := ch.Background
+	buf := []byte{bg.B, bg.G, bg.R, bg.A, 0, 0}
+	ByteOrder(w).PutUint16(buf[4:], ch.LoopCount)
+	buf = append(buf, ch.extra...)
+	ch.size = uint32(len(buf))
+
+	n, err := WriteIDAndSize(w, IDANIM, ch.size)
+	sum += n
+	if err != nil {
+		return sum, fmt.Errorf(errFmtEncode, Uint32(IDANIM), err)
+	}
+
+	in, err := w.Write(buf)
+	sum += int64(in)
+	if err != nil {
+		return sum, fmt.Errorf(errFmtEncode, Uint32(IDANIM), err)
+	}
+
+	n, err = WritePaddingIf(w, ch.size)
+	sum += n
+	if err != nil {
+		return sum, fmt.Errorf(errFmtEncode, Uint32(IDANIM), err)
+	}
+
+	return sum, nil
+}
+
+func (ch *ChunkANIM) Reset() {
+	ch.size = ANIMChunkSize
+	ch.Background = color.NRGBA{}
+	ch.LoopCount = 0
+	ch.extra = ch.extra[:0]
+}
@@ -0,0 +1,126 @@
+package riff
+
+import (
+	"bytes"
+	"image/color"
+	"io"
+	"testing"
+
+	"github.com/ctx42/testing/pkg/assert"
+	"github.com/ctx42/testing/pkg/kit/iokit"
+	"github.com/ctx42/testing/pkg/must"
+
+	"github.com/rzajac/riff/internal/test"
+)
+
+func animChunk(t *testing.T) io.Reader {
+	src := &bytes.Buffer{}
+	test.ReadFrom(t, src, Uint32(IDANIM))          // ( 0) 4 - Chunk ID
+	test.WriteUint32LE(t, src, 6)                  // ( 4) 4 - Chunk size
+	test.WriteBytes(t, src, []byte{1, 2, 3, 0xff}) // ( 8) 4 - Background color
+	test.WriteUint16LE(t, src, 3)                  // (12) 2 - Loop count
+	// Total length: 8+6=14
+	return src
+}
+
+func Test_ChunkANIM_ANIM(t *testing.T) {
+	// --- When ---
+	ch := ANIM()
+
+	// --- Then ---
+	assert.Equal(t, IDANIM, ch.ID())
+	assert.Equal(t, ANIMChunkSize, ch.Size())
+	assert.Equal(t, uint32(0), ch.Type())
+	assert.False(t, ch.Multi())
+	assert.Nil(t, ch.Chunks())
+	assert.False(t, ch.Raw())
+}
+
+func Test_ChunkANIM_ReadFrom(t *testing.T) {
+	// --- Given ---
+	src := animChunk(t)
+	test.Skip4B(t, src) // Skip chunk ID.
+
+	// --- When ---
+	ch := ANIM()
+	n, err := ch.ReadFrom(src)
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, int64(10), n)
+	assert.Equal(t, ANIMChunkSize, ch.Size())
+	assert.Equal(t, color.NRGBA{R: 3, G: 2, B: 1, A: 0xff}, ch.Background)
+	assert.Equal(t, uint16(3), ch.LoopCount)
+	assert.True(t, test.IsAllRead(src))
+}
+
+func Test_ChunkANIM_ReadFrom_Errors(t *testing.T) {
+	// Reading less than 10 bytes should always result in an error.
+	for _, i := range []int{1, 4, 5, 9} {
+		// --- Given ---
+		src := animChunk(t)
+		test.Skip4B(t, src) // Skip chunk ID.
+
+		// --- When ---
+		_, err := ANIM().ReadFrom(io.LimitReader(src, int64(i)))
+
+		// --- Then ---
+		assert.Error(t, err)
+	}
+}
+
+func Test_ChunkANIM_ReadFrom_TooShortError(t *testing.T) {
+	// --- Given ---
+	src := &bytes.Buffer{}
+	test.WriteUint32LE(t, src, 5)
+
+	// --- When ---
+	_, err := ANIM().ReadFrom(src)
+
+	// --- Then ---
+	assert.ErrorIs(t, ErrTooShort, err)
+}
+
+func Test_ChunkANIM_WriteTo(t *testing.T) {
+	// --- Given ---
+	ch := ANIM()
+	ch.Background = color.NRGBA{R: 3, G: 2, B: 1, A: 0xff}
+	ch.LoopCount = 3
+
+	// --- When ---
+	dst := &bytes.Buffer{}
+	n, err := ch.WriteTo(dst)
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, int64(14), n)
+	exp := must.Value(io.ReadAll(animChunk(t)))
+	assert.Equal(t, exp, dst.Bytes())
+}
+
+func Test_ChunkANIM_WriteTo_Errors(t *testing.T) {
+	for _, i := range []int{1, 4, 8, 13} {
+		// --- When ---
+		_, err := ANIM().WriteTo(iokit.ErrWriter(&bytes.Buffer{}, i))
+
+		// --- Then ---
+		assert.Error(t, err)
+	}
+}
+
+func Test_ChunkANIM_Reset(t *testing.T) {
+	// --- Given ---
+	src := animChunk(t)
+	test.Skip4B(t, src) // Skip chunk ID.
+	ch := ANIM()
+	_, err := ch.ReadFrom(src)
+	assert.NoError(t, err)
+
+	// --- When ---
+	ch.Reset()
+
+	// --- Then ---
+	assert.Equal(t, ANIMChunkSize, ch.Size())
+	assert.Equal(t, color.NRGBA{}, ch.Background)
+	assert.Equal(t, uint16(0), ch.LoopCount)
+}
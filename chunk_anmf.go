@@ -0,0 +1,193 @@
+package riff
+
+import (
+	"fmt"
+	"io"
+)
+
+// IDANMF represents "ANMF" (WebP animation frame) chunk ID.
+const IDANMF uint32 = 0x414e4d46
+
+// ANMFChunkSize represents the size of ANMF chunk static part in bytes.
+// Does not count the frame data sub-chunks.
+const ANMFChunkSize uint32 = 16
+
+// Animation frame flags.
+const (
+	// ANMFDispose is set when the frame area should be disposed to the
+	// background color before rendering the next frame.
+	ANMFDispose uint8 = 0x01
+
+	// ANMFNoBlend is set when the frame should not be alpha-blended with
+	// the previous canvas content.
+	ANMFNoBlend uint8 = 0x02
+)
+
+// ChunkANMF represents "ANMF" chunk of the animated WebP image. The frame
+// data ("ALPH", "VP8 " or "VP8L" and unknown chunks) are decoded as
+// sub-chunks.
+type ChunkANMF struct {
+	// Chunk size in bytes.
+	// The ID and extra padding byte is not counted in the chunk size.
+	size uint32
+
+	// Horizontal offset of the frame on the canvas in pixels. Must be even.
+	X uint32
+
+	// Vertical offset of the frame on the canvas in pixels. Must be even.
+	Y uint32
+
+	// Width of the frame in pixels.
+	Width uint32
+
+	// Height of the frame in pixels.
+	Height uint32
+
+	// Duration of the frame in milliseconds.
+	Duration uint32
+
+	// Bitwise combination of ANMF* flags. The reserved bits are preserved.
+	Flags uint8
+
+	// Frame data sub-chunks.
+	chunks Chunks
+
+	// Frame data sub-chunk decoders.
+	reg *Registry
+}
+
+// ANMFMake returns [Maker] function for [ChunkANMF] instances. The frame
+// data sub-chunks are decoded with the decoders registered in reg with
+// [Registry.RegisterList] for the [IDANMF] chunk.
+func ANMFMake(load bool, reg *Registry) Maker {
+	return func() Chunk {
+		return ANMF(load, reg)
+	}
+}
+
+// ANMF returns a new instance of [ChunkANMF]. When reg is nil, the "ALPH",
+// "VP8 " and "VP8L" frame data sub-chunks are decoded.
+func ANMF(load bool, reg *Registry) *ChunkANMF {
+	if reg == nil {
+		reg = NewRegistry(RAWCMake(load))
+		reg.RegisterList(IDANMF, IDALPH, ALPHMake(load))
+		reg.RegisterList(IDANMF, IDVP8, VP8Make(load))
+		reg.RegisterList(IDANMF, IDVP8L, VP8LMake(load))
+	}
+	return &ChunkANMF{
+		size:   ANMFChunkSize,
+		chunks: make(Chunks, 0, 2),
+		reg:    reg.List(IDANMF),
+	}
+}
+
+func (ch *ChunkANMF) ID() uint32     { return IDANMF }
+func (ch *ChunkANMF) Size() uint32   { return ch.size }
+func (ch *ChunkANMF) Type() uint32   { return 0 }
+func (ch *ChunkANMF) Multi() bool    { return true }
+func (ch *ChunkANMF) Chunks() Chunks { return ch.chunks }
+func (ch *ChunkANMF) Raw() bool      { return false }
+
+// Modify set a new set of the frame data chunks.
+func (ch *ChunkANMF) Modify(chs Chunks) {
+	ch.chunks = chs
+	// Recalculate chunks size.
+	ch.size = ANMFChunkSize + ch.chunks.Size()
+}
+
+func (ch *ChunkANMF) ReadFrom(r io.Reader) (int64, error) {
+	var sum int64
+	var err error
+	if ch.size, err = ReadChunkSize(r); err != nil {
+		return sum, fmt.Errorf(errFmtDecode, Uint32(IDANMF), err)
+	}
+	sum += 4
+
+	if ch.size < ANMFChunkSize {
+		return sum, fmt.Errorf(errFmtDecode, Uint32(IDANMF), ErrTooShort)
+	}
+
+	var buf [ANMFChunkSize]byte
+	in, err := io.ReadFull(r, buf[:])
+	sum += int64(in)
+	if err != nil {
+		return sum, fmt.Errorf(errFmtDecode, Uint32(IDANMF), err)
+	}
+	ch.X = uint24(buf[0:]) * 2
+	ch.Y = uint24(buf[3:]) * 2
+	ch.Width = uint24(buf[6:]) + 1
+	ch.Height = uint24(buf[9:]) + 1
+	ch.Duration = uint24(buf[12:])
+	ch.Flags = buf[15]
+
+	var id uint32
+	for sum-4 < int64(ch.size) {
+		if err = ReadChunkID(r, &id); err != nil {
+			return sum, fmt.Errorf(errFmtDecode, Uint32(IDANMF), err)
+		}
+		sum += 4
+
+		dec := ch.reg.Get(id)
+		dec.Reset()
+		n, err := dec.ReadFrom(r)
+		sum += n
+		if err != nil {
+			return sum, fmt.Errorf(errFmtDecode, linkids(IDANMF, id), err)
+		}
+		ch.chunks = append(ch.chunks, dec)
+	}
+
+	if sum-4 != int64(ch.size) {
+		return sum, fmt.Errorf(errFmtDecode, Uint32(IDANMF), ErrChunkSizeMismatch)
+	}
+
+	return sum, nil
+}
+
+func (ch *ChunkANMF) WriteTo(w io.Writer) (int64, error) {
+	var sum int64
+
+	ch.size = ANMFChunkSize + ch.chunks.Size()
+
+	n, err := WriteIDAndSize(w, IDANMF, ch.size)
+	sum += n
+	if err != nil {
+		return sum, fmt.Errorf(errFmtEncode, Uint32(IDANMF), err)
+	}
+
+	var buf [ANMFChunkSize]byte
+	putUint24(buf[0:], ch.X/2)
+	putUint24(buf[3:], ch.Y/2)
+	putUint24(buf[6:], ch.Width-1)
+	putUint24(buf[9:], ch.Height-1)
+	putUint24(buf[12:], ch.Duration)
+	buf[15] = ch.Flags
+
+	in, err := w.Write(buf[:])
+	sum += int64(in)
+	if err != nil {
+		return sum, fmt.Errorf(errFmtEncode, Uint32(IDANMF), err)
+	}
+
+	n, err = ch.chunks.WriteTo(w)
+	sum += n
+	if err != nil {
+		return sum, fmt.Errorf(errFmtEncode, Uint32(IDANMF), err)
+	}
+
+	return sum, nil
+}
+
+func (ch *ChunkANMF) Reset() {
+	ch.size = ANMFChunkSize
+	ch.X = 0
+	ch.Y = 0
+	ch.Width = 0
+	ch.Height = 0
+	ch.Duration = 0
+	ch.Flags = 0
+	for _, dec := range ch.chunks {
+		ch.reg.Put(dec)
+	}
+	ch.chunks = ch.chunks[:0]
+}
@@ -0,0 +1,217 @@
+package riff
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/ctx42/testing/pkg/assert"
+	"github.com/ctx42/testing/pkg/kit/iokit"
+	"github.com/ctx42/testing/pkg/must"
+
+	"github.com/rzajac/riff/internal/test"
+)
+
+func anmfChunk(t *testing.T) io.Reader {
+	src := &bytes.Buffer{}
+	test.ReadFrom(t, src, Uint32(IDANMF))                         // ( 0)  4 - Chunk ID
+	test.WriteUint32LE(t, src, 50)                                // ( 4)  4 - Chunk size
+	test.WriteBytes(t, src, []byte{0x01, 0x00, 0x00})             // ( 8)  3 - X / 2
+	test.WriteBytes(t, src, []byte{0x02, 0x00, 0x00})             // (11)  3 - Y / 2
+	test.WriteBytes(t, src, []byte{0x8f, 0x01, 0x00})             // (14)  3 - Width - 1
+	test.WriteBytes(t, src, []byte{0x2b, 0x01, 0x00})             // (17)  3 - Height - 1
+	test.WriteBytes(t, src, []byte{0x64, 0x00, 0x00})             // (20)  3 - Duration
+	test.WriteByte(t, src, ANMFNoBlend)                           // (23)  1 - Flags
+	test.WriteBytes(t, src, must.Value(io.ReadAll(alphChunk(t)))) // (24) 12 - ALPH
+	test.WriteBytes(t, src, must.Value(io.ReadAll(vp8Chunk(t))))  // (36) 22 - VP8
+	// Total length: 8+50=58
+	return src
+}
+
+func Test_ChunkANMF_ANMF(t *testing.T) {
+	// --- When ---
+	ch := ANMF(LoadData, nil)
+
+	// --- Then ---
+	assert.Equal(t, IDANMF, ch.ID())
+	assert.Equal(t, ANMFChunkSize, ch.Size())
+	assert.Equal(t, uint32(0), ch.Type())
+	assert.True(t, ch.Multi())
+	assert.Len(t, 0, ch.Chunks())
+	assert.False(t, ch.Raw())
+}
+
+func Test_ChunkANMF_ReadFrom(t *testing.T) {
+	// --- Given ---
+	src := anmfChunk(t)
+	test.Skip4B(t, src) // Skip chunk ID.
+
+	// --- When ---
+	ch := ANMF(LoadData, nil)
+	n, err := ch.ReadFrom(src)
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, int64(54), n)
+	assert.Equal(t, uint32(50), ch.Size())
+	assert.Equal(t, uint32(2), ch.X)
+	assert.Equal(t, uint32(4), ch.Y)
+	assert.Equal(t, uint32(400), ch.Width)
+	assert.Equal(t, uint32(300), ch.Height)
+	assert.Equal(t, uint32(100), ch.Duration)
+	assert.Equal(t, ANMFNoBlend, ch.Flags)
+	assert.Equal(t, []uint32{IDALPH, IDVP8}, ch.Chunks().IDs())
+	assert.Type(t, &ChunkALPH{}, ch.Chunks()[0])
+	assert.Equal(t, uint32(400), ch.Chunks()[1].(*ChunkVP8).Width())
+	assert.True(t, test.IsAllRead(src))
+}
+
+func Test_ChunkANMF_ReadFrom_Registry(t *testing.T) {
+	// --- Given ---
+	reg := NewRegistry(RAWCMake(LoadData))
+	reg.RegisterList(IDANMF, IDALPH, func() Chunk { return RAWC(IDALPH, LoadData) })
+	reg.RegisterList(IDANMF, IDVP8, VP8Make(LoadData))
+
+	src := anmfChunk(t)
+	test.Skip4B(t, src) // Skip chunk ID.
+
+	// --- When ---
+	ch := ANMF(LoadData, reg)
+	_, err := ch.ReadFrom(src)
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Type(t, &ChunkRAWC{}, ch.Chunks()[0])
+	assert.Type(t, &ChunkVP8{}, ch.Chunks()[1])
+}
+
+func Test_ChunkANMF_ReadFrom_FrameDecoders(t *testing.T) {
+	// --- Given ---
+	src := &bytes.Buffer{}
+	test.WriteBytes(t, src, must.Value(io.ReadAll(anmfChunk(t)))[4:])
+	test.WriteBytes(t, src, []byte("ANMF\x00\x00\x00\x00VP8X\x00\x00\x00\x00"))
+	le.PutUint32(src.Bytes(), le.Uint32(src.Bytes())+16)
+
+	// --- When ---
+	ch := ANMF(LoadData, New(LoadData).reg)
+	_, err := ch.ReadFrom(src)
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, []uint32{IDALPH, IDVP8, IDANMF, IDVP8X}, ch.Chunks().IDs())
+	assert.Type(t, &ChunkALPH{}, ch.Chunks()[0])
+	assert.Type(t, &ChunkVP8{}, ch.Chunks()[1])
+	assert.Type(t, &ChunkRAWC{}, ch.Chunks()[2])
+	assert.Type(t, &ChunkRAWC{}, ch.Chunks()[3])
+	assert.True(t, test.IsAllRead(src))
+}
+
+func Test_ChunkANMF_ReadFrom_Errors(t *testing.T) {
+	// Reading less than 54 bytes should always result in an error.
+	for _, i := range []int{1, 4, 5, 19, 20, 24, 30, 53} {
+		// --- Given ---
+		src := anmfChunk(t)
+		test.Skip4B(t, src) // Skip chunk ID.
+
+		// --- When ---
+		_, err := ANMF(LoadData, nil).ReadFrom(io.LimitReader(src, int64(i)))
+
+		// --- Then ---
+		assert.Error(t, err)
+	}
+}
+
+func Test_ChunkANMF_ReadFrom_Invalid(t *testing.T) {
+	t.Run("too short", func(t *testing.T) {
+		// --- Given ---
+		src := &bytes.Buffer{}
+		test.WriteUint32LE(t, src, 15)
+
+		// --- When ---
+		_, err := ANMF(LoadData, nil).ReadFrom(src)
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrTooShort, err)
+	})
+
+	t.Run("size mismatch", func(t *testing.T) {
+		// --- Given ---
+		b := must.Value(io.ReadAll(anmfChunk(t)))
+		le.PutUint32(b[4:], 48)
+
+		// --- When ---
+		_, err := ANMF(LoadData, nil).ReadFrom(bytes.NewReader(b[4:]))
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrChunkSizeMismatch, err)
+	})
+}
+
+func Test_ChunkANMF_WriteTo(t *testing.T) {
+	// --- Given ---
+	src := anmfChunk(t)
+	test.Skip4B(t, src) // Skip chunk ID.
+
+	ch := ANMF(LoadData, nil)
+	_, err := ch.ReadFrom(src)
+	assert.NoError(t, err)
+
+	// --- When ---
+	dst := &bytes.Buffer{}
+	n, err := ch.WriteTo(dst)
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, int64(58), n)
+	exp := must.Value(io.ReadAll(anmfChunk(t)))
+	assert.Equal(t, exp, dst.Bytes())
+}
+
+func Test_ChunkANMF_WriteTo_Errors(t *testing.T) {
+	for _, i := range []int{1, 4, 8, 23, 24, 57} {
+		// --- Given ---
+		src := anmfChunk(t)
+		test.Skip4B(t, src) // Skip chunk ID.
+
+		ch := ANMF(LoadData, nil)
+		_, err := ch.ReadFrom(src)
+		assert.NoError(t, err)
+
+		// --- When ---
+		_, err = ch.WriteTo(iokit.ErrWriter(&bytes.Buffer{}, i))
+
+		// --- Then ---
+		assert.Error(t, err)
+	}
+}
+
+func Test_ChunkANMF_Modify(t *testing.T) {
+	// --- Given ---
+	vp8 := VP8(LoadData)
+	assert.NoError(t, vp8.SetData(vp8Bitstream()))
+	ch := ANMF(LoadData, nil)
+
+	// --- When ---
+	ch.Modify(Chunks{vp8})
+
+	// --- Then ---
+	assert.Equal(t, uint32(16+22), ch.Size())
+	assert.Same(t, vp8, ch.Chunks()[0])
+}
+
+func Test_ChunkANMF_Reset(t *testing.T) {
+	// --- Given ---
+	src := anmfChunk(t)
+	test.Skip4B(t, src) // Skip chunk ID.
+	ch := ANMF(LoadData, nil)
+	_, err := ch.ReadFrom(src)
+	assert.NoError(t, err)
+
+	// --- When ---
+	ch.Reset()
+
+	// --- Then ---
+	assert.Equal(t, ANMFChunkSize, ch.Size())
+	assert.Equal(t, uint32(0), ch.Width)
+	assert.Len(t, 0, ch.Chunks())
+}
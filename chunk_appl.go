@@ -0,0 +1,154 @@
+package riff
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+)
+
+// IDAPPL represents "APPL" (application specific) chunk ID of the AIFF
+// file.
+const IDAPPL uint32 = 0x4150504c
+
+// APPLChunkSize represents the size of the "APPL" chunk static part in
+// bytes. Does not count ID and data bytes.
+const APPLChunkSize uint32 = 4
+
+// ChunkAPPL represents the "APPL" chunk of the AIFF file holding the
+// application specific data.
+type ChunkAPPL struct {
+	// Chunk size in bytes.
+	// The ID and extra padding byte is not counted in the chunk size.
+	size uint32
+
+	// Application signature (e.g. "pdos" or "stoc").
+	Signature uint32
+
+	// Application specific data. It's nil in [SkipData] mode.
+	data []byte
+}
+
+// APPLMake returns [Maker] function for creating [ChunkAPPL] instances.
+func APPLMake(load bool) Maker {
+	return func() Chunk {
+		return APPL(load)
+	}
+}
+
+// APPL returns a new instance of [ChunkAPPL]. If load is false the
+// application data will not be loaded into memory.
+func APPL(load bool) *ChunkAPPL {
+	ch := &ChunkAPPL{size: APPLChunkSize}
+	if load {
+		ch.data = make([]byte, 0, 1<<8)
+	}
+	return ch
+}
+
+func (ch *ChunkAPPL) ID() uint32     { return IDAPPL }
+func (ch *ChunkAPPL) Size() uint32   { return ch.size }
+func (ch *ChunkAPPL) Type() uint32   { return 0 }
+func (ch *ChunkAPPL) Multi() bool    { return true }
+func (ch *ChunkAPPL) Chunks() Chunks { return nil }
+func (ch *ChunkAPPL) Raw() bool      { return false }
+
+// Data returns reader for the application data. If in [SkipData] mode, an
+// empty reader is returned.
+func (ch *ChunkAPPL) Data() io.Reader {
+	return bytes.NewReader(ch.data)
+}
+
+// SetData sets the application data bytes. It will return
+// [ErrSkipDataMode] if in [SkipData] mode.
+func (ch *ChunkAPPL) SetData(data []byte) error {
+	if ch.data == nil {
+		return ErrSkipDataMode
+	}
+	ch.data = grow(ch.data, len(data))
+	copy(ch.data, data)
+	ch.size = APPLChunkSize + uint32(len(data))
+	return nil
+}
+
+func (ch *ChunkAPPL) ReadFrom(r io.Reader) (int64, error) {
+	var sum int64
+	var err error
+	if ch.size, err = ReadChunkSize(r); err != nil {
+		return sum, fmt.Errorf(errFmtDecode, Uint32(IDAPPL), err)
+	}
+	sum += 4
+
+	if ch.size < APPLChunkSize {
+		return sum, fmt.Errorf(errFmtDecode, Uint32(IDAPPL), ErrTooShort)
+	}
+
+	if err = ReadChunkID(r, &ch.Signature); err != nil {
+		return sum, fmt.Errorf(errFmtDecode, Uint32(IDAPPL), err)
+	}
+	sum += int64(APPLChunkSize)
+
+	if ch.data == nil {
+		rs := RealSize(ch.size) - APPLChunkSize // Skip padding byte if present.
+		if err = SkipN(r, rs); err != nil {
+			return sum, fmt.Errorf(errFmtDecode, Uint32(IDAPPL), err)
+		}
+		sum += int64(rs)
+		return sum, nil
+	}
+
+	ch.data = grow(ch.data, int(ch.size-APPLChunkSize))
+	in, err := io.ReadFull(r, ch.data)
+	sum += int64(in)
+	if err != nil {
+		return sum, fmt.Errorf(errFmtDecode, Uint32(IDAPPL), err)
+	}
+
+	n, err := ReadPaddingIf(r, ch.size)
+	sum += n
+	if err != nil {
+		return sum, fmt.Errorf(errFmtDecode, Uint32(IDAPPL), err)
+	}
+
+	return sum, nil
+}
+
+func (ch *ChunkAPPL) WriteTo(w io.Writer) (int64, error) {
+	if ch.data == nil {
+		return 0, ErrSkipDataMode
+	}
+
+	var sum int64
+
+	n, err := WriteIDAndSize(w, IDAPPL, ch.size)
+	sum += n
+	if err != nil {
+		return sum, fmt.Errorf(errFmtEncode, Uint32(IDAPPL), err)
+	}
+
+	in, err := w.Write(be.AppendUint32(nil, ch.Signature))
+	sum += int64(in)
+	if err != nil {
+		return sum, fmt.Errorf(errFmtEncode, Uint32(IDAPPL), err)
+	}
+
+	in, err = w.Write(ch.data)
+	sum += int64(in)
+	if err != nil {
+		return sum, fmt.Errorf(errFmtEncode, Uint32(IDAPPL), err)
+	}
+
+	n, err = WritePaddingIf(w, ch.size)
+	sum += n
+	if err != nil {
+		return sum, fmt.Errorf(errFmtEncode, Uint32(IDAPPL), err)
+	}
+
+	return sum, nil
+}
+
+// Reset resets the chunk so it can be reused.
+func (ch *ChunkAPPL) Reset() {
+	ch.size = APPLChunkSize
+	ch.Signature = 0
+	ch.data = ch.data[:0]
+}
@@ -0,0 +1,189 @@
+package riff
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/ctx42/testing/pkg/assert"
+	"github.com/ctx42/testing/pkg/kit/iokit"
+	"github.com/ctx42/testing/pkg/must"
+
+	"github.com/rzajac/riff/internal/test"
+)
+
+func applChunk(t *testing.T) io.Reader {
+	src := &bytes.Buffer{}
+	test.ReadFrom(t, src, Uint32(IDAPPL))    // ( 0) 4 - Chunk ID
+	test.WriteUint32BE(t, src, 7)            // ( 4) 4 - Chunk size
+	test.WriteBytes(t, src, []byte("stoc"))  // ( 8) 4 - Signature
+	test.WriteBytes(t, src, []byte{1, 2, 3}) // (12) 3 - Data
+	test.WriteByte(t, src, 0)                // (15) 1 - Padding
+	// Total length: 8+7+1=16
+	return src
+}
+
+func Test_ChunkAPPL_APPL(t *testing.T) {
+	// --- When ---
+	ch := APPL(LoadData)
+
+	// --- Then ---
+	assert.Equal(t, IDAPPL, ch.ID())
+	assert.Equal(t, APPLChunkSize, ch.Size())
+	assert.Equal(t, uint32(0), ch.Type())
+	assert.True(t, ch.Multi())
+	assert.Nil(t, ch.Chunks())
+	assert.False(t, ch.Raw())
+	assert.NotNil(t, ch.data)
+}
+
+func Test_ChunkAPPL_APPL_SkipDataMode(t *testing.T) {
+	// --- When ---
+	ch := APPL(SkipData)
+
+	// --- Then ---
+	assert.Nil(t, ch.data)
+}
+
+func Test_ChunkAPPL_ReadFrom(t *testing.T) {
+	// --- Given ---
+	src := applChunk(t)
+	test.Skip4B(t, src) // Skip chunk ID.
+
+	// --- When ---
+	ch := APPL(LoadData)
+	n, err := ch.ReadFrom(OrderReader(src, be))
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, int64(12), n)
+	assert.Equal(t, uint32(7), ch.Size())
+	assert.Equal(t, "stoc", Uint32(ch.Signature).String())
+	assert.Equal(t, []byte{1, 2, 3}, must.Value(io.ReadAll(ch.Data())))
+	assert.True(t, test.IsAllRead(src))
+}
+
+func Test_ChunkAPPL_ReadFrom_SkipDataMode(t *testing.T) {
+	// --- Given ---
+	src := applChunk(t)
+	test.Skip4B(t, src) // Skip chunk ID.
+
+	// --- When ---
+	ch := APPL(SkipData)
+	n, err := ch.ReadFrom(OrderReader(src, be))
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, int64(12), n)
+	assert.Equal(t, uint32(7), ch.Size())
+	assert.Nil(t, ch.data)
+	assert.True(t, test.IsAllRead(src))
+}
+
+func Test_ChunkAPPL_ReadFrom_Errors(t *testing.T) {
+	// Reading less than 12 bytes should always result in an error.
+	for _, load := range []bool{LoadData, SkipData} {
+		for _, i := range []int{1, 4, 5, 8, 10, 11} {
+			// --- Given ---
+			src := applChunk(t)
+			test.Skip4B(t, src) // Skip chunk ID.
+
+			// --- When ---
+			_, err := APPL(load).ReadFrom(OrderReader(io.LimitReader(src, int64(i)), be))
+
+			// --- Then ---
+			assert.Error(t, err)
+		}
+	}
+}
+
+func Test_ChunkAPPL_ReadFrom_TooShort(t *testing.T) {
+	// --- Given ---
+	src := &bytes.Buffer{}
+	test.WriteUint32BE(t, src, 3)
+
+	// --- When ---
+	_, err := APPL(LoadData).ReadFrom(OrderReader(src, be))
+
+	// --- Then ---
+	assert.ErrorIs(t, ErrTooShort, err)
+}
+
+func Test_ChunkAPPL_SetData(t *testing.T) {
+	t.Run("load data", func(t *testing.T) {
+		// --- Given ---
+		ch := APPL(LoadData)
+
+		// --- When ---
+		err := ch.SetData([]byte{1, 2, 3})
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, uint32(7), ch.Size())
+	})
+
+	t.Run("skip data", func(t *testing.T) {
+		// --- When ---
+		err := APPL(SkipData).SetData([]byte{1, 2, 3})
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrSkipDataMode, err)
+	})
+}
+
+func Test_ChunkAPPL_WriteTo(t *testing.T) {
+	// --- Given ---
+	ch := APPL(LoadData)
+	ch.Signature = 0x73746f63 // stoc
+	must.Nil(ch.SetData([]byte{1, 2, 3}))
+
+	// --- When ---
+	dst := &bytes.Buffer{}
+	n, err := ch.WriteTo(OrderWriter(dst, be))
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, int64(16), n)
+	exp := must.Value(io.ReadAll(applChunk(t)))
+	assert.Equal(t, exp, dst.Bytes())
+}
+
+func Test_ChunkAPPL_WriteTo_SkipDataMode(t *testing.T) {
+	// --- When ---
+	n, err := APPL(SkipData).WriteTo(&bytes.Buffer{})
+
+	// --- Then ---
+	assert.ErrorIs(t, ErrSkipDataMode, err)
+	assert.Equal(t, int64(0), n)
+}
+
+func Test_ChunkAPPL_WriteTo_Errors(t *testing.T) {
+	for _, i := range []int{1, 4, 8, 12, 15} {
+		// --- Given ---
+		ch := APPL(LoadData)
+		must.Nil(ch.SetData([]byte{1, 2, 3}))
+
+		// --- When ---
+		_, err := ch.WriteTo(iokit.ErrWriter(&bytes.Buffer{}, i))
+
+		// --- Then ---
+		assert.Error(t, err)
+	}
+}
+
+func Test_ChunkAPPL_Reset(t *testing.T) {
+	// --- Given ---
+	src := applChunk(t)
+	test.Skip4B(t, src) // Skip chunk ID.
+	ch := APPL(LoadData)
+	_, err := ch.ReadFrom(OrderReader(src, be))
+	assert.NoError(t, err)
+
+	// --- When ---
+	ch.Reset()
+
+	// --- Then ---
+	assert.Equal(t, APPLChunkSize, ch.Size())
+	assert.Equal(t, uint32(0), ch.Signature)
+	assert.Equal(t, []byte{}, ch.data)
+}
@@ -0,0 +1,190 @@
+package riff
+
+import (
+	"fmt"
+	"io"
+)
+
+// IDs of the DLS articulator chunks.
+const (
+	// IDart1 represents "art1" (DLS level 1 articulator) sub-chunk ID of
+	// the "LIST lart" chunk.
+	IDart1 uint32 = 0x61727431
+
+	// IDart2 represents "art2" (DLS level 2 articulator) sub-chunk ID of
+	// the "LIST lar2" chunk.
+	IDart2 uint32 = 0x61727432
+)
+
+// ARTChunkSize represents the size of the articulator chunk static part
+// in bytes.
+const ARTChunkSize uint32 = 8
+
+// DLSConnectionSize represents the size of the connection block in bytes.
+const DLSConnectionSize uint32 = 12
+
+// DLSConnection represents the articulator connection block. It connects
+// the source (e.g. LFO, key number) to the destination (e.g. pitch, gain).
+type DLSConnection struct {
+	// Source of the connection.
+	Source uint16
+
+	// Control of the connection.
+	Control uint16
+
+	// Destination of the connection.
+	Destination uint16
+
+	// Transform applied to the source.
+	Transform uint16
+
+	// Scale of the connection.
+	Scale int32
+}
+
+// ChunkART represents "art1" or "art2" chunk of the DLS instrument or
+// region.
+type ChunkART struct {
+	// Chunk ID.
+	id uint32
+
+	// Chunk size in bytes.
+	// The ID and extra padding byte is not counted in the chunk size.
+	size uint32
+
+	// Size of the static part in bytes. Should be 8.
+	HeaderSize uint32
+
+	// Number of connection blocks.
+	ConnectionCount uint32
+
+	// Connection blocks. Decoded only when HeaderSize is 8.
+	Connections []DLSConnection
+
+	// Bytes following the static part which are not decoded connections.
+	extra []byte
+}
+
+// ARTMake returns [IDMaker] function for creating [ChunkART] instances.
+func ARTMake(_ bool) IDMaker {
+	return func(id uint32) Chunk {
+		return ART(id)
+	}
+}
+
+// ART returns a new instance of [ChunkART] for given ID ([IDart1] or
+// [IDart2]).
+func ART(id uint32) *ChunkART {
+	return &ChunkART{id: id, size: ARTChunkSize, HeaderSize: ARTChunkSize}
+}
+
+func (ch *ChunkART) ID() uint32 { return ch.id }
+func (ch *ChunkART) Size() uint32 {
+	return ARTChunkSize + uint32(len(ch.Connections))*DLSConnectionSize + uint32(len(ch.extra))
+}
+func (ch *ChunkART) Type() uint32   { return 0 }
+func (ch *ChunkART) Multi() bool    { return true }
+func (ch *ChunkART) Chunks() Chunks { return nil }
+func (ch *ChunkART) Raw() bool      { return false }
+
+func (ch *ChunkART) ReadFrom(r io.Reader) (int64, error) {
+	bo := ByteOrder(r)
+	var sum int64
+	var err error
+	if ch.size, err = ReadChunkSize(r); err != nil {
+		return sum, fmt.Errorf(errFmtDecode, Uint32(ch.id), err)
+	}
+	sum += 4
+
+	if ch.size < ARTChunkSize {
+		return sum, fmt.Errorf(errFmtDecode, Uint32(ch.id), ErrTooShort)
+	}
+
+	buf := make([]byte, ch.size)
+	in, err := io.ReadFull(r, buf)
+	sum += int64(in)
+	if err != nil {
+		return sum, fmt.Errorf(errFmtDecode, Uint32(ch.id), err)
+	}
+
+	ch.HeaderSize = bo.Uint32(buf)
+	ch.ConnectionCount = bo.Uint32(buf[4:])
+	buf = buf[ARTChunkSize:]
+
+	if ch.HeaderSize == ARTChunkSize {
+		cs := int(DLSConnectionSize)
+		if uint64(ch.ConnectionCount)*uint64(cs) > uint64(len(buf)) {
+			return sum, fmt.Errorf(errFmtDecode, Uint32(ch.id), ErrChunkSizeMismatch)
+		}
+		for i := 0; i < int(ch.ConnectionCount); i++ {
+			b := buf[i*cs:]
+			ch.Connections = append(ch.Connections, DLSConnection{
+				Source:      bo.Uint16(b),
+				Control:     bo.Uint16(b[2:]),
+				Destination: bo.Uint16(b[4:]),
+				Transform:   bo.Uint16(b[6:]),
+				Scale:       int32(bo.Uint32(b[8:])),
+			})
+		}
+		buf = buf[int(ch.ConnectionCount)*cs:]
+	}
+	ch.extra = append(ch.extra[:0], buf...)
+
+	n, err := ReadPaddingIf(r, ch.size)
+	sum += n
+	if err != nil {
+		return sum, fmt.Errorf(errFmtDecode, Uint32(ch.id), err)
+	}
+
+	return sum, nil
+}
+
+func (ch *ChunkART) WriteTo(w io.Writer) (int64, error) {
+	bo := ByteOrder(w)
+	var sum int64
+
+	ch.size = ch.Size()
+	if ch.HeaderSize == ARTChunkSize {
+		ch.ConnectionCount = uint32(len(ch.Connections))
+	}
+
+	n, err := WriteIDAndSize(w, ch.id, ch.size)
+	sum += n
+	if err != nil {
+		return sum, fmt.Errorf(errFmtEncode, Uint32(ch.id), err)
+	}
+
+	buf := make([]byte, 0, ch.size)
+	buf = bo.AppendUint32(buf, ch.HeaderSize)
+	buf = bo.AppendUint32(buf, ch.ConnectionCount)
+	for _, c := range ch.Connections {
+		buf = bo.AppendUint16(buf, c.Source)
+		buf = bo.AppendUint16(buf, c.Control)
+		buf = bo.AppendUint16(buf, c.Destination)
+		buf = bo.AppendUint16(buf, c.Transform)
+		buf = bo.AppendUint32(buf, uint32(c.Scale))
+	}
+	buf = append(buf, ch.extra...)
+
+	in, err := w.Write(buf)
+	sum += int64(in)
+	if err != nil {
+		return sum, fmt.Errorf(errFmtEncode, Uint32(ch.id), err)
+	}
+
+	n, err = WritePaddingIf(w, ch.size)
+	sum += n
+	if err != nil {
+		return sum, fmt.Errorf(errFmtEncode, Uint32(ch.id), err)
+	}
+
+	return sum, nil
+}
+
+func (ch *ChunkART) Reset() {
+	ch.size = ARTChunkSize
+	ch.HeaderSize = ARTChunkSize
+	ch.ConnectionCount = 0
+	ch.Connections = ch.Connections[:0]
+	ch.extra = ch.extra[:0]
+}
@@ -0,0 +1,172 @@
+package riff
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/ctx42/testing/pkg/assert"
+	"github.com/ctx42/testing/pkg/kit/iokit"
+	"github.com/ctx42/testing/pkg/must"
+
+	"github.com/rzajac/riff/internal/test"
+)
+
+func artChunk(t *testing.T) io.Reader {
+	src := &bytes.Buffer{}
+	test.ReadFrom(t, src, Uint32(IDart1))  // ( 0) 4 - Chunk ID
+	test.WriteUint32LE(t, src, 20)         // ( 4) 4 - Chunk size
+	test.WriteUint32LE(t, src, 8)          // ( 8) 4 - HeaderSize
+	test.WriteUint32LE(t, src, 1)          // (12) 4 - ConnectionCount
+	test.WriteUint16LE(t, src, 0x0001)     // (16) 2 - Source
+	test.WriteUint16LE(t, src, 0x0000)     // (18) 2 - Control
+	test.WriteUint16LE(t, src, 0x0003)     // (20) 2 - Destination
+	test.WriteUint16LE(t, src, 0x0000)     // (22) 2 - Transform
+	test.WriteUint32LE(t, src, 0xffff0000) // (24) 4 - Scale (-65536)
+	// Total length: 8+20=28
+	return src
+}
+
+func Test_ChunkART_ART(t *testing.T) {
+	// --- When ---
+	ch := ART(IDart2)
+
+	// --- Then ---
+	assert.Equal(t, IDart2, ch.ID())
+	assert.Equal(t, ARTChunkSize, ch.Size())
+	assert.Equal(t, uint32(0), ch.Type())
+	assert.True(t, ch.Multi())
+	assert.Nil(t, ch.Chunks())
+	assert.False(t, ch.Raw())
+	assert.Equal(t, ARTChunkSize, ch.HeaderSize)
+}
+
+func Test_ChunkART_ARTMake(t *testing.T) {
+	// --- When ---
+	ch := ARTMake(LoadData)(IDart1)
+
+	// --- Then ---
+	assert.Equal(t, IDart1, ch.ID())
+}
+
+func Test_ChunkART_ReadFrom(t *testing.T) {
+	// --- Given ---
+	src := artChunk(t)
+	test.Skip4B(t, src) // Skip chunk ID.
+
+	// --- When ---
+	ch := ART(IDart1)
+	n, err := ch.ReadFrom(src)
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, int64(24), n)
+	assert.Equal(t, uint32(20), ch.Size())
+	assert.Equal(t, uint32(1), ch.ConnectionCount)
+	exp := []DLSConnection{{Source: 1, Destination: 3, Scale: -65536}}
+	assert.Equal(t, exp, ch.Connections)
+	assert.True(t, test.IsAllRead(src))
+}
+
+func Test_ChunkART_ReadFrom_UnknownHeader(t *testing.T) {
+	// --- Given ---
+	b := must.Value(io.ReadAll(artChunk(t)))
+	le.PutUint32(b[8:], 12)
+
+	// --- When ---
+	ch := ART(IDart1)
+	_, err := ch.ReadFrom(bytes.NewReader(b[4:]))
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Len(t, 0, ch.Connections)
+
+	dst := &bytes.Buffer{}
+	must.Value(ch.WriteTo(dst))
+	assert.Equal(t, b, dst.Bytes())
+}
+
+func Test_ChunkART_ReadFrom_Errors(t *testing.T) {
+	// Reading less than 24 bytes should always result in an error.
+	for _, i := range []int{1, 4, 5, 23} {
+		// --- Given ---
+		src := artChunk(t)
+		test.Skip4B(t, src) // Skip chunk ID.
+
+		// --- When ---
+		_, err := ART(IDart1).ReadFrom(io.LimitReader(src, int64(i)))
+
+		// --- Then ---
+		assert.Error(t, err)
+	}
+}
+
+func Test_ChunkART_ReadFrom_Invalid(t *testing.T) {
+	t.Run("too short", func(t *testing.T) {
+		// --- Given ---
+		src := &bytes.Buffer{}
+		test.WriteUint32LE(t, src, 7)
+
+		// --- When ---
+		_, err := ART(IDart1).ReadFrom(src)
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrTooShort, err)
+	})
+
+	t.Run("connection count", func(t *testing.T) {
+		// --- Given ---
+		b := must.Value(io.ReadAll(artChunk(t)))
+		le.PutUint32(b[12:], 2)
+
+		// --- When ---
+		_, err := ART(IDart1).ReadFrom(bytes.NewReader(b[4:]))
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrChunkSizeMismatch, err)
+	})
+}
+
+func Test_ChunkART_WriteTo(t *testing.T) {
+	// --- Given ---
+	ch := ART(IDart1)
+	ch.Connections = []DLSConnection{{Source: 1, Destination: 3, Scale: -65536}}
+
+	// --- When ---
+	dst := &bytes.Buffer{}
+	n, err := ch.WriteTo(dst)
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, int64(28), n)
+	exp := must.Value(io.ReadAll(artChunk(t)))
+	assert.Equal(t, exp, dst.Bytes())
+}
+
+func Test_ChunkART_WriteTo_Errors(t *testing.T) {
+	for _, i := range []int{1, 4, 8, 15} {
+		// --- When ---
+		_, err := ART(IDart1).WriteTo(iokit.ErrWriter(&bytes.Buffer{}, i))
+
+		// --- Then ---
+		assert.Error(t, err)
+	}
+}
+
+func Test_ChunkART_Reset(t *testing.T) {
+	// --- Given ---
+	src := artChunk(t)
+	test.Skip4B(t, src) // Skip chunk ID.
+	ch := ART(IDart1)
+	_, err := ch.ReadFrom(src)
+	assert.NoError(t, err)
+
+	// --- When ---
+	ch.Reset()
+
+	// --- Then ---
+	assert.Equal(t, IDart1, ch.ID())
+	assert.Equal(t, ARTChunkSize, ch.Size())
+	assert.Equal(t, uint32(0), ch.ConnectionCount)
+	assert.Len(t, 0, ch.Connections)
+}
@@ -0,0 +1,192 @@
+package riff
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"time"
+)
+
+// IDhdrl represents "hdrl" (AVI header) type of the LIST chunk.
+const IDhdrl uint32 = 0x6864726c
+
+// IDavih represents "avih" (AVI main header) sub-chunk ID of the
+// "LIST hdrl" chunk.
+const IDavih uint32 = 0x61766968
+
+// AVIHChunkSize represents the size of avih chunk static part in bytes.
+const AVIHChunkSize uint32 = 56
+
+// AVI main header flags.
+const (
+	// AVIFHasIndex indicates the file has "idx1" index.
+	AVIFHasIndex uint32 = 0x00000010
+
+	// AVIFMustUseIndex indicates the index should be used to determine
+	// the order of the presentation of the data.
+	AVIFMustUseIndex uint32 = 0x00000020
+
+	// AVIFIsInterleaved indicates the file is interleaved.
+	AVIFIsInterleaved uint32 = 0x00000100
+
+	// AVIFTrustCKType indicates the keyframe flags in the index are
+	// trustworthy.
+	AVIFTrustCKType uint32 = 0x00000800
+
+	// AVIFWasCaptureFile indicates the file is specially allocated file
+	// used for capturing real-time video.
+	AVIFWasCaptureFile uint32 = 0x00010000
+
+	// AVIFCopyrighted indicates the file contains copyrighted data.
+	AVIFCopyrighted uint32 = 0x00020000
+)
+
+// avihStatic represents chunk static data (always there).
+// This struct is defined separately to allow for binary
+// decoding / encoding in one call to binary.Read / binary.Write.
+type avihStatic struct {
+	// Number of microseconds between frames.
+	MicroSecPerFrame uint32
+
+	// Approximate maximum data rate of the file in bytes per second.
+	MaxBytesPerSec uint32
+
+	// Alignment for data in bytes.
+	PaddingGranularity uint32
+
+	// Bitwise combination of AVIF* flags.
+	Flags uint32
+
+	// Total number of frames of data in the "RIFF AVI " form.
+	TotalFrames uint32
+
+	// Initial frame for interleaved files.
+	InitialFrames uint32
+
+	// Number of streams in the file.
+	Streams uint32
+
+	// Suggested buffer size for reading the file.
+	SuggestedBufferSize uint32
+
+	// Width of the video in pixels.
+	Width uint32
+
+	// Height of the video in pixels.
+	Height uint32
+
+	// Reserved. Set to zero.
+	Reserved [4]uint32
+}
+
+// ChunkAVIH represents "avih" chunk (MainAVIHeader) of the AVI file.
+type ChunkAVIH struct {
+	// Chunk size in bytes.
+	// The ID and extra padding byte is not counted in the chunk size.
+	size uint32
+
+	avihStatic
+
+	// Bytes following the static part.
+	extra []byte
+}
+
+// AVIHMake is a [Maker] function for creating [ChunkAVIH] instances.
+func AVIHMake() Chunk { return AVIH() }
+
+// AVIH returns a new instance of [ChunkAVIH].
+func AVIH() *ChunkAVIH {
+	return &ChunkAVIH{size: AVIHChunkSize}
+}
+
+func (ch *ChunkAVIH) ID() uint32     { return IDavih }
+func (ch *ChunkAVIH) Size() uint32   { return ch.size }
+func (ch *ChunkAVIH) Type() uint32   { return 0 }
+func (ch *ChunkAVIH) Multi() bool    { return false }
+func (ch *ChunkAVIH) Chunks() Chunks { return nil }
+func (ch *ChunkAVIH) Raw() bool      { return false }
+
+// FrameRate returns number of frames per second. Returns zero when the
+// frame duration is not set.
+func (ch *ChunkAVIH) FrameRate() float64 {
+	if ch.MicroSecPerFrame == 0 {
+		return 0
+	}
+	return 1e6 / float64(ch.MicroSecPerFrame)
+}
+
+// Duration returns the duration of the video.
+func (ch *ChunkAVIH) Duration() time.Duration {
+	us := uint64(ch.TotalFrames) * uint64(ch.MicroSecPerFrame)
+	return time.Duration(us) * time.Microsecond
+}
+
+func (ch *ChunkAVIH) ReadFrom(r io.Reader) (int64, error) {
+	bo := ByteOrder(r)
+	var sum int64
+	if err := binary.Read(r, bo, &ch.size); err != nil {
+		return sum, fmt.Errorf(errFmtDecode, linkids(IDhdrl, IDavih), err)
+	}
+	sum += 4
+
+	if ch.size < AVIHChunkSize {
+		return sum, fmt.Errorf(errFmtDecode, linkids(IDhdrl, IDavih), ErrTooShort)
+	}
+
+	if err := binary.Read(r, bo, &ch.avihStatic); err != nil {
+		return sum, fmt.Errorf(errFmtDecode, linkids(IDhdrl, IDavih), err)
+	}
+	sum += int64(AVIHChunkSize)
+
+	ch.extra = grow(ch.extra, int(ch.size-AVIHChunkSize))
+	in, err := io.ReadFull(r, ch.extra)
+	sum += int64(in)
+	if err != nil {
+		return sum, fmt.Errorf(errFmtDecode, linkids(IDhdrl, IDavih), err)
+	}
+
+	n, err := ReadPaddingIf(r, ch.size)
+	sum += n
+	if err != nil {
+		return sum, fmt.Errorf(errFmtDecode, linkids(IDhdrl, IDavih), err)
+	}
+
+	return sum, nil
+}
+
+func (ch *ChunkAVIH) WriteTo(w io.Writer) (int64, error) {
+	var sum int64
+
+	ch.size = AVIHChunkSize + uint32(len(ch.extra))
+
+	n, err := WriteIDAndSize(w, IDavih, ch.size)
+	sum += n
+	if err != nil {
+		return sum, fmt.Errorf(errFmtEncode, linkids(IDhdrl, IDavih), err)
+	}
+
+	if err = binary.Write(w, ByteOrder(w), ch.avihStatic); err != nil {
+		return sum, fmt.Errorf(errFmtEncode, linkids(IDhdrl, IDavih), err)
+	}
+	sum += int64(AVIHChunkSize)
+
+	in, err := w.Write(ch.extra)
+	sum += int64(in)
+	if err != nil {
+		return sum, fmt.Errorf(errFmtEncode, linkids(IDhdrl, IDavih), err)
+	}
+
+	n, err = WritePaddingIf(w, ch.size)
+	sum += n
+	if err != nil {
+		return sum, fmt.Errorf(errFmtEncode, linkids(IDhdrl, IDavih), err)
+	}
+
+	return sum, nil
+}
+
+func (ch *ChunkAVIH) Reset() {
+	ch.size = AVIHChunkSize
+	ch.avihStatic = avihStatic{}
+	ch.extra = ch.extra[:0]
+}
@@ -0,0 +1,153 @@
+package riff
+
+import (
+	"bytes"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/ctx42/testing/pkg/assert"
+	"github.com/ctx42/testing/pkg/kit/iokit"
+	"github.com/ctx42/testing/pkg/must"
+
+	"github.com/rzajac/riff/internal/test"
+)
+
+func avihChunk(t *testing.T) io.Reader {
+	src := &bytes.Buffer{}
+	test.ReadFrom(t, src, Uint32(IDavih))     // ( 0) 4 - Chunk ID
+	test.WriteUint32LE(t, src, 56)            // ( 4) 4 - Chunk size
+	test.WriteUint32LE(t, src, 66666)         // ( 8) 4 - MicroSecPerFrame
+	test.WriteUint32LE(t, src, 69080)         // (12) 4 - MaxBytesPerSec
+	test.WriteUint32LE(t, src, 0)             // (16) 4 - PaddingGranularity
+	test.WriteUint32LE(t, src, 0x110)         // (20) 4 - Flags
+	test.WriteUint32LE(t, src, 50)            // (24) 4 - TotalFrames
+	test.WriteUint32LE(t, src, 0)             // (28) 4 - InitialFrames
+	test.WriteUint32LE(t, src, 2)             // (32) 4 - Streams
+	test.WriteUint32LE(t, src, 0)             // (36) 4 - SuggestedBufferSize
+	test.WriteUint32LE(t, src, 80)            // (40) 4 - Width
+	test.WriteUint32LE(t, src, 60)            // (44) 4 - Height
+	test.WriteBytes(t, src, make([]byte, 16)) // (48) 16 - Reserved
+	// Total length: 8+56=64
+	return src
+}
+
+func Test_ChunkAVIH_AVIH(t *testing.T) {
+	// --- When ---
+	ch := AVIH()
+
+	// --- Then ---
+	assert.Equal(t, IDavih, ch.ID())
+	assert.Equal(t, AVIHChunkSize, ch.Size())
+	assert.Equal(t, uint32(0), ch.Type())
+	assert.False(t, ch.Multi())
+	assert.Nil(t, ch.Chunks())
+	assert.False(t, ch.Raw())
+	assert.Equal(t, 0.0, ch.FrameRate())
+}
+
+func Test_ChunkAVIH_ReadFrom(t *testing.T) {
+	// --- Given ---
+	src := avihChunk(t)
+	test.Skip4B(t, src) // Skip chunk ID.
+
+	// --- When ---
+	ch := AVIH()
+	n, err := ch.ReadFrom(src)
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, int64(60), n)
+	assert.Equal(t, uint32(56), ch.Size())
+	assert.Equal(t, uint32(66666), ch.MicroSecPerFrame)
+	assert.Equal(t, uint32(69080), ch.MaxBytesPerSec)
+	assert.Equal(t, AVIFHasIndex|AVIFIsInterleaved, ch.Flags)
+	assert.Equal(t, uint32(50), ch.TotalFrames)
+	assert.Equal(t, uint32(2), ch.Streams)
+	assert.Equal(t, uint32(80), ch.Width)
+	assert.Equal(t, uint32(60), ch.Height)
+	assert.Epsilon(t, 15.0, 0.001, ch.FrameRate())
+	assert.Equal(t, 3333300*time.Microsecond, ch.Duration())
+	assert.True(t, test.IsAllRead(src))
+}
+
+func Test_ChunkAVIH_ReadFrom_Errors(t *testing.T) {
+	// Reading less than 60 bytes should always result in an error.
+	for _, i := range []int{1, 4, 5, 20, 59} {
+		// --- Given ---
+		src := avihChunk(t)
+		test.Skip4B(t, src) // Skip chunk ID.
+
+		// --- When ---
+		_, err := AVIH().ReadFrom(io.LimitReader(src, int64(i)))
+
+		// --- Then ---
+		assert.Error(t, err)
+	}
+}
+
+func Test_ChunkAVIH_ReadFrom_TooShortError(t *testing.T) {
+	// --- Given ---
+	src := &bytes.Buffer{}
+	test.WriteUint32LE(t, src, 55)
+
+	// --- When ---
+	_, err := AVIH().ReadFrom(src)
+
+	// --- Then ---
+	assert.ErrorIs(t, ErrTooShort, err)
+}
+
+func Test_ChunkAVIH_WriteTo(t *testing.T) {
+	// --- Given ---
+	src := avihChunk(t)
+	test.Skip4B(t, src) // Skip chunk ID.
+
+	ch := AVIH()
+	_, err := ch.ReadFrom(src)
+	assert.NoError(t, err)
+
+	// --- When ---
+	dst := &bytes.Buffer{}
+	n, err := ch.WriteTo(dst)
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, int64(64), n)
+	exp := must.Value(io.ReadAll(avihChunk(t)))
+	assert.Equal(t, exp, dst.Bytes())
+}
+
+func Test_ChunkAVIH_WriteTo_Errors(t *testing.T) {
+	for _, i := range []int{1, 4, 8, 63} {
+		// --- Given ---
+		src := avihChunk(t)
+		test.Skip4B(t, src) // Skip chunk ID.
+
+		ch := AVIH()
+		_, err := ch.ReadFrom(src)
+		assert.NoError(t, err)
+
+		// --- When ---
+		_, err = ch.WriteTo(iokit.ErrWriter(&bytes.Buffer{}, i))
+
+		// --- Then ---
+		assert.Error(t, err)
+	}
+}
+
+func Test_ChunkAVIH_Reset(t *testing.T) {
+	// --- Given ---
+	src := avihChunk(t)
+	test.Skip4B(t, src) // Skip chunk ID.
+	ch := AVIH()
+	_, err := ch.ReadFrom(src)
+	assert.NoError(t, err)
+
+	// --- When ---
+	ch.Reset()
+
+	// --- Then ---
+	assert.Equal(t, AVIHChunkSize, ch.Size())
+	assert.Equal(t, uint32(0), ch.TotalFrames)
+}
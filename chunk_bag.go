@@ -0,0 +1,109 @@
+package riff
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+)
+
+// IDs of the SoundFont zone chunks.
+const (
+	// IDpbag represents "pbag" (preset zones) sub-chunk ID of the "LIST pdta"
+	// chunk.
+	IDpbag uint32 = 0x70626167
+
+	// IDibag represents "ibag" (instrument zones) sub-chunk ID of the
+	// "LIST pdta" chunk.
+	IDibag uint32 = 0x69626167
+)
+
+// SF2BagSize represents the size of the zone record in bytes.
+const SF2BagSize uint32 = 4
+
+// SF2Bag represents the SoundFont zone record.
+type SF2Bag struct {
+	// Index of the first zone generator.
+	GenIndex uint16
+
+	// Index of the first zone modulator.
+	ModIndex uint16
+}
+
+// ChunkBAG represents "pbag" or "ibag" chunk of the SoundFont file.
+type ChunkBAG struct {
+	// Chunk ID.
+	id uint32
+
+	// Chunk size in bytes.
+	// The ID and extra padding byte is not counted in the chunk size.
+	size uint32
+
+	// Zone records. The last one is the terminal record.
+	Bags []SF2Bag
+}
+
+// BAGMake returns [IDMaker] function for creating [ChunkBAG] instances.
+func BAGMake(_ bool) IDMaker {
+	return func(id uint32) Chunk {
+		return BAG(id)
+	}
+}
+
+// BAG returns a new instance of [ChunkBAG] for given ID ([IDpbag] or
+// [IDibag]).
+func BAG(id uint32) *ChunkBAG {
+	return &ChunkBAG{id: id}
+}
+
+func (ch *ChunkBAG) ID() uint32     { return ch.id }
+func (ch *ChunkBAG) Size() uint32   { return uint32(len(ch.Bags)) * SF2BagSize }
+func (ch *ChunkBAG) Type() uint32   { return 0 }
+func (ch *ChunkBAG) Multi() bool    { return false }
+func (ch *ChunkBAG) Chunks() Chunks { return nil }
+func (ch *ChunkBAG) Raw() bool      { return false }
+
+func (ch *ChunkBAG) ReadFrom(r io.Reader) (int64, error) {
+	var sum int64
+	var err error
+	if ch.size, err = ReadChunkSize(r); err != nil {
+		return sum, fmt.Errorf(errFmtDecode, Uint32(ch.id), err)
+	}
+	sum += 4
+
+	if ch.size%SF2BagSize != 0 {
+		return sum, fmt.Errorf(errFmtDecode, Uint32(ch.id), ErrChunkSizeMismatch)
+	}
+
+	recs := make([]SF2Bag, ch.size/SF2BagSize)
+	if err = binary.Read(r, ByteOrder(r), recs); err != nil {
+		return sum, fmt.Errorf(errFmtDecode, Uint32(ch.id), err)
+	}
+	ch.Bags = append(ch.Bags[:0], recs...)
+	sum += int64(ch.size)
+
+	return sum, nil
+}
+
+func (ch *ChunkBAG) WriteTo(w io.Writer) (int64, error) {
+	var sum int64
+
+	ch.size = ch.Size()
+
+	n, err := WriteIDAndSize(w, ch.id, ch.size)
+	sum += n
+	if err != nil {
+		return sum, fmt.Errorf(errFmtEncode, Uint32(ch.id), err)
+	}
+
+	if err = binary.Write(w, ByteOrder(w), ch.Bags); err != nil {
+		return sum, fmt.Errorf(errFmtEncode, Uint32(ch.id), err)
+	}
+	sum += int64(ch.size)
+
+	return sum, nil
+}
+
+func (ch *ChunkBAG) Reset() {
+	ch.size = 0
+	ch.Bags = ch.Bags[:0]
+}
@@ -0,0 +1,139 @@
+package riff
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/ctx42/testing/pkg/assert"
+	"github.com/ctx42/testing/pkg/kit/iokit"
+	"github.com/ctx42/testing/pkg/must"
+
+	"github.com/rzajac/riff/internal/test"
+)
+
+func bagChunk(t *testing.T, id uint32) io.Reader {
+	src := &bytes.Buffer{}
+	test.ReadFrom(t, src, Uint32(id)) // ( 0) 4 - Chunk ID
+	test.WriteUint32LE(t, src, 8)     // ( 4) 4 - Chunk size
+	test.WriteUint16LE(t, src, 0)     // ( 8) 2 - GenIndex
+	test.WriteUint16LE(t, src, 0)     // (10) 2 - ModIndex
+	test.WriteUint16LE(t, src, 3)     // (12) 2 - GenIndex
+	test.WriteUint16LE(t, src, 1)     // (14) 2 - ModIndex
+	// Total length: 8+8=16
+	return src
+}
+
+func Test_ChunkBAG_BAG(t *testing.T) {
+	// --- When ---
+	ch := BAG(IDibag)
+
+	// --- Then ---
+	assert.Equal(t, IDibag, ch.ID())
+	assert.Equal(t, uint32(0), ch.Size())
+	assert.Equal(t, uint32(0), ch.Type())
+	assert.False(t, ch.Multi())
+	assert.Nil(t, ch.Chunks())
+	assert.False(t, ch.Raw())
+	assert.Len(t, 0, ch.Bags)
+}
+
+func Test_ChunkBAG_BAGMake(t *testing.T) {
+	// --- When ---
+	ch := BAGMake(LoadData)(IDpbag)
+
+	// --- Then ---
+	assert.Equal(t, IDpbag, ch.ID())
+}
+
+func Test_ChunkBAG_ReadFrom(t *testing.T) {
+	// --- Given ---
+	src := bagChunk(t, IDpbag)
+	test.Skip4B(t, src) // Skip chunk ID.
+
+	// --- When ---
+	ch := BAG(IDpbag)
+	n, err := ch.ReadFrom(src)
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, int64(12), n)
+	assert.Equal(t, uint32(8), ch.Size())
+	exp := []SF2Bag{{}, {GenIndex: 3, ModIndex: 1}}
+	assert.Equal(t, exp, ch.Bags)
+	assert.True(t, test.IsAllRead(src))
+}
+
+func Test_ChunkBAG_ReadFrom_SizeMismatch(t *testing.T) {
+	// --- Given ---
+	src := &bytes.Buffer{}
+	test.WriteUint32LE(t, src, 6)
+
+	// --- When ---
+	_, err := BAG(IDpbag).ReadFrom(src)
+
+	// --- Then ---
+	assert.ErrorIs(t, ErrChunkSizeMismatch, err)
+}
+
+func Test_ChunkBAG_ReadFrom_Errors(t *testing.T) {
+	// Reading less than 12 bytes should always result in an error.
+	for _, i := range []int{1, 4, 5, 11} {
+		// --- Given ---
+		src := bagChunk(t, IDibag)
+		test.Skip4B(t, src) // Skip chunk ID.
+
+		// --- When ---
+		_, err := BAG(IDibag).ReadFrom(io.LimitReader(src, int64(i)))
+
+		// --- Then ---
+		assert.Error(t, err)
+	}
+}
+
+func Test_ChunkBAG_WriteTo(t *testing.T) {
+	// --- Given ---
+	ch := BAG(IDibag)
+	ch.Bags = []SF2Bag{{}, {GenIndex: 3, ModIndex: 1}}
+
+	// --- When ---
+	dst := &bytes.Buffer{}
+	n, err := ch.WriteTo(dst)
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, int64(16), n)
+	exp := must.Value(io.ReadAll(bagChunk(t, IDibag)))
+	assert.Equal(t, exp, dst.Bytes())
+}
+
+func Test_ChunkBAG_WriteTo_Errors(t *testing.T) {
+	for _, i := range []int{1, 4, 8, 10} {
+		// --- Given ---
+		ch := BAG(IDibag)
+		ch.Bags = []SF2Bag{{}, {}}
+
+		// --- When ---
+		_, err := ch.WriteTo(iokit.ErrWriter(&bytes.Buffer{}, i))
+
+		// --- Then ---
+		assert.Error(t, err)
+	}
+}
+
+func Test_ChunkBAG_Reset(t *testing.T) {
+	// --- Given ---
+	src := bagChunk(t, IDpbag)
+	test.Skip4B(t, src) // Skip chunk ID.
+	ch := BAG(IDpbag)
+	_, err := ch.ReadFrom(src)
+	assert.NoError(t, err)
+
+	// --- When ---
+	ch.Reset()
+
+	// --- Then ---
+	assert.Equal(t, IDpbag, ch.ID())
+	assert.Equal(t, uint32(0), ch.Size())
+	assert.Len(t, 0, ch.Bags)
+}
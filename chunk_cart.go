@@ -0,0 +1,323 @@
+package riff
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"time"
+)
+
+// IDcart represents "cart" chunk ID.
+const IDcart uint32 = 0x63617274
+
+// CARTChunkSize represents the size of cart chunk static part in bytes.
+// Does not count ID and tag text bytes.
+const CARTChunkSize uint32 = 2048
+
+// CARTPostTimerCnt represents the number of post timers in cart chunk.
+const CARTPostTimerCnt = 8
+
+// cartDateLayout and cartTimeLayout are the layouts of cart chunk
+// date and time fields.
+const (
+	cartDateLayout = "2006-01-02"
+	cartTimeLayout = "15:04:05"
+)
+
+// cartTimer represents post timer as stored in the file.
+type cartTimer struct {
+	Usage [4]byte
+	Value uint32
+}
+
+// cartStatic represents chunk static data (always there).
+// This struct is defined separately to allow for binary
+// decoding / encoding in one call to binary.Read / binary.Write.
+type cartStatic struct {
+	Version            [4]byte
+	Title              [64]byte
+	Artist             [64]byte
+	CutID              [64]byte
+	ClientID           [64]byte
+	Category           [64]byte
+	Classification     [64]byte
+	OutCue             [64]byte
+	StartDate          [10]byte
+	StartTime          [8]byte
+	EndDate            [10]byte
+	EndTime            [8]byte
+	ProducerAppID      [64]byte
+	ProducerAppVersion [64]byte
+	UserDef            [64]byte
+	LevelReference     int32
+	PostTimers         [CARTPostTimerCnt]cartTimer
+	Reserved           [276]byte
+	URL                [1024]byte
+}
+
+// CartTimer represents a single cart chunk post timer.
+type CartTimer struct {
+	// Timer usage ID (e.g. "SEGs", "INTs", "AUXo").
+	Usage uint32
+
+	// Timer value in samples from the beginning of the audio data.
+	Value uint32
+}
+
+// ChunkCART represents the "cart" chunk defined in AES46 (CartChunk).
+// It's used by radio broadcast automation systems to exchange
+// information about the audio content. The text fields are stored in
+// fixed-width, zero padded fields, unchanged fields keep their padding.
+type ChunkCART struct {
+	// Chunk size in bytes.
+	// The ID and extra padding byte is not counted in the chunk size.
+	size uint32
+
+	// Static part as read from the file.
+	raw cartStatic
+
+	// Version of the cart chunk (e.g. "0101").
+	Version string
+
+	// Title of the cut.
+	Title string
+
+	// Artist of the cut.
+	Artist string
+
+	// Cut number identification.
+	CutID string
+
+	// Client identification.
+	ClientID string
+
+	// Category (e.g. "SPOT", "NEWS").
+	Category string
+
+	// Classification of the audio content.
+	Classification string
+
+	// Out cue text.
+	OutCue string
+
+	// Start date in "yyyy-mm-dd" format.
+	StartDate string
+
+	// Start time in "hh:mm:ss" format.
+	StartTime string
+
+	// End date in "yyyy-mm-dd" format.
+	EndDate string
+
+	// End time in "hh:mm:ss" format.
+	EndTime string
+
+	// Name of the vendor or application which produced the chunk.
+	ProducerAppID string
+
+	// Version of the producer application.
+	ProducerAppVersion string
+
+	// User defined text.
+	UserDef string
+
+	// Sample value for 0 dB reference.
+	LevelReference int32
+
+	// Post timers.
+	PostTimers [CARTPostTimerCnt]CartTimer
+
+	// Uniform resource locator.
+	URL string
+
+	// Free form text (lines terminated by CR/LF).
+	TagText string
+
+	// Tag text bytes as read from the file.
+	tag []byte
+}
+
+// CARTMake is a [Maker] function for creating [ChunkCART] instances.
+func CARTMake() Chunk { return CART() }
+
+// CART returns a new instance of [ChunkCART].
+func CART() *ChunkCART {
+	return &ChunkCART{size: CARTChunkSize}
+}
+
+func (ch *ChunkCART) ID() uint32     { return IDcart }
+func (ch *ChunkCART) Size() uint32   { return CARTChunkSize + uint32(len(ch.tagBytes())) }
+func (ch *ChunkCART) Type() uint32   { return 0 }
+func (ch *ChunkCART) Multi() bool    { return false }
+func (ch *ChunkCART) Chunks() Chunks { return nil }
+func (ch *ChunkCART) Raw() bool      { return false }
+
+// Start returns parsed start date and time.
+func (ch *ChunkCART) Start() (time.Time, error) {
+	return time.Parse(cartDateLayout+" "+cartTimeLayout, ch.StartDate+" "+ch.StartTime)
+}
+
+// SetStart sets start date and time fields.
+func (ch *ChunkCART) SetStart(tim time.Time) {
+	ch.StartDate = tim.Format(cartDateLayout)
+	ch.StartTime = tim.Format(cartTimeLayout)
+}
+
+// End returns parsed end date and time.
+func (ch *ChunkCART) End() (time.Time, error) {
+	return time.Parse(cartDateLayout+" "+cartTimeLayout, ch.EndDate+" "+ch.EndTime)
+}
+
+// SetEnd sets end date and time fields.
+func (ch *ChunkCART) SetEnd(tim time.Time) {
+	ch.EndDate = tim.Format(cartDateLayout)
+	ch.EndTime = tim.Format(cartTimeLayout)
+}
+
+// textFields returns pairs of text fields and their fixed-width buffers.
+func (ch *ChunkCART) textFields(raw *cartStatic) []struct {
+	val *string
+	buf []byte
+} {
+	return []struct {
+		val *string
+		buf []byte
+	}{
+		{&ch.Version, raw.Version[:]},
+		{&ch.Title, raw.Title[:]},
+		{&ch.Artist, raw.Artist[:]},
+		{&ch.CutID, raw.CutID[:]},
+		{&ch.ClientID, raw.ClientID[:]},
+		{&ch.Category, raw.Category[:]},
+		{&ch.Classification, raw.Classification[:]},
+		{&ch.OutCue, raw.OutCue[:]},
+		{&ch.StartDate, raw.StartDate[:]},
+		{&ch.StartTime, raw.StartTime[:]},
+		{&ch.EndDate, raw.EndDate[:]},
+		{&ch.EndTime, raw.EndTime[:]},
+		{&ch.ProducerAppID, raw.ProducerAppID[:]},
+		{&ch.ProducerAppVersion, raw.ProducerAppVersion[:]},
+		{&ch.UserDef, raw.UserDef[:]},
+		{&ch.URL, raw.URL[:]},
+	}
+}
+
+// tagBytes returns the tag text bytes to write.
+func (ch *ChunkCART) tagBytes() []byte {
+	if cartText(ch.tag) == ch.TagText {
+		return ch.tag
+	}
+	return []byte(ch.TagText)
+}
+
+func (ch *ChunkCART) ReadFrom(r io.Reader) (int64, error) {
+	bo := ByteOrder(r)
+	var sum int64
+	if err := binary.Read(r, bo, &ch.size); err != nil {
+		return sum, fmt.Errorf(errFmtDecode, Uint32(IDcart), err)
+	}
+	sum += 4
+
+	if ch.size < CARTChunkSize {
+		return sum, fmt.Errorf(errFmtDecode, Uint32(IDcart), ErrTooShort)
+	}
+
+	if err := binary.Read(r, bo, &ch.raw); err != nil {
+		return sum, fmt.Errorf(errFmtDecode, Uint32(IDcart), err)
+	}
+	sum += int64(CARTChunkSize)
+
+	for _, fld := range ch.textFields(&ch.raw) {
+		*fld.val = cartText(fld.buf)
+	}
+	ch.LevelReference = ch.raw.LevelReference
+	for i, tmr := range ch.raw.PostTimers {
+		ch.PostTimers[i] = CartTimer{
+			Usage: be.Uint32(tmr.Usage[:]),
+			Value: tmr.Value,
+		}
+	}
+
+	ch.tag = grow(ch.tag, int(ch.size-CARTChunkSize))
+	in, err := io.ReadFull(r, ch.tag)
+	sum += int64(in)
+	if err != nil {
+		return sum, fmt.Errorf(errFmtDecode, Uint32(IDcart), err)
+	}
+	ch.TagText = cartText(ch.tag)
+
+	n, err := ReadPaddingIf(r, ch.size)
+	sum += n
+	if err != nil {
+		return sum, fmt.Errorf(errFmtDecode, Uint32(IDcart), err)
+	}
+
+	return sum, nil
+}
+
+func (ch *ChunkCART) WriteTo(w io.Writer) (int64, error) {
+	var sum int64
+
+	raw := ch.raw
+	for _, fld := range ch.textFields(&raw) {
+		if cartText(fld.buf) == *fld.val {
+			continue
+		}
+		if len(*fld.val) > len(fld.buf) {
+			err := fmt.Errorf("text %q longer than %d: %w", *fld.val, len(fld.buf), ErrOutOfRange)
+			return sum, fmt.Errorf(errFmtEncode, Uint32(IDcart), err)
+		}
+		clear(fld.buf)
+		copy(fld.buf, *fld.val)
+	}
+	raw.LevelReference = ch.LevelReference
+	for i, tmr := range ch.PostTimers {
+		be.PutUint32(raw.PostTimers[i].Usage[:], tmr.Usage)
+		raw.PostTimers[i].Value = tmr.Value
+	}
+
+	tag := ch.tagBytes()
+	ch.size = CARTChunkSize + uint32(len(tag))
+
+	n, err := WriteIDAndSize(w, IDcart, ch.size)
+	sum += n
+	if err != nil {
+		return sum, fmt.Errorf(errFmtEncode, Uint32(IDcart), err)
+	}
+
+	if err = binary.Write(w, ByteOrder(w), raw); err != nil {
+		return sum, fmt.Errorf(errFmtEncode, Uint32(IDcart), err)
+	}
+	sum += int64(CARTChunkSize)
+
+	in, err := w.Write(tag)
+	sum += int64(in)
+	if err != nil {
+		return sum, fmt.Errorf(errFmtEncode, Uint32(IDcart), err)
+	}
+
+	n, err = WritePaddingIf(w, ch.size)
+	sum += n
+	if err != nil {
+		return sum, fmt.Errorf(errFmtEncode, Uint32(IDcart), err)
+	}
+
+	return sum, nil
+}
+
+func (ch *ChunkCART) Reset() {
+	*ch = ChunkCART{
+		size: CARTChunkSize,
+		tag:  ch.tag[:0],
+	}
+}
+
+// cartText returns text stored in the fixed-width field (up to the first
+// zero byte).
+func cartText(b []byte) string {
+	if idx := bytes.IndexByte(b, 0); idx >= 0 {
+		b = b[:idx]
+	}
+	return string(b)
+}
@@ -0,0 +1,252 @@
+package riff
+
+import (
+	"bytes"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/ctx42/testing/pkg/assert"
+	"github.com/ctx42/testing/pkg/kit/iokit"
+	"github.com/ctx42/testing/pkg/must"
+
+	"github.com/rzajac/riff/internal/test"
+)
+
+// fixed returns s padded with zeros to the length of n.
+func fixed(s string, n int) []byte {
+	b := make([]byte, n)
+	copy(b, s)
+	return b
+}
+
+func cartChunk(t *testing.T) io.Reader {
+	title := fixed("Title", 64)
+	title[10] = 'X' // Garbage after the terminating zero.
+
+	src := &bytes.Buffer{}
+	test.ReadFrom(t, src, Uint32(IDcart))              // (   0)    4 - Chunk ID
+	test.WriteUint32LE(t, src, 2053)                   // (   4)    4 - Chunk size
+	test.WriteBytes(t, src, []byte("0101"))            // (   8)    4 - Version
+	test.WriteBytes(t, src, title)                     // (  12)   64 - Title
+	test.WriteBytes(t, src, fixed("Artist", 64))       // (  76)   64 - Artist
+	test.WriteBytes(t, src, fixed("CUT1", 64))         // ( 140)   64 - Cut ID
+	test.WriteBytes(t, src, fixed("Client", 64))       // ( 204)   64 - Client ID
+	test.WriteBytes(t, src, fixed("SPOT", 64))         // ( 268)   64 - Category
+	test.WriteBytes(t, src, fixed("Class", 64))        // ( 332)   64 - Classification
+	test.WriteBytes(t, src, fixed("Out", 64))          // ( 396)   64 - Out cue
+	test.WriteBytes(t, src, []byte("2024-05-06"))      // ( 460)   10 - Start date
+	test.WriteBytes(t, src, []byte("07:08:09"))        // ( 470)    8 - Start time
+	test.WriteBytes(t, src, []byte("2024-06-07"))      // ( 478)   10 - End date
+	test.WriteBytes(t, src, []byte("10:11:12"))        // ( 488)    8 - End time
+	test.WriteBytes(t, src, fixed("App", 64))          // ( 496)   64 - Producer app ID
+	test.WriteBytes(t, src, fixed("1.0", 64))          // ( 560)   64 - Producer app version
+	test.WriteBytes(t, src, fixed("User", 64))         // ( 624)   64 - User defined
+	test.WriteUint32LE(t, src, 32768)                  // ( 688)    4 - Level reference
+	test.WriteBytes(t, src, []byte("SEGs"))            // ( 692)    4 - Timer usage
+	test.WriteUint32LE(t, src, 1000)                   // ( 696)    4 - Timer value
+	test.WriteBytes(t, src, make([]byte, 56))          // ( 700)   56 - Other timers
+	test.WriteBytes(t, src, make([]byte, 276))         // ( 756)  276 - Reserved
+	test.WriteBytes(t, src, fixed("http://a.b", 1024)) // (1032) 1024 - URL
+	test.WriteBytes(t, src, []byte("tag\r\n"))         // (2056)    5 - Tag text
+	test.WriteByte(t, src, 0)                          // (2061)    1 - Padding byte
+	// Total length: 8+2048+5+1=2062
+	return src
+}
+
+func Test_ChunkCART_CART(t *testing.T) {
+	// --- When ---
+	ch := CART()
+
+	// --- Then ---
+	assert.Equal(t, IDcart, ch.ID())
+	assert.Equal(t, uint32(2048), ch.Size())
+	assert.Equal(t, uint32(0), ch.Type())
+	assert.False(t, ch.Multi())
+	assert.Nil(t, ch.Chunks())
+	assert.False(t, ch.Raw())
+}
+
+func Test_ChunkCART_ReadFrom(t *testing.T) {
+	// --- Given ---
+	src := cartChunk(t)
+	test.Skip4B(t, src) // Skip chunk ID.
+
+	// --- When ---
+	ch := CART()
+	n, err := ch.ReadFrom(src)
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2058), n)
+	assert.Equal(t, uint32(2053), ch.Size())
+	assert.Equal(t, "0101", ch.Version)
+	assert.Equal(t, "Title", ch.Title)
+	assert.Equal(t, "Artist", ch.Artist)
+	assert.Equal(t, "CUT1", ch.CutID)
+	assert.Equal(t, "Client", ch.ClientID)
+	assert.Equal(t, "SPOT", ch.Category)
+	assert.Equal(t, "Class", ch.Classification)
+	assert.Equal(t, "Out", ch.OutCue)
+	assert.Equal(t, "2024-05-06", ch.StartDate)
+	assert.Equal(t, "07:08:09", ch.StartTime)
+	assert.Equal(t, "2024-06-07", ch.EndDate)
+	assert.Equal(t, "10:11:12", ch.EndTime)
+	assert.Equal(t, "App", ch.ProducerAppID)
+	assert.Equal(t, "1.0", ch.ProducerAppVersion)
+	assert.Equal(t, "User", ch.UserDef)
+	assert.Equal(t, int32(32768), ch.LevelReference)
+	assert.Equal(t, CartTimer{Usage: StrToID("SEGs"), Value: 1000}, ch.PostTimers[0])
+	assert.Equal(t, CartTimer{}, ch.PostTimers[1])
+	assert.Equal(t, "http://a.b", ch.URL)
+	assert.Equal(t, "tag\r\n", ch.TagText)
+	assert.True(t, test.IsAllRead(src))
+}
+
+func Test_ChunkCART_ReadFrom_Errors(t *testing.T) {
+	// Reading less than 2058 bytes should always result in an error.
+	for _, i := range []int{1, 4, 5, 100, 2052, 2053, 2057} {
+		// --- Given ---
+		src := cartChunk(t)
+		test.Skip4B(t, src) // Skip chunk ID.
+
+		// --- When ---
+		_, err := CART().ReadFrom(io.LimitReader(src, int64(i)))
+
+		// --- Then ---
+		assert.Error(t, err)
+	}
+}
+
+func Test_ChunkCART_ReadFrom_TooShortError(t *testing.T) {
+	// --- Given ---
+	src := &bytes.Buffer{}
+	test.WriteUint32LE(t, src, 2047)
+
+	// --- When ---
+	n, err := CART().ReadFrom(src)
+
+	// --- Then ---
+	assert.ErrorIs(t, ErrTooShort, err)
+	assert.ErrorContain(t, "cart chunk", err)
+	assert.Equal(t, int64(4), n)
+}
+
+func Test_ChunkCART_StartEnd(t *testing.T) {
+	// --- Given ---
+	ch := CART()
+	start := time.Date(2024, 5, 6, 7, 8, 9, 0, time.UTC)
+	end := time.Date(2024, 6, 7, 10, 11, 12, 0, time.UTC)
+
+	// --- When ---
+	ch.SetStart(start)
+	ch.SetEnd(end)
+
+	// --- Then ---
+	assert.Equal(t, "2024-05-06", ch.StartDate)
+	assert.Equal(t, "07:08:09", ch.StartTime)
+	assert.Equal(t, start, must.Value(ch.Start()))
+	assert.Equal(t, end, must.Value(ch.End()))
+}
+
+func Test_ChunkCART_WriteTo(t *testing.T) {
+	// --- Given ---
+	src := cartChunk(t)
+	test.Skip4B(t, src) // Skip chunk ID.
+
+	ch := CART()
+	_, err := ch.ReadFrom(src)
+	assert.NoError(t, err)
+
+	// --- When ---
+	dst := &bytes.Buffer{}
+	n, err := ch.WriteTo(dst)
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2062), n)
+	exp := must.Value(io.ReadAll(cartChunk(t)))
+	assert.Equal(t, exp, dst.Bytes())
+}
+
+func Test_ChunkCART_WriteTo_Edited(t *testing.T) {
+	// --- Given ---
+	src := cartChunk(t)
+	test.Skip4B(t, src) // Skip chunk ID.
+
+	ch := CART()
+	_, err := ch.ReadFrom(src)
+	assert.NoError(t, err)
+
+	ch.Title = "New"
+	ch.PostTimers[1] = CartTimer{Usage: StrToID("INTs"), Value: 5}
+	ch.TagText = "t"
+
+	// --- When ---
+	dst := &bytes.Buffer{}
+	n, err := ch.WriteTo(dst)
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2058), n)
+
+	have := dst.Bytes()
+	assert.Equal(t, fixed("New", 64), have[12:76])
+	assert.Equal(t, []byte("INTs"), have[700:704])
+
+	got := CART()
+	_, err = got.ReadFrom(bytes.NewReader(have[4:]))
+	assert.NoError(t, err)
+	assert.Equal(t, "New", got.Title)
+	assert.Equal(t, "Artist", got.Artist)
+	assert.Equal(t, "t", got.TagText)
+	assert.Equal(t, uint32(2049), got.Size())
+}
+
+func Test_ChunkCART_WriteTo_TextTooLong(t *testing.T) {
+	// --- Given ---
+	ch := CART()
+	ch.Version = "01010"
+
+	// --- When ---
+	_, err := ch.WriteTo(&bytes.Buffer{})
+
+	// --- Then ---
+	assert.ErrorIs(t, ErrOutOfRange, err)
+}
+
+func Test_ChunkCART_WriteTo_Errors(t *testing.T) {
+	for _, i := range []int{1, 4, 8, 100, 2056, 2061} {
+		// --- Given ---
+		src := cartChunk(t)
+		test.Skip4B(t, src) // Skip chunk ID.
+
+		ch := CART()
+		_, err := ch.ReadFrom(src)
+		assert.NoError(t, err)
+
+		// --- When ---
+		_, err = ch.WriteTo(iokit.ErrWriter(&bytes.Buffer{}, i))
+
+		// --- Then ---
+		assert.Error(t, err)
+	}
+}
+
+func Test_ChunkCART_Reset(t *testing.T) {
+	// --- Given ---
+	src := cartChunk(t)
+	test.Skip4B(t, src) // Skip chunk ID.
+	ch := CART()
+	_, err := ch.ReadFrom(src)
+	assert.NoError(t, err)
+
+	// --- When ---
+	ch.Reset()
+
+	// --- Then ---
+	assert.Equal(t, uint32(2048), ch.Size())
+	assert.Equal(t, "", ch.Title)
+	assert.Equal(t, "", ch.TagText)
+	assert.Equal(t, CartTimer{}, ch.PostTimers[0])
+}
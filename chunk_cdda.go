@@ -0,0 +1,214 @@
+package riff
+
+import (
+	"fmt"
+	"io"
+)
+
+// CDDAChunkSize represents the size of the "RIFF CDDA" format chunk static
+// part in bytes.
+const CDDAChunkSize uint32 = 24
+
+// CDDAVersion represents the only known version of the CDDA format chunk.
+const CDDAVersion uint16 = 1
+
+// CDFramesPerSecond represents the number of CD frames (sectors) in one
+// second of audio.
+const CDFramesPerSecond = 75
+
+// CDPregap represents the number of frames of the two second lead-in
+// preceding the first track. The MSF addresses include it, the LBA
+// addresses don't.
+const CDPregap = 2 * CDFramesPerSecond
+
+// MSF represents the CD position or duration in minutes, seconds and
+// frames.
+type MSF struct {
+	Minute uint8
+	Second uint8
+	Frame  uint8
+}
+
+// Frames returns the number of frames the MSF represents.
+func (m MSF) Frames() uint32 {
+	return (uint32(m.Minute)*60+uint32(m.Second))*CDFramesPerSecond +
+		uint32(m.Frame)
+}
+
+// String returns the MSF in "mm:ss:ff" form.
+func (m MSF) String() string {
+	return fmt.Sprintf("%02d:%02d:%02d", m.Minute, m.Second, m.Frame)
+}
+
+// FramesMSF returns the MSF representing the number of frames.
+func FramesMSF(frames uint32) MSF {
+	return MSF{
+		Minute: uint8(frames / CDFramesPerSecond / 60),
+		Second: uint8(frames / CDFramesPerSecond % 60),
+		Frame:  uint8(frames % CDFramesPerSecond),
+	}
+}
+
+// LBAToMSF returns the MSF address of the logical block address.
+func LBAToMSF(lba uint32) MSF { return FramesMSF(lba + CDPregap) }
+
+// MSFToLBA returns the logical block address of the MSF address. Addresses
+// within the pregap return zero.
+func MSFToLBA(m MSF) uint32 {
+	return m.Frames() - min(m.Frames(), CDPregap)
+}
+
+// ChunkCDDA represents the "fmt " chunk of the RIFF CDDA file describing
+// the audio CD track.
+type ChunkCDDA struct {
+	// Chunk size in bytes.
+	// The ID and extra padding byte is not counted in the chunk size.
+	size uint32
+
+	// Format version. Always [CDDAVersion].
+	Version uint16
+
+	// Track number starting from one.
+	Track uint16
+
+	// Disc serial number.
+	Serial uint32
+
+	// Logical block address of the track start.
+	Start uint32
+
+	// Track length in frames.
+	Length uint32
+
+	// MSF address of the track start.
+	StartMSF MSF
+
+	// Track length as MSF.
+	LengthMSF MSF
+
+	// Bytes following the static part.
+	extra []byte
+}
+
+// CDDAMake is a [Maker] function for creating [ChunkCDDA] instances.
+func CDDAMake() Chunk { return CDDA() }
+
+// CDDA returns a new instance of [ChunkCDDA].
+func CDDA() *ChunkCDDA {
+	return &ChunkCDDA{size: CDDAChunkSize, Version: CDDAVersion}
+}
+
+func (ch *ChunkCDDA) ID() uint32     { return IDfmt }
+func (ch *ChunkCDDA) Size() uint32   { return ch.size }
+func (ch *ChunkCDDA) Type() uint32   { return 0 }
+func (ch *ChunkCDDA) Multi() bool    { return false }
+func (ch *ChunkCDDA) Chunks() Chunks { return nil }
+func (ch *ChunkCDDA) Raw() bool      { return false }
+
+// SetRange sets the track start and length in both LBA and MSF forms.
+func (ch *ChunkCDDA) SetRange(start, length uint32) {
+	ch.Start = start
+	ch.Length = length
+	ch.StartMSF = LBAToMSF(start)
+	ch.LengthMSF = FramesMSF(length)
+}
+
+func (ch *ChunkCDDA) ReadFrom(r io.Reader) (int64, error) {
+	bo := ByteOrder(r)
+	var sum int64
+	var err error
+	if ch.size, err = ReadChunkSize(r); err != nil {
+		return sum, fmt.Errorf(errFmtDecode, linkids(TypeCDDA, IDfmt), err)
+	}
+	sum += 4
+
+	if ch.size < CDDAChunkSize {
+		return sum, fmt.Errorf(errFmtDecode, linkids(TypeCDDA, IDfmt), ErrTooShort)
+	}
+
+	buf := make([]byte, ch.size)
+	in, err := io.ReadFull(r, buf)
+	sum += int64(in)
+	if err != nil {
+		return sum, fmt.Errorf(errFmtDecode, linkids(TypeCDDA, IDfmt), err)
+	}
+
+	ch.Version = bo.Uint16(buf)
+	ch.Track = bo.Uint16(buf[2:])
+	ch.Serial = bo.Uint32(buf[4:])
+	ch.Start = bo.Uint32(buf[8:])
+	ch.Length = bo.Uint32(buf[12:])
+	// The MSF is stored in [Frame, Second, Minute, Unused] byte order.
+	ch.StartMSF = MSF{Frame: buf[16], Second: buf[17], Minute: buf[18]}
+	ch.LengthMSF = MSF{Frame: buf[20], Second: buf[21], Minute: buf[22]}
+	ch.extra = grow(ch.extra, len(buf)-int(CDDAChunkSize))
+	copy(ch.extra, buf[CDDAChunkSize:])
+
+	n, err := ReadPaddingIf(r, ch.size)
+	sum += n
+	if err != nil {
+		return sum, fmt.Errorf(errFmtDecode, linkids(TypeCDDA, IDfmt), err)
+	}
+
+	return sum, nil
+}
+
+func (ch *ChunkCDDA) WriteTo(w io.Writer) (int64, error) {
+	bo := ByteOrder(w)
+	var sum int64
+
+	buf := make([]byte, CDDAChunkSize, CDDAChunkSize+uint32(len(ch.extra)))
+	bo.PutUint16(buf, ch.Version)
+	bo.PutUint16(buf[2:], ch.Track)
+	bo.PutUint32(buf[4:], ch.Serial)
+	bo.PutUint32(buf[8:], ch.Start)
+	bo.PutUint32(buf[12:], ch.Length)
+	buf[16], buf[17], buf[18] = ch.StartMSF.Frame, ch.StartMSF.Second, ch.StartMSF.Minute
+	buf[20], buf[21], buf[22] = ch.LengthMSF.Frame, ch.LengthMSF.Second, ch.LengthMSF.Minute
+	buf = append(buf, ch.extra...)
+	ch.size = uint32(len(buf))
+
+	n, err := WriteIDAndSize(w, IDfmt, ch.size)
+	sum += n
+	if err != nil {
+		return sum, fmt.Errorf(errFmtEncode, linkids(TypeCDDA, IDfmt), err)
+	}
+
+	in, err := w.Write(buf)
+	sum += int64(in)
+	if err != nil {
+		return sum, fmt.Errorf(errFmtEncode, linkids(TypeCDDA, IDfmt), err)
+	}
+
+	n, err = WritePaddingIf(w, ch.size)
+	sum += n
+	if err != nil {
+		return sum, fmt.Errorf(errFmtEncode, linkids(TypeCDDA, IDfmt), err)
+	}
+
+	return sum, nil
+}
+
+func (ch *ChunkCDDA) Reset() {
+	ch.size = CDDAChunkSize
+	ch.Version = CDDAVersion
+	ch.Track = 0
+	ch.Serial = 0
+	ch.Start = 0
+	ch.Length = 0
+	ch.StartMSF = MSF{}
+	ch.LengthMSF = MSF{}
+	ch.extra = ch.extra[:0]
+}
+
+// ComposeCDDA returns a new RIFF CDDA file describing the track starting at
+// the logical block address start and having length frames.
+func ComposeCDDA(track uint16, serial, start, length uint32) *RIFF {
+	ch := CDDA()
+	ch.Track = track
+	ch.Serial = serial
+	ch.SetRange(start, length)
+	rif := Compose(Chunks{ch})
+	rif.SetType(TypeCDDA)
+	return rif
+}
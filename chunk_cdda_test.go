@@ -0,0 +1,261 @@
+package riff
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/ctx42/testing/pkg/assert"
+	"github.com/ctx42/testing/pkg/kit/iokit"
+	"github.com/ctx42/testing/pkg/must"
+
+	"github.com/rzajac/riff/internal/test"
+)
+
+func cddaChunk(t *testing.T) io.Reader {
+	src := &bytes.Buffer{}
+	test.ReadFrom(t, src, Uint32(IDfmt))          // ( 0) 4 - Chunk ID
+	test.WriteUint32LE(t, src, 24)                // ( 4) 4 - Chunk size
+	test.WriteUint16LE(t, src, 1)                 // ( 8) 2 - Version
+	test.WriteUint16LE(t, src, 2)                 // (10) 2 - Track
+	test.WriteUint32LE(t, src, 0x12345678)        // (12) 4 - Serial
+	test.WriteUint32LE(t, src, 20000)             // (16) 4 - Start
+	test.WriteUint32LE(t, src, 15000)             // (20) 4 - Length
+	test.WriteBytes(t, src, []byte{50, 28, 4, 0}) // (24) 4 - StartMSF
+	test.WriteBytes(t, src, []byte{0, 20, 3, 0})  // (28) 4 - LengthMSF
+	// Total length: 8+24=32
+	return src
+}
+
+func Test_MSF_Frames(t *testing.T) {
+	// --- Given ---
+	m := MSF{Minute: 4, Second: 28, Frame: 50}
+
+	// --- When ---
+	have := m.Frames()
+
+	// --- Then ---
+	assert.Equal(t, uint32(20150), have)
+}
+
+func Test_MSF_String(t *testing.T) {
+	// --- Given ---
+	m := MSF{Minute: 4, Second: 8, Frame: 5}
+
+	// --- When ---
+	have := m.String()
+
+	// --- Then ---
+	assert.Equal(t, "04:08:05", have)
+}
+
+func Test_FramesMSF(t *testing.T) {
+	// --- When ---
+	have := FramesMSF(15001)
+
+	// --- Then ---
+	assert.Equal(t, MSF{Minute: 3, Second: 20, Frame: 1}, have)
+}
+
+func Test_LBAToMSF(t *testing.T) {
+	t.Run("first sector", func(t *testing.T) {
+		// --- When ---
+		have := LBAToMSF(0)
+
+		// --- Then ---
+		assert.Equal(t, MSF{Second: 2}, have)
+	})
+
+	t.Run("track start", func(t *testing.T) {
+		// --- When ---
+		have := LBAToMSF(20000)
+
+		// --- Then ---
+		assert.Equal(t, MSF{Minute: 4, Second: 28, Frame: 50}, have)
+	})
+}
+
+func Test_MSFToLBA(t *testing.T) {
+	t.Run("track start", func(t *testing.T) {
+		// --- When ---
+		have := MSFToLBA(MSF{Minute: 4, Second: 28, Frame: 50})
+
+		// --- Then ---
+		assert.Equal(t, uint32(20000), have)
+	})
+
+	t.Run("pregap", func(t *testing.T) {
+		// --- When ---
+		have := MSFToLBA(MSF{Second: 1})
+
+		// --- Then ---
+		assert.Equal(t, uint32(0), have)
+	})
+}
+
+func Test_ChunkCDDA_CDDA(t *testing.T) {
+	// --- When ---
+	ch := CDDA()
+
+	// --- Then ---
+	assert.Equal(t, IDfmt, ch.ID())
+	assert.Equal(t, CDDAChunkSize, ch.Size())
+	assert.Equal(t, uint32(0), ch.Type())
+	assert.False(t, ch.Multi())
+	assert.Nil(t, ch.Chunks())
+	assert.False(t, ch.Raw())
+	assert.Equal(t, CDDAVersion, ch.Version)
+}
+
+func Test_ChunkCDDA_ReadFrom(t *testing.T) {
+	// --- Given ---
+	src := cddaChunk(t)
+	test.Skip4B(t, src) // Skip chunk ID.
+
+	// --- When ---
+	ch := CDDA()
+	n, err := ch.ReadFrom(src)
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, int64(28), n)
+	assert.Equal(t, uint32(24), ch.Size())
+	assert.Equal(t, CDDAVersion, ch.Version)
+	assert.Equal(t, uint16(2), ch.Track)
+	assert.Equal(t, uint32(0x12345678), ch.Serial)
+	assert.Equal(t, uint32(20000), ch.Start)
+	assert.Equal(t, uint32(15000), ch.Length)
+	assert.Equal(t, MSF{Minute: 4, Second: 28, Frame: 50}, ch.StartMSF)
+	assert.Equal(t, MSF{Minute: 3, Second: 20}, ch.LengthMSF)
+	assert.True(t, test.IsAllRead(src))
+}
+
+func Test_ChunkCDDA_ReadFrom_Extra(t *testing.T) {
+	// --- Given ---
+	b := must.Value(io.ReadAll(cddaChunk(t)))
+	b = append(b, 1, 2)
+	le.PutUint32(b[4:], 26)
+
+	// --- When ---
+	ch := CDDA()
+	_, err := ch.ReadFrom(bytes.NewReader(b[4:]))
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{1, 2}, ch.extra)
+
+	dst := &bytes.Buffer{}
+	must.Value(ch.WriteTo(dst))
+	assert.Equal(t, b, dst.Bytes())
+}
+
+func Test_ChunkCDDA_ReadFrom_Errors(t *testing.T) {
+	// Reading less than 28 bytes should always result in an error.
+	for _, i := range []int{1, 4, 5, 15, 27} {
+		// --- Given ---
+		src := cddaChunk(t)
+		test.Skip4B(t, src) // Skip chunk ID.
+
+		// --- When ---
+		_, err := CDDA().ReadFrom(io.LimitReader(src, int64(i)))
+
+		// --- Then ---
+		assert.Error(t, err)
+	}
+}
+
+func Test_ChunkCDDA_ReadFrom_TooShort(t *testing.T) {
+	// --- Given ---
+	src := &bytes.Buffer{}
+	test.WriteUint32LE(t, src, 16)
+
+	// --- When ---
+	_, err := CDDA().ReadFrom(src)
+
+	// --- Then ---
+	assert.ErrorIs(t, ErrTooShort, err)
+}
+
+func Test_ChunkCDDA_SetRange(t *testing.T) {
+	// --- Given ---
+	ch := CDDA()
+
+	// --- When ---
+	ch.SetRange(20000, 15000)
+
+	// --- Then ---
+	assert.Equal(t, uint32(20000), ch.Start)
+	assert.Equal(t, uint32(15000), ch.Length)
+	assert.Equal(t, MSF{Minute: 4, Second: 28, Frame: 50}, ch.StartMSF)
+	assert.Equal(t, MSF{Minute: 3, Second: 20}, ch.LengthMSF)
+}
+
+func Test_ChunkCDDA_WriteTo(t *testing.T) {
+	// --- Given ---
+	ch := CDDA()
+	ch.Track = 2
+	ch.Serial = 0x12345678
+	ch.SetRange(20000, 15000)
+
+	// --- When ---
+	dst := &bytes.Buffer{}
+	n, err := ch.WriteTo(dst)
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, int64(32), n)
+	exp := must.Value(io.ReadAll(cddaChunk(t)))
+	assert.Equal(t, exp, dst.Bytes())
+}
+
+func Test_ChunkCDDA_WriteTo_Errors(t *testing.T) {
+	for _, i := range []int{1, 4, 8, 20} {
+		// --- When ---
+		_, err := CDDA().WriteTo(iokit.ErrWriter(&bytes.Buffer{}, i))
+
+		// --- Then ---
+		assert.Error(t, err)
+	}
+}
+
+func Test_ChunkCDDA_Reset(t *testing.T) {
+	// --- Given ---
+	src := cddaChunk(t)
+	test.Skip4B(t, src) // Skip chunk ID.
+	ch := CDDA()
+	_, err := ch.ReadFrom(src)
+	assert.NoError(t, err)
+
+	// --- When ---
+	ch.Reset()
+
+	// --- Then ---
+	assert.Equal(t, CDDAChunkSize, ch.Size())
+	assert.Equal(t, CDDAVersion, ch.Version)
+	assert.Equal(t, uint16(0), ch.Track)
+	assert.Equal(t, uint32(0), ch.Serial)
+	assert.Equal(t, uint32(0), ch.Start)
+	assert.Equal(t, uint32(0), ch.Length)
+	assert.Equal(t, MSF{}, ch.StartMSF)
+	assert.Equal(t, MSF{}, ch.LengthMSF)
+}
+
+func Test_ComposeCDDA(t *testing.T) {
+	// --- When ---
+	rif := ComposeCDDA(2, 0x12345678, 20000, 15000)
+
+	// --- Then ---
+	assert.Equal(t, TypeCDDA, rif.Type())
+
+	dst := &bytes.Buffer{}
+	must.Value(rif.WriteTo(dst))
+	assert.Equal(t, 44, dst.Len())
+
+	got := New(LoadData)
+	must.Value(got.ReadFrom(dst))
+	assert.Equal(t, TypeCDDA, got.Type())
+	ch, ok := got.Chunks().First(IDfmt).(*ChunkCDDA)
+	assert.True(t, ok)
+	assert.Equal(t, uint16(2), ch.Track)
+	assert.Equal(t, MSF{Minute: 4, Second: 28, Frame: 50}, ch.StartMSF)
+}
@@ -0,0 +1,111 @@
+package riff
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+)
+
+// IDcolh represents "colh" (collection header) chunk ID.
+const IDcolh uint32 = 0x636f6c68
+
+// COLHChunkSize represents the size of colh chunk static part in bytes.
+const COLHChunkSize uint32 = 4
+
+// ChunkCOLH represents "colh" chunk of the DLS file.
+type ChunkCOLH struct {
+	// Chunk size in bytes.
+	// The ID and extra padding byte is not counted in the chunk size.
+	size uint32
+
+	// Number of instruments in the collection.
+	Instruments uint32
+
+	// Bytes following the static part.
+	extra []byte
+}
+
+// COLHMake is a [Maker] function for creating [ChunkCOLH] instances.
+func COLHMake() Chunk { return COLH() }
+
+// COLH returns a new instance of [ChunkCOLH].
+func COLH() *ChunkCOLH {
+	return &ChunkCOLH{size: COLHChunkSize}
+}
+
+func (ch *ChunkCOLH) ID() uint32     { return IDcolh }
+func (ch *ChunkCOLH) Size() uint32   { return ch.size }
+func (ch *ChunkCOLH) Type() uint32   { return 0 }
+func (ch *ChunkCOLH) Multi() bool    { return false }
+func (ch *ChunkCOLH) Chunks() Chunks { return nil }
+func (ch *ChunkCOLH) Raw() bool      { return false }
+
+func (ch *ChunkCOLH) ReadFrom(r io.Reader) (int64, error) {
+	bo := ByteOrder(r)
+	var sum int64
+	if err := binary.Read(r, bo, &ch.size); err != nil {
+		return sum, fmt.Errorf(errFmtDecode, Uint32(IDcolh), err)
+	}
+	sum += 4
+
+	if ch.size < COLHChunkSize {
+		return sum, fmt.Errorf(errFmtDecode, Uint32(IDcolh), ErrTooShort)
+	}
+
+	if err := binary.Read(r, bo, &ch.Instruments); err != nil {
+		return sum, fmt.Errorf(errFmtDecode, Uint32(IDcolh), err)
+	}
+	sum += int64(COLHChunkSize)
+
+	ch.extra = grow(ch.extra, int(ch.size-COLHChunkSize))
+	in, err := io.ReadFull(r, ch.extra)
+	sum += int64(in)
+	if err != nil {
+		return sum, fmt.Errorf(errFmtDecode, Uint32(IDcolh), err)
+	}
+
+	n, err := ReadPaddingIf(r, ch.size)
+	sum += n
+	if err != nil {
+		return sum, fmt.Errorf(errFmtDecode, Uint32(IDcolh), err)
+	}
+
+	return sum, nil
+}
+
+func (ch *ChunkCOLH) WriteTo(w io.Writer) (int64, error) {
+	var sum int64
+
+	ch.size = COLHChunkSize + uint32(len(ch.extra))
+
+	n, err := WriteIDAndSize(w, IDcolh, ch.size)
+	sum += n
+	if err != nil {
+		return sum, fmt.Errorf(errFmtEncode, Uint32(IDcolh), err)
+	}
+
+	if err = binary.Write(w, ByteOrder(w), ch.Instruments); err != nil {
+		return sum, fmt.Errorf(errFmtEncode, Uint32(IDcolh), err)
+	}
+	sum += int64(COLHChunkSize)
+
+	in, err := w.Write(ch.extra)
+	sum += int64(in)
+	if err != nil {
+		return sum, fmt.Errorf(errFmtEncode, Uint32(IDcolh), err)
+	}
+
+	n, err = WritePaddingIf(w, ch.size)
+	sum += n
+	if err != nil {
+		return sum, fmt.Errorf(errFmtEncode, Uint32(IDcolh), err)
+	}
+
+	return sum, nil
+}
+
+func (ch *ChunkCOLH) Reset() {
+	ch.size = COLHChunkSize
+	ch.Instruments = 0
+	ch.extra = ch.extra[:0]
+}
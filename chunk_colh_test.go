@@ -0,0 +1,139 @@
+package riff
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/ctx42/testing/pkg/assert"
+	"github.com/ctx42/testing/pkg/kit/iokit"
+	"github.com/ctx42/testing/pkg/must"
+
+	"github.com/rzajac/riff/internal/test"
+)
+
+func colhChunk(t *testing.T) io.Reader {
+	src := &bytes.Buffer{}
+	test.ReadFrom(t, src, Uint32(IDcolh)) // ( 0) 4 - Chunk ID
+	test.WriteUint32LE(t, src, 4)         // ( 4) 4 - Chunk size
+	test.WriteUint32LE(t, src, 2)         // ( 8) 4 - Instruments
+	// Total length: 8+4=12
+	return src
+}
+
+func Test_ChunkCOLH_COLH(t *testing.T) {
+	// --- When ---
+	ch := COLH()
+
+	// --- Then ---
+	assert.Equal(t, IDcolh, ch.ID())
+	assert.Equal(t, COLHChunkSize, ch.Size())
+	assert.Equal(t, uint32(0), ch.Type())
+	assert.False(t, ch.Multi())
+	assert.Nil(t, ch.Chunks())
+	assert.False(t, ch.Raw())
+}
+
+func Test_ChunkCOLH_ReadFrom(t *testing.T) {
+	// --- Given ---
+	src := colhChunk(t)
+	test.Skip4B(t, src) // Skip chunk ID.
+
+	// --- When ---
+	ch := COLH()
+	n, err := ch.ReadFrom(src)
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, int64(8), n)
+	assert.Equal(t, COLHChunkSize, ch.Size())
+	assert.Equal(t, uint32(2), ch.Instruments)
+	assert.True(t, test.IsAllRead(src))
+}
+
+func Test_ChunkCOLH_ReadFrom_Errors(t *testing.T) {
+	// Reading less than 8 bytes should always result in an error.
+	for _, i := range []int{1, 4, 5, 7} {
+		// --- Given ---
+		src := colhChunk(t)
+		test.Skip4B(t, src) // Skip chunk ID.
+
+		// --- When ---
+		_, err := COLH().ReadFrom(io.LimitReader(src, int64(i)))
+
+		// --- Then ---
+		assert.Error(t, err)
+	}
+}
+
+func Test_ChunkCOLH_ReadFrom_TooShortError(t *testing.T) {
+	// --- Given ---
+	src := &bytes.Buffer{}
+	test.WriteUint32LE(t, src, 3)
+
+	// --- When ---
+	_, err := COLH().ReadFrom(src)
+
+	// --- Then ---
+	assert.ErrorIs(t, ErrTooShort, err)
+}
+
+func Test_ChunkCOLH_ReadFrom_Extra(t *testing.T) {
+	// --- Given ---
+	src := &bytes.Buffer{}
+	test.WriteUint32LE(t, src, 5)
+	test.WriteUint32LE(t, src, 2)
+	test.WriteBytes(t, src, []byte{0xaa, 0})
+
+	// --- When ---
+	ch := COLH()
+	n, err := ch.ReadFrom(src)
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, int64(10), n)
+	assert.Equal(t, uint32(5), ch.Size())
+
+	dst := &bytes.Buffer{}
+	must.Value(ch.WriteTo(dst))
+	assert.Equal(t, []byte{0xaa, 0}, dst.Bytes()[12:])
+}
+
+func Test_ChunkCOLH_WriteTo(t *testing.T) {
+	// --- Given ---
+	ch := COLH()
+	ch.Instruments = 2
+
+	// --- When ---
+	dst := &bytes.Buffer{}
+	n, err := ch.WriteTo(dst)
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, int64(12), n)
+	exp := must.Value(io.ReadAll(colhChunk(t)))
+	assert.Equal(t, exp, dst.Bytes())
+}
+
+func Test_ChunkCOLH_WriteTo_Errors(t *testing.T) {
+	for _, i := range []int{1, 4, 8, 11} {
+		// --- When ---
+		_, err := COLH().WriteTo(iokit.ErrWriter(&bytes.Buffer{}, i))
+
+		// --- Then ---
+		assert.Error(t, err)
+	}
+}
+
+func Test_ChunkCOLH_Reset(t *testing.T) {
+	// --- Given ---
+	ch := COLH()
+	ch.Instruments = 2
+
+	// --- When ---
+	ch.Reset()
+
+	// --- Then ---
+	assert.Equal(t, COLHChunkSize, ch.Size())
+	assert.Equal(t, uint32(0), ch.Instruments)
+}
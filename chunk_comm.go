@@ -0,0 +1,193 @@
+package riff
+
+import (
+	"fmt"
+	"io"
+	"math"
+)
+
+// IDCOMM represents "COMM" (common) chunk ID of the AIFF file.
+const IDCOMM uint32 = 0x434f4d4d
+
+// COMMChunkSize represents the size of the AIFF "COMM" chunk in bytes.
+// The AIFF-C chunk adds the compression type and name.
+const COMMChunkSize uint32 = 18
+
+// AIFF-C compression types.
+const (
+	AIFCNone uint32 = 0x4e4f4e45 // "NONE" Big-endian PCM.
+	AIFCTwos uint32 = 0x74776f73 // "twos" Big-endian PCM.
+	AIFCSowt uint32 = 0x736f7774 // "sowt" Little-endian PCM.
+	AIFCFl32 uint32 = 0x666c3332 // "fl32" 32-bit IEEE floating point.
+	AIFCFL32 uint32 = 0x464c3332 // "FL32" 32-bit IEEE floating point.
+	AIFCFl64 uint32 = 0x666c3634 // "fl64" 64-bit IEEE floating point.
+	AIFCFL64 uint32 = 0x464c3634 // "FL64" 64-bit IEEE floating point.
+	AIFCALaw uint32 = 0x616c6177 // "alaw" ITU G.711 a-law.
+	AIFCULaw uint32 = 0x756c6177 // "ulaw" ITU G.711 µ-law.
+)
+
+// ChunkCOMM represents the "COMM" chunk of the AIFF and AIFF-C files
+// describing the format of the sound data.
+type ChunkCOMM struct {
+	// Number of audio channels.
+	ChannelCnt uint16
+
+	// Number of sample frames in the "SSND" chunk.
+	FrameCnt uint32
+
+	// Number of bits in each sample point.
+	BitsPerSample uint16
+
+	// Sample rate as 80-bit IEEE 754 extended precision number. It's kept
+	// as read so the chunk is written back unchanged.
+	rate [10]byte
+
+	// AIFF-C compression type (see AIFC* constants). Set to zero for the
+	// AIFF chunk which has no compression fields.
+	Compression uint32
+
+	// AIFF-C human-readable compression name.
+	CompressionName string
+}
+
+// COMMMake is a [Maker] function for creating [ChunkCOMM] instances.
+func COMMMake() Chunk { return COMM() }
+
+// COMM returns a new instance of [ChunkCOMM].
+func COMM() *ChunkCOMM {
+	return &ChunkCOMM{}
+}
+
+func (ch *ChunkCOMM) ID() uint32 { return IDCOMM }
+
+func (ch *ChunkCOMM) Size() uint32 {
+	if ch.Compression == 0 {
+		return COMMChunkSize
+	}
+	return COMMChunkSize + 4 + pstringSize(ch.CompressionName)
+}
+
+func (ch *ChunkCOMM) Type() uint32   { return 0 }
+func (ch *ChunkCOMM) Multi() bool    { return false }
+func (ch *ChunkCOMM) Chunks() Chunks { return nil }
+func (ch *ChunkCOMM) Raw() bool      { return false }
+
+// SampleRate returns the sample rate in sample frames per second.
+func (ch *ChunkCOMM) SampleRate() float64 { return extended(ch.rate) }
+
+// SetSampleRate sets the sample rate in sample frames per second.
+func (ch *ChunkCOMM) SetSampleRate(rate float64) { ch.rate = toExtended(rate) }
+
+func (ch *ChunkCOMM) ReadFrom(r io.Reader) (int64, error) {
+	bo := ByteOrder(r)
+	var sum int64
+	size, err := ReadChunkSize(r)
+	if err != nil {
+		return sum, fmt.Errorf(errFmtDecode, Uint32(IDCOMM), err)
+	}
+	sum += 4
+
+	if size < COMMChunkSize {
+		return sum, fmt.Errorf(errFmtDecode, Uint32(IDCOMM), ErrTooShort)
+	}
+
+	buf := make([]byte, size)
+	in, err := io.ReadFull(r, buf)
+	sum += int64(in)
+	if err != nil {
+		return sum, fmt.Errorf(errFmtDecode, Uint32(IDCOMM), err)
+	}
+
+	ch.ChannelCnt = bo.Uint16(buf)
+	ch.FrameCnt = bo.Uint32(buf[2:])
+	ch.BitsPerSample = bo.Uint16(buf[6:])
+	copy(ch.rate[:], buf[8:])
+
+	if size > COMMChunkSize {
+		if size < COMMChunkSize+4 {
+			return sum, fmt.Errorf(errFmtDecode, Uint32(IDCOMM), ErrTooShort)
+		}
+		ch.Compression = be.Uint32(buf[COMMChunkSize:])
+		if ch.CompressionName, _, err = readPString(buf[COMMChunkSize+4:]); err != nil {
+			return sum, fmt.Errorf(errFmtDecode, Uint32(IDCOMM), err)
+		}
+	}
+
+	n, err := ReadPaddingIf(r, size)
+	sum += n
+	if err != nil {
+		return sum, fmt.Errorf(errFmtDecode, Uint32(IDCOMM), err)
+	}
+
+	return sum, nil
+}
+
+func (ch *ChunkCOMM) WriteTo(w io.Writer) (int64, error) {
+	bo := ByteOrder(w)
+	var sum int64
+
+	buf := make([]byte, COMMChunkSize, ch.Size())
+	bo.PutUint16(buf, ch.ChannelCnt)
+	bo.PutUint32(buf[2:], ch.FrameCnt)
+	bo.PutUint16(buf[6:], ch.BitsPerSample)
+	copy(buf[8:], ch.rate[:])
+	if ch.Compression != 0 {
+		buf = be.AppendUint32(buf, ch.Compression)
+		buf = appendPString(buf, ch.CompressionName)
+	}
+
+	n, err := WriteIDAndSize(w, IDCOMM, uint32(len(buf)))
+	sum += n
+	if err != nil {
+		return sum, fmt.Errorf(errFmtEncode, Uint32(IDCOMM), err)
+	}
+
+	in, err := w.Write(buf)
+	sum += int64(in)
+	if err != nil {
+		return sum, fmt.Errorf(errFmtEncode, Uint32(IDCOMM), err)
+	}
+
+	return sum, nil
+}
+
+func (ch *ChunkCOMM) Reset() {
+	ch.ChannelCnt = 0
+	ch.FrameCnt = 0
+	ch.BitsPerSample = 0
+	ch.rate = [10]byte{}
+	ch.Compression = 0
+	ch.CompressionName = ""
+}
+
+// extended returns the 80-bit IEEE 754 extended precision number as
+// float64.
+func extended(b [10]byte) float64 {
+	exp := int(be.Uint16(b[:]) & 0x7fff)
+	mant := be.Uint64(b[2:])
+	if exp == 0 && mant == 0 {
+		return 0
+	}
+	f := math.Ldexp(float64(mant), exp-16383-63)
+	if b[0]&0x80 != 0 {
+		f = -f
+	}
+	return f
+}
+
+// toExtended returns f as the 80-bit IEEE 754 extended precision number.
+func toExtended(f float64) [10]byte {
+	var b [10]byte
+	if f == 0 || math.IsNaN(f) || math.IsInf(f, 0) {
+		return b
+	}
+	var sign uint16
+	if f < 0 {
+		sign = 0x8000
+		f = -f
+	}
+	frac, exp := math.Frexp(f) // The frac is in [0.5, 1) range.
+	be.PutUint16(b[:], sign|uint16(exp-1+16383))
+	be.PutUint64(b[2:], uint64(math.Ldexp(frac, 64)))
+	return b
+}
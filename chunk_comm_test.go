@@ -0,0 +1,249 @@
+package riff
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/ctx42/testing/pkg/assert"
+	"github.com/ctx42/testing/pkg/kit/iokit"
+	"github.com/ctx42/testing/pkg/must"
+
+	"github.com/rzajac/riff/internal/test"
+)
+
+func commChunk(t *testing.T) io.Reader {
+	src := &bytes.Buffer{}
+	test.ReadFrom(t, src, Uint32(IDCOMM))                                     // ( 0)  4 - Chunk ID
+	test.WriteUint32BE(t, src, 18)                                            // ( 4)  4 - Chunk size
+	test.WriteUint16BE(t, src, 2)                                             // ( 8)  2 - Channels
+	test.WriteUint32BE(t, src, 7629)                                          // (10)  4 - Frames
+	test.WriteUint16BE(t, src, 16)                                            // (14)  2 - Sample size
+	test.WriteBytes(t, src, []byte{0x40, 0x0e, 0xac, 0x44, 0, 0, 0, 0, 0, 0}) // (16) 10 - Rate
+	// Total length: 8+18=26
+	return src
+}
+
+func aifcCommChunk(t *testing.T) io.Reader {
+	src := &bytes.Buffer{}
+	test.ReadFrom(t, src, Uint32(IDCOMM))                                  // ( 0)  4 - Chunk ID
+	test.WriteUint32BE(t, src, 28)                                         // ( 4)  4 - Chunk size
+	test.WriteUint16BE(t, src, 1)                                          // ( 8)  2 - Channels
+	test.WriteUint32BE(t, src, 10)                                         // (10)  4 - Frames
+	test.WriteUint16BE(t, src, 32)                                         // (14)  2 - Sample size
+	test.WriteBytes(t, src, []byte{0x40, 0x0b, 0xfa, 0, 0, 0, 0, 0, 0, 0}) // (16) 10 - Rate
+	test.ReadFrom(t, src, Uint32(AIFCFl32))                                // (26)  4 - Compression
+	test.WriteBytes(t, src, []byte("\x05float"))                           // (30)  6 - Name
+	// Total length: 8+28=36
+	return src
+}
+
+func Test_ChunkCOMM_COMM(t *testing.T) {
+	// --- When ---
+	ch := COMM()
+
+	// --- Then ---
+	assert.Equal(t, IDCOMM, ch.ID())
+	assert.Equal(t, COMMChunkSize, ch.Size())
+	assert.Equal(t, uint32(0), ch.Type())
+	assert.False(t, ch.Multi())
+	assert.Nil(t, ch.Chunks())
+	assert.False(t, ch.Raw())
+}
+
+func Test_ChunkCOMM_ReadFrom(t *testing.T) {
+	t.Run("AIFF", func(t *testing.T) {
+		// --- Given ---
+		src := commChunk(t)
+		test.Skip4B(t, src) // Skip chunk ID.
+
+		// --- When ---
+		ch := COMM()
+		n, err := ch.ReadFrom(OrderReader(src, be))
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, int64(22), n)
+		assert.Equal(t, uint32(18), ch.Size())
+		assert.Equal(t, uint16(2), ch.ChannelCnt)
+		assert.Equal(t, uint32(7629), ch.FrameCnt)
+		assert.Equal(t, uint16(16), ch.BitsPerSample)
+		assert.Equal(t, 44100.0, ch.SampleRate())
+		assert.Equal(t, uint32(0), ch.Compression)
+		assert.True(t, test.IsAllRead(src))
+	})
+
+	t.Run("AIFF-C", func(t *testing.T) {
+		// --- Given ---
+		src := aifcCommChunk(t)
+		test.Skip4B(t, src) // Skip chunk ID.
+
+		// --- When ---
+		ch := COMM()
+		n, err := ch.ReadFrom(OrderReader(src, be))
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, int64(32), n)
+		assert.Equal(t, uint32(28), ch.Size())
+		assert.Equal(t, 8000.0, ch.SampleRate())
+		assert.Equal(t, AIFCFl32, ch.Compression)
+		assert.Equal(t, "float", ch.CompressionName)
+		assert.True(t, test.IsAllRead(src))
+	})
+}
+
+func Test_ChunkCOMM_ReadFrom_Errors(t *testing.T) {
+	// Reading less than 32 bytes should always result in an error.
+	for _, i := range []int{1, 4, 5, 21, 27, 31} {
+		// --- Given ---
+		src := aifcCommChunk(t)
+		test.Skip4B(t, src) // Skip chunk ID.
+
+		// --- When ---
+		_, err := COMM().ReadFrom(OrderReader(io.LimitReader(src, int64(i)), be))
+
+		// --- Then ---
+		assert.Error(t, err)
+	}
+}
+
+func Test_ChunkCOMM_ReadFrom_TooShort(t *testing.T) {
+	t.Run("AIFF", func(t *testing.T) {
+		// --- Given ---
+		src := &bytes.Buffer{}
+		test.WriteUint32BE(t, src, 16)
+
+		// --- When ---
+		_, err := COMM().ReadFrom(OrderReader(src, be))
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrTooShort, err)
+	})
+
+	t.Run("AIFF-C", func(t *testing.T) {
+		// --- Given ---
+		src := &bytes.Buffer{}
+		test.WriteUint32BE(t, src, 20)
+		test.WriteBytes(t, src, make([]byte, 20))
+
+		// --- When ---
+		_, err := COMM().ReadFrom(OrderReader(src, be))
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrTooShort, err)
+	})
+
+	t.Run("compression name", func(t *testing.T) {
+		// --- Given ---
+		src := &bytes.Buffer{}
+		test.WriteUint32BE(t, src, 24)
+		test.WriteBytes(t, src, make([]byte, 22))
+		test.WriteBytes(t, src, []byte{5, 'a'})
+
+		// --- When ---
+		_, err := COMM().ReadFrom(OrderReader(src, be))
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrTooShort, err)
+	})
+}
+
+func Test_ChunkCOMM_WriteTo(t *testing.T) {
+	t.Run("AIFF", func(t *testing.T) {
+		// --- Given ---
+		ch := COMM()
+		ch.ChannelCnt = 2
+		ch.FrameCnt = 7629
+		ch.BitsPerSample = 16
+		ch.SetSampleRate(44100)
+
+		// --- When ---
+		dst := &bytes.Buffer{}
+		n, err := ch.WriteTo(OrderWriter(dst, be))
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, int64(26), n)
+		exp := must.Value(io.ReadAll(commChunk(t)))
+		assert.Equal(t, exp, dst.Bytes())
+	})
+
+	t.Run("AIFF-C", func(t *testing.T) {
+		// --- Given ---
+		ch := COMM()
+		ch.ChannelCnt = 1
+		ch.FrameCnt = 10
+		ch.BitsPerSample = 32
+		ch.SetSampleRate(8000)
+		ch.Compression = AIFCFl32
+		ch.CompressionName = "float"
+
+		// --- When ---
+		dst := &bytes.Buffer{}
+		n, err := ch.WriteTo(OrderWriter(dst, be))
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, int64(36), n)
+		exp := must.Value(io.ReadAll(aifcCommChunk(t)))
+		assert.Equal(t, exp, dst.Bytes())
+	})
+}
+
+func Test_ChunkCOMM_WriteTo_Errors(t *testing.T) {
+	for _, i := range []int{1, 4, 8, 20} {
+		// --- When ---
+		_, err := COMM().WriteTo(iokit.ErrWriter(&bytes.Buffer{}, i))
+
+		// --- Then ---
+		assert.Error(t, err)
+	}
+}
+
+func Test_ChunkCOMM_Reset(t *testing.T) {
+	// --- Given ---
+	src := aifcCommChunk(t)
+	test.Skip4B(t, src) // Skip chunk ID.
+	ch := COMM()
+	_, err := ch.ReadFrom(OrderReader(src, be))
+	assert.NoError(t, err)
+
+	// --- When ---
+	ch.Reset()
+
+	// --- Then ---
+	assert.Equal(t, COMMChunkSize, ch.Size())
+	assert.Equal(t, uint16(0), ch.ChannelCnt)
+	assert.Equal(t, uint32(0), ch.FrameCnt)
+	assert.Equal(t, uint16(0), ch.BitsPerSample)
+	assert.Equal(t, 0.0, ch.SampleRate())
+	assert.Equal(t, uint32(0), ch.Compression)
+	assert.Equal(t, "", ch.CompressionName)
+}
+
+func Test_extended(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		f   float64
+		exp [10]byte
+	}{
+		{"zero", 0, [10]byte{}},
+		{"44100", 44100, [10]byte{0x40, 0x0e, 0xac, 0x44}},
+		{"8000.5", 8000.5, [10]byte{0x40, 0x0b, 0xfa, 0x04}},
+		{"one", 1, [10]byte{0x3f, 0xff, 0x80}},
+		{"negative", -2, [10]byte{0xc0, 0x00, 0x80}},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			have := toExtended(tc.f)
+
+			// --- Then ---
+			assert.Equal(t, tc.exp, have)
+			assert.Equal(t, tc.f, extended(have))
+		})
+	}
+}
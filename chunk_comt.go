@@ -0,0 +1,152 @@
+package riff
+
+import (
+	"fmt"
+	"io"
+	"time"
+)
+
+// IDCOMT represents "COMT" (comments) chunk ID of the AIFF file.
+const IDCOMT uint32 = 0x434f4d54
+
+// COMTChunkSize represents the size of the "COMT" chunk static part in
+// bytes. Does not count ID and comments.
+const COMTChunkSize uint32 = 2
+
+// aiffEpoch is the start of the AIFF time stamps.
+var aiffEpoch = time.Date(1904, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+// AIFFComment represents a single comment in [ChunkCOMT].
+type AIFFComment struct {
+	// Time the comment was created in seconds since January 1, 1904.
+	TimeStamp uint32
+
+	// ID of the marker the comment is linked to or zero.
+	Marker uint16
+
+	// Comment text.
+	Text string
+}
+
+// Time returns the comment time stamp as [time.Time].
+func (c AIFFComment) Time() time.Time {
+	return aiffEpoch.Add(time.Duration(c.TimeStamp) * time.Second)
+}
+
+// ChunkCOMT represents the "COMT" chunk of the AIFF file with the
+// comments.
+type ChunkCOMT struct {
+	// List of comments.
+	Comments []AIFFComment
+}
+
+// COMTMake is a [Maker] function for creating [ChunkCOMT] instances.
+func COMTMake() Chunk { return COMT() }
+
+// COMT returns a new instance of [ChunkCOMT].
+func COMT() *ChunkCOMT {
+	return &ChunkCOMT{}
+}
+
+func (ch *ChunkCOMT) ID() uint32 { return IDCOMT }
+
+func (ch *ChunkCOMT) Size() uint32 {
+	size := COMTChunkSize
+	for _, c := range ch.Comments {
+		size += 8 + RealSize(uint32(len(c.Text)))
+	}
+	return size
+}
+
+func (ch *ChunkCOMT) Type() uint32   { return 0 }
+func (ch *ChunkCOMT) Multi() bool    { return false }
+func (ch *ChunkCOMT) Chunks() Chunks { return nil }
+func (ch *ChunkCOMT) Raw() bool      { return false }
+
+func (ch *ChunkCOMT) ReadFrom(r io.Reader) (int64, error) {
+	bo := ByteOrder(r)
+	var sum int64
+	size, err := ReadChunkSize(r)
+	if err != nil {
+		return sum, fmt.Errorf(errFmtDecode, Uint32(IDCOMT), err)
+	}
+	sum += 4
+
+	if size < COMTChunkSize {
+		return sum, fmt.Errorf(errFmtDecode, Uint32(IDCOMT), ErrTooShort)
+	}
+
+	buf := make([]byte, size)
+	in, err := io.ReadFull(r, buf)
+	sum += int64(in)
+	if err != nil {
+		return sum, fmt.Errorf(errFmtDecode, Uint32(IDCOMT), err)
+	}
+
+	cnt := int(bo.Uint16(buf))
+	buf = buf[COMTChunkSize:]
+	for i := 0; i < cnt; i++ {
+		if len(buf) < 8 {
+			return sum, fmt.Errorf(errFmtDecode, Uint32(IDCOMT), ErrTooShort)
+		}
+		l := int(bo.Uint16(buf[6:]))
+		if len(buf) < 8+l {
+			return sum, fmt.Errorf(errFmtDecode, Uint32(IDCOMT), ErrTooShort)
+		}
+		ch.Comments = append(ch.Comments, AIFFComment{
+			TimeStamp: bo.Uint32(buf),
+			Marker:    bo.Uint16(buf[4:]),
+			Text:      string(buf[8 : 8+l]),
+		})
+		buf = buf[min(8+int(RealSize(uint32(l))), len(buf)):]
+	}
+
+	n, err := ReadPaddingIf(r, size)
+	sum += n
+	if err != nil {
+		return sum, fmt.Errorf(errFmtDecode, Uint32(IDCOMT), err)
+	}
+
+	return sum, nil
+}
+
+func (ch *ChunkCOMT) WriteTo(w io.Writer) (int64, error) {
+	bo := ByteOrder(w)
+	var sum int64
+
+	if len(ch.Comments) > 0xffff {
+		return sum, fmt.Errorf(errFmtEncode, Uint32(IDCOMT), ErrOutOfRange)
+	}
+
+	buf := bo.AppendUint16(make([]byte, 0, ch.Size()), uint16(len(ch.Comments)))
+	for _, c := range ch.Comments {
+		if len(c.Text) > 0xffff {
+			return sum, fmt.Errorf(errFmtEncode, Uint32(IDCOMT), ErrOutOfRange)
+		}
+		buf = bo.AppendUint32(buf, c.TimeStamp)
+		buf = bo.AppendUint16(buf, c.Marker)
+		buf = bo.AppendUint16(buf, uint16(len(c.Text)))
+		buf = append(buf, c.Text...)
+		if len(c.Text)%2 != 0 {
+			buf = append(buf, 0)
+		}
+	}
+
+	n, err := WriteIDAndSize(w, IDCOMT, uint32(len(buf)))
+	sum += n
+	if err != nil {
+		return sum, fmt.Errorf(errFmtEncode, Uint32(IDCOMT), err)
+	}
+
+	in, err := w.Write(buf)
+	sum += int64(in)
+	if err != nil {
+		return sum, fmt.Errorf(errFmtEncode, Uint32(IDCOMT), err)
+	}
+
+	return sum, nil
+}
+
+func (ch *ChunkCOMT) Reset() {
+	ch.Comments = ch.Comments[:0]
+}
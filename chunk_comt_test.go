@@ -0,0 +1,169 @@
+package riff
+
+import (
+	"bytes"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/ctx42/testing/pkg/assert"
+	"github.com/ctx42/testing/pkg/kit/iokit"
+	"github.com/ctx42/testing/pkg/must"
+
+	"github.com/rzajac/riff/internal/test"
+)
+
+func comtChunk(t *testing.T) io.Reader {
+	src := &bytes.Buffer{}
+	test.ReadFrom(t, src, Uint32(IDCOMT))             // ( 0) 4 - Chunk ID
+	test.WriteUint32BE(t, src, 24)                    // ( 4) 4 - Chunk size
+	test.WriteUint16BE(t, src, 2)                     // ( 8) 2 - Number of comments
+	test.WriteUint32BE(t, src, 86400)                 // (10) 4 - Time stamp
+	test.WriteUint16BE(t, src, 1)                     // (14) 2 - Marker ID
+	test.WriteUint16BE(t, src, 3)                     // (16) 2 - Text length
+	test.WriteBytes(t, src, []byte{'a', 'b', 'c', 0}) // (18) 4 - Text
+	test.WriteUint32BE(t, src, 0)                     // (22) 4 - Time stamp
+	test.WriteUint16BE(t, src, 0)                     // (26) 2 - Marker ID
+	test.WriteUint16BE(t, src, 2)                     // (28) 2 - Text length
+	test.WriteBytes(t, src, []byte{'d', 'e'})         // (30) 2 - Text
+	// Total length: 8+24=32
+	return src
+}
+
+func Test_ChunkCOMT_COMT(t *testing.T) {
+	// --- When ---
+	ch := COMT()
+
+	// --- Then ---
+	assert.Equal(t, IDCOMT, ch.ID())
+	assert.Equal(t, COMTChunkSize, ch.Size())
+	assert.Equal(t, uint32(0), ch.Type())
+	assert.False(t, ch.Multi())
+	assert.Nil(t, ch.Chunks())
+	assert.False(t, ch.Raw())
+}
+
+func Test_ChunkCOMT_ReadFrom(t *testing.T) {
+	// --- Given ---
+	src := comtChunk(t)
+	test.Skip4B(t, src) // Skip chunk ID.
+
+	// --- When ---
+	ch := COMT()
+	n, err := ch.ReadFrom(OrderReader(src, be))
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, int64(28), n)
+	assert.Equal(t, uint32(24), ch.Size())
+	exp := []AIFFComment{
+		{TimeStamp: 86400, Marker: 1, Text: "abc"},
+		{TimeStamp: 0, Marker: 0, Text: "de"},
+	}
+	assert.Equal(t, exp, ch.Comments)
+	assert.True(t, test.IsAllRead(src))
+}
+
+func Test_ChunkCOMT_ReadFrom_Errors(t *testing.T) {
+	// Reading less than 28 bytes should always result in an error.
+	for _, i := range []int{1, 4, 5, 10, 27} {
+		// --- Given ---
+		src := comtChunk(t)
+		test.Skip4B(t, src) // Skip chunk ID.
+
+		// --- When ---
+		_, err := COMT().ReadFrom(OrderReader(io.LimitReader(src, int64(i)), be))
+
+		// --- Then ---
+		assert.Error(t, err)
+	}
+}
+
+func Test_ChunkCOMT_ReadFrom_TooShort(t *testing.T) {
+	t.Run("chunk", func(t *testing.T) {
+		// --- Given ---
+		src := &bytes.Buffer{}
+		test.WriteUint32BE(t, src, 1)
+
+		// --- When ---
+		_, err := COMT().ReadFrom(OrderReader(src, be))
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrTooShort, err)
+	})
+
+	t.Run("text", func(t *testing.T) {
+		// --- Given ---
+		src := &bytes.Buffer{}
+		test.WriteUint32BE(t, src, 10)
+		test.WriteUint16BE(t, src, 1)
+		test.WriteBytes(t, src, []byte{0, 0, 0, 0, 0, 0, 0, 9})
+
+		// --- When ---
+		_, err := COMT().ReadFrom(OrderReader(src, be))
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrTooShort, err)
+	})
+}
+
+func Test_AIFFComment_Time(t *testing.T) {
+	// --- Given ---
+	c := AIFFComment{TimeStamp: 86400}
+
+	// --- When ---
+	have := c.Time()
+
+	// --- Then ---
+	assert.Equal(t, time.Date(1904, time.January, 2, 0, 0, 0, 0, time.UTC), have)
+}
+
+func Test_ChunkCOMT_WriteTo(t *testing.T) {
+	// --- Given ---
+	ch := COMT()
+	ch.Comments = []AIFFComment{
+		{TimeStamp: 86400, Marker: 1, Text: "abc"},
+		{Text: "de"},
+	}
+
+	// --- When ---
+	dst := &bytes.Buffer{}
+	n, err := ch.WriteTo(OrderWriter(dst, be))
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, int64(32), n)
+	assert.Equal(t, uint32(24), ch.Size())
+	exp := must.Value(io.ReadAll(comtChunk(t)))
+	assert.Equal(t, exp, dst.Bytes())
+}
+
+func Test_ChunkCOMT_WriteTo_Errors(t *testing.T) {
+	for _, i := range []int{1, 4, 8, 20} {
+		// --- Given ---
+		ch := COMT()
+		ch.Comments = []AIFFComment{{Text: "abc"}}
+
+		// --- When ---
+		_, err := ch.WriteTo(iokit.ErrWriter(&bytes.Buffer{}, i))
+
+		// --- Then ---
+		assert.Error(t, err)
+	}
+}
+
+func Test_ChunkCOMT_Reset(t *testing.T) {
+	// --- Given ---
+	src := comtChunk(t)
+	test.Skip4B(t, src) // Skip chunk ID.
+	ch := COMT()
+	_, err := ch.ReadFrom(OrderReader(src, be))
+	assert.NoError(t, err)
+
+	// --- When ---
+	ch.Reset()
+
+	// --- Then ---
+	assert.Equal(t, COMTChunkSize, ch.Size())
+	assert.Len(t, 0, ch.Comments)
+}
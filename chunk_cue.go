@@ -0,0 +1,163 @@
+package riff
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+)
+
+// IDcue represents "cue " chunk ID.
+const IDcue uint32 = 0x63756520
+
+// CUEChunkSize represents the size of cue chunk static part in bytes.
+// Does not count ID and cue points.
+const CUEChunkSize uint32 = 4
+
+// CuePointSize represents the size of a single cue point in bytes.
+const CuePointSize uint32 = 24
+
+// CuePoint represents a single cue point in [ChunkCUE].
+type CuePoint struct {
+	// The ID is a unique identifier for the cue point. Other chunks
+	// (e.g. labl, ltxt, plst, smpl) refer to the cue point by this ID.
+	ID uint32
+
+	// The position specifies the sample offset associated with the cue
+	// point in terms of the sample's position in the final stream of
+	// samples generated by the play list.
+	Position uint32
+
+	// The data chunk ID specifies the four-byte ID of the chunk containing
+	// the sample corresponding to this cue point. For files with a single
+	// data chunk, this is "data".
+	DataChunkID uint32
+
+	// The chunk start specifies the byte offset into the wave list chunk
+	// of the chunk containing the sample. For files with a single data
+	// chunk, this value is 0.
+	ChunkStart uint32
+
+	// The block start specifies the byte offset into the "data" chunk of
+	// the block containing the first sample. For uncompressed PCM this
+	// value is 0.
+	BlockStart uint32
+
+	// The sample offset specifies an offset into the block specified by
+	// block start (in sample frames).
+	SampleOffset uint32
+}
+
+// ChunkCUE represents the "cue " chunk. It identifies a series of
+// positions in the waveform data.
+type ChunkCUE struct {
+	// List of cue points.
+	Points []CuePoint
+}
+
+// CUEMake is a [Maker] function for creating [ChunkCUE] instances.
+func CUEMake() Chunk { return CUE() }
+
+// CUE returns a new instance of [ChunkCUE].
+func CUE() *ChunkCUE {
+	return &ChunkCUE{}
+}
+
+func (ch *ChunkCUE) ID() uint32     { return IDcue }
+func (ch *ChunkCUE) Size() uint32   { return CUEChunkSize + uint32(len(ch.Points))*CuePointSize }
+func (ch *ChunkCUE) Type() uint32   { return 0 }
+func (ch *ChunkCUE) Multi() bool    { return false }
+func (ch *ChunkCUE) Chunks() Chunks { return nil }
+func (ch *ChunkCUE) Raw() bool      { return false }
+
+// Point returns cue point with given ID. Returns false if the cue point
+// does not exist.
+func (ch *ChunkCUE) Point(id uint32) (CuePoint, bool) {
+	for _, cp := range ch.Points {
+		if cp.ID == id {
+			return cp, true
+		}
+	}
+	return CuePoint{}, false
+}
+
+func (ch *ChunkCUE) ReadFrom(r io.Reader) (int64, error) {
+	bo := ByteOrder(r)
+	var sum int64
+	var size uint32
+	if err := binary.Read(r, bo, &size); err != nil {
+		return sum, fmt.Errorf(errFmtDecode, Uint32(IDcue), err)
+	}
+	sum += 4
+
+	if size < CUEChunkSize {
+		return sum, fmt.Errorf(errFmtDecode, Uint32(IDcue), ErrTooShort)
+	}
+
+	var cnt uint32
+	if err := binary.Read(r, bo, &cnt); err != nil {
+		return sum, fmt.Errorf(errFmtDecode, Uint32(IDcue), err)
+	}
+	sum += int64(CUEChunkSize)
+
+	if uint64(size) != uint64(CUEChunkSize)+uint64(cnt)*uint64(CuePointSize) {
+		return sum, fmt.Errorf(errFmtDecode, Uint32(IDcue), ErrChunkSizeMismatch)
+	}
+
+	buf := make([]byte, CuePointSize)
+	for i := 0; i < int(cnt); i++ {
+		in, err := io.ReadFull(r, buf)
+		sum += int64(in)
+		if err != nil {
+			return sum, fmt.Errorf(errFmtDecode, Uint32(IDcue), err)
+		}
+		ch.Points = append(ch.Points, CuePoint{
+			ID:           bo.Uint32(buf[0:]),
+			Position:     bo.Uint32(buf[4:]),
+			DataChunkID:  be.Uint32(buf[8:]),
+			ChunkStart:   bo.Uint32(buf[12:]),
+			BlockStart:   bo.Uint32(buf[16:]),
+			SampleOffset: bo.Uint32(buf[20:]),
+		})
+	}
+
+	return sum, nil
+}
+
+func (ch *ChunkCUE) WriteTo(w io.Writer) (int64, error) {
+	bo := ByteOrder(w)
+	var sum int64
+
+	n, err := WriteIDAndSize(w, IDcue, ch.Size())
+	sum += n
+	if err != nil {
+		return sum, fmt.Errorf(errFmtEncode, Uint32(IDcue), err)
+	}
+
+	if err = binary.Write(w, bo, uint32(len(ch.Points))); err != nil {
+		return sum, fmt.Errorf(errFmtEncode, Uint32(IDcue), err)
+	}
+	sum += int64(CUEChunkSize)
+
+	var in int
+	buf := make([]byte, CuePointSize)
+	for _, cp := range ch.Points {
+		bo.PutUint32(buf[0:], cp.ID)
+		bo.PutUint32(buf[4:], cp.Position)
+		be.PutUint32(buf[8:], cp.DataChunkID)
+		bo.PutUint32(buf[12:], cp.ChunkStart)
+		bo.PutUint32(buf[16:], cp.BlockStart)
+		bo.PutUint32(buf[20:], cp.SampleOffset)
+
+		in, err = w.Write(buf)
+		sum += int64(in)
+		if err != nil {
+			return sum, fmt.Errorf(errFmtEncode, Uint32(IDcue), err)
+		}
+	}
+
+	return sum, nil
+}
+
+func (ch *ChunkCUE) Reset() {
+	ch.Points = ch.Points[:0]
+}
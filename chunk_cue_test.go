@@ -0,0 +1,210 @@
+package riff
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"testing"
+
+	"github.com/ctx42/testing/pkg/assert"
+	"github.com/ctx42/testing/pkg/kit/iokit"
+	"github.com/ctx42/testing/pkg/must"
+
+	"github.com/rzajac/riff/internal/test"
+)
+
+func cueChunkTwoPoints(t *testing.T) io.Reader {
+	src := &bytes.Buffer{}
+	test.ReadFrom(t, src, Uint32(IDcue))  // ( 0) 4 - Chunk ID
+	test.WriteUint32LE(t, src, 52)        // ( 4) 4 - Chunk size
+	test.WriteUint32LE(t, src, 2)         // ( 8) 4 - Number of cue points
+	test.WriteUint32LE(t, src, 1)         // (12) 4 - ID
+	test.WriteUint32LE(t, src, 0)         // (16) 4 - Position
+	test.ReadFrom(t, src, Uint32(IDdata)) // (20) 4 - Data chunk ID
+	test.WriteUint32LE(t, src, 0)         // (24) 4 - Chunk start
+	test.WriteUint32LE(t, src, 0)         // (28) 4 - Block start
+	test.WriteUint32LE(t, src, 0)         // (32) 4 - Sample offset
+	test.WriteUint32LE(t, src, 2)         // (36) 4 - ID
+	test.WriteUint32LE(t, src, 3)         // (40) 4 - Position
+	test.ReadFrom(t, src, Uint32(IDdata)) // (44) 4 - Data chunk ID
+	test.WriteUint32LE(t, src, 0)         // (48) 4 - Chunk start
+	test.WriteUint32LE(t, src, 0)         // (52) 4 - Block start
+	test.WriteUint32LE(t, src, 3)         // (56) 4 - Sample offset
+	// Total length: 8+4+2*24=60
+	return src
+}
+
+func Test_ChunkCUE_CUE(t *testing.T) {
+	// --- When ---
+	ch := CUE()
+
+	// --- Then ---
+	assert.Equal(t, IDcue, ch.ID())
+	assert.Equal(t, uint32(4), ch.Size())
+	assert.Equal(t, uint32(0), ch.Type())
+	assert.False(t, ch.Multi())
+	assert.Nil(t, ch.Chunks())
+	assert.False(t, ch.Raw())
+}
+
+func Test_ChunkCUE_ReadFrom(t *testing.T) {
+	// --- Given ---
+	src := cueChunkTwoPoints(t)
+	test.Skip4B(t, src) // Skip chunk ID.
+
+	// --- When ---
+	ch := CUE()
+	n, err := ch.ReadFrom(src)
+
+	// --- Then ---
+	assert.NoError(t, err)
+
+	assert.Equal(t, int64(56), n)
+	assert.Equal(t, uint32(52), ch.Size())
+	assert.Len(t, 2, ch.Points)
+	exp := CuePoint{
+		ID:           2,
+		Position:     3,
+		DataChunkID:  IDdata,
+		SampleOffset: 3,
+	}
+	assert.Equal(t, exp, ch.Points[1])
+	assert.True(t, test.IsAllRead(src))
+}
+
+func Test_ChunkCUE_ReadFrom_Errors(t *testing.T) {
+	// Reading less than 56 bytes should always result in an error.
+	for i := 1; i < 56; i++ {
+		// --- Given ---
+		src := cueChunkTwoPoints(t)
+		test.Skip4B(t, src) // Skip chunk ID.
+
+		// --- When ---
+		_, err := CUE().ReadFrom(io.LimitReader(src, int64(i)))
+
+		// --- Then ---
+		assert.Error(t, err)
+	}
+}
+
+func Test_ChunkCUE_ReadFrom_TooShortError(t *testing.T) {
+	// --- Given ---
+	src := &bytes.Buffer{}
+	test.WriteUint32LE(t, src, 3)
+
+	// --- When ---
+	n, err := CUE().ReadFrom(src)
+
+	// --- Then ---
+	assert.ErrorIs(t, ErrTooShort, err)
+	assert.ErrorContain(t, "cue  chunk", err)
+	assert.Equal(t, int64(4), n)
+}
+
+func Test_ChunkCUE_ReadFrom_SizeMismatchError(t *testing.T) {
+	// --- Given ---
+	src := &bytes.Buffer{}
+	test.WriteUint32LE(t, src, 28)
+	test.WriteUint32LE(t, src, 2)
+
+	// --- When ---
+	n, err := CUE().ReadFrom(src)
+
+	// --- Then ---
+	assert.ErrorIs(t, ErrChunkSizeMismatch, err)
+	assert.Equal(t, int64(8), n)
+}
+
+func Test_ChunkCUE_Point(t *testing.T) {
+	// --- Given ---
+	src := cueChunkTwoPoints(t)
+	test.Skip4B(t, src) // Skip chunk ID.
+	ch := CUE()
+	_, err := ch.ReadFrom(src)
+	assert.NoError(t, err)
+
+	t.Run("found", func(t *testing.T) {
+		// --- When ---
+		cp, ok := ch.Point(2)
+
+		// --- Then ---
+		assert.True(t, ok)
+		assert.Equal(t, uint32(3), cp.SampleOffset)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		// --- When ---
+		cp, ok := ch.Point(3)
+
+		// --- Then ---
+		assert.False(t, ok)
+		assert.Zero(t, cp)
+	})
+}
+
+func Test_ChunkCUE_WriteTo(t *testing.T) {
+	// --- Given ---
+	src := cueChunkTwoPoints(t)
+	test.Skip4B(t, src) // Skip chunk ID.
+
+	ch := CUE()
+	_, err := ch.ReadFrom(src)
+	assert.NoError(t, err)
+
+	// --- When ---
+	dst := &bytes.Buffer{}
+	n, err := ch.WriteTo(dst)
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, int64(60), n)
+	exp := must.Value(io.ReadAll(cueChunkTwoPoints(t)))
+	assert.Equal(t, exp, dst.Bytes())
+}
+
+func Test_ChunkCUE_WriteTo_Errors(t *testing.T) {
+	// Writing less than 60 bytes should always result in an error.
+	for i := 60; i > 0; i-- {
+		// --- Given ---
+		src := cueChunkTwoPoints(t)
+		test.Skip4B(t, src) // Skip chunk ID.
+
+		ch := CUE()
+		_, err := ch.ReadFrom(src)
+		assert.NoError(t, err)
+
+		// --- When ---
+		_, err = ch.WriteTo(iokit.ErrWriter(&bytes.Buffer{}, i))
+
+		// --- Then ---
+		assert.Error(t, err)
+	}
+}
+
+func Test_ChunkCUE_Decode_File(t *testing.T) {
+	// --- Given ---
+	rif := New(SkipData)
+
+	// --- When ---
+	_, err := rif.ReadFrom(must.Value(os.Open("testdata/flloop.wav")))
+
+	// --- Then ---
+	assert.NoError(t, err)
+	ch, _ := rif.Chunks().First(IDcue).(*ChunkCUE)
+	assert.NotNil(t, ch)
+	assert.Len(t, 16, ch.Points)
+	assert.Equal(t, IDdata, ch.Points[0].DataChunkID)
+}
+
+func Test_ChunkCUE_Reset(t *testing.T) {
+	// --- Given ---
+	ch := CUE()
+	ch.Points = []CuePoint{{ID: 1}}
+
+	// --- When ---
+	ch.Reset()
+
+	// --- Then ---
+	assert.Equal(t, uint32(4), ch.Size())
+	assert.Len(t, 0, ch.Points)
+}
@@ -73,7 +73,7 @@ func (ch *ChunkDATA) Duration(abr uint32) time.Duration {
 
 func (ch *ChunkDATA) ReadFrom(r io.Reader) (int64, error) {
 	var sum int64
-	if err := binary.Read(r, le, &ch.size); err != nil {
+	if err := binary.Read(r, ByteOrder(r), &ch.size); err != nil {
 		return sum, fmt.Errorf(errFmtDecode, Uint32(IDdata), err)
 	}
 	sum += 4
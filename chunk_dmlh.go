@@ -0,0 +1,115 @@
+package riff
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+)
+
+// IDodml represents "odml" (OpenDML header) type of the LIST chunk.
+const IDodml uint32 = 0x6f646d6c
+
+// IDdmlh represents "dmlh" (OpenDML extended AVI header) sub-chunk ID of
+// the "LIST odml" chunk.
+const IDdmlh uint32 = 0x646d6c68
+
+// DMLHChunkSize represents the size of dmlh chunk static part in bytes.
+const DMLHChunkSize uint32 = 4
+
+// ChunkDMLH represents "dmlh" chunk of the OpenDML AVI file.
+type ChunkDMLH struct {
+	// Chunk size in bytes.
+	// The ID and extra padding byte is not counted in the chunk size.
+	size uint32
+
+	// Total number of frames in all "RIFF AVI " and "RIFF AVIX" forms.
+	TotalFrames uint32
+
+	// Reserved bytes following the static part.
+	extra []byte
+}
+
+// DMLHMake is a [Maker] function for creating [ChunkDMLH] instances.
+func DMLHMake() Chunk { return DMLH() }
+
+// DMLH returns a new instance of [ChunkDMLH].
+func DMLH() *ChunkDMLH {
+	return &ChunkDMLH{size: DMLHChunkSize}
+}
+
+func (ch *ChunkDMLH) ID() uint32     { return IDdmlh }
+func (ch *ChunkDMLH) Size() uint32   { return ch.size }
+func (ch *ChunkDMLH) Type() uint32   { return 0 }
+func (ch *ChunkDMLH) Multi() bool    { return false }
+func (ch *ChunkDMLH) Chunks() Chunks { return nil }
+func (ch *ChunkDMLH) Raw() bool      { return false }
+
+func (ch *ChunkDMLH) ReadFrom(r io.Reader) (int64, error) {
+	bo := ByteOrder(r)
+	var sum int64
+	if err := binary.Read(r, bo, &ch.size); err != nil {
+		return sum, fmt.Errorf(errFmtDecode, linkids(IDodml, IDdmlh), err)
+	}
+	sum += 4
+
+	if ch.size < DMLHChunkSize {
+		return sum, fmt.Errorf(errFmtDecode, linkids(IDodml, IDdmlh), ErrTooShort)
+	}
+
+	if err := binary.Read(r, bo, &ch.TotalFrames); err != nil {
+		return sum, fmt.Errorf(errFmtDecode, linkids(IDodml, IDdmlh), err)
+	}
+	sum += int64(DMLHChunkSize)
+
+	ch.extra = grow(ch.extra, int(ch.size-DMLHChunkSize))
+	in, err := io.ReadFull(r, ch.extra)
+	sum += int64(in)
+	if err != nil {
+		return sum, fmt.Errorf(errFmtDecode, linkids(IDodml, IDdmlh), err)
+	}
+
+	n, err := ReadPaddingIf(r, ch.size)
+	sum += n
+	if err != nil {
+		return sum, fmt.Errorf(errFmtDecode, linkids(IDodml, IDdmlh), err)
+	}
+
+	return sum, nil
+}
+
+func (ch *ChunkDMLH) WriteTo(w io.Writer) (int64, error) {
+	var sum int64
+
+	ch.size = DMLHChunkSize + uint32(len(ch.extra))
+
+	n, err := WriteIDAndSize(w, IDdmlh, ch.size)
+	sum += n
+	if err != nil {
+		return sum, fmt.Errorf(errFmtEncode, linkids(IDodml, IDdmlh), err)
+	}
+
+	if err = binary.Write(w, ByteOrder(w), ch.TotalFrames); err != nil {
+		return sum, fmt.Errorf(errFmtEncode, linkids(IDodml, IDdmlh), err)
+	}
+	sum += int64(DMLHChunkSize)
+
+	in, err := w.Write(ch.extra)
+	sum += int64(in)
+	if err != nil {
+		return sum, fmt.Errorf(errFmtEncode, linkids(IDodml, IDdmlh), err)
+	}
+
+	n, err = WritePaddingIf(w, ch.size)
+	sum += n
+	if err != nil {
+		return sum, fmt.Errorf(errFmtEncode, linkids(IDodml, IDdmlh), err)
+	}
+
+	return sum, nil
+}
+
+func (ch *ChunkDMLH) Reset() {
+	ch.size = DMLHChunkSize
+	ch.TotalFrames = 0
+	ch.extra = ch.extra[:0]
+}
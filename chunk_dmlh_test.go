@@ -0,0 +1,134 @@
+package riff
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/ctx42/testing/pkg/assert"
+	"github.com/ctx42/testing/pkg/kit/iokit"
+	"github.com/ctx42/testing/pkg/must"
+
+	"github.com/rzajac/riff/internal/test"
+)
+
+func dmlhChunk(t *testing.T) io.Reader {
+	src := &bytes.Buffer{}
+	test.ReadFrom(t, src, Uint32(IDdmlh))    // ( 0) 4 - Chunk ID
+	test.WriteUint32LE(t, src, 12)           // ( 4) 4 - Chunk size
+	test.WriteUint32LE(t, src, 1500)         // ( 8) 4 - TotalFrames
+	test.WriteBytes(t, src, make([]byte, 8)) // (12) 8 - Reserved
+	// Total length: 8+12=20
+	return src
+}
+
+func Test_ChunkDMLH_DMLH(t *testing.T) {
+	// --- When ---
+	ch := DMLH()
+
+	// --- Then ---
+	assert.Equal(t, IDdmlh, ch.ID())
+	assert.Equal(t, DMLHChunkSize, ch.Size())
+	assert.Equal(t, uint32(0), ch.Type())
+	assert.False(t, ch.Multi())
+	assert.Nil(t, ch.Chunks())
+	assert.False(t, ch.Raw())
+}
+
+func Test_ChunkDMLH_ReadFrom(t *testing.T) {
+	// --- Given ---
+	src := dmlhChunk(t)
+	test.Skip4B(t, src) // Skip chunk ID.
+
+	// --- When ---
+	ch := DMLH()
+	n, err := ch.ReadFrom(src)
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, int64(16), n)
+	assert.Equal(t, uint32(12), ch.Size())
+	assert.Equal(t, uint32(1500), ch.TotalFrames)
+	assert.True(t, test.IsAllRead(src))
+}
+
+func Test_ChunkDMLH_ReadFrom_Errors(t *testing.T) {
+	// Reading less than 16 bytes should always result in an error.
+	for _, i := range []int{1, 4, 5, 8, 15} {
+		// --- Given ---
+		src := dmlhChunk(t)
+		test.Skip4B(t, src) // Skip chunk ID.
+
+		// --- When ---
+		_, err := DMLH().ReadFrom(io.LimitReader(src, int64(i)))
+
+		// --- Then ---
+		assert.Error(t, err)
+	}
+}
+
+func Test_ChunkDMLH_ReadFrom_TooShortError(t *testing.T) {
+	// --- Given ---
+	src := &bytes.Buffer{}
+	test.WriteUint32LE(t, src, 3)
+
+	// --- When ---
+	_, err := DMLH().ReadFrom(src)
+
+	// --- Then ---
+	assert.ErrorIs(t, ErrTooShort, err)
+}
+
+func Test_ChunkDMLH_WriteTo(t *testing.T) {
+	// --- Given ---
+	src := dmlhChunk(t)
+	test.Skip4B(t, src) // Skip chunk ID.
+
+	ch := DMLH()
+	_, err := ch.ReadFrom(src)
+	assert.NoError(t, err)
+
+	// --- When ---
+	dst := &bytes.Buffer{}
+	n, err := ch.WriteTo(dst)
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, int64(20), n)
+	exp := must.Value(io.ReadAll(dmlhChunk(t)))
+	assert.Equal(t, exp, dst.Bytes())
+}
+
+func Test_ChunkDMLH_WriteTo_Errors(t *testing.T) {
+	for _, i := range []int{1, 4, 8, 12, 19} {
+		// --- Given ---
+		src := dmlhChunk(t)
+		test.Skip4B(t, src) // Skip chunk ID.
+
+		ch := DMLH()
+		_, err := ch.ReadFrom(src)
+		assert.NoError(t, err)
+
+		// --- When ---
+		_, err = ch.WriteTo(iokit.ErrWriter(&bytes.Buffer{}, i))
+
+		// --- Then ---
+		assert.Error(t, err)
+	}
+}
+
+func Test_ChunkDMLH_Reset(t *testing.T) {
+	// --- Given ---
+	src := dmlhChunk(t)
+	test.Skip4B(t, src) // Skip chunk ID.
+	ch := DMLH()
+	_, err := ch.ReadFrom(src)
+	assert.NoError(t, err)
+
+	// --- When ---
+	ch.Reset()
+
+	// --- Then ---
+	assert.Equal(t, DMLHChunkSize, ch.Size())
+	assert.Equal(t, uint32(0), ch.TotalFrames)
+}
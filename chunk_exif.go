@@ -0,0 +1,308 @@
+package riff
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"unicode/utf16"
+)
+
+// IDexif represents "exif" type of the LIST chunk.
+const IDexif uint32 = 0x65786966
+
+// IDs of the "LIST exif" sub-chunks as defined in the Exif specification.
+const (
+	// IDever represents Exif version sub-chunk ID "ever" (e.g. "0220").
+	IDever uint32 = 0x65766572
+
+	// IDerel represents related image file sub-chunk ID "erel".
+	IDerel uint32 = 0x6572656c
+
+	// IDetim represents time of the original audio creation sub-chunk
+	// ID "etim".
+	IDetim uint32 = 0x6574696d
+
+	// IDecor represents maker (manufacturer) sub-chunk ID "ecor".
+	IDecor uint32 = 0x65636f72
+
+	// IDemdl represents model sub-chunk ID "emdl".
+	IDemdl uint32 = 0x656d646c
+
+	// IDemnt represents maker note sub-chunk ID "emnt".
+	IDemnt uint32 = 0x656d6e74
+
+	// IDeucm represents user comment sub-chunk ID "eucm".
+	IDeucm uint32 = 0x6575636d
+)
+
+// EUCMCharsetSize represents the size of the character code prefix of the
+// "eucm" sub-chunk in bytes.
+const EUCMCharsetSize uint32 = 8
+
+// Character codes of the "eucm" sub-chunk.
+const (
+	// EXIFCharsetASCII represents ITU-T T.50 IA5 (ASCII) character code.
+	EXIFCharsetASCII = "ASCII"
+
+	// EXIFCharsetJIS represents JIS X0208-1990 character code.
+	EXIFCharsetJIS = "JIS"
+
+	// EXIFCharsetUnicode represents Unicode (UCS-2) character code.
+	EXIFCharsetUnicode = "UNICODE"
+
+	// EXIFCharsetUndefined represents undefined character code.
+	EXIFCharsetUndefined = ""
+)
+
+// EXIFMake returns [IDMaker] function for creating "LIST exif" sub-chunk
+// decoders.
+func EXIFMake(_ bool) IDMaker {
+	return func(id uint32) Chunk {
+		if id == IDeucm {
+			return EUCM()
+		}
+		return EXIF(id)
+	}
+}
+
+// ChunkEXIF represents "LIST exif" sub-chunk holding a string ("ever",
+// "erel", "etim", "ecor", "emdl") or binary data ("emnt").
+type ChunkEXIF struct {
+	// Chunk ID.
+	id uint32
+
+	// Chunk size in bytes.
+	// The ID and extra padding byte is not counted in the chunk size.
+	size uint32
+
+	// Chunk data.
+	data []byte
+}
+
+// EXIF returns a new instance of [ChunkEXIF].
+func EXIF(id uint32) *ChunkEXIF {
+	return &ChunkEXIF{id: id}
+}
+
+func (ch *ChunkEXIF) ID() uint32     { return ch.id }
+func (ch *ChunkEXIF) Size() uint32   { return ch.size }
+func (ch *ChunkEXIF) Type() uint32   { return 0 }
+func (ch *ChunkEXIF) Multi() bool    { return true }
+func (ch *ChunkEXIF) Chunks() Chunks { return nil }
+func (ch *ChunkEXIF) Raw() bool      { return false }
+
+// Text returns the chunk data as a string without the terminating zero.
+func (ch *ChunkEXIF) Text() string {
+	return string(bytes.TrimRight(ch.data, "\x00"))
+}
+
+// SetText sets the chunk data to the zero terminated string. The Exif
+// version ("ever") is stored without terminating zero.
+func (ch *ChunkEXIF) SetText(s string) {
+	ch.data = append(ch.data[:0], s...)
+	if ch.id != IDever {
+		ch.data = append(ch.data, 0)
+	}
+	ch.size = uint32(len(ch.data))
+}
+
+// Data returns the chunk data.
+func (ch *ChunkEXIF) Data() []byte { return ch.data }
+
+// SetData sets the chunk data.
+func (ch *ChunkEXIF) SetData(b []byte) {
+	ch.data = append(ch.data[:0], b...)
+	ch.size = uint32(len(ch.data))
+}
+
+func (ch *ChunkEXIF) ReadFrom(r io.Reader) (int64, error) {
+	var sum int64
+
+	if err := binary.Read(r, ByteOrder(r), &ch.size); err != nil {
+		return sum, fmt.Errorf(errFmtDecode, linkids(IDexif, ch.id), err)
+	}
+	sum += 4
+
+	ch.data = grow(ch.data, int(ch.size))
+	in, err := io.ReadFull(r, ch.data)
+	sum += int64(in)
+	if err != nil {
+		return sum, fmt.Errorf(errFmtDecode, linkids(IDexif, ch.id), err)
+	}
+
+	n, err := ReadPaddingIf(r, ch.size)
+	sum += n
+	if err != nil {
+		return sum, fmt.Errorf(errFmtDecode, linkids(IDexif, ch.id), err)
+	}
+
+	return sum, nil
+}
+
+func (ch *ChunkEXIF) WriteTo(w io.Writer) (int64, error) {
+	var sum int64
+
+	n, err := WriteIDAndSize(w, ch.id, ch.size)
+	sum += n
+	if err != nil {
+		return sum, fmt.Errorf(errFmtEncode, linkids(IDexif, ch.id), err)
+	}
+
+	in, err := w.Write(ch.data)
+	sum += int64(in)
+	if err != nil {
+		return sum, fmt.Errorf(errFmtEncode, linkids(IDexif, ch.id), err)
+	}
+
+	n, err = WritePaddingIf(w, ch.size)
+	sum += n
+	if err != nil {
+		return sum, fmt.Errorf(errFmtEncode, linkids(IDexif, ch.id), err)
+	}
+
+	return sum, nil
+}
+
+func (ch *ChunkEXIF) Reset() {
+	ch.size = 0
+	ch.data = ch.data[:0]
+}
+
+// ChunkEUCM represents "eucm" (user comment) sub-chunk of the "LIST exif"
+// chunk. The comment is prefixed with 8 bytes identifying its character
+// code.
+type ChunkEUCM struct {
+	// Chunk size in bytes.
+	// The ID and extra padding byte is not counted in the chunk size.
+	size uint32
+
+	// Character code prefix.
+	charset [EUCMCharsetSize]byte
+
+	// Comment bytes in the character code.
+	comment []byte
+}
+
+// EUCMMake is a [Maker] function for creating [ChunkEUCM] instances.
+func EUCMMake() Chunk { return EUCM() }
+
+// EUCM returns a new instance of [ChunkEUCM].
+func EUCM() *ChunkEUCM {
+	return &ChunkEUCM{size: EUCMCharsetSize}
+}
+
+func (ch *ChunkEUCM) ID() uint32     { return IDeucm }
+func (ch *ChunkEUCM) Size() uint32   { return ch.size }
+func (ch *ChunkEUCM) Type() uint32   { return 0 }
+func (ch *ChunkEUCM) Multi() bool    { return false }
+func (ch *ChunkEUCM) Chunks() Chunks { return nil }
+func (ch *ChunkEUCM) Raw() bool      { return false }
+
+// Charset returns the character code of the comment (see EXIFCharset*
+// constants).
+func (ch *ChunkEUCM) Charset() string {
+	return string(bytes.TrimRight(ch.charset[:], "\x00"))
+}
+
+// Comment returns the comment decoded to UTF-8. ASCII and Unicode comments
+// are decoded, for other character codes the comment bytes are returned
+// as is. Trailing zeros and spaces are removed.
+func (ch *ChunkEUCM) Comment() string {
+	if ch.Charset() == EXIFCharsetUnicode {
+		u := make([]uint16, len(ch.comment)/2)
+		for i := range u {
+			u[i] = le.Uint16(ch.comment[2*i:])
+		}
+		return string(bytes.TrimRight([]byte(string(utf16.Decode(u))), "\x00 "))
+	}
+	return string(bytes.TrimRight(ch.comment, "\x00 "))
+}
+
+// SetComment sets the comment with given character code. For
+// [EXIFCharsetUnicode] the comment is encoded as UCS-2 little endian,
+// otherwise the bytes of the comment are stored as is.
+func (ch *ChunkEUCM) SetComment(charset, comment string) {
+	ch.charset = [EUCMCharsetSize]byte{}
+	copy(ch.charset[:], charset)
+
+	ch.comment = ch.comment[:0]
+	if charset == EXIFCharsetUnicode {
+		for _, u := range utf16.Encode([]rune(comment)) {
+			ch.comment = le.AppendUint16(ch.comment, u)
+		}
+	} else {
+		ch.comment = append(ch.comment, comment...)
+	}
+	ch.size = EUCMCharsetSize + uint32(len(ch.comment))
+}
+
+func (ch *ChunkEUCM) ReadFrom(r io.Reader) (int64, error) {
+	var sum int64
+
+	if err := binary.Read(r, ByteOrder(r), &ch.size); err != nil {
+		return sum, fmt.Errorf(errFmtDecode, linkids(IDexif, IDeucm), err)
+	}
+	sum += 4
+
+	if ch.size < EUCMCharsetSize {
+		return sum, fmt.Errorf(errFmtDecode, linkids(IDexif, IDeucm), ErrTooShort)
+	}
+
+	in, err := io.ReadFull(r, ch.charset[:])
+	sum += int64(in)
+	if err != nil {
+		return sum, fmt.Errorf(errFmtDecode, linkids(IDexif, IDeucm), err)
+	}
+
+	ch.comment = grow(ch.comment, int(ch.size-EUCMCharsetSize))
+	in, err = io.ReadFull(r, ch.comment)
+	sum += int64(in)
+	if err != nil {
+		return sum, fmt.Errorf(errFmtDecode, linkids(IDexif, IDeucm), err)
+	}
+
+	n, err := ReadPaddingIf(r, ch.size)
+	sum += n
+	if err != nil {
+		return sum, fmt.Errorf(errFmtDecode, linkids(IDexif, IDeucm), err)
+	}
+
+	return sum, nil
+}
+
+func (ch *ChunkEUCM) WriteTo(w io.Writer) (int64, error) {
+	var sum int64
+
+	n, err := WriteIDAndSize(w, IDeucm, ch.size)
+	sum += n
+	if err != nil {
+		return sum, fmt.Errorf(errFmtEncode, linkids(IDexif, IDeucm), err)
+	}
+
+	in, err := w.Write(ch.charset[:])
+	sum += int64(in)
+	if err != nil {
+		return sum, fmt.Errorf(errFmtEncode, linkids(IDexif, IDeucm), err)
+	}
+
+	in, err = w.Write(ch.comment)
+	sum += int64(in)
+	if err != nil {
+		return sum, fmt.Errorf(errFmtEncode, linkids(IDexif, IDeucm), err)
+	}
+
+	n, err = WritePaddingIf(w, ch.size)
+	sum += n
+	if err != nil {
+		return sum, fmt.Errorf(errFmtEncode, linkids(IDexif, IDeucm), err)
+	}
+
+	return sum, nil
+}
+
+func (ch *ChunkEUCM) Reset() {
+	ch.size = EUCMCharsetSize
+	ch.charset = [EUCMCharsetSize]byte{}
+	ch.comment = ch.comment[:0]
+}
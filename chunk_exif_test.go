@@ -0,0 +1,335 @@
+package riff
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/ctx42/testing/pkg/assert"
+	"github.com/ctx42/testing/pkg/kit/iokit"
+	"github.com/ctx42/testing/pkg/must"
+
+	"github.com/rzajac/riff/internal/test"
+)
+
+func listChunkType_exif(t *testing.T) io.Reader {
+	src := &bytes.Buffer{}
+	test.ReadFrom(t, src, Uint32(IDLIST))                // ( 0) 4 - Chunk ID
+	test.WriteUint32LE(t, src, 48)                       // ( 4) 4 - Chunk size
+	test.WriteUint32BE(t, src, IDexif)                   // ( 8) 4 - Type
+	test.ReadFrom(t, src, Uint32(IDever))                // (12) 4 - Chunk ID
+	test.WriteUint32LE(t, src, 4)                        // (16) 4 - Chunk size
+	test.WriteBytes(t, src, []byte("0220"))              // (20) 4 - Version
+	test.ReadFrom(t, src, Uint32(IDecor))                // (24) 4 - Chunk ID
+	test.WriteUint32LE(t, src, 6)                        // (28) 4 - Chunk size
+	test.WriteBytes(t, src, []byte("Canon\x00"))         // (32) 6 - Maker
+	test.ReadFrom(t, src, Uint32(IDeucm))                // (38) 4 - Chunk ID
+	test.WriteUint32LE(t, src, 10)                       // (42) 4 - Chunk size
+	test.WriteBytes(t, src, []byte("ASCII\x00\x00\x00")) // (46) 8 - Charset
+	test.WriteBytes(t, src, []byte("Hi"))                // (54) 2 - Comment
+	// Total length: 56
+	return src
+}
+
+func eucmChunk(t *testing.T) io.Reader {
+	src := &bytes.Buffer{}
+	test.ReadFrom(t, src, Uint32(IDeucm))                    // ( 0) 4 - Chunk ID
+	test.WriteUint32LE(t, src, 13)                           // ( 4) 4 - Chunk size
+	test.WriteBytes(t, src, []byte("UNICODE\x00"))           // ( 8) 8 - Charset
+	test.WriteBytes(t, src, []byte{'a', 0, 0x42, 0x01, ' '}) // (16) 5 - Comment
+	test.WriteByte(t, src, 0)                                // (21) 1 - Padding byte
+	// Total length: 8+13+1=22
+	return src
+}
+
+func Test_EXIFMake(t *testing.T) {
+	// --- Given ---
+	mkr := EXIFMake(LoadData)
+
+	// --- When ---
+	ever := mkr(IDever)
+	eucm := mkr(IDeucm)
+
+	// --- Then ---
+	assert.Type(t, &ChunkEXIF{}, ever)
+	assert.Equal(t, IDever, ever.ID())
+	assert.Type(t, &ChunkEUCM{}, eucm)
+	assert.Equal(t, IDeucm, eucm.ID())
+}
+
+func Test_ChunkEXIF_EXIF(t *testing.T) {
+	// --- When ---
+	ch := EXIF(IDecor)
+
+	// --- Then ---
+	assert.Equal(t, IDecor, ch.ID())
+	assert.Equal(t, uint32(0), ch.Size())
+	assert.Equal(t, uint32(0), ch.Type())
+	assert.True(t, ch.Multi())
+	assert.Nil(t, ch.Chunks())
+	assert.False(t, ch.Raw())
+}
+
+func Test_ChunkEXIF_ReadFrom(t *testing.T) {
+	// --- Given ---
+	src := &bytes.Buffer{}
+	test.WriteUint32LE(t, src, 5)
+	test.WriteBytes(t, src, []byte("EOS\x00\x00"))
+	test.WriteByte(t, src, 0)
+
+	// --- When ---
+	ch := EXIF(IDemdl)
+	n, err := ch.ReadFrom(src)
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, int64(10), n)
+	assert.Equal(t, uint32(5), ch.Size())
+	assert.Equal(t, "EOS", ch.Text())
+	assert.Equal(t, []byte("EOS\x00\x00"), ch.Data())
+	assert.True(t, test.IsAllRead(src))
+}
+
+func Test_ChunkEXIF_ReadFrom_Errors(t *testing.T) {
+	for _, i := range []int{1, 4, 5, 9} {
+		// --- Given ---
+		src := &bytes.Buffer{}
+		test.WriteUint32LE(t, src, 5)
+		test.WriteBytes(t, src, []byte("EOS\x00\x00"))
+		test.WriteByte(t, src, 0)
+
+		// --- When ---
+		_, err := EXIF(IDemdl).ReadFrom(io.LimitReader(src, int64(i)))
+
+		// --- Then ---
+		assert.Error(t, err)
+		assert.ErrorContain(t, "exif:emdl", err)
+	}
+}
+
+func Test_ChunkEXIF_SetText(t *testing.T) {
+	t.Run("zero terminated", func(t *testing.T) {
+		// --- Given ---
+		ch := EXIF(IDerel)
+
+		// --- When ---
+		ch.SetText("DSC0001.JPG")
+
+		// --- Then ---
+		assert.Equal(t, uint32(12), ch.Size())
+		assert.Equal(t, "DSC0001.JPG", ch.Text())
+	})
+
+	t.Run("version", func(t *testing.T) {
+		// --- Given ---
+		ch := EXIF(IDever)
+
+		// --- When ---
+		ch.SetText("0230")
+
+		// --- Then ---
+		assert.Equal(t, uint32(4), ch.Size())
+		assert.Equal(t, []byte("0230"), ch.Data())
+	})
+}
+
+func Test_ChunkEXIF_SetData(t *testing.T) {
+	// --- Given ---
+	ch := EXIF(IDemnt)
+
+	// --- When ---
+	ch.SetData([]byte{1, 2, 3})
+
+	// --- Then ---
+	assert.Equal(t, uint32(3), ch.Size())
+	assert.Equal(t, []byte{1, 2, 3}, ch.Data())
+}
+
+func Test_ChunkEXIF_WriteTo(t *testing.T) {
+	// --- Given ---
+	ch := EXIF(IDemdl)
+	ch.SetText("EOS")
+
+	// --- When ---
+	dst := &bytes.Buffer{}
+	n, err := ch.WriteTo(dst)
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, int64(12), n)
+	exp := []byte{'e', 'm', 'd', 'l', 4, 0, 0, 0, 'E', 'O', 'S', 0}
+	assert.Equal(t, exp, dst.Bytes())
+}
+
+func Test_ChunkEXIF_WriteTo_Errors(t *testing.T) {
+	for _, i := range []int{1, 4, 8, 12} {
+		// --- Given ---
+		ch := EXIF(IDerel)
+		ch.SetText("A.JPG")
+
+		// --- When ---
+		_, err := ch.WriteTo(iokit.ErrWriter(&bytes.Buffer{}, i))
+
+		// --- Then ---
+		assert.Error(t, err)
+	}
+}
+
+func Test_ChunkEXIF_Reset(t *testing.T) {
+	// --- Given ---
+	ch := EXIF(IDemdl)
+	ch.SetText("EOS")
+
+	// --- When ---
+	ch.Reset()
+
+	// --- Then ---
+	assert.Equal(t, IDemdl, ch.ID())
+	assert.Equal(t, uint32(0), ch.Size())
+	assert.Equal(t, "", ch.Text())
+}
+
+func Test_ChunkEUCM_EUCM(t *testing.T) {
+	// --- When ---
+	ch := EUCM()
+
+	// --- Then ---
+	assert.Equal(t, IDeucm, ch.ID())
+	assert.Equal(t, uint32(8), ch.Size())
+	assert.Equal(t, uint32(0), ch.Type())
+	assert.False(t, ch.Multi())
+	assert.Nil(t, ch.Chunks())
+	assert.False(t, ch.Raw())
+	assert.Equal(t, EXIFCharsetUndefined, ch.Charset())
+}
+
+func Test_ChunkEUCM_ReadFrom(t *testing.T) {
+	// --- Given ---
+	src := eucmChunk(t)
+	test.Skip4B(t, src) // Skip chunk ID.
+
+	// --- When ---
+	ch := EUCM()
+	n, err := ch.ReadFrom(src)
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, int64(18), n)
+	assert.Equal(t, uint32(13), ch.Size())
+	assert.Equal(t, EXIFCharsetUnicode, ch.Charset())
+	assert.Equal(t, "ał", ch.Comment())
+	assert.True(t, test.IsAllRead(src))
+}
+
+func Test_ChunkEUCM_ReadFrom_Errors(t *testing.T) {
+	// Reading less than 18 bytes should always result in an error.
+	for _, i := range []int{1, 4, 5, 12, 17} {
+		// --- Given ---
+		src := eucmChunk(t)
+		test.Skip4B(t, src) // Skip chunk ID.
+
+		// --- When ---
+		_, err := EUCM().ReadFrom(io.LimitReader(src, int64(i)))
+
+		// --- Then ---
+		assert.Error(t, err)
+	}
+}
+
+func Test_ChunkEUCM_ReadFrom_TooShortError(t *testing.T) {
+	// --- Given ---
+	src := &bytes.Buffer{}
+	test.WriteUint32LE(t, src, 7)
+
+	// --- When ---
+	n, err := EUCM().ReadFrom(src)
+
+	// --- Then ---
+	assert.ErrorIs(t, ErrTooShort, err)
+	assert.ErrorContain(t, "exif:eucm", err)
+	assert.Equal(t, int64(4), n)
+}
+
+func Test_ChunkEUCM_SetComment(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		charset string
+		comment string
+		size    uint32
+	}{
+		{"ASCII", EXIFCharsetASCII, "abc", 11},
+		{"Unicode", EXIFCharsetUnicode, "ał", 12},
+		{"undefined", EXIFCharsetUndefined, "abc", 11},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- Given ---
+			ch := EUCM()
+
+			// --- When ---
+			ch.SetComment(tc.charset, tc.comment)
+
+			// --- Then ---
+			assert.Equal(t, tc.size, ch.Size())
+			assert.Equal(t, tc.charset, ch.Charset())
+			assert.Equal(t, tc.comment, ch.Comment())
+		})
+	}
+}
+
+func Test_ChunkEUCM_WriteTo(t *testing.T) {
+	// --- Given ---
+	src := eucmChunk(t)
+	test.Skip4B(t, src) // Skip chunk ID.
+
+	ch := EUCM()
+	_, err := ch.ReadFrom(src)
+	assert.NoError(t, err)
+
+	// --- When ---
+	dst := &bytes.Buffer{}
+	n, err := ch.WriteTo(dst)
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, int64(22), n)
+	exp := must.Value(io.ReadAll(eucmChunk(t)))
+	assert.Equal(t, exp, dst.Bytes())
+}
+
+func Test_ChunkEUCM_WriteTo_Errors(t *testing.T) {
+	for _, i := range []int{1, 4, 8, 16, 21} {
+		// --- Given ---
+		src := eucmChunk(t)
+		test.Skip4B(t, src) // Skip chunk ID.
+
+		ch := EUCM()
+		_, err := ch.ReadFrom(src)
+		assert.NoError(t, err)
+
+		// --- When ---
+		_, err = ch.WriteTo(iokit.ErrWriter(&bytes.Buffer{}, i))
+
+		// --- Then ---
+		assert.Error(t, err)
+	}
+}
+
+func Test_ChunkEUCM_Reset(t *testing.T) {
+	// --- Given ---
+	src := eucmChunk(t)
+	test.Skip4B(t, src) // Skip chunk ID.
+	ch := EUCM()
+	_, err := ch.ReadFrom(src)
+	assert.NoError(t, err)
+
+	// --- When ---
+	ch.Reset()
+
+	// --- Then ---
+	assert.Equal(t, uint32(8), ch.Size())
+	assert.Equal(t, EXIFCharsetUndefined, ch.Charset())
+	assert.Equal(t, "", ch.Comment())
+}
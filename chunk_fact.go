@@ -0,0 +1,112 @@
+package riff
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+)
+
+// IDfact represents "fact" chunk ID.
+const IDfact uint32 = 0x66616374
+
+// FACTChunkSize represents the size of fact chunk static part in bytes.
+const FACTChunkSize uint32 = 4
+
+// ChunkFACT represents "fact" chunk of the WAVE file. It's required for
+// the compressed waveform data formats.
+type ChunkFACT struct {
+	// Chunk size in bytes.
+	// The ID and extra padding byte is not counted in the chunk size.
+	size uint32
+
+	// Number of samples (per channel) in the waveform data.
+	SampleLength uint32
+
+	// Bytes following the static part.
+	extra []byte
+}
+
+// FACTMake is a [Maker] function for creating [ChunkFACT] instances.
+func FACTMake() Chunk { return FACT() }
+
+// FACT returns a new instance of [ChunkFACT].
+func FACT() *ChunkFACT {
+	return &ChunkFACT{size: FACTChunkSize}
+}
+
+func (ch *ChunkFACT) ID() uint32     { return IDfact }
+func (ch *ChunkFACT) Size() uint32   { return ch.size }
+func (ch *ChunkFACT) Type() uint32   { return 0 }
+func (ch *ChunkFACT) Multi() bool    { return false }
+func (ch *ChunkFACT) Chunks() Chunks { return nil }
+func (ch *ChunkFACT) Raw() bool      { return false }
+
+func (ch *ChunkFACT) ReadFrom(r io.Reader) (int64, error) {
+	bo := ByteOrder(r)
+	var sum int64
+	if err := binary.Read(r, bo, &ch.size); err != nil {
+		return sum, fmt.Errorf(errFmtDecode, Uint32(IDfact), err)
+	}
+	sum += 4
+
+	if ch.size < FACTChunkSize {
+		return sum, fmt.Errorf(errFmtDecode, Uint32(IDfact), ErrTooShort)
+	}
+
+	if err := binary.Read(r, bo, &ch.SampleLength); err != nil {
+		return sum, fmt.Errorf(errFmtDecode, Uint32(IDfact), err)
+	}
+	sum += int64(FACTChunkSize)
+
+	ch.extra = grow(ch.extra, int(ch.size-FACTChunkSize))
+	in, err := io.ReadFull(r, ch.extra)
+	sum += int64(in)
+	if err != nil {
+		return sum, fmt.Errorf(errFmtDecode, Uint32(IDfact), err)
+	}
+
+	n, err := ReadPaddingIf(r, ch.size)
+	sum += n
+	if err != nil {
+		return sum, fmt.Errorf(errFmtDecode, Uint32(IDfact), err)
+	}
+
+	return sum, nil
+}
+
+func (ch *ChunkFACT) WriteTo(w io.Writer) (int64, error) {
+	var sum int64
+
+	ch.size = FACTChunkSize + uint32(len(ch.extra))
+
+	n, err := WriteIDAndSize(w, IDfact, ch.size)
+	sum += n
+	if err != nil {
+		return sum, fmt.Errorf(errFmtEncode, Uint32(IDfact), err)
+	}
+
+	if err = binary.Write(w, ByteOrder(w), ch.SampleLength); err != nil {
+		return sum, fmt.Errorf(errFmtEncode, Uint32(IDfact), err)
+	}
+	sum += int64(FACTChunkSize)
+
+	in, err := w.Write(ch.extra)
+	sum += int64(in)
+	if err != nil {
+		return sum, fmt.Errorf(errFmtEncode, Uint32(IDfact), err)
+	}
+
+	n, err = WritePaddingIf(w, ch.size)
+	sum += n
+	if err != nil {
+		return sum, fmt.Errorf(errFmtEncode, Uint32(IDfact), err)
+	}
+
+	return sum, nil
+}
+
+func (ch *ChunkFACT) Reset() {
+	ch.size = FACTChunkSize
+	ch.SampleLength = 0
+	ch.extra = ch.extra[:0]
+}
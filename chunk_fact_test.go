@@ -0,0 +1,156 @@
+package riff
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/ctx42/testing/pkg/assert"
+	"github.com/ctx42/testing/pkg/kit/iokit"
+	"github.com/ctx42/testing/pkg/must"
+
+	"github.com/rzajac/riff/internal/test"
+)
+
+func factChunk(t *testing.T) io.Reader {
+	src := &bytes.Buffer{}
+	test.ReadFrom(t, src, Uint32(IDfact)) // ( 0) 4 - Chunk ID
+	test.WriteUint32LE(t, src, 4)         // ( 4) 4 - Chunk size
+	test.WriteUint32LE(t, src, 1500)      // ( 8) 4 - SampleLength
+	// Total length: 8+4=12
+	return src
+}
+
+func Test_ChunkFACT_DMLH(t *testing.T) {
+	// --- When ---
+	ch := FACT()
+
+	// --- Then ---
+	assert.Equal(t, IDfact, ch.ID())
+	assert.Equal(t, FACTChunkSize, ch.Size())
+	assert.Equal(t, uint32(0), ch.Type())
+	assert.False(t, ch.Multi())
+	assert.Nil(t, ch.Chunks())
+	assert.False(t, ch.Raw())
+}
+
+func Test_ChunkFACT_ReadFrom(t *testing.T) {
+	// --- Given ---
+	src := factChunk(t)
+	test.Skip4B(t, src) // Skip chunk ID.
+
+	// --- When ---
+	ch := FACT()
+	n, err := ch.ReadFrom(src)
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, int64(8), n)
+	assert.Equal(t, uint32(4), ch.Size())
+	assert.Equal(t, uint32(1500), ch.SampleLength)
+	assert.True(t, test.IsAllRead(src))
+}
+
+func Test_ChunkFACT_ReadFrom_Errors(t *testing.T) {
+	// Reading less than 8 bytes should always result in an error.
+	for _, i := range []int{1, 4, 5, 7} {
+		// --- Given ---
+		src := factChunk(t)
+		test.Skip4B(t, src) // Skip chunk ID.
+
+		// --- When ---
+		_, err := FACT().ReadFrom(io.LimitReader(src, int64(i)))
+
+		// --- Then ---
+		assert.Error(t, err)
+	}
+}
+
+func Test_ChunkFACT_ReadFrom_TooShortError(t *testing.T) {
+	// --- Given ---
+	src := &bytes.Buffer{}
+	test.WriteUint32LE(t, src, 3)
+
+	// --- When ---
+	_, err := FACT().ReadFrom(src)
+
+	// --- Then ---
+	assert.ErrorIs(t, ErrTooShort, err)
+}
+
+func Test_ChunkFACT_WriteTo(t *testing.T) {
+	// --- Given ---
+	src := factChunk(t)
+	test.Skip4B(t, src) // Skip chunk ID.
+
+	ch := FACT()
+	_, err := ch.ReadFrom(src)
+	assert.NoError(t, err)
+
+	// --- When ---
+	dst := &bytes.Buffer{}
+	n, err := ch.WriteTo(dst)
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, int64(12), n)
+	exp := must.Value(io.ReadAll(factChunk(t)))
+	assert.Equal(t, exp, dst.Bytes())
+}
+
+func Test_ChunkFACT_WriteTo_Errors(t *testing.T) {
+	for _, i := range []int{1, 4, 8, 11} {
+		// --- Given ---
+		src := factChunk(t)
+		test.Skip4B(t, src) // Skip chunk ID.
+
+		ch := FACT()
+		_, err := ch.ReadFrom(src)
+		assert.NoError(t, err)
+
+		// --- When ---
+		_, err = ch.WriteTo(iokit.ErrWriter(&bytes.Buffer{}, i))
+
+		// --- Then ---
+		assert.Error(t, err)
+	}
+}
+
+func Test_ChunkFACT_Reset(t *testing.T) {
+	// --- Given ---
+	src := factChunk(t)
+	test.Skip4B(t, src) // Skip chunk ID.
+	ch := FACT()
+	_, err := ch.ReadFrom(src)
+	assert.NoError(t, err)
+
+	// --- When ---
+	ch.Reset()
+
+	// --- Then ---
+	assert.Equal(t, FACTChunkSize, ch.Size())
+	assert.Equal(t, uint32(0), ch.SampleLength)
+}
+
+func Test_ChunkFACT_WriteTo_Extra(t *testing.T) {
+	// --- Given ---
+	src := &bytes.Buffer{}
+	test.WriteUint32LE(t, src, 7)
+	test.WriteUint32LE(t, src, 1500)
+	test.WriteBytes(t, src, []byte{1, 2, 3, 0})
+
+	ch := FACT()
+	n, err := ch.ReadFrom(bytes.NewReader(src.Bytes()))
+	assert.NoError(t, err)
+	assert.Equal(t, int64(12), n)
+
+	// --- When ---
+	dst := &bytes.Buffer{}
+	n, err = ch.WriteTo(dst)
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, int64(16), n)
+	exp := append([]byte("fact"), src.Bytes()...)
+	assert.Equal(t, exp, dst.Bytes())
+}
@@ -17,8 +17,12 @@ const FMTChunkSize uint32 = 16
 
 // Compression codes.
 const (
-	CompNone uint16 = 0x0000 // Uncompressed PCM file.
-	CompPCM  uint16 = 0x0001 // Microsoft Pulse Code Modulation (PCM).
+	CompNone       uint16 = 0x0000 // Uncompressed PCM file.
+	CompPCM        uint16 = 0x0001 // Microsoft Pulse Code Modulation (PCM).
+	CompFloat      uint16 = 0x0003 // IEEE floating point.
+	CompALaw       uint16 = 0x0006 // ITU G.711 a-law.
+	CompMULaw      uint16 = 0x0007 // ITU G.711 µ-law.
+	CompExtensible uint16 = 0xfffe // Determined by SubFormat.
 )
 
 // fmtStatic represents chunk static data (always there).
@@ -122,9 +126,46 @@ func (ch *ChunkFMT) Duration(ds uint32) time.Duration {
 	return time.Duration(dur)
 }
 
+// Silence returns a single block (BlockAlign bytes) of samples
+// representing silence in the chunk's format. It returns
+// [ErrUnsupportedFormat] for compression codes without a constant
+// silence value.
+func (ch *ChunkFMT) Silence() ([]byte, error) {
+	if ch.BlockAlign == 0 {
+		return nil, fmt.Errorf("invalid fmt block align: %w", ErrUnsupportedFormat)
+	}
+
+	var val byte
+	switch ch.CompCode {
+	case CompNone, CompPCM, CompExtensible:
+		// The 8-bit samples are unsigned, everything wider is signed.
+		if ch.BitsPerSample <= 8 {
+			val = 0x80
+		}
+	case CompFloat:
+	case CompALaw:
+		val = 0xd5
+	case CompMULaw:
+		val = 0xff
+	default:
+		return nil, fmt.Errorf("%w: 0x%04x", ErrUnsupportedFormat, ch.CompCode)
+	}
+	return bytes.Repeat([]byte{val}, int(ch.BlockAlign)), nil
+}
+
+// compressed returns true if the waveform data is compressed.
+func (ch *ChunkFMT) compressed() bool {
+	switch ch.CompCode {
+	case CompNone, CompPCM, CompFloat, CompExtensible:
+		return false
+	}
+	return true
+}
+
 func (ch *ChunkFMT) ReadFrom(r io.Reader) (int64, error) {
+	bo := ByteOrder(r)
 	var sum int64
-	if err := binary.Read(r, le, &ch.size); err != nil {
+	if err := binary.Read(r, bo, &ch.size); err != nil {
 		return sum, fmt.Errorf(errFmtDecode, Uint32(IDfmt), err)
 	}
 	sum += 4
@@ -133,7 +174,7 @@ func (ch *ChunkFMT) ReadFrom(r io.Reader) (int64, error) {
 		return sum, fmt.Errorf(errFmtDecode, Uint32(IDfmt), ErrTooShort)
 	}
 
-	if err := binary.Read(r, le, &ch.fmtStatic); err != nil {
+	if err := binary.Read(r, bo, &ch.fmtStatic); err != nil {
 		return sum, fmt.Errorf(errFmtDecode, Uint32(IDfmt), err)
 	}
 	sum += int64(FMTChunkSize)
@@ -146,7 +187,7 @@ func (ch *ChunkFMT) ReadFrom(r io.Reader) (int64, error) {
 		// padding should be added to the end of this data to word align it,
 		// but the value should remain non-aligned.
 		var es uint16
-		if err := binary.Read(r, le, &es); err != nil {
+		if err := binary.Read(r, bo, &es); err != nil {
 			return sum, fmt.Errorf(errFmtDecode, Uint32(IDfmt), err)
 		}
 		sum += 2
@@ -185,6 +226,7 @@ func (ch *ChunkFMT) ReadFrom(r io.Reader) (int64, error) {
 }
 
 func (ch *ChunkFMT) WriteTo(w io.Writer) (int64, error) {
+	bo := ByteOrder(w)
 	var sum int64
 
 	size := FMTChunkSize
@@ -200,14 +242,14 @@ func (ch *ChunkFMT) WriteTo(w io.Writer) (int64, error) {
 		return sum, fmt.Errorf(errFmtEncode, Uint32(IDfmt), err)
 	}
 
-	if err = binary.Write(w, le, ch.fmtStatic); err != nil {
+	if err = binary.Write(w, bo, ch.fmtStatic); err != nil {
 		return sum, fmt.Errorf(errFmtEncode, Uint32(IDfmt), err)
 	}
 	sum += int64(FMTChunkSize)
 
 	if eln > 0 || ch.WriteZeroExtra {
 		// Write size of extra bytes.
-		if err = binary.Write(w, le, uint16(eln)); err != nil {
+		if err = binary.Write(w, bo, uint16(eln)); err != nil {
 			return sum, fmt.Errorf(errFmtEncode, Uint32(IDfmt), err)
 		}
 		sum += 2
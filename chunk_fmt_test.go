@@ -494,3 +494,98 @@ func Test_ChunkFMT_Duration(t *testing.T) {
 	// --- Then ---
 	assert.Equal(t, time.Second, d)
 }
+
+func Test_ChunkFMT_Silence(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		comp uint16
+		bits uint16
+		ba   uint16
+		exp  []byte
+	}{
+		{"8-bit PCM", CompPCM, 8, 2, []byte{0x80, 0x80}},
+		{"16-bit PCM", CompPCM, 16, 4, []byte{0, 0, 0, 0}},
+		{"8-bit none", CompNone, 8, 1, []byte{0x80}},
+		{"8-bit extensible", CompExtensible, 8, 1, []byte{0x80}},
+		{"float", CompFloat, 32, 4, []byte{0, 0, 0, 0}},
+		{"a-law", CompALaw, 8, 1, []byte{0xd5}},
+		{"µ-law", CompMULaw, 8, 1, []byte{0xff}},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- Given ---
+			ch := FMT()
+			ch.CompCode = tc.comp
+			ch.BitsPerSample = tc.bits
+			ch.BlockAlign = tc.ba
+
+			// --- When ---
+			have, err := ch.Silence()
+
+			// --- Then ---
+			assert.NoError(t, err)
+			assert.Equal(t, tc.exp, have)
+		})
+	}
+}
+
+func Test_ChunkFMT_Silence_Errors(t *testing.T) {
+	t.Run("zero block align", func(t *testing.T) {
+		// --- Given ---
+		ch := FMT()
+		ch.CompCode = CompPCM
+
+		// --- When ---
+		have, err := ch.Silence()
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrUnsupportedFormat, err)
+		assert.Nil(t, have)
+	})
+
+	t.Run("compressed", func(t *testing.T) {
+		// --- Given ---
+		ch := FMT()
+		ch.CompCode = 0x0011 // IMA ADPCM
+		ch.BlockAlign = 256
+
+		// --- When ---
+		have, err := ch.Silence()
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrUnsupportedFormat, err)
+		assert.Nil(t, have)
+	})
+}
+
+func Test_ChunkFMT_compressed(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		comp uint16
+		exp  bool
+	}{
+		{"none", CompNone, false},
+		{"PCM", CompPCM, false},
+		{"float", CompFloat, false},
+		{"extensible", CompExtensible, false},
+		{"a-law", CompALaw, true},
+		{"µ-law", CompMULaw, true},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- Given ---
+			ch := FMT()
+			ch.CompCode = tc.comp
+
+			// --- When ---
+			have := ch.compressed()
+
+			// --- Then ---
+			assert.Equal(t, tc.exp, have)
+		})
+	}
+}
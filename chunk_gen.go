@@ -0,0 +1,348 @@
+package riff
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+)
+
+// IDs of the SoundFont generator chunks.
+const (
+	// IDpgen represents "pgen" (preset generators) sub-chunk ID of the
+	// "LIST pdta" chunk.
+	IDpgen uint32 = 0x7067656e
+
+	// IDigen represents "igen" (instrument generators) sub-chunk ID of the
+	// "LIST pdta" chunk.
+	IDigen uint32 = 0x6967656e
+)
+
+// SF2GeneratorSize represents the size of the generator record in bytes.
+const SF2GeneratorSize uint32 = 4
+
+// SF2Gen represents the SoundFont generator operator.
+type SF2Gen uint16
+
+// SoundFont generator operators.
+const (
+	// SF2GenStartAddrsOffset represents offset of the sample start in data
+	// points.
+	SF2GenStartAddrsOffset SF2Gen = 0
+
+	// SF2GenEndAddrsOffset represents offset of the sample end in data points.
+	SF2GenEndAddrsOffset SF2Gen = 1
+
+	// SF2GenStartloopAddrsOffset represents offset of the loop start in data
+	// points.
+	SF2GenStartloopAddrsOffset SF2Gen = 2
+
+	// SF2GenEndloopAddrsOffset represents offset of the loop end in data
+	// points.
+	SF2GenEndloopAddrsOffset SF2Gen = 3
+
+	// SF2GenStartAddrsCoarseOffset represents offset of the sample start in
+	// 32768 data points.
+	SF2GenStartAddrsCoarseOffset SF2Gen = 4
+
+	// SF2GenModLfoToPitch represents modulation LFO influence on pitch in
+	// cents.
+	SF2GenModLfoToPitch SF2Gen = 5
+
+	// SF2GenVibLfoToPitch represents vibrato LFO influence on pitch in cents.
+	SF2GenVibLfoToPitch SF2Gen = 6
+
+	// SF2GenModEnvToPitch represents modulation envelope influence on pitch in
+	// cents.
+	SF2GenModEnvToPitch SF2Gen = 7
+
+	// SF2GenInitialFilterFc represents filter cutoff frequency in absolute
+	// cents.
+	SF2GenInitialFilterFc SF2Gen = 8
+
+	// SF2GenInitialFilterQ represents filter resonance in centibels.
+	SF2GenInitialFilterQ SF2Gen = 9
+
+	// SF2GenModLfoToFilterFc represents modulation LFO influence on filter
+	// cutoff in cents.
+	SF2GenModLfoToFilterFc SF2Gen = 10
+
+	// SF2GenModEnvToFilterFc represents modulation envelope influence on filter
+	// cutoff in cents.
+	SF2GenModEnvToFilterFc SF2Gen = 11
+
+	// SF2GenEndAddrsCoarseOffset represents offset of the sample end in 32768
+	// data points.
+	SF2GenEndAddrsCoarseOffset SF2Gen = 12
+
+	// SF2GenModLfoToVolume represents modulation LFO influence on volume in
+	// centibels.
+	SF2GenModLfoToVolume SF2Gen = 13
+
+	// SF2GenUnused1 is unused or reserved.
+	SF2GenUnused1 SF2Gen = 14
+
+	// SF2GenChorusEffectsSend represents chorus send in 0.1% units.
+	SF2GenChorusEffectsSend SF2Gen = 15
+
+	// SF2GenReverbEffectsSend represents reverb send in 0.1% units.
+	SF2GenReverbEffectsSend SF2Gen = 16
+
+	// SF2GenPan represents pan in 0.1% units (-500 left, 500 right).
+	SF2GenPan SF2Gen = 17
+
+	// SF2GenUnused2 is unused or reserved.
+	SF2GenUnused2 SF2Gen = 18
+
+	// SF2GenUnused3 is unused or reserved.
+	SF2GenUnused3 SF2Gen = 19
+
+	// SF2GenUnused4 is unused or reserved.
+	SF2GenUnused4 SF2Gen = 20
+
+	// SF2GenDelayModLFO represents modulation LFO delay in timecents.
+	SF2GenDelayModLFO SF2Gen = 21
+
+	// SF2GenFreqModLFO represents modulation LFO frequency in absolute cents.
+	SF2GenFreqModLFO SF2Gen = 22
+
+	// SF2GenDelayVibLFO represents vibrato LFO delay in timecents.
+	SF2GenDelayVibLFO SF2Gen = 23
+
+	// SF2GenFreqVibLFO represents vibrato LFO frequency in absolute cents.
+	SF2GenFreqVibLFO SF2Gen = 24
+
+	// SF2GenDelayModEnv represents modulation envelope delay in timecents.
+	SF2GenDelayModEnv SF2Gen = 25
+
+	// SF2GenAttackModEnv represents modulation envelope attack in timecents.
+	SF2GenAttackModEnv SF2Gen = 26
+
+	// SF2GenHoldModEnv represents modulation envelope hold in timecents.
+	SF2GenHoldModEnv SF2Gen = 27
+
+	// SF2GenDecayModEnv represents modulation envelope decay in timecents.
+	SF2GenDecayModEnv SF2Gen = 28
+
+	// SF2GenSustainModEnv represents modulation envelope sustain in 0.1% units.
+	SF2GenSustainModEnv SF2Gen = 29
+
+	// SF2GenReleaseModEnv represents modulation envelope release in timecents.
+	SF2GenReleaseModEnv SF2Gen = 30
+
+	// SF2GenKeynumToModEnvHold represents key number influence on modulation
+	// envelope hold.
+	SF2GenKeynumToModEnvHold SF2Gen = 31
+
+	// SF2GenKeynumToModEnvDecay represents key number influence on modulation
+	// envelope decay.
+	SF2GenKeynumToModEnvDecay SF2Gen = 32
+
+	// SF2GenDelayVolEnv represents volume envelope delay in timecents.
+	SF2GenDelayVolEnv SF2Gen = 33
+
+	// SF2GenAttackVolEnv represents volume envelope attack in timecents.
+	SF2GenAttackVolEnv SF2Gen = 34
+
+	// SF2GenHoldVolEnv represents volume envelope hold in timecents.
+	SF2GenHoldVolEnv SF2Gen = 35
+
+	// SF2GenDecayVolEnv represents volume envelope decay in timecents.
+	SF2GenDecayVolEnv SF2Gen = 36
+
+	// SF2GenSustainVolEnv represents volume envelope sustain attenuation in
+	// centibels.
+	SF2GenSustainVolEnv SF2Gen = 37
+
+	// SF2GenReleaseVolEnv represents volume envelope release in timecents.
+	SF2GenReleaseVolEnv SF2Gen = 38
+
+	// SF2GenKeynumToVolEnvHold represents key number influence on volume
+	// envelope hold.
+	SF2GenKeynumToVolEnvHold SF2Gen = 39
+
+	// SF2GenKeynumToVolEnvDecay represents key number influence on volume
+	// envelope decay.
+	SF2GenKeynumToVolEnvDecay SF2Gen = 40
+
+	// SF2GenInstrument represents index of the instrument of the preset zone.
+	// It is the last generator of the zone.
+	SF2GenInstrument SF2Gen = 41
+
+	// SF2GenReserved1 is unused or reserved.
+	SF2GenReserved1 SF2Gen = 42
+
+	// SF2GenKeyRange represents range of MIDI keys of the zone. It is the first
+	// generator of the zone.
+	SF2GenKeyRange SF2Gen = 43
+
+	// SF2GenVelRange represents range of MIDI velocities of the zone.
+	SF2GenVelRange SF2Gen = 44
+
+	// SF2GenStartloopAddrsCoarseOffset represents offset of the loop start in
+	// 32768 data points.
+	SF2GenStartloopAddrsCoarseOffset SF2Gen = 45
+
+	// SF2GenKeynum represents forced MIDI key number.
+	SF2GenKeynum SF2Gen = 46
+
+	// SF2GenVelocity represents forced MIDI velocity.
+	SF2GenVelocity SF2Gen = 47
+
+	// SF2GenInitialAttenuation represents attenuation in centibels.
+	SF2GenInitialAttenuation SF2Gen = 48
+
+	// SF2GenReserved2 is unused or reserved.
+	SF2GenReserved2 SF2Gen = 49
+
+	// SF2GenEndloopAddrsCoarseOffset represents offset of the loop end in 32768
+	// data points.
+	SF2GenEndloopAddrsCoarseOffset SF2Gen = 50
+
+	// SF2GenCoarseTune represents pitch offset in semitones.
+	SF2GenCoarseTune SF2Gen = 51
+
+	// SF2GenFineTune represents pitch offset in cents.
+	SF2GenFineTune SF2Gen = 52
+
+	// SF2GenSampleID represents index of the sample of the instrument zone. It
+	// is the last generator of the zone.
+	SF2GenSampleID SF2Gen = 53
+
+	// SF2GenSampleModes represents sample looping mode (see SF2Loop*
+	// constants).
+	SF2GenSampleModes SF2Gen = 54
+
+	// SF2GenReserved3 is unused or reserved.
+	SF2GenReserved3 SF2Gen = 55
+
+	// SF2GenScaleTuning represents degree of the MIDI key number influence on
+	// pitch in cents.
+	SF2GenScaleTuning SF2Gen = 56
+
+	// SF2GenExclusiveClass represents exclusive class of the zone. Zones of the
+	// same class stop each other.
+	SF2GenExclusiveClass SF2Gen = 57
+
+	// SF2GenOverridingRootKey represents mIDI key number overriding the sample
+	// original pitch.
+	SF2GenOverridingRootKey SF2Gen = 58
+
+	// SF2GenUnused5 is unused or reserved.
+	SF2GenUnused5 SF2Gen = 59
+
+	// SF2GenEndOper represents operator of the terminal generator record.
+	SF2GenEndOper SF2Gen = 60
+)
+
+// SoundFont sample looping modes (the [SF2GenSampleModes] amount).
+const (
+	// SF2LoopNone represents the sample played without the loop.
+	SF2LoopNone uint16 = 0
+
+	// SF2LoopContinuous represents the loop played continuously.
+	SF2LoopContinuous uint16 = 1
+
+	// SF2LoopRelease represents the loop played until the key is released
+	// and then played to the end of the sample.
+	SF2LoopRelease uint16 = 3
+)
+
+// SF2Generator represents the SoundFont generator record.
+type SF2Generator struct {
+	// Generator operator.
+	Oper SF2Gen
+
+	// Generator amount. Depending on the operator it's a range, signed
+	// or unsigned value.
+	Amount uint16
+}
+
+// Range returns the low and high value of the range amount.
+func (g SF2Generator) Range() (lo, hi uint8) {
+	return uint8(g.Amount), uint8(g.Amount >> 8)
+}
+
+// Int returns the signed amount.
+func (g SF2Generator) Int() int16 { return int16(g.Amount) }
+
+// SF2RangeAmount returns the range generator amount.
+func SF2RangeAmount(lo, hi uint8) uint16 { return uint16(lo) | uint16(hi)<<8 }
+
+// ChunkGEN represents "pgen" or "igen" chunk of the SoundFont file.
+type ChunkGEN struct {
+	// Chunk ID.
+	id uint32
+
+	// Chunk size in bytes.
+	// The ID and extra padding byte is not counted in the chunk size.
+	size uint32
+
+	// Generator records. The last one is the terminal record.
+	Gens []SF2Generator
+}
+
+// GENMake returns [IDMaker] function for creating [ChunkGEN] instances.
+func GENMake(_ bool) IDMaker {
+	return func(id uint32) Chunk {
+		return GEN(id)
+	}
+}
+
+// GEN returns a new instance of [ChunkGEN] for given ID ([IDpgen] or
+// [IDigen]).
+func GEN(id uint32) *ChunkGEN {
+	return &ChunkGEN{id: id}
+}
+
+func (ch *ChunkGEN) ID() uint32     { return ch.id }
+func (ch *ChunkGEN) Size() uint32   { return uint32(len(ch.Gens)) * SF2GeneratorSize }
+func (ch *ChunkGEN) Type() uint32   { return 0 }
+func (ch *ChunkGEN) Multi() bool    { return false }
+func (ch *ChunkGEN) Chunks() Chunks { return nil }
+func (ch *ChunkGEN) Raw() bool      { return false }
+
+func (ch *ChunkGEN) ReadFrom(r io.Reader) (int64, error) {
+	var sum int64
+	var err error
+	if ch.size, err = ReadChunkSize(r); err != nil {
+		return sum, fmt.Errorf(errFmtDecode, Uint32(ch.id), err)
+	}
+	sum += 4
+
+	if ch.size%SF2GeneratorSize != 0 {
+		return sum, fmt.Errorf(errFmtDecode, Uint32(ch.id), ErrChunkSizeMismatch)
+	}
+
+	recs := make([]SF2Generator, ch.size/SF2GeneratorSize)
+	if err = binary.Read(r, ByteOrder(r), recs); err != nil {
+		return sum, fmt.Errorf(errFmtDecode, Uint32(ch.id), err)
+	}
+	ch.Gens = append(ch.Gens[:0], recs...)
+	sum += int64(ch.size)
+
+	return sum, nil
+}
+
+func (ch *ChunkGEN) WriteTo(w io.Writer) (int64, error) {
+	var sum int64
+
+	ch.size = ch.Size()
+
+	n, err := WriteIDAndSize(w, ch.id, ch.size)
+	sum += n
+	if err != nil {
+		return sum, fmt.Errorf(errFmtEncode, Uint32(ch.id), err)
+	}
+
+	if err = binary.Write(w, ByteOrder(w), ch.Gens); err != nil {
+		return sum, fmt.Errorf(errFmtEncode, Uint32(ch.id), err)
+	}
+	sum += int64(ch.size)
+
+	return sum, nil
+}
+
+func (ch *ChunkGEN) Reset() {
+	ch.size = 0
+	ch.Gens = ch.Gens[:0]
+}
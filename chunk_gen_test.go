@@ -0,0 +1,169 @@
+package riff
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/ctx42/testing/pkg/assert"
+	"github.com/ctx42/testing/pkg/kit/iokit"
+	"github.com/ctx42/testing/pkg/must"
+
+	"github.com/rzajac/riff/internal/test"
+)
+
+func genChunk(t *testing.T, id uint32) io.Reader {
+	src := &bytes.Buffer{}
+	test.ReadFrom(t, src, Uint32(id))  // ( 0) 4 - Chunk ID
+	test.WriteUint32LE(t, src, 8)      // ( 4) 4 - Chunk size
+	test.WriteUint16LE(t, src, 43)     // ( 8) 2 - Oper
+	test.WriteUint16LE(t, src, 0x7f24) // (10) 2 - Amount
+	test.WriteUint16LE(t, src, 17)     // (12) 2 - Oper
+	test.WriteUint16LE(t, src, 0xff9c) // (14) 2 - Amount
+	// Total length: 8+8=16
+	return src
+}
+
+func Test_SF2Generator_Range(t *testing.T) {
+	// --- Given ---
+	g := SF2Generator{Oper: SF2GenKeyRange, Amount: SF2RangeAmount(36, 127)}
+
+	// --- When ---
+	lo, hi := g.Range()
+
+	// --- Then ---
+	assert.Equal(t, uint16(0x7f24), g.Amount)
+	assert.Equal(t, uint8(36), lo)
+	assert.Equal(t, uint8(127), hi)
+}
+
+func Test_SF2Generator_Int(t *testing.T) {
+	// --- Given ---
+	g := SF2Generator{Oper: SF2GenPan, Amount: 0xff9c}
+
+	// --- When ---
+	have := g.Int()
+
+	// --- Then ---
+	assert.Equal(t, int16(-100), have)
+}
+
+func Test_ChunkGEN_GEN(t *testing.T) {
+	// --- When ---
+	ch := GEN(IDigen)
+
+	// --- Then ---
+	assert.Equal(t, IDigen, ch.ID())
+	assert.Equal(t, uint32(0), ch.Size())
+	assert.Equal(t, uint32(0), ch.Type())
+	assert.False(t, ch.Multi())
+	assert.Nil(t, ch.Chunks())
+	assert.False(t, ch.Raw())
+	assert.Len(t, 0, ch.Gens)
+}
+
+func Test_ChunkGEN_GENMake(t *testing.T) {
+	// --- When ---
+	ch := GENMake(LoadData)(IDpgen)
+
+	// --- Then ---
+	assert.Equal(t, IDpgen, ch.ID())
+}
+
+func Test_ChunkGEN_ReadFrom(t *testing.T) {
+	// --- Given ---
+	src := genChunk(t, IDpgen)
+	test.Skip4B(t, src) // Skip chunk ID.
+
+	// --- When ---
+	ch := GEN(IDpgen)
+	n, err := ch.ReadFrom(src)
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, int64(12), n)
+	assert.Equal(t, uint32(8), ch.Size())
+	exp := []SF2Generator{
+		{Oper: SF2GenKeyRange, Amount: 0x7f24},
+		{Oper: SF2GenPan, Amount: 0xff9c},
+	}
+	assert.Equal(t, exp, ch.Gens)
+	assert.True(t, test.IsAllRead(src))
+}
+
+func Test_ChunkGEN_ReadFrom_SizeMismatch(t *testing.T) {
+	// --- Given ---
+	src := &bytes.Buffer{}
+	test.WriteUint32LE(t, src, 6)
+
+	// --- When ---
+	_, err := GEN(IDpgen).ReadFrom(src)
+
+	// --- Then ---
+	assert.ErrorIs(t, ErrChunkSizeMismatch, err)
+}
+
+func Test_ChunkGEN_ReadFrom_Errors(t *testing.T) {
+	// Reading less than 12 bytes should always result in an error.
+	for _, i := range []int{1, 4, 5, 11} {
+		// --- Given ---
+		src := genChunk(t, IDigen)
+		test.Skip4B(t, src) // Skip chunk ID.
+
+		// --- When ---
+		_, err := GEN(IDigen).ReadFrom(io.LimitReader(src, int64(i)))
+
+		// --- Then ---
+		assert.Error(t, err)
+	}
+}
+
+func Test_ChunkGEN_WriteTo(t *testing.T) {
+	// --- Given ---
+	ch := GEN(IDigen)
+	ch.Gens = []SF2Generator{
+		{Oper: SF2GenKeyRange, Amount: 0x7f24},
+		{Oper: SF2GenPan, Amount: 0xff9c},
+	}
+
+	// --- When ---
+	dst := &bytes.Buffer{}
+	n, err := ch.WriteTo(dst)
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, int64(16), n)
+	exp := must.Value(io.ReadAll(genChunk(t, IDigen)))
+	assert.Equal(t, exp, dst.Bytes())
+}
+
+func Test_ChunkGEN_WriteTo_Errors(t *testing.T) {
+	for _, i := range []int{1, 4, 8, 10} {
+		// --- Given ---
+		ch := GEN(IDigen)
+		ch.Gens = []SF2Generator{{}, {}}
+
+		// --- When ---
+		_, err := ch.WriteTo(iokit.ErrWriter(&bytes.Buffer{}, i))
+
+		// --- Then ---
+		assert.Error(t, err)
+	}
+}
+
+func Test_ChunkGEN_Reset(t *testing.T) {
+	// --- Given ---
+	src := genChunk(t, IDpgen)
+	test.Skip4B(t, src) // Skip chunk ID.
+	ch := GEN(IDpgen)
+	_, err := ch.ReadFrom(src)
+	assert.NoError(t, err)
+
+	// --- When ---
+	ch.Reset()
+
+	// --- Then ---
+	assert.Equal(t, IDpgen, ch.ID())
+	assert.Equal(t, uint32(0), ch.Size())
+	assert.Len(t, 0, ch.Gens)
+}
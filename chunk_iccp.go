@@ -0,0 +1,82 @@
+package riff
+
+import (
+	"fmt"
+	"io"
+)
+
+// IDICCP represents "ICCP" (ICC color profile) chunk ID.
+const IDICCP uint32 = 0x49434350
+
+// ChunkICCP represents "ICCP" chunk of the WebP image holding the ICC
+// color profile.
+type ChunkICCP struct {
+	// Chunk size in bytes.
+	// The ID and extra padding byte is not counted in the chunk size.
+	size uint32
+
+	// ICC profile bytes.
+	profile []byte
+}
+
+// ICCPMake is a [Maker] function for creating [ChunkICCP] instances.
+func ICCPMake() Chunk { return ICCP() }
+
+// ICCP returns a new instance of [ChunkICCP].
+func ICCP() *ChunkICCP {
+	return &ChunkICCP{}
+}
+
+func (ch *ChunkICCP) ID() uint32     { return IDICCP }
+func (ch *ChunkICCP) Size() uint32   { return ch.size }
+func (ch *ChunkICCP) Type() uint32   { return 0 }
+func (ch *ChunkICCP) Multi() bool    { return false }
+func (ch *ChunkICCP) Chunks() Chunks { return nil }
+func (ch *ChunkICCP) Raw() bool      { return false }
+
+// Profile returns the ICC profile bytes.
+func (ch *ChunkICCP) Profile() []byte { return ch.profile }
+
+// SetProfile sets the ICC profile bytes.
+func (ch *ChunkICCP) SetProfile(profile []byte) {
+	ch.profile = grow(ch.profile, len(profile))
+	copy(ch.profile, profile)
+	ch.size = uint32(len(profile))
+}
+
+func (ch *ChunkICCP) ReadFrom(r io.Reader) (int64, error) {
+	var sum int64
+	var err error
+	if ch.size, err = ReadChunkSize(r); err != nil {
+		return sum, fmt.Errorf(errFmtDecode, Uint32(IDICCP), err)
+	}
+	sum += 4
+
+	ch.profile = grow(ch.profile, int(ch.size))
+	in, err := io.ReadFull(r, ch.profile)
+	sum += int64(in)
+	if err != nil {
+		return sum, fmt.Errorf(errFmtDecode, Uint32(IDICCP), err)
+	}
+
+	n, err := ReadPaddingIf(r, ch.size)
+	sum += n
+	if err != nil {
+		return sum, fmt.Errorf(errFmtDecode, Uint32(IDICCP), err)
+	}
+
+	return sum, nil
+}
+
+func (ch *ChunkICCP) WriteTo(w io.Writer) (int64, error) {
+	n, err := writeBitstream(w, IDICCP, ch.profile)
+	if err != nil {
+		return n, fmt.Errorf(errFmtEncode, Uint32(IDICCP), err)
+	}
+	return n, nil
+}
+
+func (ch *ChunkICCP) Reset() {
+	ch.size = 0
+	ch.profile = ch.profile[:0]
+}
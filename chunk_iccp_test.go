@@ -0,0 +1,111 @@
+package riff
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/ctx42/testing/pkg/assert"
+	"github.com/ctx42/testing/pkg/kit/iokit"
+	"github.com/ctx42/testing/pkg/must"
+
+	"github.com/rzajac/riff/internal/test"
+)
+
+func iccpChunk(t *testing.T) io.Reader {
+	src := &bytes.Buffer{}
+	test.ReadFrom(t, src, Uint32(IDICCP))    // ( 0) 4 - Chunk ID
+	test.WriteUint32LE(t, src, 5)            // ( 4) 4 - Chunk size
+	test.WriteBytes(t, src, []byte("prof1")) // ( 8) 5 - Profile
+	test.WriteByte(t, src, 0)                // (13) 1 - Padding byte
+	// Total length: 8+6=14
+	return src
+}
+
+func Test_ChunkICCP_ICCP(t *testing.T) {
+	// --- When ---
+	ch := ICCP()
+
+	// --- Then ---
+	assert.Equal(t, IDICCP, ch.ID())
+	assert.Equal(t, uint32(0), ch.Size())
+	assert.Equal(t, uint32(0), ch.Type())
+	assert.False(t, ch.Multi())
+	assert.Nil(t, ch.Chunks())
+	assert.False(t, ch.Raw())
+}
+
+func Test_ChunkICCP_ReadFrom(t *testing.T) {
+	// --- Given ---
+	src := iccpChunk(t)
+	test.Skip4B(t, src) // Skip chunk ID.
+
+	// --- When ---
+	ch := ICCP()
+	n, err := ch.ReadFrom(src)
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, int64(10), n)
+	assert.Equal(t, uint32(5), ch.Size())
+	assert.Equal(t, []byte("prof1"), ch.Profile())
+	assert.True(t, test.IsAllRead(src))
+}
+
+func Test_ChunkICCP_ReadFrom_Errors(t *testing.T) {
+	// Reading less than 10 bytes should always result in an error.
+	for _, i := range []int{1, 4, 5, 9} {
+		// --- Given ---
+		src := iccpChunk(t)
+		test.Skip4B(t, src) // Skip chunk ID.
+
+		// --- When ---
+		_, err := ICCP().ReadFrom(io.LimitReader(src, int64(i)))
+
+		// --- Then ---
+		assert.Error(t, err)
+	}
+}
+
+func Test_ChunkICCP_SetProfile(t *testing.T) {
+	// --- Given ---
+	ch := ICCP()
+
+	// --- When ---
+	ch.SetProfile([]byte("prof1"))
+
+	// --- Then ---
+	assert.Equal(t, uint32(5), ch.Size())
+	dst := &bytes.Buffer{}
+	n, err := ch.WriteTo(dst)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(14), n)
+	assert.Equal(t, must.Value(io.ReadAll(iccpChunk(t))), dst.Bytes())
+}
+
+func Test_ChunkICCP_WriteTo_Errors(t *testing.T) {
+	for _, i := range []int{1, 4, 8, 13} {
+		// --- Given ---
+		ch := ICCP()
+		ch.SetProfile([]byte("prof1"))
+
+		// --- When ---
+		_, err := ch.WriteTo(iokit.ErrWriter(&bytes.Buffer{}, i))
+
+		// --- Then ---
+		assert.Error(t, err)
+	}
+}
+
+func Test_ChunkICCP_Reset(t *testing.T) {
+	// --- Given ---
+	ch := ICCP()
+	ch.SetProfile([]byte("prof1"))
+
+	// --- When ---
+	ch.Reset()
+
+	// --- Then ---
+	assert.Equal(t, uint32(0), ch.Size())
+	assert.Len(t, 0, ch.Profile())
+}
@@ -0,0 +1,103 @@
+package riff
+
+import (
+	"fmt"
+	"io"
+)
+
+// IDfram represents "fram" (animated cursor frames) type of the LIST chunk.
+const IDfram uint32 = 0x6672616d
+
+// IDicon represents "icon" sub-chunk ID of the "LIST fram" chunk.
+const IDicon uint32 = 0x69636f6e
+
+// ChunkICON represents "icon" chunk of the animated cursor file. It holds
+// a single frame in the ICO or CUR file format.
+type ChunkICON struct {
+	// Chunk size in bytes.
+	// The ID and extra padding byte is not counted in the chunk size.
+	size uint32
+
+	// The icon file bytes. It's nil in SkipData mode.
+	data []byte
+}
+
+// ICONMake returns [Maker] function for [ChunkICON] instances.
+func ICONMake(load bool) Maker {
+	return func() Chunk {
+		return ICON(load)
+	}
+}
+
+// ICON returns a new instance of [ChunkICON]. If load is false the icon
+// bytes will not be loaded into memory.
+func ICON(load bool) *ChunkICON {
+	ch := &ChunkICON{}
+	if load {
+		ch.data = make([]byte, 0, 1<<10)
+	}
+	return ch
+}
+
+func (ch *ChunkICON) ID() uint32     { return IDicon }
+func (ch *ChunkICON) Size() uint32   { return ch.size }
+func (ch *ChunkICON) Type() uint32   { return 0 }
+func (ch *ChunkICON) Multi() bool    { return true }
+func (ch *ChunkICON) Chunks() Chunks { return nil }
+func (ch *ChunkICON) Raw() bool      { return false }
+
+// Data returns the icon file bytes. It returns nil in SkipData mode.
+func (ch *ChunkICON) Data() []byte { return ch.data }
+
+// SetData sets the icon file bytes. It will return [ErrSkipDataMode] if in
+// SkipData mode or [ErrACONInvalid] if data is not an ICO or CUR file.
+func (ch *ChunkICON) SetData(data []byte) error {
+	if ch.data == nil {
+		return ErrSkipDataMode
+	}
+	// ICONDIR header: reserved, type (1 - icon, 2 - cursor) and count.
+	if len(data) < 6 || le.Uint16(data) != 0 || le.Uint16(data[4:]) == 0 {
+		return fmt.Errorf("not an icon file: %w", ErrACONInvalid)
+	}
+	if typ := le.Uint16(data[2:]); typ != 1 && typ != 2 {
+		return fmt.Errorf("not an icon file: %w", ErrACONInvalid)
+	}
+	ch.data = grow(ch.data, len(data))
+	copy(ch.data, data)
+	ch.size = uint32(len(data))
+	return nil
+}
+
+func (ch *ChunkICON) ReadFrom(r io.Reader) (int64, error) {
+	var sum int64
+	var err error
+	if ch.size, err = ReadChunkSize(r); err != nil {
+		return sum, fmt.Errorf(errFmtDecode, linkids(IDfram, IDicon), err)
+	}
+	sum += 4
+
+	var n int64
+	ch.data, n, err = readBitstream(r, ch.size, nil, ch.data)
+	sum += n
+	if err != nil {
+		return sum, fmt.Errorf(errFmtDecode, linkids(IDfram, IDicon), err)
+	}
+
+	return sum, nil
+}
+
+func (ch *ChunkICON) WriteTo(w io.Writer) (int64, error) {
+	if ch.data == nil {
+		return 0, ErrSkipDataMode
+	}
+	n, err := writeBitstream(w, IDicon, ch.data)
+	if err != nil {
+		return n, fmt.Errorf(errFmtEncode, linkids(IDfram, IDicon), err)
+	}
+	return n, nil
+}
+
+func (ch *ChunkICON) Reset() {
+	ch.size = 0
+	ch.data = ch.data[:0]
+}
@@ -0,0 +1,177 @@
+package riff
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/ctx42/testing/pkg/assert"
+	"github.com/ctx42/testing/pkg/kit/iokit"
+	"github.com/ctx42/testing/pkg/must"
+
+	"github.com/rzajac/riff/internal/test"
+)
+
+// icoFile returns a minimal cursor file with the given byte as the image.
+func icoFile(img byte) []byte {
+	// ICONDIR: reserved, type (2 - cursor), count and a single image byte.
+	return []byte{0, 0, 2, 0, 1, 0, img}
+}
+
+func iconChunk(t *testing.T) io.Reader {
+	src := &bytes.Buffer{}
+	test.ReadFrom(t, src, Uint32(IDicon))  // ( 0) 4 - Chunk ID
+	test.WriteUint32LE(t, src, 7)          // ( 4) 4 - Chunk size
+	test.WriteBytes(t, src, icoFile(0xaa)) // ( 8) 7 - Icon file
+	test.WriteByte(t, src, 0)              // (15) 1 - Padding byte
+	// Total length: 8+8=16
+	return src
+}
+
+func Test_ChunkICON_ICON(t *testing.T) {
+	// --- When ---
+	ch := ICON(LoadData)
+
+	// --- Then ---
+	assert.Equal(t, IDicon, ch.ID())
+	assert.Equal(t, uint32(0), ch.Size())
+	assert.Equal(t, uint32(0), ch.Type())
+	assert.True(t, ch.Multi())
+	assert.Nil(t, ch.Chunks())
+	assert.False(t, ch.Raw())
+	assert.Nil(t, ICON(SkipData).Data())
+}
+
+func Test_ChunkICON_ReadFrom(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		load bool
+		exp  []byte
+	}{
+		{"load", LoadData, icoFile(0xaa)},
+		{"skip", SkipData, nil},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- Given ---
+			src := iconChunk(t)
+			test.Skip4B(t, src) // Skip chunk ID.
+
+			// --- When ---
+			ch := ICON(tc.load)
+			n, err := ch.ReadFrom(src)
+
+			// --- Then ---
+			assert.NoError(t, err)
+			assert.Equal(t, int64(12), n)
+			assert.Equal(t, uint32(7), ch.Size())
+			assert.Equal(t, tc.exp, ch.Data())
+			assert.True(t, test.IsAllRead(src))
+		})
+	}
+}
+
+func Test_ChunkICON_ReadFrom_Errors(t *testing.T) {
+	// Reading less than 12 bytes should always result in an error.
+	for _, i := range []int{1, 4, 5, 8, 11} {
+		// --- Given ---
+		src := iconChunk(t)
+		test.Skip4B(t, src) // Skip chunk ID.
+
+		// --- When ---
+		_, err := ICON(LoadData).ReadFrom(io.LimitReader(src, int64(i)))
+
+		// --- Then ---
+		assert.Error(t, err)
+	}
+}
+
+func Test_ChunkICON_SetData(t *testing.T) {
+	// --- Given ---
+	ch := ICON(LoadData)
+
+	// --- When ---
+	err := ch.SetData(icoFile(0xbb))
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(7), ch.Size())
+	assert.Equal(t, icoFile(0xbb), ch.Data())
+}
+
+func Test_ChunkICON_SetData_Errors(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		data []byte
+	}{
+		{"too short", []byte{0, 0, 1, 0}},
+		{"reserved", []byte{1, 0, 1, 0, 1, 0}},
+		{"type", []byte{0, 0, 3, 0, 1, 0}},
+		{"no images", []byte{0, 0, 1, 0, 0, 0}},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			err := ICON(LoadData).SetData(tc.data)
+
+			// --- Then ---
+			assert.ErrorIs(t, ErrACONInvalid, err)
+		})
+	}
+
+	assert.ErrorIs(t, ErrSkipDataMode, ICON(SkipData).SetData(icoFile(0)))
+}
+
+func Test_ChunkICON_WriteTo(t *testing.T) {
+	// --- Given ---
+	src := iconChunk(t)
+	test.Skip4B(t, src) // Skip chunk ID.
+
+	ch := ICON(LoadData)
+	_, err := ch.ReadFrom(src)
+	assert.NoError(t, err)
+
+	// --- When ---
+	dst := &bytes.Buffer{}
+	n, err := ch.WriteTo(dst)
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, int64(16), n)
+	exp := must.Value(io.ReadAll(iconChunk(t)))
+	assert.Equal(t, exp, dst.Bytes())
+}
+
+func Test_ChunkICON_WriteTo_Errors(t *testing.T) {
+	for _, i := range []int{1, 4, 8, 15} {
+		// --- Given ---
+		ch := ICON(LoadData)
+		assert.NoError(t, ch.SetData(icoFile(0xaa)))
+
+		// --- When ---
+		_, err := ch.WriteTo(iokit.ErrWriter(&bytes.Buffer{}, i))
+
+		// --- Then ---
+		assert.Error(t, err)
+	}
+
+	_, err := ICON(SkipData).WriteTo(&bytes.Buffer{})
+	assert.ErrorIs(t, ErrSkipDataMode, err)
+}
+
+func Test_ChunkICON_Reset(t *testing.T) {
+	// --- Given ---
+	ch := ICON(LoadData)
+	assert.NoError(t, ch.SetData(icoFile(0xaa)))
+
+	// --- When ---
+	ch.Reset()
+
+	// --- Then ---
+	assert.Equal(t, uint32(0), ch.Size())
+	assert.Len(t, 0, ch.Data())
+}
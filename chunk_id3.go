@@ -0,0 +1,134 @@
+package riff
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+)
+
+// ChunkID3 represents "ID3 " or "id3 " chunk holding the ID3v2.3 or ID3v2.4
+// tag. The tag is re-encoded only when it was edited after decoding.
+type ChunkID3 struct {
+	// Chunk ID (IDID3 or IDid3).
+	id uint32
+
+	// Chunk size in bytes.
+	// The ID and extra padding byte is not counted in the chunk size.
+	size uint32
+
+	// Chunk body as read from the file.
+	raw []byte
+
+	// Copy of the tag right after decoding. Used to detect tag edits.
+	orig *ID3Tag
+
+	// Decoded tag. It's nil when the chunk data is not a supported ID3v2
+	// tag (e.g. ID3v2.2), the data is then written back as read.
+	Tag *ID3Tag
+}
+
+// ID3Make returns [Maker] function for creating [ChunkID3] instances for
+// given ID.
+func ID3Make(id uint32) Maker {
+	return func() Chunk { return ID3(id) }
+}
+
+// ID3 returns a new instance of [ChunkID3] for given ID with an empty
+// ID3v2.4 tag.
+func ID3(id uint32) *ChunkID3 {
+	return &ChunkID3{id: id, Tag: NewID3Tag(4)}
+}
+
+func (ch *ChunkID3) ID() uint32     { return ch.id }
+func (ch *ChunkID3) Type() uint32   { return 0 }
+func (ch *ChunkID3) Multi() bool    { return false }
+func (ch *ChunkID3) Chunks() Chunks { return nil }
+func (ch *ChunkID3) Raw() bool      { return false }
+
+func (ch *ChunkID3) Size() uint32 {
+	if !ch.edited() {
+		return uint32(len(ch.raw))
+	}
+	return uint32(ch.Tag.size())
+}
+
+// edited returns true if the tag must be encoded when writing.
+func (ch *ChunkID3) edited() bool {
+	if ch.Tag == nil {
+		return false
+	}
+	return ch.orig == nil || !ch.Tag.equal(ch.orig)
+}
+
+// body returns the chunk body to write.
+func (ch *ChunkID3) body() ([]byte, error) {
+	if !ch.edited() {
+		return ch.raw, nil
+	}
+	return ch.Tag.Bytes()
+}
+
+func (ch *ChunkID3) ReadFrom(r io.Reader) (int64, error) {
+	var sum int64
+	if err := binary.Read(r, ByteOrder(r), &ch.size); err != nil {
+		return sum, fmt.Errorf(errFmtDecode, Uint32(ch.id), err)
+	}
+	sum += 4
+
+	ch.raw = grow(ch.raw, int(ch.size))
+	in, err := io.ReadFull(r, ch.raw)
+	sum += int64(in)
+	if err != nil {
+		return sum, fmt.Errorf(errFmtDecode, Uint32(ch.id), err)
+	}
+
+	ch.Tag, ch.orig = nil, nil
+	if tag, err := ParseID3(ch.raw); err == nil {
+		ch.Tag, ch.orig = tag, tag.clone()
+	}
+
+	n, err := ReadPaddingIf(r, ch.size)
+	sum += n
+	if err != nil {
+		return sum, fmt.Errorf(errFmtDecode, Uint32(ch.id), err)
+	}
+
+	return sum, nil
+}
+
+func (ch *ChunkID3) WriteTo(w io.Writer) (int64, error) {
+	var sum int64
+
+	body, err := ch.body()
+	if err != nil {
+		return sum, fmt.Errorf(errFmtEncode, Uint32(ch.id), err)
+	}
+	ch.size = uint32(len(body))
+
+	n, err := WriteIDAndSize(w, ch.id, ch.size)
+	sum += n
+	if err != nil {
+		return sum, fmt.Errorf(errFmtEncode, Uint32(ch.id), err)
+	}
+
+	in, err := w.Write(body)
+	sum += int64(in)
+	if err != nil {
+		return sum, fmt.Errorf(errFmtEncode, Uint32(ch.id), err)
+	}
+
+	n, err = WritePaddingIf(w, ch.size)
+	sum += n
+	if err != nil {
+		return sum, fmt.Errorf(errFmtEncode, Uint32(ch.id), err)
+	}
+
+	return sum, nil
+}
+
+func (ch *ChunkID3) Reset() {
+	ch.size = 0
+	ch.raw = ch.raw[:0]
+	ch.orig = nil
+	ch.Tag = NewID3Tag(4)
+}
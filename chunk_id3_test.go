@@ -0,0 +1,239 @@
+package riff
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"testing"
+
+	"github.com/ctx42/testing/pkg/assert"
+	"github.com/ctx42/testing/pkg/kit/iokit"
+	"github.com/ctx42/testing/pkg/must"
+
+	"github.com/rzajac/riff/internal/test"
+)
+
+func id3Chunk(t *testing.T) io.Reader {
+	src := &bytes.Buffer{}
+	test.ReadFrom(t, src, Uint32(IDid3))             // ( 0) 4 - Chunk ID
+	test.WriteUint32LE(t, src, 48)                   // ( 4) 4 - Chunk size
+	test.WriteBytes(t, src, []byte("ID3"))           // ( 8) 3 - Tag ID
+	test.WriteBytes(t, src, []byte{4, 0})            // (11) 2 - Version
+	test.WriteByte(t, src, 0)                        // (13) 1 - Flags
+	test.WriteBytes(t, src, []byte{0, 0, 0, 38})     // (14) 4 - Tag size
+	test.WriteUint32BE(t, src, ID3TIT2)              // (18) 4 - Frame ID
+	test.WriteBytes(t, src, []byte{0, 0, 0, 6})      // (22) 4 - Frame size
+	test.WriteBytes(t, src, []byte{0, 0})            // (26) 2 - Frame flags
+	test.WriteBytes(t, src, []byte("\x00Title"))     // (28) 6 - Frame data
+	test.WriteUint32BE(t, src, ID3TPE1)              // (34) 4 - Frame ID
+	test.WriteBytes(t, src, []byte{0, 0, 0, 8})      // (38) 4 - Frame size
+	test.WriteBytes(t, src, []byte{0, 3})            // (42) 2 - Frame flags
+	test.WriteBytes(t, src, []byte{0, 0, 0, 3})      // (44) 4 - Data length
+	test.WriteBytes(t, src, []byte{0, 'A', 0xff, 0}) // (48) 4 - Frame data
+	test.WriteBytes(t, src, []byte{0, 0, 0, 0})      // (52) 4 - Padding
+	// Total length: 8+48=56
+	return src
+}
+
+func Test_ChunkID3_ID3(t *testing.T) {
+	// --- When ---
+	ch := ID3(IDID3)
+
+	// --- Then ---
+	assert.Equal(t, IDID3, ch.ID())
+	assert.Equal(t, uint32(10), ch.Size())
+	assert.Equal(t, uint32(0), ch.Type())
+	assert.False(t, ch.Multi())
+	assert.Nil(t, ch.Chunks())
+	assert.False(t, ch.Raw())
+	assert.Equal(t, byte(4), ch.Tag.Version)
+}
+
+func Test_ID3Make(t *testing.T) {
+	// --- When ---
+	ch := ID3Make(IDid3)()
+
+	// --- Then ---
+	assert.Equal(t, IDid3, ch.ID())
+}
+
+func Test_ChunkID3_ReadFrom(t *testing.T) {
+	// --- Given ---
+	src := id3Chunk(t)
+	test.Skip4B(t, src) // Skip chunk ID.
+
+	// --- When ---
+	ch := ID3(IDid3)
+	n, err := ch.ReadFrom(src)
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, int64(52), n)
+	assert.Equal(t, uint32(48), ch.Size())
+	assert.Equal(t, "Title", ch.Tag.Text(ID3TIT2))
+	assert.Equal(t, "Aÿ", ch.Tag.Text(ID3TPE1))
+	assert.Equal(t, uint16(0), ch.Tag.Frame(ID3TPE1).Flags)
+	assert.Equal(t, 4, ch.Tag.Padding)
+	assert.True(t, test.IsAllRead(src))
+}
+
+func Test_ChunkID3_ReadFrom_Errors(t *testing.T) {
+	// Reading less than 52 bytes should always result in an error.
+	for _, i := range []int{1, 4, 5, 20, 51} {
+		// --- Given ---
+		src := id3Chunk(t)
+		test.Skip4B(t, src) // Skip chunk ID.
+
+		// --- When ---
+		_, err := ID3(IDid3).ReadFrom(io.LimitReader(src, int64(i)))
+
+		// --- Then ---
+		assert.Error(t, err)
+	}
+}
+
+func Test_ChunkID3_ReadFrom_InvalidTag(t *testing.T) {
+	// --- Given ---
+	src := &bytes.Buffer{}
+	test.WriteUint32LE(t, src, 4)
+	test.WriteBytes(t, src, []byte("TAG!"))
+
+	// --- When ---
+	ch := ID3(IDID3)
+	n, err := ch.ReadFrom(src)
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, int64(8), n)
+	assert.Nil(t, ch.Tag)
+	assert.Equal(t, uint32(4), ch.Size())
+
+	dst := &bytes.Buffer{}
+	must.Value(ch.WriteTo(dst))
+	assert.Equal(t, []byte("ID3 \x04\x00\x00\x00TAG!"), dst.Bytes())
+}
+
+func Test_ChunkID3_ReadFrom_testdata(t *testing.T) {
+	// --- Given ---
+	rif := New(SkipData)
+	fil := must.Value(os.Open("testdata/listinfo.wav"))
+	t.Cleanup(func() { _ = fil.Close() })
+
+	// --- When ---
+	_, err := rif.ReadFrom(fil)
+
+	// --- Then ---
+	assert.NoError(t, err)
+	ch, ok := rif.Chunks().First(IDid3).(*ChunkID3)
+	assert.True(t, ok)
+	assert.Equal(t, byte(3), ch.Tag.Version)
+	assert.NotEmpty(t, ch.Tag.Text(ID3TIT2))
+}
+
+func Test_ChunkID3_WriteTo(t *testing.T) {
+	// --- Given ---
+	src := id3Chunk(t)
+	test.Skip4B(t, src) // Skip chunk ID.
+
+	ch := ID3(IDid3)
+	_, err := ch.ReadFrom(src)
+	assert.NoError(t, err)
+
+	// --- When ---
+	dst := &bytes.Buffer{}
+	n, err := ch.WriteTo(dst)
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, int64(56), n)
+	exp := must.Value(io.ReadAll(id3Chunk(t)))
+	assert.Equal(t, exp, dst.Bytes())
+}
+
+func Test_ChunkID3_WriteTo_Edited(t *testing.T) {
+	// --- Given ---
+	src := id3Chunk(t)
+	test.Skip4B(t, src) // Skip chunk ID.
+
+	ch := ID3(IDid3)
+	_, err := ch.ReadFrom(src)
+	assert.NoError(t, err)
+
+	ch.Tag.SetText(ID3TIT2, "New")
+
+	// --- When ---
+	dst := &bytes.Buffer{}
+	n, err := ch.WriteTo(dst)
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, int64(50), n)
+	assert.Equal(t, uint32(41), ch.Size())
+
+	got := ID3(IDid3)
+	_, err = got.ReadFrom(bytes.NewReader(dst.Bytes()[4:]))
+	assert.NoError(t, err)
+	assert.Equal(t, "New", got.Tag.Text(ID3TIT2))
+	assert.Equal(t, "Aÿ", got.Tag.Text(ID3TPE1))
+	assert.Equal(t, 4, got.Tag.Padding)
+}
+
+func Test_ChunkID3_WriteTo_EditedInPlace(t *testing.T) {
+	// --- Given ---
+	src := id3Chunk(t)
+	test.Skip4B(t, src) // Skip chunk ID.
+
+	ch := ID3(IDid3)
+	_, err := ch.ReadFrom(src)
+	assert.NoError(t, err)
+
+	ch.Tag.Frame(ID3TIT2).Data[1] = 'X'
+
+	// --- When ---
+	dst := &bytes.Buffer{}
+	n, err := ch.WriteTo(dst)
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, int64(52), n)
+	assert.Equal(t, uint32(43), ch.Size())
+
+	got := ID3(IDid3)
+	_, err = got.ReadFrom(bytes.NewReader(dst.Bytes()[4:]))
+	assert.NoError(t, err)
+	assert.Equal(t, "Xitle", got.Tag.Text(ID3TIT2))
+}
+
+func Test_ChunkID3_WriteTo_Errors(t *testing.T) {
+	for _, i := range []int{1, 4, 8, 55} {
+		// --- Given ---
+		src := id3Chunk(t)
+		test.Skip4B(t, src) // Skip chunk ID.
+
+		ch := ID3(IDid3)
+		_, err := ch.ReadFrom(src)
+		assert.NoError(t, err)
+
+		// --- When ---
+		_, err = ch.WriteTo(iokit.ErrWriter(&bytes.Buffer{}, i))
+
+		// --- Then ---
+		assert.Error(t, err)
+	}
+}
+
+func Test_ChunkID3_Reset(t *testing.T) {
+	// --- Given ---
+	src := id3Chunk(t)
+	test.Skip4B(t, src) // Skip chunk ID.
+	ch := ID3(IDid3)
+	_, err := ch.ReadFrom(src)
+	assert.NoError(t, err)
+
+	// --- When ---
+	ch.Reset()
+
+	// --- Then ---
+	assert.Equal(t, uint32(10), ch.Size())
+	assert.Len(t, 0, ch.Tag.Frames)
+}
@@ -0,0 +1,207 @@
+package riff
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+)
+
+// IDidx1 represents "idx1" (AVI index) chunk ID.
+const IDidx1 uint32 = 0x69647831
+
+// AVIIndexEntrySize represents the size of the "idx1" entry in bytes.
+const AVIIndexEntrySize uint32 = 16
+
+// AVI index entry flags.
+const (
+	// AVIIFList indicates the entry points to the LIST chunk.
+	AVIIFList uint32 = 0x00000001
+
+	// AVIIFKeyframe indicates the data chunk is a key frame.
+	AVIIFKeyframe uint32 = 0x00000010
+
+	// AVIIFNoTime indicates the data chunk doesn't affect the timing of
+	// the stream (e.g. palette change).
+	AVIIFNoTime uint32 = 0x00000100
+)
+
+// AVIIndexEntry represents an entry of the "idx1" chunk.
+type AVIIndexEntry struct {
+	// ID of the data chunk (e.g. "00dc" or "01wb").
+	ChunkID uint32
+
+	// Bitwise combination of AVIIF* flags.
+	Flags uint32
+
+	// Offset of the data chunk header. Usually relative to the "movi"
+	// list type, but some writers use offsets relative to the beginning
+	// of the file.
+	Offset uint32
+
+	// Size of the data chunk in bytes.
+	Size uint32
+}
+
+// Stream returns the stream number encoded in the first two characters of
+// the chunk ID. Returns -1 if the chunk ID doesn't reference a stream
+// (e.g. "rec ").
+func (e AVIIndexEntry) Stream() int {
+	return aviStream(e.ChunkID)
+}
+
+// IsKeyframe returns true if the entry is marked as key frame.
+func (e AVIIndexEntry) IsKeyframe() bool {
+	return e.Flags&AVIIFKeyframe != 0
+}
+
+// ChunkIDX1 represents "idx1" chunk of the AVI file.
+type ChunkIDX1 struct {
+	// Chunk size in bytes.
+	// The ID and extra padding byte is not counted in the chunk size.
+	size uint32
+
+	// Index entries in the order they appear in the file.
+	Entries []AVIIndexEntry
+}
+
+// IDX1Make is a [Maker] function for creating [ChunkIDX1] instances.
+func IDX1Make() Chunk { return IDX1() }
+
+// IDX1 returns a new instance of [ChunkIDX1].
+func IDX1() *ChunkIDX1 {
+	return &ChunkIDX1{}
+}
+
+func (ch *ChunkIDX1) ID() uint32 { return IDidx1 }
+func (ch *ChunkIDX1) Size() uint32 {
+	return uint32(len(ch.Entries)) * AVIIndexEntrySize
+}
+func (ch *ChunkIDX1) Type() uint32   { return 0 }
+func (ch *ChunkIDX1) Multi() bool    { return false }
+func (ch *ChunkIDX1) Chunks() Chunks { return nil }
+func (ch *ChunkIDX1) Raw() bool      { return false }
+
+func (ch *ChunkIDX1) ReadFrom(r io.Reader) (int64, error) {
+	bo := ByteOrder(r)
+	var sum int64
+	if err := binary.Read(r, bo, &ch.size); err != nil {
+		return sum, fmt.Errorf(errFmtDecode, Uint32(IDidx1), err)
+	}
+	sum += 4
+
+	if ch.size%AVIIndexEntrySize != 0 {
+		return sum, fmt.Errorf(errFmtDecode, Uint32(IDidx1), ErrChunkSizeMismatch)
+	}
+
+	buf := make([]byte, AVIIndexEntrySize)
+	for i := uint32(0); i < ch.size/AVIIndexEntrySize; i++ {
+		in, err := io.ReadFull(r, buf)
+		sum += int64(in)
+		if err != nil {
+			return sum, fmt.Errorf(errFmtDecode, Uint32(IDidx1), err)
+		}
+		ch.Entries = append(ch.Entries, AVIIndexEntry{
+			ChunkID: be.Uint32(buf),
+			Flags:   bo.Uint32(buf[4:]),
+			Offset:  bo.Uint32(buf[8:]),
+			Size:    bo.Uint32(buf[12:]),
+		})
+	}
+
+	return sum, nil
+}
+
+func (ch *ChunkIDX1) WriteTo(w io.Writer) (int64, error) {
+	bo := ByteOrder(w)
+	var sum int64
+
+	ch.size = ch.Size()
+
+	n, err := WriteIDAndSize(w, IDidx1, ch.size)
+	sum += n
+	if err != nil {
+		return sum, fmt.Errorf(errFmtEncode, Uint32(IDidx1), err)
+	}
+
+	buf := make([]byte, 0, AVIIndexEntrySize)
+	for _, e := range ch.Entries {
+		buf = be.AppendUint32(buf[:0], e.ChunkID)
+		buf = bo.AppendUint32(buf, e.Flags)
+		buf = bo.AppendUint32(buf, e.Offset)
+		buf = bo.AppendUint32(buf, e.Size)
+		in, err := w.Write(buf)
+		sum += int64(in)
+		if err != nil {
+			return sum, fmt.Errorf(errFmtEncode, Uint32(IDidx1), err)
+		}
+	}
+
+	return sum, nil
+}
+
+func (ch *ChunkIDX1) Reset() {
+	ch.size = 0
+	ch.Entries = ch.Entries[:0]
+}
+
+// IndexMovi builds "idx1" chunk by scanning the data chunks of the
+// "LIST movi" chunk. The offsets are relative to the "movi" list type.
+// Since the key frame information is not available in the data chunks
+// only the uncompressed video frames ("db") and audio data ("wb") are
+// marked as key frames.
+func IndexMovi(movi Chunk) (*ChunkIDX1, error) {
+	if movi.ID() != IDLIST || movi.Type() != IDmovi {
+		return nil, fmt.Errorf("expected %s list got %s", Uint32(IDmovi), Uint32(movi.Type()))
+	}
+	idx := IDX1()
+	indexMovi(idx, movi.Chunks(), ListTypeSize)
+	return idx, nil
+}
+
+// indexMovi appends index entries for chs which start at offset off
+// relative to the "movi" list type.
+func indexMovi(idx *ChunkIDX1, chs Chunks, off uint32) {
+	for _, ch := range chs {
+		if ch.ID() == IDLIST {
+			idx.Entries = append(idx.Entries, AVIIndexEntry{
+				ChunkID: ch.Type(),
+				Flags:   AVIIFList,
+				Offset:  off,
+				Size:    ch.Size(),
+			})
+			indexMovi(idx, ch.Chunks(), off+8+ListTypeSize)
+		} else if aviStream(ch.ID()) >= 0 {
+			var flags uint32
+			if aviKeyframe(ch.ID()) {
+				flags = AVIIFKeyframe
+			}
+			idx.Entries = append(idx.Entries, AVIIndexEntry{
+				ChunkID: ch.ID(),
+				Flags:   flags,
+				Offset:  off,
+				Size:    ch.Size(),
+			})
+		}
+		off += 8 + RealSize(ch.Size())
+	}
+}
+
+// aviStream returns the stream number encoded in the first two characters
+// of the AVI data chunk ID or -1.
+func aviStream(id uint32) int {
+	hi, lo := byte(id>>24), byte(id>>16)
+	if hi < '0' || hi > '9' || lo < '0' || lo > '9' {
+		return -1
+	}
+	return int(hi-'0')*10 + int(lo-'0')
+}
+
+// aviKeyframe returns true if the AVI data chunk with given ID is always a
+// key frame: the uncompressed video frame ("db") or audio data ("wb").
+func aviKeyframe(id uint32) bool {
+	switch id & 0xffff {
+	case 'd'<<8 | 'b', 'w'<<8 | 'b':
+		return true
+	}
+	return false
+}
@@ -0,0 +1,187 @@
+package riff
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/ctx42/testing/pkg/assert"
+	"github.com/ctx42/testing/pkg/kit/iokit"
+	"github.com/ctx42/testing/pkg/must"
+
+	"github.com/rzajac/riff/internal/test"
+)
+
+func idx1Chunk(t *testing.T) io.Reader {
+	src := &bytes.Buffer{}
+	test.ReadFrom(t, src, Uint32(IDidx1))   // ( 0) 4 - Chunk ID
+	test.WriteUint32LE(t, src, 32)          // ( 4) 4 - Chunk size
+	test.WriteBytes(t, src, []byte("01wb")) // ( 8) 4 - Chunk ID
+	test.WriteUint32LE(t, src, 0x10)        // (12) 4 - Flags
+	test.WriteUint32LE(t, src, 4)           // (16) 4 - Offset
+	test.WriteUint32LE(t, src, 11025)       // (20) 4 - Size
+	test.WriteBytes(t, src, []byte("00dc")) // (24) 4 - Chunk ID
+	test.WriteUint32LE(t, src, 0)           // (28) 4 - Flags
+	test.WriteUint32LE(t, src, 11038)       // (32) 4 - Offset
+	test.WriteUint32LE(t, src, 2804)        // (36) 4 - Size
+	// Total length: 8+32=40
+	return src
+}
+
+func Test_ChunkIDX1_IDX1(t *testing.T) {
+	// --- When ---
+	ch := IDX1()
+
+	// --- Then ---
+	assert.Equal(t, IDidx1, ch.ID())
+	assert.Equal(t, uint32(0), ch.Size())
+	assert.Equal(t, uint32(0), ch.Type())
+	assert.False(t, ch.Multi())
+	assert.Nil(t, ch.Chunks())
+	assert.False(t, ch.Raw())
+}
+
+func Test_ChunkIDX1_ReadFrom(t *testing.T) {
+	// --- Given ---
+	src := idx1Chunk(t)
+	test.Skip4B(t, src) // Skip chunk ID.
+
+	// --- When ---
+	ch := IDX1()
+	n, err := ch.ReadFrom(src)
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, int64(36), n)
+	assert.Equal(t, uint32(32), ch.Size())
+	exp := []AVIIndexEntry{
+		{ChunkID: 0x30317762, Flags: AVIIFKeyframe, Offset: 4, Size: 11025},
+		{ChunkID: 0x30306463, Flags: 0, Offset: 11038, Size: 2804},
+	}
+	assert.Equal(t, exp, ch.Entries)
+	assert.Equal(t, 1, ch.Entries[0].Stream())
+	assert.True(t, ch.Entries[0].IsKeyframe())
+	assert.Equal(t, 0, ch.Entries[1].Stream())
+	assert.False(t, ch.Entries[1].IsKeyframe())
+	assert.True(t, test.IsAllRead(src))
+}
+
+func Test_ChunkIDX1_ReadFrom_Errors(t *testing.T) {
+	// Reading less than 36 bytes should always result in an error.
+	for _, i := range []int{1, 4, 5, 20, 35} {
+		// --- Given ---
+		src := idx1Chunk(t)
+		test.Skip4B(t, src) // Skip chunk ID.
+
+		// --- When ---
+		_, err := IDX1().ReadFrom(io.LimitReader(src, int64(i)))
+
+		// --- Then ---
+		assert.Error(t, err)
+	}
+}
+
+func Test_ChunkIDX1_ReadFrom_SizeMismatch(t *testing.T) {
+	// --- Given ---
+	src := &bytes.Buffer{}
+	test.WriteUint32LE(t, src, 15)
+
+	// --- When ---
+	_, err := IDX1().ReadFrom(src)
+
+	// --- Then ---
+	assert.ErrorIs(t, ErrChunkSizeMismatch, err)
+}
+
+func Test_ChunkIDX1_WriteTo(t *testing.T) {
+	// --- Given ---
+	src := idx1Chunk(t)
+	test.Skip4B(t, src) // Skip chunk ID.
+
+	ch := IDX1()
+	_, err := ch.ReadFrom(src)
+	assert.NoError(t, err)
+
+	// --- When ---
+	dst := &bytes.Buffer{}
+	n, err := ch.WriteTo(dst)
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, int64(40), n)
+	exp := must.Value(io.ReadAll(idx1Chunk(t)))
+	assert.Equal(t, exp, dst.Bytes())
+}
+
+func Test_ChunkIDX1_WriteTo_Errors(t *testing.T) {
+	for _, i := range []int{1, 4, 8, 20, 39} {
+		// --- Given ---
+		src := idx1Chunk(t)
+		test.Skip4B(t, src) // Skip chunk ID.
+
+		ch := IDX1()
+		_, err := ch.ReadFrom(src)
+		assert.NoError(t, err)
+
+		// --- When ---
+		_, err = ch.WriteTo(iokit.ErrWriter(&bytes.Buffer{}, i))
+
+		// --- Then ---
+		assert.Error(t, err)
+	}
+}
+
+func Test_ChunkIDX1_Reset(t *testing.T) {
+	// --- Given ---
+	src := idx1Chunk(t)
+	test.Skip4B(t, src) // Skip chunk ID.
+	ch := IDX1()
+	_, err := ch.ReadFrom(src)
+	assert.NoError(t, err)
+
+	// --- When ---
+	ch.Reset()
+
+	// --- Then ---
+	assert.Equal(t, uint32(0), ch.Size())
+	assert.Len(t, 0, ch.Entries)
+}
+
+func Test_IndexMovi(t *testing.T) {
+	// --- Given ---
+	vid := RAWC(StrToID("00dc"), LoadData)
+	vid.size = 3
+
+	rec := LIST(LoadData, nil)
+	rec.ListType = StrToID("rec ")
+	rec.Modify(Chunks{vid, RAWC(StrToID("01wb"), LoadData)})
+
+	movi := LIST(LoadData, nil)
+	movi.ListType = IDmovi
+	movi.Modify(Chunks{RAWC(IDJUNK, LoadData), rec})
+
+	// --- When ---
+	idx, err := IndexMovi(movi)
+
+	// --- Then ---
+	assert.NoError(t, err)
+	exp := []AVIIndexEntry{
+		{ChunkID: StrToID("rec "), Flags: AVIIFList, Offset: 12, Size: 4 + 16 + 4},
+		{ChunkID: StrToID("00dc"), Flags: 0, Offset: 24, Size: 3},
+		{ChunkID: StrToID("01wb"), Flags: AVIIFKeyframe, Offset: 36, Size: 0},
+	}
+	assert.Equal(t, exp, idx.Entries)
+}
+
+func Test_IndexMovi_NotMovi(t *testing.T) {
+	// --- Given ---
+	lst := LIST(LoadData, nil)
+	lst.ListType = IDINFO
+
+	// --- When ---
+	idx, err := IndexMovi(lst)
+
+	// --- Then ---
+	assert.ErrorEqual(t, "expected movi list got INFO", err)
+	assert.Nil(t, idx)
+}
@@ -0,0 +1,276 @@
+package riff
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+)
+
+// IDindx represents "indx" (OpenDML super index) sub-chunk ID of the
+// "LIST strl" chunk.
+const IDindx uint32 = 0x696e6478
+
+// INDXChunkSize represents the size of the OpenDML index header in bytes.
+const INDXChunkSize uint32 = 24
+
+// OpenDML index types.
+const (
+	// AVIIndexOfIndexes represents the super index pointing to standard
+	// indexes ("ix##" chunks).
+	AVIIndexOfIndexes byte = 0x00
+
+	// AVIIndexOfChunks represents the standard index pointing to data
+	// chunks.
+	AVIIndexOfChunks byte = 0x01
+
+	// AVIIndexIsData represents the index with the data in it.
+	AVIIndexIsData byte = 0x80
+)
+
+// AVIIndex2Field represents the index sub-type for the field indexes.
+const AVIIndex2Field byte = 0x01
+
+// avistdNotKeyframe is the bit of the standard index entry size set for
+// frames which are not key frames.
+const avistdNotKeyframe uint32 = 0x80000000
+
+// AVISuperIndexEntry represents an entry of the OpenDML super index.
+type AVISuperIndexEntry struct {
+	// Offset of the "ix##" chunk from the beginning of the file.
+	Offset uint64
+
+	// Size of the "ix##" chunk including its header.
+	Size uint32
+
+	// Duration of the indexed data in stream ticks.
+	Duration uint32
+}
+
+// AVIStdIndexEntry represents an entry of the OpenDML standard index.
+type AVIStdIndexEntry struct {
+	// Offset of the chunk data relative to the index base offset.
+	Offset uint32
+
+	// Size of the chunk data. Bit 31 is set if the chunk is not
+	// a key frame.
+	Size uint32
+}
+
+// IsKeyframe returns true if the entry is a key frame.
+func (e AVIStdIndexEntry) IsKeyframe() bool {
+	return e.Size&avistdNotKeyframe == 0
+}
+
+// DataSize returns the size of the chunk data.
+func (e AVIStdIndexEntry) DataSize() uint32 {
+	return e.Size &^ avistdNotKeyframe
+}
+
+// ChunkINDX represents OpenDML index chunk. It's used for the "indx"
+// super index in the "LIST strl" chunk and for "ix##" standard indexes.
+type ChunkINDX struct {
+	// Chunk ID.
+	id uint32
+
+	// Chunk size in bytes.
+	// The ID and extra padding byte is not counted in the chunk size.
+	size uint32
+
+	// Size of the index entry in 4-byte units.
+	LongsPerEntry uint16
+
+	// Index sub-type (e.g. [AVIIndex2Field]).
+	IndexSubType byte
+
+	// Index type (see AVIIndex* constants).
+	IndexType byte
+
+	// Number of entries in use.
+	EntriesInUse uint32
+
+	// ID of the indexed data chunks (e.g. "00dc").
+	ChunkID uint32
+
+	// Base offset of the standard index entries from the beginning of
+	// the file. Reserved for the super index.
+	BaseOffset uint64
+
+	// Reserved.
+	Reserved uint32
+
+	// Super index entries (when IndexType is [AVIIndexOfIndexes]).
+	Super []AVISuperIndexEntry
+
+	// Standard index entries (when IndexType is [AVIIndexOfChunks]).
+	Std []AVIStdIndexEntry
+
+	// Bytes following the entries in use (usually space reserved for
+	// entries added later) or entries of other index types.
+	extra []byte
+}
+
+// INDXMake returns [IDMaker] function for creating [ChunkINDX] instances.
+func INDXMake(_ bool) IDMaker {
+	return func(id uint32) Chunk {
+		return INDX(id)
+	}
+}
+
+// INDX returns a new instance of [ChunkINDX] for given ID.
+func INDX(id uint32) *ChunkINDX {
+	return &ChunkINDX{id: id, size: INDXChunkSize}
+}
+
+// IsStdIndexID returns true if id is the ID of the OpenDML standard index
+// chunk ("ix##").
+func IsStdIndexID(id uint32) bool {
+	return id>>16 == 0x6978 && aviStream(id<<16) >= 0
+}
+
+func (ch *ChunkINDX) ID() uint32 { return ch.id }
+func (ch *ChunkINDX) Size() uint32 {
+	return INDXChunkSize + ch.entriesSize() + uint32(len(ch.extra))
+}
+func (ch *ChunkINDX) Type() uint32   { return 0 }
+func (ch *ChunkINDX) Multi() bool    { return ch.id != IDindx }
+func (ch *ChunkINDX) Chunks() Chunks { return nil }
+func (ch *ChunkINDX) Raw() bool      { return false }
+
+// entrySize returns the size of the index entry in bytes or zero if the
+// entries of the index type are not decoded.
+func (ch *ChunkINDX) entrySize() int {
+	switch {
+	case ch.IndexType == AVIIndexOfIndexes && ch.LongsPerEntry == 4:
+		return 16
+	case ch.IndexType == AVIIndexOfChunks && ch.IndexSubType == 0 && ch.LongsPerEntry == 2:
+		return 8
+	}
+	return 0
+}
+
+// entriesSize returns the size of decoded entries in bytes.
+func (ch *ChunkINDX) entriesSize() uint32 {
+	return uint32(len(ch.Super))*16 + uint32(len(ch.Std))*8
+}
+
+func (ch *ChunkINDX) ReadFrom(r io.Reader) (int64, error) {
+	bo := ByteOrder(r)
+	var sum int64
+	if err := binary.Read(r, bo, &ch.size); err != nil {
+		return sum, fmt.Errorf(errFmtDecode, Uint32(ch.id), err)
+	}
+	sum += 4
+
+	if ch.size < INDXChunkSize {
+		return sum, fmt.Errorf(errFmtDecode, Uint32(ch.id), ErrTooShort)
+	}
+
+	buf := make([]byte, ch.size)
+	in, err := io.ReadFull(r, buf)
+	sum += int64(in)
+	if err != nil {
+		return sum, fmt.Errorf(errFmtDecode, Uint32(ch.id), err)
+	}
+
+	ch.LongsPerEntry = bo.Uint16(buf)
+	ch.IndexSubType = buf[2]
+	ch.IndexType = buf[3]
+	ch.EntriesInUse = bo.Uint32(buf[4:])
+	ch.ChunkID = be.Uint32(buf[8:])
+	ch.BaseOffset = bo.Uint64(buf[12:])
+	ch.Reserved = bo.Uint32(buf[20:])
+	buf = buf[INDXChunkSize:]
+
+	if es := ch.entrySize(); es > 0 {
+		if uint64(ch.EntriesInUse)*uint64(es) > uint64(len(buf)) {
+			return sum, fmt.Errorf(errFmtDecode, Uint32(ch.id), ErrChunkSizeMismatch)
+		}
+		for i := 0; i < int(ch.EntriesInUse); i++ {
+			b := buf[i*es:]
+			if es == 16 {
+				ch.Super = append(ch.Super, AVISuperIndexEntry{
+					Offset:   bo.Uint64(b),
+					Size:     bo.Uint32(b[8:]),
+					Duration: bo.Uint32(b[12:]),
+				})
+			} else {
+				ch.Std = append(ch.Std, AVIStdIndexEntry{
+					Offset: bo.Uint32(b),
+					Size:   bo.Uint32(b[4:]),
+				})
+			}
+		}
+		buf = buf[int(ch.EntriesInUse)*es:]
+	}
+	ch.extra = append(ch.extra[:0], buf...)
+
+	n, err := ReadPaddingIf(r, ch.size)
+	sum += n
+	if err != nil {
+		return sum, fmt.Errorf(errFmtDecode, Uint32(ch.id), err)
+	}
+
+	return sum, nil
+}
+
+func (ch *ChunkINDX) WriteTo(w io.Writer) (int64, error) {
+	bo := ByteOrder(w)
+	var sum int64
+
+	ch.size = ch.Size()
+	if ch.entrySize() > 0 {
+		ch.EntriesInUse = uint32(len(ch.Super) + len(ch.Std))
+	}
+
+	n, err := WriteIDAndSize(w, ch.id, ch.size)
+	sum += n
+	if err != nil {
+		return sum, fmt.Errorf(errFmtEncode, Uint32(ch.id), err)
+	}
+
+	buf := make([]byte, 0, ch.size)
+	buf = bo.AppendUint16(buf, ch.LongsPerEntry)
+	buf = append(buf, ch.IndexSubType, ch.IndexType)
+	buf = bo.AppendUint32(buf, ch.EntriesInUse)
+	buf = be.AppendUint32(buf, ch.ChunkID)
+	buf = bo.AppendUint64(buf, ch.BaseOffset)
+	buf = bo.AppendUint32(buf, ch.Reserved)
+	for _, e := range ch.Super {
+		buf = bo.AppendUint64(buf, e.Offset)
+		buf = bo.AppendUint32(buf, e.Size)
+		buf = bo.AppendUint32(buf, e.Duration)
+	}
+	for _, e := range ch.Std {
+		buf = bo.AppendUint32(buf, e.Offset)
+		buf = bo.AppendUint32(buf, e.Size)
+	}
+	buf = append(buf, ch.extra...)
+
+	in, err := w.Write(buf)
+	sum += int64(in)
+	if err != nil {
+		return sum, fmt.Errorf(errFmtEncode, Uint32(ch.id), err)
+	}
+
+	n, err = WritePaddingIf(w, ch.size)
+	sum += n
+	if err != nil {
+		return sum, fmt.Errorf(errFmtEncode, Uint32(ch.id), err)
+	}
+
+	return sum, nil
+}
+
+func (ch *ChunkINDX) Reset() {
+	ch.size = INDXChunkSize
+	ch.LongsPerEntry = 0
+	ch.IndexSubType = 0
+	ch.IndexType = 0
+	ch.EntriesInUse = 0
+	ch.ChunkID = 0
+	ch.BaseOffset = 0
+	ch.Reserved = 0
+	ch.Super = ch.Super[:0]
+	ch.Std = ch.Std[:0]
+	ch.extra = ch.extra[:0]
+}
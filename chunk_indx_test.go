@@ -0,0 +1,276 @@
+package riff
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/ctx42/testing/pkg/assert"
+	"github.com/ctx42/testing/pkg/kit/iokit"
+	"github.com/ctx42/testing/pkg/must"
+
+	"github.com/rzajac/riff/internal/test"
+)
+
+func indxChunkSuper(t *testing.T) io.Reader {
+	src := &bytes.Buffer{}
+	test.ReadFrom(t, src, Uint32(IDindx))       // ( 0) 4 - Chunk ID
+	test.WriteUint32LE(t, src, 56)              // ( 4) 4 - Chunk size
+	test.WriteUint16LE(t, src, 4)               // ( 8) 2 - LongsPerEntry
+	test.WriteByte(t, src, 0)                   // (10) 1 - IndexSubType
+	test.WriteByte(t, src, AVIIndexOfIndexes)   // (11) 1 - IndexType
+	test.WriteUint32LE(t, src, 1)               // (12) 4 - EntriesInUse
+	test.WriteBytes(t, src, []byte("00dc"))     // (16) 4 - ChunkID
+	test.WriteBytes(t, src, make([]byte, 12))   // (20) 12 - Reserved
+	test.WriteBytes(t, src, []byte{0, 0, 0, 0}) // (32) 8 - Offset
+	test.WriteBytes(t, src, []byte{1, 0, 0, 0}) // (36)   -
+	test.WriteUint32LE(t, src, 32)              // (40) 4 - Size
+	test.WriteUint32LE(t, src, 1)               // (44) 4 - Duration
+	test.WriteBytes(t, src, make([]byte, 16))   // (48) 16 - Unused entry
+	// Total length: 8+56=64
+	return src
+}
+
+func indxChunkStd(t *testing.T) io.Reader {
+	src := &bytes.Buffer{}
+	test.ReadFrom(t, src, Uint32(StrToID("ix01"))) // ( 0) 4 - Chunk ID
+	test.WriteUint32LE(t, src, 40)                 // ( 4) 4 - Chunk size
+	test.WriteUint16LE(t, src, 2)                  // ( 8) 2 - LongsPerEntry
+	test.WriteByte(t, src, 0)                      // (10) 1 - IndexSubType
+	test.WriteByte(t, src, AVIIndexOfChunks)       // (11) 1 - IndexType
+	test.WriteUint32LE(t, src, 2)                  // (12) 4 - EntriesInUse
+	test.WriteBytes(t, src, []byte("01wb"))        // (16) 4 - ChunkID
+	test.WriteUint32LE(t, src, 1000)               // (20) 8 - BaseOffset
+	test.WriteUint32LE(t, src, 0)                  // (24)   -
+	test.WriteUint32LE(t, src, 0)                  // (28) 4 - Reserved
+	test.WriteUint32LE(t, src, 8)                  // (32) 4 - Offset
+	test.WriteUint32LE(t, src, 100)                // (36) 4 - Size
+	test.WriteUint32LE(t, src, 116)                // (40) 4 - Offset
+	test.WriteUint32LE(t, src, 0x80000064)         // (44) 4 - Size (not key frame)
+	// Total length: 8+40=48
+	return src
+}
+
+func Test_ChunkINDX_INDX(t *testing.T) {
+	// --- When ---
+	ch := INDX(IDindx)
+
+	// --- Then ---
+	assert.Equal(t, IDindx, ch.ID())
+	assert.Equal(t, INDXChunkSize, ch.Size())
+	assert.Equal(t, uint32(0), ch.Type())
+	assert.False(t, ch.Multi())
+	assert.Nil(t, ch.Chunks())
+	assert.False(t, ch.Raw())
+	assert.True(t, INDX(StrToID("ix00")).Multi())
+}
+
+func Test_IsStdIndexID(t *testing.T) {
+	assert.True(t, IsStdIndexID(StrToID("ix00")))
+	assert.True(t, IsStdIndexID(StrToID("ix12")))
+	assert.False(t, IsStdIndexID(StrToID("ixab")))
+	assert.False(t, IsStdIndexID(IDindx))
+	assert.False(t, IsStdIndexID(StrToID("00dc")))
+}
+
+func Test_ChunkINDX_ReadFrom_Super(t *testing.T) {
+	// --- Given ---
+	src := indxChunkSuper(t)
+	test.Skip4B(t, src) // Skip chunk ID.
+
+	// --- When ---
+	ch := INDX(IDindx)
+	n, err := ch.ReadFrom(src)
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, int64(60), n)
+	assert.Equal(t, uint32(56), ch.Size())
+	assert.Equal(t, uint16(4), ch.LongsPerEntry)
+	assert.Equal(t, AVIIndexOfIndexes, ch.IndexType)
+	assert.Equal(t, uint32(1), ch.EntriesInUse)
+	assert.Equal(t, "00dc", Uint32(ch.ChunkID).String())
+	exp := []AVISuperIndexEntry{{Offset: 1 << 32, Size: 32, Duration: 1}}
+	assert.Equal(t, exp, ch.Super)
+	assert.Nil(t, ch.Std)
+	assert.True(t, test.IsAllRead(src))
+}
+
+func Test_ChunkINDX_ReadFrom_Std(t *testing.T) {
+	// --- Given ---
+	src := indxChunkStd(t)
+	test.Skip4B(t, src) // Skip chunk ID.
+
+	// --- When ---
+	ch := INDX(StrToID("ix01"))
+	n, err := ch.ReadFrom(src)
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, int64(44), n)
+	assert.Equal(t, uint32(40), ch.Size())
+	assert.Equal(t, AVIIndexOfChunks, ch.IndexType)
+	assert.Equal(t, uint64(1000), ch.BaseOffset)
+	assert.Nil(t, ch.Super)
+	assert.Len(t, 2, ch.Std)
+	assert.True(t, ch.Std[0].IsKeyframe())
+	assert.Equal(t, uint32(100), ch.Std[0].DataSize())
+	assert.False(t, ch.Std[1].IsKeyframe())
+	assert.Equal(t, uint32(100), ch.Std[1].DataSize())
+	assert.True(t, test.IsAllRead(src))
+}
+
+func Test_ChunkINDX_ReadFrom_OtherIndexType(t *testing.T) {
+	// --- Given ---
+	src := &bytes.Buffer{}
+	test.WriteUint32LE(t, src, 36)
+	test.WriteUint16LE(t, src, 3)
+	test.WriteByte(t, src, AVIIndex2Field)
+	test.WriteByte(t, src, AVIIndexOfChunks)
+	test.WriteUint32LE(t, src, 1)
+	test.WriteBytes(t, src, make([]byte, 28))
+
+	// --- When ---
+	ch := INDX(StrToID("ix00"))
+	_, err := ch.ReadFrom(src)
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Nil(t, ch.Std)
+	assert.Equal(t, uint32(36), ch.Size())
+}
+
+func Test_ChunkINDX_ReadFrom_Errors(t *testing.T) {
+	// Reading less than 44 bytes should always result in an error.
+	for _, i := range []int{1, 4, 5, 20, 43} {
+		// --- Given ---
+		src := indxChunkStd(t)
+		test.Skip4B(t, src) // Skip chunk ID.
+
+		// --- When ---
+		_, err := INDX(StrToID("ix01")).ReadFrom(io.LimitReader(src, int64(i)))
+
+		// --- Then ---
+		assert.Error(t, err)
+	}
+}
+
+func Test_ChunkINDX_ReadFrom_TooShortError(t *testing.T) {
+	// --- Given ---
+	src := &bytes.Buffer{}
+	test.WriteUint32LE(t, src, 23)
+
+	// --- When ---
+	_, err := INDX(IDindx).ReadFrom(src)
+
+	// --- Then ---
+	assert.ErrorIs(t, ErrTooShort, err)
+}
+
+func Test_ChunkINDX_ReadFrom_SizeMismatch(t *testing.T) {
+	// --- Given ---
+	b := must.Value(io.ReadAll(indxChunkStd(t)))
+	le.PutUint32(b[12:], 3) // Entries in use.
+
+	// --- When ---
+	_, err := INDX(StrToID("ix01")).ReadFrom(bytes.NewReader(b[4:]))
+
+	// --- Then ---
+	assert.ErrorIs(t, ErrChunkSizeMismatch, err)
+}
+
+func Test_ChunkINDX_WriteTo(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		id  uint32
+		src func(t *testing.T) io.Reader
+		n   int64
+	}{
+		{"super", IDindx, indxChunkSuper, 64},
+		{"std", StrToID("ix01"), indxChunkStd, 48},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- Given ---
+			src := tc.src(t)
+			test.Skip4B(t, src) // Skip chunk ID.
+
+			ch := INDX(tc.id)
+			_, err := ch.ReadFrom(src)
+			assert.NoError(t, err)
+
+			// --- When ---
+			dst := &bytes.Buffer{}
+			n, err := ch.WriteTo(dst)
+
+			// --- Then ---
+			assert.NoError(t, err)
+			assert.Equal(t, tc.n, n)
+			exp := must.Value(io.ReadAll(tc.src(t)))
+			assert.Equal(t, exp, dst.Bytes())
+		})
+	}
+}
+
+func Test_ChunkINDX_WriteTo_Edited(t *testing.T) {
+	// --- Given ---
+	src := indxChunkStd(t)
+	test.Skip4B(t, src) // Skip chunk ID.
+
+	ch := INDX(StrToID("ix01"))
+	_, err := ch.ReadFrom(src)
+	assert.NoError(t, err)
+
+	ch.Std = append(ch.Std, AVIStdIndexEntry{Offset: 224, Size: 100})
+
+	// --- When ---
+	dst := &bytes.Buffer{}
+	n, err := ch.WriteTo(dst)
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, int64(56), n)
+	assert.Equal(t, uint32(3), ch.EntriesInUse)
+
+	got := INDX(StrToID("ix01"))
+	_, err = got.ReadFrom(bytes.NewReader(dst.Bytes()[4:]))
+	assert.NoError(t, err)
+	assert.Equal(t, ch.Std, got.Std)
+}
+
+func Test_ChunkINDX_WriteTo_Errors(t *testing.T) {
+	for _, i := range []int{1, 4, 8, 47} {
+		// --- Given ---
+		src := indxChunkStd(t)
+		test.Skip4B(t, src) // Skip chunk ID.
+
+		ch := INDX(StrToID("ix01"))
+		_, err := ch.ReadFrom(src)
+		assert.NoError(t, err)
+
+		// --- When ---
+		_, err = ch.WriteTo(iokit.ErrWriter(&bytes.Buffer{}, i))
+
+		// --- Then ---
+		assert.Error(t, err)
+	}
+}
+
+func Test_ChunkINDX_Reset(t *testing.T) {
+	// --- Given ---
+	src := indxChunkSuper(t)
+	test.Skip4B(t, src) // Skip chunk ID.
+	ch := INDX(IDindx)
+	_, err := ch.ReadFrom(src)
+	assert.NoError(t, err)
+
+	// --- When ---
+	ch.Reset()
+
+	// --- Then ---
+	assert.Equal(t, INDXChunkSize, ch.Size())
+	assert.Equal(t, uint32(0), ch.EntriesInUse)
+	assert.Len(t, 0, ch.Super)
+}
@@ -163,10 +163,30 @@ func (ch *ChunkINFO) Text() io.Reader {
 	return bytes.NewReader(TrimZeroRight(ch.text))
 }
 
+// infoText returns the "LIST INFO" sub-chunk with the text.
+func infoText(id uint32, text []byte) *ChunkINFO {
+	ch := INFO(id)
+	ch.text = text
+	ch.size = uint32(len(text))
+	return ch
+}
+
+// copyINFO returns the new "LIST INFO" chunk with the sub-chunks of lst,
+// which may be nil. The copy makes the list writable when lst was decoded
+// in SkipData mode, the INFO sub-chunks are always loaded.
+func copyINFO(lst *ChunkLIST) *ChunkLIST {
+	info := LIST(LoadData, NewRegistry(RAWCMake(LoadData)))
+	info.ListType = IDINFO
+	if lst != nil {
+		info.Modify(lst.Chunks())
+	}
+	return info
+}
+
 func (ch *ChunkINFO) ReadFrom(r io.Reader) (int64, error) {
 	var sum int64
 
-	if err := binary.Read(r, le, &ch.size); err != nil {
+	if err := binary.Read(r, ByteOrder(r), &ch.size); err != nil {
 		return sum, fmt.Errorf(errFmtDecode, linkids(IDINFO, ch.id), err)
 	}
 	sum += 4
@@ -227,3 +227,32 @@ func Test_ChunkINFO_InfoLabel(t *testing.T) {
 		})
 	}
 }
+
+func Test_copyINFO(t *testing.T) {
+	t.Run("copy", func(t *testing.T) {
+		// --- Given ---
+		lst := LIST(SkipData, nil)
+		lst.ListType = IDINFO
+		lst.Modify(Chunks{infoText(LabINAM, []byte("name\x00"))})
+
+		// --- When ---
+		have := copyINFO(lst)
+
+		// --- Then ---
+		assert.NotSame(t, lst, have)
+		assert.True(t, have.load)
+		assert.Equal(t, IDINFO, have.Type())
+		assert.Equal(t, lst.Chunks(), have.Chunks())
+		assert.Equal(t, lst.Size(), have.Size())
+	})
+
+	t.Run("nil", func(t *testing.T) {
+		// --- When ---
+		have := copyINFO(nil)
+
+		// --- Then ---
+		assert.Equal(t, IDINFO, have.Type())
+		assert.Len(t, 0, have.Chunks())
+		assert.NotNil(t, have.reg)
+	})
+}
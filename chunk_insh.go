@@ -0,0 +1,134 @@
+package riff
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+)
+
+// IDinsh represents "insh" (instrument header) sub-chunk ID of the
+// "LIST ins " chunk.
+const IDinsh uint32 = 0x696e7368
+
+// INSHChunkSize represents the size of insh chunk static part in bytes.
+const INSHChunkSize uint32 = 12
+
+// DLSBankDrums represents the bit of the DLS instrument bank set for the
+// drum instruments.
+const DLSBankDrums uint32 = 0x80000000
+
+// inshStatic represents chunk static data (always there).
+// This struct is defined separately to allow for binary
+// decoding / encoding in one call to binary.Read / binary.Write.
+type inshStatic struct {
+	// Number of regions of the instrument.
+	Regions uint32
+
+	// MIDI bank of the instrument. Bits 8-14 are the bank select MSB,
+	// bits 0-6 the bank select LSB and [DLSBankDrums] bit is set for
+	// the drum instruments.
+	Bank uint32
+
+	// MIDI program number of the instrument (bits 0-6).
+	Program uint32
+}
+
+// ChunkINSH represents "insh" chunk of the DLS instrument.
+type ChunkINSH struct {
+	// Chunk size in bytes.
+	// The ID and extra padding byte is not counted in the chunk size.
+	size uint32
+
+	inshStatic
+
+	// Bytes following the static part.
+	extra []byte
+}
+
+// INSHMake is a [Maker] function for creating [ChunkINSH] instances.
+func INSHMake() Chunk { return INSH() }
+
+// INSH returns a new instance of [ChunkINSH].
+func INSH() *ChunkINSH {
+	return &ChunkINSH{size: INSHChunkSize}
+}
+
+func (ch *ChunkINSH) ID() uint32     { return IDinsh }
+func (ch *ChunkINSH) Size() uint32   { return ch.size }
+func (ch *ChunkINSH) Type() uint32   { return 0 }
+func (ch *ChunkINSH) Multi() bool    { return true }
+func (ch *ChunkINSH) Chunks() Chunks { return nil }
+func (ch *ChunkINSH) Raw() bool      { return false }
+
+// Drums returns true if the instrument is a drum instrument.
+func (ch *ChunkINSH) Drums() bool { return ch.Bank&DLSBankDrums != 0 }
+
+func (ch *ChunkINSH) ReadFrom(r io.Reader) (int64, error) {
+	bo := ByteOrder(r)
+	var sum int64
+	if err := binary.Read(r, bo, &ch.size); err != nil {
+		return sum, fmt.Errorf(errFmtDecode, Uint32(IDinsh), err)
+	}
+	sum += 4
+
+	if ch.size < INSHChunkSize {
+		return sum, fmt.Errorf(errFmtDecode, Uint32(IDinsh), ErrTooShort)
+	}
+
+	if err := binary.Read(r, bo, &ch.inshStatic); err != nil {
+		return sum, fmt.Errorf(errFmtDecode, Uint32(IDinsh), err)
+	}
+	sum += int64(INSHChunkSize)
+
+	ch.extra = grow(ch.extra, int(ch.size-INSHChunkSize))
+	in, err := io.ReadFull(r, ch.extra)
+	sum += int64(in)
+	if err != nil {
+		return sum, fmt.Errorf(errFmtDecode, Uint32(IDinsh), err)
+	}
+
+	n, err := ReadPaddingIf(r, ch.size)
+	sum += n
+	if err != nil {
+		return sum, fmt.Errorf(errFmtDecode, Uint32(IDinsh), err)
+	}
+
+	return sum, nil
+}
+
+func (ch *ChunkINSH) WriteTo(w io.Writer) (int64, error) {
+	var sum int64
+
+	ch.size = INSHChunkSize + uint32(len(ch.extra))
+
+	n, err := WriteIDAndSize(w, IDinsh, ch.size)
+	sum += n
+	if err != nil {
+		return sum, fmt.Errorf(errFmtEncode, Uint32(IDinsh), err)
+	}
+
+	if err = binary.Write(w, ByteOrder(w), ch.inshStatic); err != nil {
+		return sum, fmt.Errorf(errFmtEncode, Uint32(IDinsh), err)
+	}
+	sum += int64(INSHChunkSize)
+
+	in, err := w.Write(ch.extra)
+	sum += int64(in)
+	if err != nil {
+		return sum, fmt.Errorf(errFmtEncode, Uint32(IDinsh), err)
+	}
+
+	n, err = WritePaddingIf(w, ch.size)
+	sum += n
+	if err != nil {
+		return sum, fmt.Errorf(errFmtEncode, Uint32(IDinsh), err)
+	}
+
+	return sum, nil
+}
+
+func (ch *ChunkINSH) Reset() {
+	ch.size = INSHChunkSize
+	ch.inshStatic = inshStatic{}
+	ch.extra = ch.extra[:0]
+}
@@ -0,0 +1,132 @@
+package riff
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/ctx42/testing/pkg/assert"
+	"github.com/ctx42/testing/pkg/kit/iokit"
+	"github.com/ctx42/testing/pkg/must"
+
+	"github.com/rzajac/riff/internal/test"
+)
+
+func inshChunk(t *testing.T) io.Reader {
+	src := &bytes.Buffer{}
+	test.ReadFrom(t, src, Uint32(IDinsh))           // ( 0) 4 - Chunk ID
+	test.WriteUint32LE(t, src, 12)                  // ( 4) 4 - Chunk size
+	test.WriteUint32LE(t, src, 1)                   // ( 8) 4 - Regions
+	test.WriteUint32LE(t, src, DLSBankDrums|0x0100) // (12) 4 - Bank
+	test.WriteUint32LE(t, src, 5)                   // (16) 4 - Program
+	// Total length: 8+12=20
+	return src
+}
+
+func Test_ChunkINSH_INSH(t *testing.T) {
+	// --- When ---
+	ch := INSH()
+
+	// --- Then ---
+	assert.Equal(t, IDinsh, ch.ID())
+	assert.Equal(t, INSHChunkSize, ch.Size())
+	assert.Equal(t, uint32(0), ch.Type())
+	assert.True(t, ch.Multi())
+	assert.Nil(t, ch.Chunks())
+	assert.False(t, ch.Raw())
+	assert.False(t, ch.Drums())
+}
+
+func Test_ChunkINSH_ReadFrom(t *testing.T) {
+	// --- Given ---
+	src := inshChunk(t)
+	test.Skip4B(t, src) // Skip chunk ID.
+
+	// --- When ---
+	ch := INSH()
+	n, err := ch.ReadFrom(src)
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, int64(16), n)
+	assert.Equal(t, INSHChunkSize, ch.Size())
+	assert.Equal(t, uint32(1), ch.Regions)
+	assert.Equal(t, DLSBankDrums|0x0100, ch.Bank)
+	assert.Equal(t, uint32(5), ch.Program)
+	assert.True(t, ch.Drums())
+	assert.True(t, test.IsAllRead(src))
+}
+
+func Test_ChunkINSH_ReadFrom_Errors(t *testing.T) {
+	// Reading less than 16 bytes should always result in an error.
+	for _, i := range []int{1, 4, 5, 15} {
+		// --- Given ---
+		src := inshChunk(t)
+		test.Skip4B(t, src) // Skip chunk ID.
+
+		// --- When ---
+		_, err := INSH().ReadFrom(io.LimitReader(src, int64(i)))
+
+		// --- Then ---
+		assert.Error(t, err)
+	}
+}
+
+func Test_ChunkINSH_ReadFrom_TooShortError(t *testing.T) {
+	// --- Given ---
+	src := &bytes.Buffer{}
+	test.WriteUint32LE(t, src, 11)
+
+	// --- When ---
+	_, err := INSH().ReadFrom(src)
+
+	// --- Then ---
+	assert.ErrorIs(t, ErrTooShort, err)
+}
+
+func Test_ChunkINSH_WriteTo(t *testing.T) {
+	// --- Given ---
+	src := inshChunk(t)
+	test.Skip4B(t, src) // Skip chunk ID.
+
+	ch := INSH()
+	_, err := ch.ReadFrom(src)
+	assert.NoError(t, err)
+
+	// --- When ---
+	dst := &bytes.Buffer{}
+	n, err := ch.WriteTo(dst)
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, int64(20), n)
+	exp := must.Value(io.ReadAll(inshChunk(t)))
+	assert.Equal(t, exp, dst.Bytes())
+}
+
+func Test_ChunkINSH_WriteTo_Errors(t *testing.T) {
+	for _, i := range []int{1, 4, 8, 19} {
+		// --- When ---
+		_, err := INSH().WriteTo(iokit.ErrWriter(&bytes.Buffer{}, i))
+
+		// --- Then ---
+		assert.Error(t, err)
+	}
+}
+
+func Test_ChunkINSH_Reset(t *testing.T) {
+	// --- Given ---
+	src := inshChunk(t)
+	test.Skip4B(t, src) // Skip chunk ID.
+	ch := INSH()
+	_, err := ch.ReadFrom(src)
+	assert.NoError(t, err)
+
+	// --- When ---
+	ch.Reset()
+
+	// --- Then ---
+	assert.Equal(t, INSHChunkSize, ch.Size())
+	assert.Equal(t, uint32(0), ch.Regions)
+	assert.Equal(t, uint32(0), ch.Bank)
+}
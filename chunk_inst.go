@@ -0,0 +1,96 @@
+package riff
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+)
+
+// IDinst represents "inst" (instrument headers) sub-chunk ID of the
+// "LIST pdta" chunk.
+const IDinst uint32 = 0x696e7374
+
+// SF2InstHeaderSize represents the size of the instrument header record in
+// bytes.
+const SF2InstHeaderSize uint32 = 22
+
+// SF2InstHeader represents the SoundFont instrument header record.
+type SF2InstHeader struct {
+	// Zero terminated instrument name.
+	Name [20]byte
+
+	// Index of the first instrument zone in the "ibag" chunk.
+	BagIndex uint16
+}
+
+// ChunkINST represents "inst" chunk of the SoundFont file.
+type ChunkINST struct {
+	// Chunk size in bytes.
+	// The ID and extra padding byte is not counted in the chunk size.
+	size uint32
+
+	// Instrument header records. The last one is the terminal "EOI"
+	// record.
+	Headers []SF2InstHeader
+}
+
+// INSTMake is a [Maker] function for creating [ChunkINST] instances.
+func INSTMake() Chunk { return INST() }
+
+// INST returns a new instance of [ChunkINST].
+func INST() *ChunkINST {
+	return &ChunkINST{}
+}
+
+func (ch *ChunkINST) ID() uint32     { return IDinst }
+func (ch *ChunkINST) Size() uint32   { return uint32(len(ch.Headers)) * SF2InstHeaderSize }
+func (ch *ChunkINST) Type() uint32   { return 0 }
+func (ch *ChunkINST) Multi() bool    { return false }
+func (ch *ChunkINST) Chunks() Chunks { return nil }
+func (ch *ChunkINST) Raw() bool      { return false }
+
+func (ch *ChunkINST) ReadFrom(r io.Reader) (int64, error) {
+	var sum int64
+	var err error
+	if ch.size, err = ReadChunkSize(r); err != nil {
+		return sum, fmt.Errorf(errFmtDecode, Uint32(IDinst), err)
+	}
+	sum += 4
+
+	if ch.size%SF2InstHeaderSize != 0 {
+		return sum, fmt.Errorf(errFmtDecode, Uint32(IDinst), ErrChunkSizeMismatch)
+	}
+
+	recs := make([]SF2InstHeader, ch.size/SF2InstHeaderSize)
+	if err = binary.Read(r, ByteOrder(r), recs); err != nil {
+		return sum, fmt.Errorf(errFmtDecode, Uint32(IDinst), err)
+	}
+	ch.Headers = append(ch.Headers[:0], recs...)
+	sum += int64(ch.size)
+
+	return sum, nil
+}
+
+func (ch *ChunkINST) WriteTo(w io.Writer) (int64, error) {
+	var sum int64
+
+	ch.size = ch.Size()
+
+	n, err := WriteIDAndSize(w, IDinst, ch.size)
+	sum += n
+	if err != nil {
+		return sum, fmt.Errorf(errFmtEncode, Uint32(IDinst), err)
+	}
+
+	if err = binary.Write(w, ByteOrder(w), ch.Headers); err != nil {
+		return sum, fmt.Errorf(errFmtEncode, Uint32(IDinst), err)
+	}
+	sum += int64(ch.size)
+
+	return sum, nil
+}
+
+func (ch *ChunkINST) Reset() {
+	ch.size = 0
+	ch.Headers = ch.Headers[:0]
+}
@@ -0,0 +1,136 @@
+package riff
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/ctx42/testing/pkg/assert"
+	"github.com/ctx42/testing/pkg/kit/iokit"
+	"github.com/ctx42/testing/pkg/must"
+
+	"github.com/rzajac/riff/internal/test"
+)
+
+func instChunk(t *testing.T) io.Reader {
+	src := &bytes.Buffer{}
+	test.ReadFrom(t, src, Uint32(IDinst))     // ( 0)  4 - Chunk ID
+	test.WriteUint32LE(t, src, 44)            // ( 4)  4 - Chunk size
+	test.WriteBytes(t, src, sf2NameB("Kick")) // ( 8) 20 - Name
+	test.WriteUint16LE(t, src, 0)             // (28)  2 - BagIndex
+	test.WriteBytes(t, src, sf2NameB("EOI"))  // (30) 20 - Name
+	test.WriteUint16LE(t, src, 2)             // (50)  2 - BagIndex
+	// Total length: 8+44=52
+	return src
+}
+
+func Test_ChunkINST_INST(t *testing.T) {
+	// --- When ---
+	ch := INST()
+
+	// --- Then ---
+	assert.Equal(t, IDinst, ch.ID())
+	assert.Equal(t, uint32(0), ch.Size())
+	assert.Equal(t, uint32(0), ch.Type())
+	assert.False(t, ch.Multi())
+	assert.Nil(t, ch.Chunks())
+	assert.False(t, ch.Raw())
+	assert.Len(t, 0, ch.Headers)
+}
+
+func Test_ChunkINST_ReadFrom(t *testing.T) {
+	// --- Given ---
+	src := instChunk(t)
+	test.Skip4B(t, src) // Skip chunk ID.
+
+	// --- When ---
+	ch := INST()
+	n, err := ch.ReadFrom(src)
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, int64(48), n)
+	assert.Equal(t, uint32(44), ch.Size())
+	exp := []SF2InstHeader{
+		{Name: sf2Name("Kick")},
+		{Name: sf2Name("EOI"), BagIndex: 2},
+	}
+	assert.Equal(t, exp, ch.Headers)
+	assert.True(t, test.IsAllRead(src))
+}
+
+func Test_ChunkINST_ReadFrom_SizeMismatch(t *testing.T) {
+	// --- Given ---
+	src := &bytes.Buffer{}
+	test.WriteUint32LE(t, src, SF2InstHeaderSize+1)
+
+	// --- When ---
+	_, err := INST().ReadFrom(src)
+
+	// --- Then ---
+	assert.ErrorIs(t, ErrChunkSizeMismatch, err)
+}
+
+func Test_ChunkINST_ReadFrom_Errors(t *testing.T) {
+	// Reading less than 48 bytes should always result in an error.
+	for _, i := range []int{1, 4, 5, 25, 47} {
+		// --- Given ---
+		src := instChunk(t)
+		test.Skip4B(t, src) // Skip chunk ID.
+
+		// --- When ---
+		_, err := INST().ReadFrom(io.LimitReader(src, int64(i)))
+
+		// --- Then ---
+		assert.Error(t, err)
+	}
+}
+
+func Test_ChunkINST_WriteTo(t *testing.T) {
+	// --- Given ---
+	ch := INST()
+	ch.Headers = []SF2InstHeader{
+		{Name: sf2Name("Kick")},
+		{Name: sf2Name("EOI"), BagIndex: 2},
+	}
+
+	// --- When ---
+	dst := &bytes.Buffer{}
+	n, err := ch.WriteTo(dst)
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, int64(52), n)
+	exp := must.Value(io.ReadAll(instChunk(t)))
+	assert.Equal(t, exp, dst.Bytes())
+}
+
+func Test_ChunkINST_WriteTo_Errors(t *testing.T) {
+	for _, i := range []int{1, 4, 8, 30} {
+		// --- Given ---
+		ch := INST()
+		ch.Headers = []SF2InstHeader{{}, {}}
+
+		// --- When ---
+		_, err := ch.WriteTo(iokit.ErrWriter(&bytes.Buffer{}, i))
+
+		// --- Then ---
+		assert.Error(t, err)
+	}
+}
+
+func Test_ChunkINST_Reset(t *testing.T) {
+	// --- Given ---
+	src := instChunk(t)
+	test.Skip4B(t, src) // Skip chunk ID.
+	ch := INST()
+	_, err := ch.ReadFrom(src)
+	assert.NoError(t, err)
+
+	// --- When ---
+	ch.Reset()
+
+	// --- Then ---
+	assert.Equal(t, uint32(0), ch.Size())
+	assert.Len(t, 0, ch.Headers)
+}
@@ -54,8 +54,9 @@ func (ch *ChunkLABL) Label() io.Reader {
 }
 
 func (ch *ChunkLABL) ReadFrom(r io.Reader) (int64, error) {
+	bo := ByteOrder(r)
 	var sum int64
-	if err := binary.Read(r, le, &ch.size); err != nil {
+	if err := binary.Read(r, bo, &ch.size); err != nil {
 		return sum, fmt.Errorf(errFmtDecode, linkids(IDINFO, IDlabl), err)
 	}
 	sum += 4
@@ -64,7 +65,7 @@ func (ch *ChunkLABL) ReadFrom(r io.Reader) (int64, error) {
 		return sum, fmt.Errorf(errFmtDecode, linkids(IDINFO, IDlabl), ErrTooShort)
 	}
 
-	if err := binary.Read(r, le, &ch.CuePointID); err != nil {
+	if err := binary.Read(r, bo, &ch.CuePointID); err != nil {
 		return sum, fmt.Errorf(errFmtDecode, linkids(IDINFO, IDlabl), err)
 	}
 	sum += int64(LABLChunkSize)
@@ -96,7 +97,7 @@ func (ch *ChunkLABL) WriteTo(w io.Writer) (int64, error) {
 		return sum, fmt.Errorf(errFmtEncode, linkids(IDINFO, IDlabl), err)
 	}
 
-	if err = binary.Write(w, le, ch.CuePointID); err != nil {
+	if err = binary.Write(w, ByteOrder(w), ch.CuePointID); err != nil {
 		return sum, fmt.Errorf(errFmtEncode, linkids(IDINFO, IDlabl), err)
 	}
 	sum += int64(LABLChunkSize)
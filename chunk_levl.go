@@ -0,0 +1,289 @@
+package riff
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+)
+
+// IDlevl represents "levl" chunk ID.
+const IDlevl uint32 = 0x6c65766c
+
+// LEVLChunkSize represents the size of levl chunk static part in bytes.
+// Does not count ID and peak data bytes.
+const LEVLChunkSize uint32 = 120
+
+// LEVLOffsetToPeaks represents the default offset of peak data from the
+// beginning of the levl chunk (including its ID and size).
+const LEVLOffsetToPeaks uint32 = 128
+
+// Formats of a peak point.
+const (
+	// PeakFormat8 represents peak points stored as unsigned char.
+	PeakFormat8 uint32 = 1
+
+	// PeakFormat16 represents peak points stored as unsigned short.
+	PeakFormat16 uint32 = 2
+)
+
+// levlTimeLayout is the layout of the levl chunk timestamp without
+// the milliseconds part.
+const levlTimeLayout = "2006:01:02:15:04:05"
+
+// levlStatic represents chunk static data (always there).
+// This struct is defined separately to allow for binary
+// decoding / encoding in one call to binary.Read / binary.Write.
+type levlStatic struct {
+	// Version of the peak envelope chunk.
+	Version uint32
+
+	// Format of a peak point. See PeakFormat* constants.
+	Format uint32
+
+	// Number of peak points per peak value.
+	// 1 - only the positive peak point.
+	// 2 - positive and negative peak point.
+	PointsPerValue uint32
+
+	// Number of audio samples (frames) used to generate each peak frame.
+	// The default value is 256.
+	BlockSize uint32
+
+	// Number of peak channels.
+	PeakChannels uint32
+
+	// Number of peak frames.
+	PeakFrames uint32
+
+	// The audio sample frame index of the peak of peaks (the maximum
+	// absolute sample value). Value of 0xffffffff means unknown.
+	PosPeakOfPeaks uint32
+
+	// Offset of the peak data from the beginning of the levl chunk
+	// (including its ID and size fields).
+	OffsetToPeaks uint32
+
+	// ASCII timestamp of the peak data creation in
+	// "YYYY:MM:DD:hh:mm:ss:uuu" format.
+	Timestamp [28]byte
+
+	// Reserved for future use.
+	Reserved [60]byte
+}
+
+// ChunkLEVL represents "levl" chunk defined in EBU Tech 3285 Supplement 3.
+// It holds peak envelope data used by digital audio workstations to draw
+// waveforms.
+type ChunkLEVL struct {
+	// Chunk size in bytes.
+	// The ID and extra padding byte is not counted in the chunk size.
+	size uint32
+
+	levlStatic
+
+	// Bytes between the static part and the peak data when OffsetToPeaks
+	// is greater than LEVLOffsetToPeaks.
+	gap []byte
+
+	// Peak points in file order. For each peak frame and each channel
+	// there are PointsPerValue points (positive peak first).
+	Peaks []uint16
+}
+
+// LEVLMake is a [Maker] function for creating [ChunkLEVL] instances.
+func LEVLMake() Chunk { return LEVL() }
+
+// LEVL returns a new instance of [ChunkLEVL].
+func LEVL() *ChunkLEVL {
+	ch := &ChunkLEVL{}
+	ch.Reset()
+	return ch
+}
+
+func (ch *ChunkLEVL) ID() uint32     { return IDlevl }
+func (ch *ChunkLEVL) Size() uint32   { return ch.size }
+func (ch *ChunkLEVL) Type() uint32   { return 0 }
+func (ch *ChunkLEVL) Multi() bool    { return false }
+func (ch *ChunkLEVL) Chunks() Chunks { return nil }
+func (ch *ChunkLEVL) Raw() bool      { return false }
+
+// PeakTime returns parsed timestamp of the peak data creation.
+func (ch *ChunkLEVL) PeakTime() (time.Time, error) {
+	ts := string(TrimZeroRight(ch.Timestamp[:]))
+	if len(ts) != len(levlTimeLayout)+4 || ts[len(levlTimeLayout)] != ':' {
+		return time.Time{}, fmt.Errorf("invalid levl timestamp %q", ts)
+	}
+	tim, err := time.Parse(levlTimeLayout, ts[:len(levlTimeLayout)])
+	if err != nil {
+		return time.Time{}, err
+	}
+	ms, err := strconv.Atoi(ts[len(levlTimeLayout)+1:])
+	if err != nil {
+		return time.Time{}, err
+	}
+	return tim.Add(time.Duration(ms) * time.Millisecond), nil
+}
+
+// SetPeakTime sets timestamp of the peak data creation.
+func (ch *ChunkLEVL) SetPeakTime(tim time.Time) {
+	ts := tim.Format(levlTimeLayout)
+	ts += fmt.Sprintf(":%03d", tim.Nanosecond()/int(time.Millisecond))
+	ch.Timestamp = [28]byte{}
+	copy(ch.Timestamp[:], ts)
+}
+
+// Peak returns the positive and negative peak points for the given peak
+// frame and channel. The negative point is zero when PointsPerValue is 1.
+func (ch *ChunkLEVL) Peak(frame, channel int) (uint16, uint16, error) {
+	ppv := int(ch.PointsPerValue)
+	idx := (frame*int(ch.PeakChannels) + channel) * ppv
+	if frame < 0 || channel < 0 || channel >= int(ch.PeakChannels) ||
+		idx+ppv > len(ch.Peaks) {
+		return 0, 0, ErrOutOfRange
+	}
+	if ppv == 1 {
+		return ch.Peaks[idx], 0, nil
+	}
+	return ch.Peaks[idx], ch.Peaks[idx+1], nil
+}
+
+// pointSize returns the size of a single peak point in bytes.
+func (ch *ChunkLEVL) pointSize() (uint32, error) {
+	switch ch.Format {
+	case PeakFormat8:
+		return 1, nil
+	case PeakFormat16:
+		return 2, nil
+	default:
+		return 0, fmt.Errorf("peak format %d: %w", ch.Format, ErrUnsupportedFormat)
+	}
+}
+
+// updateSize updates the chunk size based on the number of peak points.
+func (ch *ChunkLEVL) updateSize() error {
+	ps, err := ch.pointSize()
+	if err != nil {
+		return err
+	}
+	ch.OffsetToPeaks = LEVLOffsetToPeaks + uint32(len(ch.gap))
+	ch.size = LEVLChunkSize + uint32(len(ch.gap)) + uint32(len(ch.Peaks))*ps
+	return nil
+}
+
+func (ch *ChunkLEVL) ReadFrom(r io.Reader) (int64, error) {
+	bo := ByteOrder(r)
+	var sum int64
+	if err := binary.Read(r, bo, &ch.size); err != nil {
+		return sum, fmt.Errorf(errFmtDecode, Uint32(IDlevl), err)
+	}
+	sum += 4
+
+	if ch.size < LEVLChunkSize {
+		return sum, fmt.Errorf(errFmtDecode, Uint32(IDlevl), ErrTooShort)
+	}
+
+	if err := binary.Read(r, bo, &ch.levlStatic); err != nil {
+		return sum, fmt.Errorf(errFmtDecode, Uint32(IDlevl), err)
+	}
+	sum += int64(LEVLChunkSize)
+
+	ps, err := ch.pointSize()
+	if err != nil {
+		return sum, fmt.Errorf(errFmtDecode, Uint32(IDlevl), err)
+	}
+
+	if ch.OffsetToPeaks < LEVLOffsetToPeaks ||
+		uint64(ch.OffsetToPeaks) > uint64(ch.size)+8 {
+		return sum, fmt.Errorf(errFmtDecode, Uint32(IDlevl), ErrChunkSizeMismatch)
+	}
+
+	ch.gap = grow(ch.gap, int(ch.OffsetToPeaks-LEVLOffsetToPeaks))
+	in, err := io.ReadFull(r, ch.gap)
+	sum += int64(in)
+	if err != nil {
+		return sum, fmt.Errorf(errFmtDecode, Uint32(IDlevl), err)
+	}
+
+	pl := ch.size - LEVLChunkSize - uint32(len(ch.gap))
+	if pl%ps != 0 {
+		return sum, fmt.Errorf(errFmtDecode, Uint32(IDlevl), ErrChunkSizeMismatch)
+	}
+
+	buf := make([]byte, pl)
+	in, err = io.ReadFull(r, buf)
+	sum += int64(in)
+	if err != nil {
+		return sum, fmt.Errorf(errFmtDecode, Uint32(IDlevl), err)
+	}
+
+	for i := 0; i < len(buf); i += int(ps) {
+		if ps == 1 {
+			ch.Peaks = append(ch.Peaks, uint16(buf[i]))
+			continue
+		}
+		ch.Peaks = append(ch.Peaks, bo.Uint16(buf[i:]))
+	}
+
+	n, err := ReadPaddingIf(r, ch.size)
+	sum += n
+	if err != nil {
+		return sum, fmt.Errorf(errFmtDecode, Uint32(IDlevl), err)
+	}
+
+	return sum, nil
+}
+
+func (ch *ChunkLEVL) WriteTo(w io.Writer) (int64, error) {
+	bo := ByteOrder(w)
+	var sum int64
+
+	if err := ch.updateSize(); err != nil {
+		return sum, fmt.Errorf(errFmtEncode, Uint32(IDlevl), err)
+	}
+
+	n, err := WriteIDAndSize(w, IDlevl, ch.size)
+	sum += n
+	if err != nil {
+		return sum, fmt.Errorf(errFmtEncode, Uint32(IDlevl), err)
+	}
+
+	if err = binary.Write(w, bo, ch.levlStatic); err != nil {
+		return sum, fmt.Errorf(errFmtEncode, Uint32(IDlevl), err)
+	}
+	sum += int64(LEVLChunkSize)
+
+	buf := &bytes.Buffer{}
+	buf.Write(ch.gap)
+	for _, p := range ch.Peaks {
+		if ch.Format == PeakFormat8 {
+			buf.WriteByte(byte(p))
+			continue
+		}
+		buf.Write(bo.AppendUint16(nil, p))
+	}
+
+	in, err := buf.WriteTo(w)
+	sum += in
+	if err != nil {
+		return sum, fmt.Errorf(errFmtEncode, Uint32(IDlevl), err)
+	}
+
+	n, err = WritePaddingIf(w, ch.size)
+	sum += n
+	if err != nil {
+		return sum, fmt.Errorf(errFmtEncode, Uint32(IDlevl), err)
+	}
+
+	return sum, nil
+}
+
+func (ch *ChunkLEVL) Reset() {
+	ch.size = LEVLChunkSize
+	ch.levlStatic = levlStatic{}
+	ch.OffsetToPeaks = LEVLOffsetToPeaks
+	ch.gap = ch.gap[:0]
+	ch.Peaks = ch.Peaks[:0]
+}
@@ -0,0 +1,277 @@
+package riff
+
+import (
+	"bytes"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/ctx42/testing/pkg/assert"
+	"github.com/ctx42/testing/pkg/kit/iokit"
+	"github.com/ctx42/testing/pkg/must"
+
+	"github.com/rzajac/riff/internal/test"
+)
+
+func levlChunk16bit(t *testing.T) io.Reader {
+	ts := make([]byte, 28)
+	copy(ts, "2024:05:06:07:08:09:010")
+
+	src := &bytes.Buffer{}
+	test.ReadFrom(t, src, Uint32(IDlevl))     // (  0)  4 - Chunk ID
+	test.WriteUint32LE(t, src, 128)           // (  4)  4 - Chunk size
+	test.WriteUint32LE(t, src, 0)             // (  8)  4 - Version
+	test.WriteUint32LE(t, src, 2)             // ( 12)  4 - Format
+	test.WriteUint32LE(t, src, 2)             // ( 16)  4 - Points per value
+	test.WriteUint32LE(t, src, 256)           // ( 20)  4 - Block size
+	test.WriteUint32LE(t, src, 1)             // ( 24)  4 - Peak channels
+	test.WriteUint32LE(t, src, 2)             // ( 28)  4 - Peak frames
+	test.WriteUint32LE(t, src, 300)           // ( 32)  4 - Position of peak of peaks
+	test.WriteUint32LE(t, src, 128)           // ( 36)  4 - Offset to peaks
+	test.WriteBytes(t, src, ts)               // ( 40) 28 - Timestamp
+	test.WriteBytes(t, src, make([]byte, 60)) // ( 68) 60 - Reserved
+	test.WriteUint16LE(t, src, 1)             // (128)  2 - Positive peak
+	test.WriteUint16LE(t, src, 2)             // (130)  2 - Negative peak
+	test.WriteUint16LE(t, src, 3)             // (132)  2 - Positive peak
+	test.WriteUint16LE(t, src, 4)             // (134)  2 - Negative peak
+	// Total length: 8+120+8=136
+	return src
+}
+
+func levlChunk8bit(t *testing.T) io.Reader {
+	src := &bytes.Buffer{}
+	test.ReadFrom(t, src, Uint32(IDlevl))     // (  0)  4 - Chunk ID
+	test.WriteUint32LE(t, src, 123)           // (  4)  4 - Chunk size
+	test.WriteUint32LE(t, src, 0)             // (  8)  4 - Version
+	test.WriteUint32LE(t, src, 1)             // ( 12)  4 - Format
+	test.WriteUint32LE(t, src, 1)             // ( 16)  4 - Points per value
+	test.WriteUint32LE(t, src, 256)           // ( 20)  4 - Block size
+	test.WriteUint32LE(t, src, 3)             // ( 24)  4 - Peak channels
+	test.WriteUint32LE(t, src, 1)             // ( 28)  4 - Peak frames
+	test.WriteUint32LE(t, src, 0xffffffff)    // ( 32)  4 - Position of peak of peaks
+	test.WriteUint32LE(t, src, 128)           // ( 36)  4 - Offset to peaks
+	test.WriteBytes(t, src, make([]byte, 28)) // ( 40) 28 - Timestamp
+	test.WriteBytes(t, src, make([]byte, 60)) // ( 68) 60 - Reserved
+	test.WriteBytes(t, src, []byte{1, 2, 3})  // (128)  3 - Peaks
+	test.WriteByte(t, src, 0)                 // (131)  1 - Padding byte
+	// Total length: 8+120+3+1=132
+	return src
+}
+
+func Test_ChunkLEVL_LEVL(t *testing.T) {
+	// --- When ---
+	ch := LEVL()
+
+	// --- Then ---
+	assert.Equal(t, IDlevl, ch.ID())
+	assert.Equal(t, uint32(120), ch.Size())
+	assert.Equal(t, uint32(0), ch.Type())
+	assert.False(t, ch.Multi())
+	assert.Nil(t, ch.Chunks())
+	assert.False(t, ch.Raw())
+	assert.Equal(t, LEVLOffsetToPeaks, ch.OffsetToPeaks)
+}
+
+func Test_ChunkLEVL_ReadFrom_16bit(t *testing.T) {
+	// --- Given ---
+	src := levlChunk16bit(t)
+	test.Skip4B(t, src) // Skip chunk ID.
+
+	// --- When ---
+	ch := LEVL()
+	n, err := ch.ReadFrom(src)
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, int64(132), n)
+	assert.Equal(t, uint32(128), ch.Size())
+	assert.Equal(t, PeakFormat16, ch.Format)
+	assert.Equal(t, uint32(2), ch.PointsPerValue)
+	assert.Equal(t, uint32(256), ch.BlockSize)
+	assert.Equal(t, uint32(1), ch.PeakChannels)
+	assert.Equal(t, uint32(2), ch.PeakFrames)
+	assert.Equal(t, uint32(300), ch.PosPeakOfPeaks)
+	assert.Equal(t, []uint16{1, 2, 3, 4}, ch.Peaks)
+	exp := time.Date(2024, 5, 6, 7, 8, 9, 10*int(time.Millisecond), time.UTC)
+	assert.Equal(t, exp, must.Value(ch.PeakTime()))
+	assert.True(t, test.IsAllRead(src))
+}
+
+func Test_ChunkLEVL_ReadFrom_8bit(t *testing.T) {
+	// --- Given ---
+	src := levlChunk8bit(t)
+	test.Skip4B(t, src) // Skip chunk ID.
+
+	// --- When ---
+	ch := LEVL()
+	n, err := ch.ReadFrom(src)
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, int64(128), n)
+	assert.Equal(t, uint32(123), ch.Size())
+	assert.Equal(t, []uint16{1, 2, 3}, ch.Peaks)
+	assert.True(t, test.IsAllRead(src))
+}
+
+func Test_ChunkLEVL_ReadFrom_Errors(t *testing.T) {
+	// Reading less than 132 bytes should always result in an error.
+	for i := 1; i < 132; i++ {
+		// --- Given ---
+		src := levlChunk16bit(t)
+		test.Skip4B(t, src) // Skip chunk ID.
+
+		// --- When ---
+		_, err := LEVL().ReadFrom(io.LimitReader(src, int64(i)))
+
+		// --- Then ---
+		assert.Error(t, err)
+	}
+}
+
+func Test_ChunkLEVL_ReadFrom_TooShortError(t *testing.T) {
+	// --- Given ---
+	src := &bytes.Buffer{}
+	test.WriteUint32LE(t, src, 119)
+
+	// --- When ---
+	n, err := LEVL().ReadFrom(src)
+
+	// --- Then ---
+	assert.ErrorIs(t, ErrTooShort, err)
+	assert.ErrorContain(t, "levl chunk", err)
+	assert.Equal(t, int64(4), n)
+}
+
+func Test_ChunkLEVL_ReadFrom_UnsupportedFormat(t *testing.T) {
+	// --- Given ---
+	src := &bytes.Buffer{}
+	test.WriteUint32LE(t, src, 120)
+	test.WriteUint32LE(t, src, 0)
+	test.WriteUint32LE(t, src, 3) // Format
+	test.WriteBytes(t, src, make([]byte, 112))
+
+	// --- When ---
+	_, err := LEVL().ReadFrom(src)
+
+	// --- Then ---
+	assert.ErrorIs(t, ErrUnsupportedFormat, err)
+}
+
+func Test_ChunkLEVL_Peak(t *testing.T) {
+	// --- Given ---
+	src := levlChunk16bit(t)
+	test.Skip4B(t, src) // Skip chunk ID.
+	ch := LEVL()
+	_, err := ch.ReadFrom(src)
+	assert.NoError(t, err)
+
+	t.Run("ok", func(t *testing.T) {
+		// --- When ---
+		pos, neg, err := ch.Peak(1, 0)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, uint16(3), pos)
+		assert.Equal(t, uint16(4), neg)
+	})
+
+	t.Run("out of range", func(t *testing.T) {
+		// --- When ---
+		_, _, err := ch.Peak(2, 0)
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrOutOfRange, err)
+	})
+
+	t.Run("invalid channel", func(t *testing.T) {
+		// --- When ---
+		_, _, err := ch.Peak(0, 1)
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrOutOfRange, err)
+	})
+}
+
+func Test_ChunkLEVL_SetPeakTime(t *testing.T) {
+	// --- Given ---
+	ch := LEVL()
+	tim := time.Date(2024, 5, 6, 7, 8, 9, 10*int(time.Millisecond), time.UTC)
+
+	// --- When ---
+	ch.SetPeakTime(tim)
+
+	// --- Then ---
+	assert.Equal(t, tim, must.Value(ch.PeakTime()))
+}
+
+func Test_ChunkLEVL_WriteTo(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		n  int64
+		ch func(*testing.T) io.Reader
+	}{
+		{"16bit", 136, levlChunk16bit},
+		{"8bit", 132, levlChunk8bit},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- Given ---
+			src := tc.ch(t)
+			test.Skip4B(t, src) // Skip chunk ID.
+
+			ch := LEVL()
+			_, err := ch.ReadFrom(src)
+			assert.NoError(t, err)
+
+			// --- When ---
+			dst := &bytes.Buffer{}
+			n, err := ch.WriteTo(dst)
+
+			// --- Then ---
+			assert.NoError(t, err)
+			assert.Equal(t, tc.n, n)
+			exp := must.Value(io.ReadAll(tc.ch(t)))
+			assert.Equal(t, exp, dst.Bytes())
+		})
+	}
+}
+
+func Test_ChunkLEVL_WriteTo_Errors(t *testing.T) {
+	// Writing less than 136 bytes should always result in an error.
+	for i := 136; i > 0; i-- {
+		// --- Given ---
+		src := levlChunk16bit(t)
+		test.Skip4B(t, src) // Skip chunk ID.
+
+		ch := LEVL()
+		_, err := ch.ReadFrom(src)
+		assert.NoError(t, err)
+
+		// --- When ---
+		_, err = ch.WriteTo(iokit.ErrWriter(&bytes.Buffer{}, i))
+
+		// --- Then ---
+		assert.Error(t, err)
+	}
+}
+
+func Test_ChunkLEVL_Reset(t *testing.T) {
+	// --- Given ---
+	src := levlChunk16bit(t)
+	test.Skip4B(t, src) // Skip chunk ID.
+	ch := LEVL()
+	_, err := ch.ReadFrom(src)
+	assert.NoError(t, err)
+
+	// --- When ---
+	ch.Reset()
+
+	// --- Then ---
+	assert.Equal(t, uint32(120), ch.Size())
+	assert.Equal(t, uint32(0), ch.Format)
+	assert.Equal(t, LEVLOffsetToPeaks, ch.OffsetToPeaks)
+	assert.Len(t, 0, ch.Peaks)
+}
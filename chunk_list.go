@@ -19,8 +19,19 @@ const (
 
 	// IDadtl represents sub-chunk ID "adtl" of the LIST chunk.
 	IDadtl uint32 = 0x6164746C
+
+	// IDwavl represents "wavl" (wave list) type of the LIST chunk.
+	IDwavl uint32 = 0x7761766c
 )
 
+// listSibling is implemented by the sub-chunks which format depends on the
+// sub-chunks preceding them in the list (e.g. "strf" on "strh").
+type listSibling interface {
+	// precededBy is called with the preceding sub-chunks before the
+	// sub-chunk is decoded.
+	precededBy(chs Chunks)
+}
+
 // ChunkLIST represents LIST chunk.
 type ChunkLIST struct {
 	// Chunk size in bytes.
@@ -66,7 +77,7 @@ func (ch *ChunkLIST) Raw() bool      { return false }
 func (ch *ChunkLIST) ReadFrom(r io.Reader) (int64, error) {
 	var sum int64
 
-	if err := binary.Read(r, le, &ch.size); err != nil {
+	if err := binary.Read(r, ByteOrder(r), &ch.size); err != nil {
 		return sum, fmt.Errorf(errFmtDecode, Uint32(IDLIST), err)
 	}
 	sum += 4
@@ -80,18 +91,7 @@ func (ch *ChunkLIST) ReadFrom(r io.Reader) (int64, error) {
 	}
 	sum += int64(ListTypeSize)
 
-	var mkr IDMaker
-	switch ch.ListType {
-	case IDINFO:
-		mkr = INFOMake(ch.load)
-	case IDadtl:
-		ch.reg.Register(IDlabl, LABLMake)
-		ch.reg.Register(IDltxt, LTXTMake)
-		mkr = RAWCMake(ch.load)
-
-	default:
-		mkr = RAWCMake(ch.load)
-	}
+	scoped := ch.reg.List(ch.ListType)
 
 	var n int64
 	var id uint32
@@ -107,12 +107,13 @@ func (ch *ChunkLIST) ReadFrom(r io.Reader) (int64, error) {
 		}
 		sum += 4
 
-		dec := ch.reg.GetNoRaw(id)
-		if dec == nil {
-			dec = mkr(id)
-		}
+		dec := ch.decoder(scoped, id)
 		dec.Reset()
 
+		if sib, ok := dec.(listSibling); ok {
+			sib.precededBy(ch.chunks)
+		}
+
 		n, err = dec.ReadFrom(r)
 		sum += n
 		if err != nil {
@@ -169,11 +170,28 @@ func (ch *ChunkLIST) WriteTo(w io.Writer) (int64, error) {
 	return sum, nil
 }
 
+// decoder returns decoder for the sub-chunk with given ID. The decoders
+// registered for the list type take precedence over the ones registered in
+// the registry. Unknown sub-chunks are decoded with [ChunkRAWC].
+func (ch *ChunkLIST) decoder(scoped *Registry, id uint32) Chunk {
+	if dec := scoped.GetNoRaw(id); dec != nil {
+		return dec
+	}
+	if scoped.def != nil {
+		return scoped.def(id)
+	}
+	if dec := ch.reg.GetNoRaw(id); dec != nil {
+		return dec
+	}
+	return RAWC(id, ch.load)
+}
+
 func (ch *ChunkLIST) Reset() {
+	scoped := ch.reg.List(ch.ListType)
 	ch.size = 0
 	ch.ListType = 0
 	for _, dec := range ch.chunks {
-		ch.reg.Put(dec)
+		scoped.Put(dec)
 	}
 	ch.chunks = ch.chunks[:0]
 }
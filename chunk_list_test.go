@@ -77,7 +77,7 @@ func Test_ChunkLIST_LIST(t *testing.T) {
 
 func Test_ChunkLIST_Type_INFO(t *testing.T) {
 	// --- Given ---
-	reg := NewRegistry(RAWCMake(LoadData))
+	reg := New(LoadData).reg
 
 	src := listChunkType_INFO(t)
 	test.Skip4B(t, src) // Skip chunk ID.
@@ -99,7 +99,7 @@ func Test_ChunkLIST_Type_INFO(t *testing.T) {
 
 func Test_ChunkLIST_Type_adtl(t *testing.T) {
 	// --- Given ---
-	reg := NewRegistry(RAWCMake(LoadData))
+	reg := New(LoadData).reg
 
 	src := listChunkType_adtl(t)
 	test.Skip4B(t, src) // Skip chunk ID.
@@ -123,6 +123,36 @@ func Test_ChunkLIST_Type_adtl(t *testing.T) {
 	assert.Equal(t, IDltxt, sub.ID())
 }
 
+func Test_ChunkLIST_Type_exif(t *testing.T) {
+	// --- Given ---
+	reg := New(LoadData).reg
+
+	src := listChunkType_exif(t)
+	test.Skip4B(t, src) // Skip chunk ID.
+
+	// --- When ---
+	ch := LIST(LoadData, reg)
+	n, err := ch.ReadFrom(src)
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, int64(52), n)
+	assert.Equal(t, IDexif, ch.Type())
+	assert.Len(t, 3, ch.Chunks())
+
+	sub := ch.Chunks()[0]
+	assert.Type(t, &ChunkEXIF{}, sub)
+	assert.Equal(t, "0220", sub.(*ChunkEXIF).Text())
+
+	sub = ch.Chunks()[1]
+	assert.Type(t, &ChunkEXIF{}, sub)
+	assert.Equal(t, "Canon", sub.(*ChunkEXIF).Text())
+
+	sub = ch.Chunks()[2]
+	assert.Type(t, &ChunkEUCM{}, sub)
+	assert.Equal(t, "Hi", sub.(*ChunkEUCM).Comment())
+}
+
 func Test_ChunkLIST_Type_unknown(t *testing.T) {
 	// --- Given ---
 	reg := NewRegistry(RAWCMake(LoadData))
@@ -145,6 +175,64 @@ func Test_ChunkLIST_Type_unknown(t *testing.T) {
 	assert.Equal(t, LabIART, sub.ID())
 }
 
+func Test_ChunkLIST_RegisterList(t *testing.T) {
+	// --- Given ---
+	reg := NewRegistry(RAWCMake(LoadData))
+	reg.RegisterList(IDUNKN, LabIART, func() Chunk { return INFO(LabIART) })
+
+	src := listChunkType_unknown(t)
+	test.Skip4B(t, src) // Skip chunk ID.
+
+	// --- When ---
+	ch := LIST(LoadData, reg)
+	n, err := ch.ReadFrom(src)
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, int64(20), n)
+	assert.Len(t, 1, ch.Chunks())
+	assert.Type(t, &ChunkINFO{}, ch.Chunks()[0])
+	assert.False(t, reg.Has(LabIART))
+}
+
+func Test_ChunkLIST_RegisterList_OverridesBuiltIn(t *testing.T) {
+	// --- Given ---
+	reg := New(LoadData).reg
+	reg.RegisterList(IDadtl, IDlabl, func() Chunk { return RAWC(IDlabl, LoadData) })
+
+	src := listChunkType_adtl(t)
+	test.Skip4B(t, src) // Skip chunk ID.
+
+	// --- When ---
+	ch := LIST(LoadData, reg)
+	_, err := ch.ReadFrom(src)
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Type(t, &ChunkRAWC{}, ch.Chunks()[0])
+	assert.Type(t, &ChunkLTXT{}, ch.Chunks()[1])
+}
+
+func Test_ChunkLIST_DoesNotModifyRegistry(t *testing.T) {
+	// --- Given ---
+	reg := New(LoadData).reg
+	src := listChunkType_adtl(t)
+	test.Skip4B(t, src) // Skip chunk ID.
+
+	ch := LIST(LoadData, reg)
+	_, err := ch.ReadFrom(src)
+	assert.NoError(t, err)
+
+	// --- When ---
+	ch.Reset()
+
+	// --- Then ---
+	assert.False(t, reg.Has(IDlabl))
+	assert.False(t, reg.Has(IDltxt))
+	assert.Type(t, &ChunkRAWC{}, reg.Get(IDlabl))
+	assert.Type(t, &ChunkLABL{}, reg.List(IDadtl).Get(IDlabl))
+}
+
 func Test_ChunkLIST_ReadFrom_Errors(t *testing.T) {
 	// Reading less than 20 bytes should always result in an error.
 	for i := 1; i < 20; i++ {
@@ -188,6 +276,7 @@ func Test_ChunkLIST_WriteTo(t *testing.T) {
 	}{
 		{"listChunkType_INFO", 24, listChunkType_INFO},
 		{"listChunkType_adtl", 60, listChunkType_adtl},
+		{"listChunkType_exif", 56, listChunkType_exif},
 	}
 
 	for _, tc := range tt {
@@ -285,3 +374,223 @@ func Test_ChunkLIST_Modify(t *testing.T) {
 		assert.Nil(t, ch.Chunks().First(IDltxt))
 	})
 }
+
+func Test_ChunkLIST_Type_strl(t *testing.T) {
+	// --- Given ---
+	reg := New(LoadData).reg
+
+	src := listChunkType_strl(t)
+	test.Skip4B(t, src) // Skip chunk ID.
+
+	// --- When ---
+	ch := LIST(LoadData, reg)
+	n, err := ch.ReadFrom(src)
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, int64(140), n)
+	assert.Equal(t, IDstrl, ch.Type())
+	assert.Len(t, 3, ch.Chunks())
+
+	sub := ch.Chunks()[0]
+	assert.Type(t, &ChunkSTRH{}, sub)
+	assert.Equal(t, StreamVideo, sub.(*ChunkSTRH).FccType)
+
+	sub = ch.Chunks()[1]
+	assert.Type(t, &ChunkSTRF{}, sub)
+	assert.Equal(t, StreamVideo, sub.(*ChunkSTRF).StreamType)
+	assert.Equal(t, int32(80), sub.(*ChunkSTRF).Video.Width)
+
+	sub = ch.Chunks()[2]
+	assert.Type(t, &ChunkSTRN{}, sub)
+	assert.Equal(t, "Video", sub.(*ChunkSTRN).Name())
+}
+
+func Test_ChunkLIST_Type_strl_RegisterList(t *testing.T) {
+	// --- Given ---
+	reg := New(LoadData).reg
+	reg.RegisterList(IDstrl, IDstrh, func() Chunk { return RAWC(IDstrh, LoadData) })
+
+	src := listChunkType_strl(t)
+	test.Skip4B(t, src) // Skip chunk ID.
+
+	// --- When ---
+	ch := LIST(LoadData, reg)
+	_, err := ch.ReadFrom(src)
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Type(t, &ChunkRAWC{}, ch.Chunks()[0])
+	assert.Type(t, &ChunkSTRF{}, ch.Chunks()[1])
+	assert.Equal(t, uint32(0), ch.Chunks()[1].(*ChunkSTRF).StreamType)
+}
+
+func Test_ChunkLIST_Type_strl_PooledSTRF(t *testing.T) {
+	// --- Given ---
+	reg := New(LoadData).reg
+	ch := LIST(LoadData, reg)
+	src := listChunkType_strl(t)
+	test.Skip4B(t, src) // Skip chunk ID.
+	_, err := ch.ReadFrom(src)
+	assert.NoError(t, err)
+	ch.Reset()
+
+	src = listChunkType_strl(t)
+	test.Skip4B(t, src) // Skip chunk ID.
+
+	// --- When ---
+	_, err = ch.ReadFrom(src)
+
+	// --- Then ---
+	assert.NoError(t, err)
+	strf := ch.Chunks().First(IDstrf).(*ChunkSTRF)
+	assert.Equal(t, StreamVideo, strf.StreamType)
+	assert.NotNil(t, strf.Video)
+}
+
+func Test_ChunkLIST_Type_odml(t *testing.T) {
+	// --- Given ---
+	src := &bytes.Buffer{}
+	test.WriteUint32LE(t, src, 4+20)
+	test.WriteBytes(t, src, []byte("odml"))
+	test.WriteBytes(t, src, must.Value(io.ReadAll(dmlhChunk(t))))
+
+	// --- When ---
+	ch := LIST(LoadData, New(LoadData).reg)
+	n, err := ch.ReadFrom(src)
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, int64(28), n)
+	assert.Type(t, &ChunkDMLH{}, ch.Chunks()[0])
+	assert.Equal(t, uint32(1500), ch.Chunks()[0].(*ChunkDMLH).TotalFrames)
+}
+
+func Test_ChunkLIST_Type_movi_StdIndex(t *testing.T) {
+	// --- Given ---
+	src := &bytes.Buffer{}
+	test.WriteUint32LE(t, src, 4+48)
+	test.WriteBytes(t, src, []byte("movi"))
+	test.WriteBytes(t, src, must.Value(io.ReadAll(indxChunkStd(t))))
+
+	// --- When ---
+	ch := LIST(LoadData, New(LoadData).reg)
+	_, err := ch.ReadFrom(src)
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Type(t, &ChunkINDX{}, ch.Chunks()[0])
+	assert.Equal(t, "ix01", Uint32(ch.Chunks()[0].ID()).String())
+}
+
+func Test_ChunkLIST_Type_fram(t *testing.T) {
+	// --- Given ---
+	src := &bytes.Buffer{}
+	test.WriteUint32LE(t, src, 4+16)
+	test.WriteBytes(t, src, []byte("fram"))
+	test.WriteBytes(t, src, must.Value(io.ReadAll(iconChunk(t))))
+
+	// --- When ---
+	ch := LIST(LoadData, New(LoadData).reg)
+	n, err := ch.ReadFrom(src)
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, int64(24), n)
+	assert.Type(t, &ChunkICON{}, ch.Chunks()[0])
+	assert.Equal(t, icoFile(0xaa), ch.Chunks()[0].(*ChunkICON).Data())
+}
+
+func Test_ChunkLIST_Type_rgn2(t *testing.T) {
+	// --- Given ---
+	src := &bytes.Buffer{}
+	test.WriteUint32LE(t, src, 4+22+20+44)
+	test.WriteBytes(t, src, []byte("rgn2"))
+	test.WriteBytes(t, src, must.Value(io.ReadAll(rgnhChunk(t))))
+	test.WriteBytes(t, src, must.Value(io.ReadAll(wlnkChunk(t))))
+	test.WriteBytes(t, src, must.Value(io.ReadAll(wsmpChunk(t))))
+
+	// --- When ---
+	ch := LIST(LoadData, New(LoadData).reg)
+	n, err := ch.ReadFrom(src)
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, int64(94), n)
+	assert.Type(t, &ChunkRGNH{}, ch.Chunks()[0])
+	assert.Type(t, &ChunkWLNK{}, ch.Chunks()[1])
+	assert.Type(t, &ChunkWSMP{}, ch.Chunks()[2])
+}
+
+func Test_ChunkLIST_Type_lart(t *testing.T) {
+	// --- Given ---
+	src := &bytes.Buffer{}
+	test.WriteUint32LE(t, src, 4+28)
+	test.WriteBytes(t, src, []byte("lart"))
+	test.WriteBytes(t, src, must.Value(io.ReadAll(artChunk(t))))
+
+	// --- When ---
+	ch := LIST(LoadData, New(LoadData).reg)
+	_, err := ch.ReadFrom(src)
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Type(t, &ChunkART{}, ch.Chunks()[0])
+	assert.Equal(t, IDart1, ch.Chunks()[0].ID())
+}
+
+func Test_ChunkLIST_Type_sdta(t *testing.T) {
+	// --- Given ---
+	src := &bytes.Buffer{}
+	test.WriteUint32LE(t, src, 4+12+12)
+	test.WriteBytes(t, src, []byte("sdta"))
+	test.WriteBytes(t, src, must.Value(io.ReadAll(sdtaChunk(t, IDsmpl))))
+	test.WriteBytes(t, src, must.Value(io.ReadAll(sdtaChunk(t, IDsm24))))
+
+	// --- When ---
+	ch := LIST(LoadData, New(LoadData).reg)
+	n, err := ch.ReadFrom(src)
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, int64(32), n)
+	assert.Type(t, &ChunkSDTA{}, ch.Chunks()[0])
+	assert.Equal(t, IDsmpl, ch.Chunks()[0].ID())
+	assert.Type(t, &ChunkSDTA{}, ch.Chunks()[1])
+	assert.Equal(t, IDsm24, ch.Chunks()[1].ID())
+}
+
+func Test_ChunkLIST_Type_pdta(t *testing.T) {
+	// --- Given ---
+	src := &bytes.Buffer{}
+	test.WriteUint32LE(t, src, 4+84+16+18+16+52+16+18+16+54)
+	test.WriteBytes(t, src, []byte("pdta"))
+	test.WriteBytes(t, src, must.Value(io.ReadAll(phdrChunk(t))))
+	test.WriteBytes(t, src, must.Value(io.ReadAll(bagChunk(t, IDpbag))))
+	test.WriteBytes(t, src, must.Value(io.ReadAll(modChunk(t, IDpmod))))
+	test.WriteBytes(t, src, must.Value(io.ReadAll(genChunk(t, IDpgen))))
+	test.WriteBytes(t, src, must.Value(io.ReadAll(instChunk(t))))
+	test.WriteBytes(t, src, must.Value(io.ReadAll(bagChunk(t, IDibag))))
+	test.WriteBytes(t, src, must.Value(io.ReadAll(modChunk(t, IDimod))))
+	test.WriteBytes(t, src, must.Value(io.ReadAll(genChunk(t, IDigen))))
+	test.WriteBytes(t, src, must.Value(io.ReadAll(shdrChunk(t))))
+
+	// --- When ---
+	ch := LIST(LoadData, New(LoadData).reg)
+	n, err := ch.ReadFrom(src)
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, int64(298), n)
+	assert.Type(t, &ChunkPHDR{}, ch.Chunks()[0])
+	assert.Type(t, &ChunkBAG{}, ch.Chunks()[1])
+	assert.Type(t, &ChunkMOD{}, ch.Chunks()[2])
+	assert.Type(t, &ChunkGEN{}, ch.Chunks()[3])
+	assert.Type(t, &ChunkINST{}, ch.Chunks()[4])
+	assert.Type(t, &ChunkBAG{}, ch.Chunks()[5])
+	assert.Equal(t, IDibag, ch.Chunks()[5].ID())
+	assert.Type(t, &ChunkMOD{}, ch.Chunks()[6])
+	assert.Type(t, &ChunkGEN{}, ch.Chunks()[7])
+	assert.Equal(t, IDigen, ch.Chunks()[7].ID())
+	assert.Type(t, &ChunkSHDR{}, ch.Chunks()[8])
+}
@@ -89,8 +89,9 @@ func (ch *ChunkLTXT) Text() io.Reader {
 }
 
 func (ch *ChunkLTXT) ReadFrom(r io.Reader) (int64, error) {
+	bo := ByteOrder(r)
 	var sum int64
-	if err := binary.Read(r, le, &ch.size); err != nil {
+	if err := binary.Read(r, bo, &ch.size); err != nil {
 		return sum, fmt.Errorf(errFmtDecode, linkids(IDINFO, IDltxt), err)
 	}
 	sum += 4
@@ -99,7 +100,7 @@ func (ch *ChunkLTXT) ReadFrom(r io.Reader) (int64, error) {
 		return sum, fmt.Errorf(errFmtDecode, linkids(IDINFO, IDltxt), ErrTooShort)
 	}
 
-	if err := binary.Read(r, le, &ch.ltxtStatic); err != nil {
+	if err := binary.Read(r, bo, &ch.ltxtStatic); err != nil {
 		return sum, fmt.Errorf(errFmtDecode, linkids(IDINFO, IDltxt), err)
 	}
 	sum += int64(LTXTChunkSize)
@@ -131,7 +132,7 @@ func (ch *ChunkLTXT) WriteTo(w io.Writer) (int64, error) {
 		return sum, fmt.Errorf(errFmtEncode, linkids(IDINFO, IDltxt), err)
 	}
 
-	if err = binary.Write(w, le, ch.ltxtStatic); err != nil {
+	if err = binary.Write(w, ByteOrder(w), ch.ltxtStatic); err != nil {
 		return sum, fmt.Errorf(errFmtEncode, linkids(IDINFO, IDltxt), err)
 	}
 	sum += int64(LTXTChunkSize)
@@ -0,0 +1,150 @@
+package riff
+
+import (
+	"fmt"
+	"io"
+)
+
+// IDMARK represents "MARK" (marker) chunk ID of the AIFF file.
+const IDMARK uint32 = 0x4d41524b
+
+// MARKChunkSize represents the size of the "MARK" chunk static part in
+// bytes. Does not count ID and markers.
+const MARKChunkSize uint32 = 2
+
+// AIFFMarker represents a single marker in [ChunkMARK].
+type AIFFMarker struct {
+	// Unique positive marker ID. Other chunks (e.g. INST, COMT) refer to
+	// the marker by this ID.
+	ID uint16
+
+	// Position of the marker in sample frames. The marker points to the
+	// location between two sample frames, zero is before the first one.
+	Position uint32
+
+	// Marker name.
+	Name string
+}
+
+// ChunkMARK represents the "MARK" chunk of the AIFF file with the markers
+// pointing to positions in the sound data.
+type ChunkMARK struct {
+	// List of markers.
+	Markers []AIFFMarker
+}
+
+// MARKMake is a [Maker] function for creating [ChunkMARK] instances.
+func MARKMake() Chunk { return MARK() }
+
+// MARK returns a new instance of [ChunkMARK].
+func MARK() *ChunkMARK {
+	return &ChunkMARK{}
+}
+
+func (ch *ChunkMARK) ID() uint32 { return IDMARK }
+
+func (ch *ChunkMARK) Size() uint32 {
+	size := MARKChunkSize
+	for _, m := range ch.Markers {
+		size += 6 + pstringSize(m.Name)
+	}
+	return size
+}
+
+func (ch *ChunkMARK) Type() uint32   { return 0 }
+func (ch *ChunkMARK) Multi() bool    { return false }
+func (ch *ChunkMARK) Chunks() Chunks { return nil }
+func (ch *ChunkMARK) Raw() bool      { return false }
+
+// Marker returns marker with given ID. Returns false if the marker does
+// not exist.
+func (ch *ChunkMARK) Marker(id uint16) (AIFFMarker, bool) {
+	for _, m := range ch.Markers {
+		if m.ID == id {
+			return m, true
+		}
+	}
+	return AIFFMarker{}, false
+}
+
+func (ch *ChunkMARK) ReadFrom(r io.Reader) (int64, error) {
+	bo := ByteOrder(r)
+	var sum int64
+	size, err := ReadChunkSize(r)
+	if err != nil {
+		return sum, fmt.Errorf(errFmtDecode, Uint32(IDMARK), err)
+	}
+	sum += 4
+
+	if size < MARKChunkSize {
+		return sum, fmt.Errorf(errFmtDecode, Uint32(IDMARK), ErrTooShort)
+	}
+
+	buf := make([]byte, size)
+	in, err := io.ReadFull(r, buf)
+	sum += int64(in)
+	if err != nil {
+		return sum, fmt.Errorf(errFmtDecode, Uint32(IDMARK), err)
+	}
+
+	cnt := int(bo.Uint16(buf))
+	buf = buf[MARKChunkSize:]
+	for i := 0; i < cnt; i++ {
+		if len(buf) < 6 {
+			return sum, fmt.Errorf(errFmtDecode, Uint32(IDMARK), ErrTooShort)
+		}
+		m := AIFFMarker{
+			ID:       bo.Uint16(buf),
+			Position: bo.Uint32(buf[2:]),
+		}
+		name, n, err := readPString(buf[6:])
+		if err != nil {
+			return sum, fmt.Errorf(errFmtDecode, Uint32(IDMARK), err)
+		}
+		m.Name = name
+		ch.Markers = append(ch.Markers, m)
+		buf = buf[6+n:]
+	}
+
+	n, err := ReadPaddingIf(r, size)
+	sum += n
+	if err != nil {
+		return sum, fmt.Errorf(errFmtDecode, Uint32(IDMARK), err)
+	}
+
+	return sum, nil
+}
+
+func (ch *ChunkMARK) WriteTo(w io.Writer) (int64, error) {
+	bo := ByteOrder(w)
+	var sum int64
+
+	if len(ch.Markers) > 0xffff {
+		return sum, fmt.Errorf(errFmtEncode, Uint32(IDMARK), ErrOutOfRange)
+	}
+
+	buf := bo.AppendUint16(make([]byte, 0, ch.Size()), uint16(len(ch.Markers)))
+	for _, m := range ch.Markers {
+		buf = bo.AppendUint16(buf, m.ID)
+		buf = bo.AppendUint32(buf, m.Position)
+		buf = appendPString(buf, m.Name)
+	}
+
+	n, err := WriteIDAndSize(w, IDMARK, uint32(len(buf)))
+	sum += n
+	if err != nil {
+		return sum, fmt.Errorf(errFmtEncode, Uint32(IDMARK), err)
+	}
+
+	in, err := w.Write(buf)
+	sum += int64(in)
+	if err != nil {
+		return sum, fmt.Errorf(errFmtEncode, Uint32(IDMARK), err)
+	}
+
+	return sum, nil
+}
+
+func (ch *ChunkMARK) Reset() {
+	ch.Markers = ch.Markers[:0]
+}
@@ -0,0 +1,171 @@
+package riff
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/ctx42/testing/pkg/assert"
+	"github.com/ctx42/testing/pkg/kit/iokit"
+	"github.com/ctx42/testing/pkg/must"
+
+	"github.com/rzajac/riff/internal/test"
+)
+
+func markChunk(t *testing.T) io.Reader {
+	src := &bytes.Buffer{}
+	test.ReadFrom(t, src, Uint32(IDMARK))        // ( 0) 4 - Chunk ID
+	test.WriteUint32BE(t, src, 22)               // ( 4) 4 - Chunk size
+	test.WriteUint16BE(t, src, 2)                // ( 8) 2 - Number of markers
+	test.WriteUint16BE(t, src, 1)                // (10) 2 - Marker ID
+	test.WriteUint32BE(t, src, 10)               // (12) 4 - Position
+	test.WriteBytes(t, src, []byte("\x05start")) // (16) 6 - Name
+	test.WriteUint16BE(t, src, 2)                // (22) 2 - Marker ID
+	test.WriteUint32BE(t, src, 20)               // (24) 4 - Position
+	test.WriteBytes(t, src, []byte{0, 0})        // (28) 2 - Name
+	// Total length: 8+22=30
+	return src
+}
+
+func Test_ChunkMARK_MARK(t *testing.T) {
+	// --- When ---
+	ch := MARK()
+
+	// --- Then ---
+	assert.Equal(t, IDMARK, ch.ID())
+	assert.Equal(t, MARKChunkSize, ch.Size())
+	assert.Equal(t, uint32(0), ch.Type())
+	assert.False(t, ch.Multi())
+	assert.Nil(t, ch.Chunks())
+	assert.False(t, ch.Raw())
+}
+
+func Test_ChunkMARK_ReadFrom(t *testing.T) {
+	// --- Given ---
+	src := markChunk(t)
+	test.Skip4B(t, src) // Skip chunk ID.
+
+	// --- When ---
+	ch := MARK()
+	n, err := ch.ReadFrom(OrderReader(src, be))
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, int64(26), n)
+	assert.Equal(t, uint32(22), ch.Size())
+	exp := []AIFFMarker{
+		{ID: 1, Position: 10, Name: "start"},
+		{ID: 2, Position: 20, Name: ""},
+	}
+	assert.Equal(t, exp, ch.Markers)
+	assert.True(t, test.IsAllRead(src))
+}
+
+func Test_ChunkMARK_ReadFrom_Errors(t *testing.T) {
+	// Reading less than 26 bytes should always result in an error.
+	for _, i := range []int{1, 4, 5, 10, 25} {
+		// --- Given ---
+		src := markChunk(t)
+		test.Skip4B(t, src) // Skip chunk ID.
+
+		// --- When ---
+		_, err := MARK().ReadFrom(OrderReader(io.LimitReader(src, int64(i)), be))
+
+		// --- Then ---
+		assert.Error(t, err)
+	}
+}
+
+func Test_ChunkMARK_ReadFrom_TooShort(t *testing.T) {
+	t.Run("chunk", func(t *testing.T) {
+		// --- Given ---
+		src := &bytes.Buffer{}
+		test.WriteUint32BE(t, src, 1)
+
+		// --- When ---
+		_, err := MARK().ReadFrom(OrderReader(src, be))
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrTooShort, err)
+	})
+
+	t.Run("marker", func(t *testing.T) {
+		// --- Given ---
+		src := &bytes.Buffer{}
+		test.WriteUint32BE(t, src, 6)
+		test.WriteUint16BE(t, src, 1)
+		test.WriteBytes(t, src, []byte{0, 1, 0, 0})
+
+		// --- When ---
+		_, err := MARK().ReadFrom(OrderReader(src, be))
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrTooShort, err)
+	})
+}
+
+func Test_ChunkMARK_Marker(t *testing.T) {
+	// --- Given ---
+	ch := MARK()
+	ch.Markers = []AIFFMarker{{ID: 1, Position: 10}, {ID: 3, Position: 30}}
+
+	// --- When ---
+	have, ok := ch.Marker(3)
+
+	// --- Then ---
+	assert.True(t, ok)
+	assert.Equal(t, AIFFMarker{ID: 3, Position: 30}, have)
+
+	_, ok = ch.Marker(2)
+	assert.False(t, ok)
+}
+
+func Test_ChunkMARK_WriteTo(t *testing.T) {
+	// --- Given ---
+	ch := MARK()
+	ch.Markers = []AIFFMarker{
+		{ID: 1, Position: 10, Name: "start"},
+		{ID: 2, Position: 20},
+	}
+
+	// --- When ---
+	dst := &bytes.Buffer{}
+	n, err := ch.WriteTo(OrderWriter(dst, be))
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, int64(30), n)
+	assert.Equal(t, uint32(22), ch.Size())
+	exp := must.Value(io.ReadAll(markChunk(t)))
+	assert.Equal(t, exp, dst.Bytes())
+}
+
+func Test_ChunkMARK_WriteTo_Errors(t *testing.T) {
+	for _, i := range []int{1, 4, 8, 20} {
+		// --- Given ---
+		ch := MARK()
+		ch.Markers = []AIFFMarker{{ID: 1, Position: 10, Name: "start"}}
+
+		// --- When ---
+		_, err := ch.WriteTo(iokit.ErrWriter(&bytes.Buffer{}, i))
+
+		// --- Then ---
+		assert.Error(t, err)
+	}
+}
+
+func Test_ChunkMARK_Reset(t *testing.T) {
+	// --- Given ---
+	src := markChunk(t)
+	test.Skip4B(t, src) // Skip chunk ID.
+	ch := MARK()
+	_, err := ch.ReadFrom(OrderReader(src, be))
+	assert.NoError(t, err)
+
+	// --- When ---
+	ch.Reset()
+
+	// --- Then ---
+	assert.Equal(t, MARKChunkSize, ch.Size())
+	assert.Len(t, 0, ch.Markers)
+}
@@ -0,0 +1,126 @@
+package riff
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+)
+
+// ChunkMIDI represents the "data" chunk of the RIFF RMID file which holds
+// the Standard MIDI File. The file is rebuilt from the tracks only when
+// they are edited.
+type ChunkMIDI struct {
+	// Chunk size in bytes.
+	// The ID and extra padding byte is not counted in the chunk size.
+	size uint32
+
+	// Chunk body as read from the file.
+	raw []byte
+
+	// SMF encoding right after decoding. Used to detect edits.
+	enc []byte
+
+	// Decoded Standard MIDI File. It's nil when the chunk data is not a
+	// valid Standard MIDI File, the data is then written back as read.
+	SMF *SMF
+}
+
+// MIDIMake is a [Maker] function for creating [ChunkMIDI] instances.
+func MIDIMake() Chunk { return MIDI() }
+
+// MIDI returns a new instance of [ChunkMIDI] with an empty format 0 file.
+func MIDI() *ChunkMIDI {
+	return &ChunkMIDI{SMF: &SMF{Division: 96}}
+}
+
+func (ch *ChunkMIDI) ID() uint32     { return IDdata }
+func (ch *ChunkMIDI) Size() uint32   { return uint32(len(ch.body())) }
+func (ch *ChunkMIDI) Type() uint32   { return 0 }
+func (ch *ChunkMIDI) Multi() bool    { return false }
+func (ch *ChunkMIDI) Chunks() Chunks { return nil }
+func (ch *ChunkMIDI) Raw() bool      { return false }
+
+// body returns the chunk body to write.
+func (ch *ChunkMIDI) body() []byte {
+	if ch.SMF == nil {
+		return ch.raw
+	}
+	enc := ch.SMF.Bytes()
+	if ch.enc != nil && bytes.Equal(enc, ch.enc) {
+		return ch.raw
+	}
+	return enc
+}
+
+func (ch *ChunkMIDI) ReadFrom(r io.Reader) (int64, error) {
+	var sum int64
+	if err := binary.Read(r, ByteOrder(r), &ch.size); err != nil {
+		return sum, fmt.Errorf(errFmtDecode, linkids(TypeRMID, IDdata), err)
+	}
+	sum += 4
+
+	ch.raw = grow(ch.raw, int(ch.size))
+	in, err := io.ReadFull(r, ch.raw)
+	sum += int64(in)
+	if err != nil {
+		return sum, fmt.Errorf(errFmtDecode, linkids(TypeRMID, IDdata), err)
+	}
+
+	ch.SMF, ch.enc = nil, nil
+	if smf, err := ParseSMF(ch.raw); err == nil {
+		ch.SMF, ch.enc = smf, smf.Bytes()
+	}
+
+	n, err := ReadPaddingIf(r, ch.size)
+	sum += n
+	if err != nil {
+		return sum, fmt.Errorf(errFmtDecode, linkids(TypeRMID, IDdata), err)
+	}
+
+	return sum, nil
+}
+
+func (ch *ChunkMIDI) WriteTo(w io.Writer) (int64, error) {
+	var sum int64
+
+	body := ch.body()
+	ch.size = uint32(len(body))
+
+	n, err := WriteIDAndSize(w, IDdata, ch.size)
+	sum += n
+	if err != nil {
+		return sum, fmt.Errorf(errFmtEncode, linkids(TypeRMID, IDdata), err)
+	}
+
+	in, err := w.Write(body)
+	sum += int64(in)
+	if err != nil {
+		return sum, fmt.Errorf(errFmtEncode, linkids(TypeRMID, IDdata), err)
+	}
+
+	n, err = WritePaddingIf(w, ch.size)
+	sum += n
+	if err != nil {
+		return sum, fmt.Errorf(errFmtEncode, linkids(TypeRMID, IDdata), err)
+	}
+
+	return sum, nil
+}
+
+func (ch *ChunkMIDI) Reset() {
+	ch.size = 0
+	ch.raw = ch.raw[:0]
+	ch.enc = nil
+	ch.SMF = &SMF{Division: 96}
+}
+
+// ComposeRMID returns a new RIFF RMID file with the "data" chunk holding
+// the smf followed by chs (e.g. "LIST INFO" or "DISP" chunks).
+func ComposeRMID(smf *SMF, chs ...Chunk) *RIFF {
+	data := MIDI()
+	data.SMF = smf
+	rif := Compose(append(Chunks{data}, chs...))
+	rif.SetType(TypeRMID)
+	return rif
+}
@@ -0,0 +1,201 @@
+package riff
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/ctx42/testing/pkg/assert"
+	"github.com/ctx42/testing/pkg/kit/iokit"
+	"github.com/ctx42/testing/pkg/must"
+
+	"github.com/rzajac/riff/internal/test"
+)
+
+func midiChunk(t *testing.T) io.Reader {
+	src := &bytes.Buffer{}
+	test.ReadFrom(t, src, Uint32(IDdata)) // ( 0)  4 - Chunk ID
+	test.WriteUint32LE(t, src, 69)        // ( 4)  4 - Chunk size
+	test.WriteBytes(t, src, smfFile(t))   // ( 8) 69 - Standard MIDI File
+	test.WriteByte(t, src, 0)             // (77)  1 - Padding byte
+	// Total length: 8+69+1=78
+	return src
+}
+
+func Test_ChunkMIDI_MIDI(t *testing.T) {
+	// --- When ---
+	ch := MIDI()
+
+	// --- Then ---
+	assert.Equal(t, IDdata, ch.ID())
+	assert.Equal(t, uint32(14), ch.Size())
+	assert.Equal(t, uint32(0), ch.Type())
+	assert.False(t, ch.Multi())
+	assert.Nil(t, ch.Chunks())
+	assert.False(t, ch.Raw())
+	assert.NotNil(t, ch.SMF)
+}
+
+func Test_ChunkMIDI_ReadFrom(t *testing.T) {
+	// --- Given ---
+	src := midiChunk(t)
+	test.Skip4B(t, src) // Skip chunk ID.
+
+	// --- When ---
+	ch := MIDI()
+	n, err := ch.ReadFrom(src)
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, int64(74), n)
+	assert.Equal(t, uint32(69), ch.Size())
+	assert.Len(t, 1, ch.SMF.Tracks)
+	assert.Equal(t, "Lead", ch.SMF.Tracks[0].Name())
+	assert.True(t, test.IsAllRead(src))
+}
+
+func Test_ChunkMIDI_ReadFrom_Errors(t *testing.T) {
+	// Reading less than 74 bytes should always result in an error.
+	for _, i := range []int{1, 4, 5, 20, 72, 73} {
+		// --- Given ---
+		src := midiChunk(t)
+		test.Skip4B(t, src) // Skip chunk ID.
+
+		// --- When ---
+		_, err := MIDI().ReadFrom(io.LimitReader(src, int64(i)))
+
+		// --- Then ---
+		assert.Error(t, err)
+	}
+}
+
+func Test_ChunkMIDI_ReadFrom_InvalidSMF(t *testing.T) {
+	// --- Given ---
+	src := &bytes.Buffer{}
+	test.WriteUint32LE(t, src, 4)
+	test.WriteBytes(t, src, []byte("MThd"))
+
+	// --- When ---
+	ch := MIDI()
+	n, err := ch.ReadFrom(src)
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, int64(8), n)
+	assert.Nil(t, ch.SMF)
+	assert.Equal(t, uint32(4), ch.Size())
+
+	dst := &bytes.Buffer{}
+	must.Value(ch.WriteTo(dst))
+	assert.Equal(t, []byte("data\x04\x00\x00\x00MThd"), dst.Bytes())
+}
+
+func Test_ChunkMIDI_WriteTo(t *testing.T) {
+	// --- Given ---
+	src := midiChunk(t)
+	test.Skip4B(t, src) // Skip chunk ID.
+
+	ch := MIDI()
+	_, err := ch.ReadFrom(src)
+	assert.NoError(t, err)
+
+	// --- When ---
+	dst := &bytes.Buffer{}
+	n, err := ch.WriteTo(dst)
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, int64(78), n)
+	exp := must.Value(io.ReadAll(midiChunk(t)))
+	assert.Equal(t, exp, dst.Bytes())
+}
+
+func Test_ChunkMIDI_WriteTo_Edited(t *testing.T) {
+	// --- Given ---
+	src := midiChunk(t)
+	test.Skip4B(t, src) // Skip chunk ID.
+
+	ch := MIDI()
+	_, err := ch.ReadFrom(src)
+	assert.NoError(t, err)
+
+	ch.SMF.Tracks[0].Events[0].Data = []byte("Bass")
+	ch.SMF.Tracks = append(ch.SMF.Tracks, MIDITrack{
+		Events: []MIDIEvent{{Status: MIDIMeta, Meta: MetaEndOfTrack}},
+	})
+	ch.SMF.Format = 1
+
+	// --- When ---
+	dst := &bytes.Buffer{}
+	n, err := ch.WriteTo(dst)
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, int64(dst.Len()), n)
+	assert.Equal(t, ch.Size(), le.Uint32(dst.Bytes()[4:]))
+
+	got := MIDI()
+	_, err = got.ReadFrom(bytes.NewReader(dst.Bytes()[4:]))
+	assert.NoError(t, err)
+	assert.Equal(t, uint16(1), got.SMF.Format)
+	assert.Len(t, 2, got.SMF.Tracks)
+	assert.Equal(t, "Bass", got.SMF.Tracks[0].Name())
+}
+
+func Test_ChunkMIDI_WriteTo_Errors(t *testing.T) {
+	for _, i := range []int{1, 4, 8, 76, 77} {
+		// --- Given ---
+		src := midiChunk(t)
+		test.Skip4B(t, src) // Skip chunk ID.
+
+		ch := MIDI()
+		_, err := ch.ReadFrom(src)
+		assert.NoError(t, err)
+
+		// --- When ---
+		_, err = ch.WriteTo(iokit.ErrWriter(&bytes.Buffer{}, i))
+
+		// --- Then ---
+		assert.Error(t, err)
+	}
+}
+
+func Test_ChunkMIDI_Reset(t *testing.T) {
+	// --- Given ---
+	src := midiChunk(t)
+	test.Skip4B(t, src) // Skip chunk ID.
+	ch := MIDI()
+	_, err := ch.ReadFrom(src)
+	assert.NoError(t, err)
+
+	// --- When ---
+	ch.Reset()
+
+	// --- Then ---
+	assert.Equal(t, uint32(14), ch.Size())
+	assert.Len(t, 0, ch.SMF.Tracks)
+}
+
+func Test_ComposeRMID(t *testing.T) {
+	// --- Given ---
+	smf := must.Value(ParseSMF(smfFile(t)))
+	xmp := XMP()
+	xmp.Packet.SetTitle("Song")
+
+	// --- When ---
+	rif := ComposeRMID(smf, xmp)
+
+	// --- Then ---
+	assert.Equal(t, TypeRMID, rif.Type())
+
+	dst := &bytes.Buffer{}
+	must.Value(rif.WriteTo(dst))
+
+	got := New(LoadData)
+	must.Value(got.ReadFrom(dst))
+	assert.Equal(t, TypeRMID, got.Type())
+	data, ok := got.Chunks().First(IDdata).(*ChunkMIDI)
+	assert.True(t, ok)
+	assert.Equal(t, smfFile(t), data.SMF.Bytes())
+	assert.Equal(t, "Song", got.Chunks().First(IDXMP).(*ChunkXMP).Packet.Title())
+}
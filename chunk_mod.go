@@ -0,0 +1,118 @@
+package riff
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+)
+
+// IDs of the SoundFont modulator chunks.
+const (
+	// IDpmod represents "pmod" (preset modulators) sub-chunk ID of the
+	// "LIST pdta" chunk.
+	IDpmod uint32 = 0x706d6f64
+
+	// IDimod represents "imod" (instrument modulators) sub-chunk ID of the
+	// "LIST pdta" chunk.
+	IDimod uint32 = 0x696d6f64
+)
+
+// SF2ModSize represents the size of the modulator record in bytes.
+const SF2ModSize uint32 = 10
+
+// SF2Mod represents the SoundFont modulator record.
+type SF2Mod struct {
+	// Source of the modulator.
+	Src uint16
+
+	// Destination generator of the modulator.
+	Dest SF2Gen
+
+	// Degree to which the source modulates the destination.
+	Amount int16
+
+	// Source modulating the amount.
+	AmountSrc uint16
+
+	// Transform applied to the source.
+	Trans uint16
+}
+
+// ChunkMOD represents "pmod" or "imod" chunk of the SoundFont file.
+type ChunkMOD struct {
+	// Chunk ID.
+	id uint32
+
+	// Chunk size in bytes.
+	// The ID and extra padding byte is not counted in the chunk size.
+	size uint32
+
+	// Modulator records. The last one is the terminal record.
+	Mods []SF2Mod
+}
+
+// MODMake returns [IDMaker] function for creating [ChunkMOD] instances.
+func MODMake(_ bool) IDMaker {
+	return func(id uint32) Chunk {
+		return MOD(id)
+	}
+}
+
+// MOD returns a new instance of [ChunkMOD] for given ID ([IDpmod] or
+// [IDimod]).
+func MOD(id uint32) *ChunkMOD {
+	return &ChunkMOD{id: id}
+}
+
+func (ch *ChunkMOD) ID() uint32     { return ch.id }
+func (ch *ChunkMOD) Size() uint32   { return uint32(len(ch.Mods)) * SF2ModSize }
+func (ch *ChunkMOD) Type() uint32   { return 0 }
+func (ch *ChunkMOD) Multi() bool    { return false }
+func (ch *ChunkMOD) Chunks() Chunks { return nil }
+func (ch *ChunkMOD) Raw() bool      { return false }
+
+func (ch *ChunkMOD) ReadFrom(r io.Reader) (int64, error) {
+	var sum int64
+	var err error
+	if ch.size, err = ReadChunkSize(r); err != nil {
+		return sum, fmt.Errorf(errFmtDecode, Uint32(ch.id), err)
+	}
+	sum += 4
+
+	if ch.size%SF2ModSize != 0 {
+		return sum, fmt.Errorf(errFmtDecode, Uint32(ch.id), ErrChunkSizeMismatch)
+	}
+
+	recs := make([]SF2Mod, ch.size/SF2ModSize)
+	if err = binary.Read(r, ByteOrder(r), recs); err != nil {
+		return sum, fmt.Errorf(errFmtDecode, Uint32(ch.id), err)
+	}
+	ch.Mods = append(ch.Mods[:0], recs...)
+	sum += int64(ch.size)
+
+	return sum, nil
+}
+
+func (ch *ChunkMOD) WriteTo(w io.Writer) (int64, error) {
+	var sum int64
+
+	ch.size = ch.Size()
+
+	n, err := WriteIDAndSize(w, ch.id, ch.size)
+	sum += n
+	if err != nil {
+		return sum, fmt.Errorf(errFmtEncode, Uint32(ch.id), err)
+	}
+
+	if err = binary.Write(w, ByteOrder(w), ch.Mods); err != nil {
+		return sum, fmt.Errorf(errFmtEncode, Uint32(ch.id), err)
+	}
+	sum += int64(ch.size)
+
+	return sum, nil
+}
+
+func (ch *ChunkMOD) Reset() {
+	ch.size = 0
+	ch.Mods = ch.Mods[:0]
+}
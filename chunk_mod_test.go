@@ -0,0 +1,140 @@
+package riff
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/ctx42/testing/pkg/assert"
+	"github.com/ctx42/testing/pkg/kit/iokit"
+	"github.com/ctx42/testing/pkg/must"
+
+	"github.com/rzajac/riff/internal/test"
+)
+
+func modChunk(t *testing.T, id uint32) io.Reader {
+	src := &bytes.Buffer{}
+	test.ReadFrom(t, src, Uint32(id))  // ( 0) 4 - Chunk ID
+	test.WriteUint32LE(t, src, 10)     // ( 4) 4 - Chunk size
+	test.WriteUint16LE(t, src, 0x0502) // ( 8) 2 - Src
+	test.WriteUint16LE(t, src, 48)     // (10) 2 - Dest
+	test.WriteUint16LE(t, src, 960)    // (12) 2 - Amount
+	test.WriteUint16LE(t, src, 0)      // (14) 2 - AmountSrc
+	test.WriteUint16LE(t, src, 0)      // (16) 2 - Trans
+	// Total length: 8+10=18
+	return src
+}
+
+func Test_ChunkMOD_MOD(t *testing.T) {
+	// --- When ---
+	ch := MOD(IDimod)
+
+	// --- Then ---
+	assert.Equal(t, IDimod, ch.ID())
+	assert.Equal(t, uint32(0), ch.Size())
+	assert.Equal(t, uint32(0), ch.Type())
+	assert.False(t, ch.Multi())
+	assert.Nil(t, ch.Chunks())
+	assert.False(t, ch.Raw())
+	assert.Len(t, 0, ch.Mods)
+}
+
+func Test_ChunkMOD_MODMake(t *testing.T) {
+	// --- When ---
+	ch := MODMake(LoadData)(IDpmod)
+
+	// --- Then ---
+	assert.Equal(t, IDpmod, ch.ID())
+}
+
+func Test_ChunkMOD_ReadFrom(t *testing.T) {
+	// --- Given ---
+	src := modChunk(t, IDpmod)
+	test.Skip4B(t, src) // Skip chunk ID.
+
+	// --- When ---
+	ch := MOD(IDpmod)
+	n, err := ch.ReadFrom(src)
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, int64(14), n)
+	assert.Equal(t, uint32(10), ch.Size())
+	exp := []SF2Mod{{Src: 0x0502, Dest: SF2GenInitialAttenuation, Amount: 960}}
+	assert.Equal(t, exp, ch.Mods)
+	assert.True(t, test.IsAllRead(src))
+}
+
+func Test_ChunkMOD_ReadFrom_SizeMismatch(t *testing.T) {
+	// --- Given ---
+	src := &bytes.Buffer{}
+	test.WriteUint32LE(t, src, 12)
+
+	// --- When ---
+	_, err := MOD(IDpmod).ReadFrom(src)
+
+	// --- Then ---
+	assert.ErrorIs(t, ErrChunkSizeMismatch, err)
+}
+
+func Test_ChunkMOD_ReadFrom_Errors(t *testing.T) {
+	// Reading less than 14 bytes should always result in an error.
+	for _, i := range []int{1, 4, 5, 13} {
+		// --- Given ---
+		src := modChunk(t, IDimod)
+		test.Skip4B(t, src) // Skip chunk ID.
+
+		// --- When ---
+		_, err := MOD(IDimod).ReadFrom(io.LimitReader(src, int64(i)))
+
+		// --- Then ---
+		assert.Error(t, err)
+	}
+}
+
+func Test_ChunkMOD_WriteTo(t *testing.T) {
+	// --- Given ---
+	ch := MOD(IDimod)
+	ch.Mods = []SF2Mod{{Src: 0x0502, Dest: SF2GenInitialAttenuation, Amount: 960}}
+
+	// --- When ---
+	dst := &bytes.Buffer{}
+	n, err := ch.WriteTo(dst)
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, int64(18), n)
+	exp := must.Value(io.ReadAll(modChunk(t, IDimod)))
+	assert.Equal(t, exp, dst.Bytes())
+}
+
+func Test_ChunkMOD_WriteTo_Errors(t *testing.T) {
+	for _, i := range []int{1, 4, 8, 12} {
+		// --- Given ---
+		ch := MOD(IDimod)
+		ch.Mods = []SF2Mod{{}}
+
+		// --- When ---
+		_, err := ch.WriteTo(iokit.ErrWriter(&bytes.Buffer{}, i))
+
+		// --- Then ---
+		assert.Error(t, err)
+	}
+}
+
+func Test_ChunkMOD_Reset(t *testing.T) {
+	// --- Given ---
+	src := modChunk(t, IDpmod)
+	test.Skip4B(t, src) // Skip chunk ID.
+	ch := MOD(IDpmod)
+	_, err := ch.ReadFrom(src)
+	assert.NoError(t, err)
+
+	// --- When ---
+	ch.Reset()
+
+	// --- Then ---
+	assert.Equal(t, IDpmod, ch.ID())
+	assert.Equal(t, uint32(0), ch.Size())
+	assert.Len(t, 0, ch.Mods)
+}
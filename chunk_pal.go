@@ -0,0 +1,191 @@
+package riff
+
+import (
+	"fmt"
+	"image/color"
+	"io"
+)
+
+// PALChunkSize represents the size of the "RIFF PAL " data chunk static
+// part (LOGPALETTE header) in bytes.
+const PALChunkSize uint32 = 4
+
+// PALEntrySize represents the size of the palette entry in bytes.
+const PALEntrySize uint32 = 4
+
+// PALVersion represents the only defined version of the LOGPALETTE.
+const PALVersion uint16 = 0x0300
+
+// Palette entry flags.
+const (
+	// PALReserved marks the entry used for the palette animation.
+	PALReserved uint8 = 0x01
+
+	// PALExplicit marks the entry holding the hardware palette index in
+	// the low-order word.
+	PALExplicit uint8 = 0x02
+
+	// PALNoCollapse marks the entry placed in the unused system palette
+	// entry instead of being matched to the existing color.
+	PALNoCollapse uint8 = 0x04
+)
+
+// PALEntry represents the palette entry (PALETTEENTRY).
+type PALEntry struct {
+	R, G, B uint8
+
+	// Entry flags (see PAL* constants).
+	Flags uint8
+}
+
+// ChunkPAL represents the "data" chunk of the RIFF PAL file which holds
+// the logical palette (LOGPALETTE).
+type ChunkPAL struct {
+	// Palette version. Always [PALVersion].
+	Version uint16
+
+	// Palette entries.
+	Entries []PALEntry
+
+	// Bytes following the palette entries.
+	extra []byte
+}
+
+// PALMake is a [Maker] function for creating [ChunkPAL] instances.
+func PALMake() Chunk { return PAL() }
+
+// PAL returns a new instance of [ChunkPAL].
+func PAL() *ChunkPAL {
+	return &ChunkPAL{Version: PALVersion}
+}
+
+func (ch *ChunkPAL) ID() uint32     { return IDdata }
+func (ch *ChunkPAL) Type() uint32   { return 0 }
+func (ch *ChunkPAL) Multi() bool    { return false }
+func (ch *ChunkPAL) Chunks() Chunks { return nil }
+func (ch *ChunkPAL) Raw() bool      { return false }
+
+func (ch *ChunkPAL) Size() uint32 {
+	return PALChunkSize + uint32(len(ch.Entries))*PALEntrySize + uint32(len(ch.extra))
+}
+
+// Palette returns the palette entries as opaque colors.
+func (ch *ChunkPAL) Palette() color.Palette {
+	p := make(color.Palette, len(ch.Entries))
+	for i, e := range ch.Entries {
+		p[i] = color.NRGBA{R: e.R, G: e.G, B: e.B, A: 0xff}
+	}
+	return p
+}
+
+// SetPalette sets the palette entries from p. The alpha channel is
+// ignored and entry flags are set to zero.
+func (ch *ChunkPAL) SetPalette(p color.Palette) {
+	ch.Entries = ch.Entries[:0]
+	for _, c := range p {
+		n := color.NRGBAModel.Convert(c).(color.NRGBA)
+		ch.Entries = append(ch.Entries, PALEntry{R: n.R, G: n.G, B: n.B})
+	}
+}
+
+func (ch *ChunkPAL) ReadFrom(r io.Reader) (int64, error) {
+	bo := ByteOrder(r)
+	var sum int64
+	size, err := ReadChunkSize(r)
+	if err != nil {
+		return sum, fmt.Errorf(errFmtDecode, linkids(TypePAL, IDdata), err)
+	}
+	sum += 4
+
+	if size < PALChunkSize {
+		return sum, fmt.Errorf(errFmtDecode, linkids(TypePAL, IDdata), ErrTooShort)
+	}
+
+	buf := make([]byte, size)
+	in, err := io.ReadFull(r, buf)
+	sum += int64(in)
+	if err != nil {
+		return sum, fmt.Errorf(errFmtDecode, linkids(TypePAL, IDdata), err)
+	}
+
+	ch.Version = bo.Uint16(buf)
+	cnt := uint32(bo.Uint16(buf[2:]))
+	end := PALChunkSize + cnt*PALEntrySize
+	if end > size {
+		return sum, fmt.Errorf(errFmtDecode, linkids(TypePAL, IDdata), ErrChunkSizeMismatch)
+	}
+
+	ch.Entries = ch.Entries[:0]
+	for off := PALChunkSize; off < end; off += PALEntrySize {
+		ch.Entries = append(ch.Entries, PALEntry{
+			R:     buf[off],
+			G:     buf[off+1],
+			B:     buf[off+2],
+			Flags: buf[off+3],
+		})
+	}
+	ch.extra = grow(ch.extra, len(buf)-int(end))
+	copy(ch.extra, buf[end:])
+
+	n, err := ReadPaddingIf(r, size)
+	sum += n
+	if err != nil {
+		return sum, fmt.Errorf(errFmtDecode, linkids(TypePAL, IDdata), err)
+	}
+
+	return sum, nil
+}
+
+func (ch *ChunkPAL) WriteTo(w io.Writer) (int64, error) {
+	bo := ByteOrder(w)
+	var sum int64
+
+	if len(ch.Entries) > 0xffff {
+		return sum, fmt.Errorf(errFmtEncode, linkids(TypePAL, IDdata), ErrOutOfRange)
+	}
+
+	buf := make([]byte, PALChunkSize, PALChunkSize+uint32(len(ch.Entries))*PALEntrySize)
+	bo.PutUint16(buf, ch.Version)
+	bo.PutUint16(buf[2:], uint16(len(ch.Entries)))
+	for _, e := range ch.Entries {
+		buf = append(buf, e.R, e.G, e.B, e.Flags)
+	}
+	buf = append(buf, ch.extra...)
+	size := uint32(len(buf))
+
+	n, err := WriteIDAndSize(w, IDdata, size)
+	sum += n
+	if err != nil {
+		return sum, fmt.Errorf(errFmtEncode, linkids(TypePAL, IDdata), err)
+	}
+
+	in, err := w.Write(buf)
+	sum += int64(in)
+	if err != nil {
+		return sum, fmt.Errorf(errFmtEncode, linkids(TypePAL, IDdata), err)
+	}
+
+	n, err = WritePaddingIf(w, size)
+	sum += n
+	if err != nil {
+		return sum, fmt.Errorf(errFmtEncode, linkids(TypePAL, IDdata), err)
+	}
+
+	return sum, nil
+}
+
+func (ch *ChunkPAL) Reset() {
+	ch.Version = PALVersion
+	ch.Entries = ch.Entries[:0]
+	ch.extra = ch.extra[:0]
+}
+
+// ComposePAL returns a new RIFF PAL file with the "data" chunk holding
+// the palette p followed by chs (e.g. "LIST INFO" chunk).
+func ComposePAL(p color.Palette, chs ...Chunk) *RIFF {
+	data := PAL()
+	data.SetPalette(p)
+	rif := Compose(append(Chunks{data}, chs...))
+	rif.SetType(TypePAL)
+	return rif
+}
@@ -0,0 +1,256 @@
+package riff
+
+import (
+	"bytes"
+	"image/color"
+	"io"
+	"testing"
+
+	"github.com/ctx42/testing/pkg/assert"
+	"github.com/ctx42/testing/pkg/kit/iokit"
+	"github.com/ctx42/testing/pkg/must"
+
+	"github.com/rzajac/riff/internal/test"
+)
+
+func palChunk(t *testing.T) io.Reader {
+	src := &bytes.Buffer{}
+	test.ReadFrom(t, src, Uint32(IDdata))          // ( 0) 4 - Chunk ID
+	test.WriteUint32LE(t, src, 12)                 // ( 4) 4 - Chunk size
+	test.WriteUint16LE(t, src, 0x0300)             // ( 8) 2 - Version
+	test.WriteUint16LE(t, src, 2)                  // (10) 2 - Entry count
+	test.WriteBytes(t, src, []byte{0xff, 0, 0, 0}) // (12) 4 - Entry
+	test.WriteBytes(t, src, []byte{1, 2, 3, 0x04}) // (16) 4 - Entry
+	// Total length: 8+12=20
+	return src
+}
+
+func Test_ChunkPAL_PAL(t *testing.T) {
+	// --- When ---
+	ch := PAL()
+
+	// --- Then ---
+	assert.Equal(t, IDdata, ch.ID())
+	assert.Equal(t, PALChunkSize, ch.Size())
+	assert.Equal(t, uint32(0), ch.Type())
+	assert.False(t, ch.Multi())
+	assert.Nil(t, ch.Chunks())
+	assert.False(t, ch.Raw())
+	assert.Equal(t, PALVersion, ch.Version)
+	assert.Len(t, 0, ch.Entries)
+}
+
+func Test_ChunkPAL_ReadFrom(t *testing.T) {
+	// --- Given ---
+	src := palChunk(t)
+	test.Skip4B(t, src) // Skip chunk ID.
+
+	// --- When ---
+	ch := PAL()
+	n, err := ch.ReadFrom(src)
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, int64(16), n)
+	assert.Equal(t, uint32(12), ch.Size())
+	assert.Equal(t, PALVersion, ch.Version)
+	exp := []PALEntry{{R: 0xff}, {R: 1, G: 2, B: 3, Flags: PALNoCollapse}}
+	assert.Equal(t, exp, ch.Entries)
+	assert.True(t, test.IsAllRead(src))
+}
+
+func Test_ChunkPAL_ReadFrom_Extra(t *testing.T) {
+	// --- Given ---
+	b := must.Value(io.ReadAll(palChunk(t)))
+	le.PutUint32(b[4:], 12)
+	le.PutUint16(b[10:], 1)
+
+	// --- When ---
+	ch := PAL()
+	_, err := ch.ReadFrom(bytes.NewReader(b[4:]))
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Len(t, 1, ch.Entries)
+	assert.Equal(t, []byte{1, 2, 3, 4}, ch.extra)
+
+	dst := &bytes.Buffer{}
+	must.Value(ch.WriteTo(dst))
+	assert.Equal(t, b, dst.Bytes())
+}
+
+func Test_ChunkPAL_ReadFrom_Errors(t *testing.T) {
+	// Reading less than 16 bytes should always result in an error.
+	for _, i := range []int{1, 4, 5, 11, 15} {
+		// --- Given ---
+		src := palChunk(t)
+		test.Skip4B(t, src) // Skip chunk ID.
+
+		// --- When ---
+		_, err := PAL().ReadFrom(io.LimitReader(src, int64(i)))
+
+		// --- Then ---
+		assert.Error(t, err)
+	}
+}
+
+func Test_ChunkPAL_ReadFrom_Invalid(t *testing.T) {
+	t.Run("too short", func(t *testing.T) {
+		// --- Given ---
+		src := &bytes.Buffer{}
+		test.WriteUint32LE(t, src, 3)
+
+		// --- When ---
+		_, err := PAL().ReadFrom(src)
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrTooShort, err)
+	})
+
+	t.Run("entry count", func(t *testing.T) {
+		// --- Given ---
+		b := must.Value(io.ReadAll(palChunk(t)))
+		le.PutUint16(b[10:], 3)
+
+		// --- When ---
+		_, err := PAL().ReadFrom(bytes.NewReader(b[4:]))
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrChunkSizeMismatch, err)
+	})
+}
+
+func Test_ChunkPAL_Palette(t *testing.T) {
+	// --- Given ---
+	ch := PAL()
+	ch.Entries = []PALEntry{{R: 0xff}, {R: 1, G: 2, B: 3, Flags: PALReserved}}
+
+	// --- When ---
+	p := ch.Palette()
+
+	// --- Then ---
+	exp := color.Palette{
+		color.NRGBA{R: 0xff, A: 0xff},
+		color.NRGBA{R: 1, G: 2, B: 3, A: 0xff},
+	}
+	assert.Equal(t, exp, p)
+}
+
+func Test_ChunkPAL_SetPalette(t *testing.T) {
+	// --- Given ---
+	ch := PAL()
+	p := color.Palette{
+		color.RGBA{R: 0xff, A: 0xff},
+		color.Gray{Y: 0x80},
+		color.NRGBA{R: 1, G: 2, B: 3, A: 0x10},
+	}
+
+	// --- When ---
+	ch.SetPalette(p)
+
+	// --- Then ---
+	exp := []PALEntry{
+		{R: 0xff},
+		{R: 0x80, G: 0x80, B: 0x80},
+		{R: 1, G: 2, B: 3},
+	}
+	assert.Equal(t, exp, ch.Entries)
+	assert.Equal(t, uint32(16), ch.Size())
+}
+
+func Test_ChunkPAL_Size(t *testing.T) {
+	// --- Given ---
+	ch := PAL()
+	ch.extra = []byte{1, 2}
+
+	// --- When ---
+	ch.Entries = append(ch.Entries, PALEntry{R: 1}, PALEntry{G: 2})
+
+	// --- Then ---
+	assert.Equal(t, PALChunkSize+2*PALEntrySize+2, ch.Size())
+}
+
+func Test_ChunkPAL_WriteTo(t *testing.T) {
+	// --- Given ---
+	ch := PAL()
+	ch.Entries = []PALEntry{{R: 0xff}, {R: 1, G: 2, B: 3, Flags: PALNoCollapse}}
+
+	// --- When ---
+	dst := &bytes.Buffer{}
+	n, err := ch.WriteTo(dst)
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, int64(20), n)
+	exp := must.Value(io.ReadAll(palChunk(t)))
+	assert.Equal(t, exp, dst.Bytes())
+}
+
+func Test_ChunkPAL_WriteTo_TooManyEntries(t *testing.T) {
+	// --- Given ---
+	ch := PAL()
+	ch.Entries = make([]PALEntry, 0x10000)
+
+	// --- When ---
+	_, err := ch.WriteTo(&bytes.Buffer{})
+
+	// --- Then ---
+	assert.ErrorIs(t, ErrOutOfRange, err)
+}
+
+func Test_ChunkPAL_WriteTo_Errors(t *testing.T) {
+	for _, i := range []int{1, 4, 8, 12} {
+		// --- Given ---
+		ch := PAL()
+		ch.Entries = []PALEntry{{R: 1}}
+
+		// --- When ---
+		_, err := ch.WriteTo(iokit.ErrWriter(&bytes.Buffer{}, i))
+
+		// --- Then ---
+		assert.Error(t, err)
+	}
+}
+
+func Test_ChunkPAL_Reset(t *testing.T) {
+	// --- Given ---
+	src := palChunk(t)
+	test.Skip4B(t, src) // Skip chunk ID.
+	ch := PAL()
+	_, err := ch.ReadFrom(src)
+	assert.NoError(t, err)
+
+	// --- When ---
+	ch.Reset()
+
+	// --- Then ---
+	assert.Equal(t, PALChunkSize, ch.Size())
+	assert.Equal(t, PALVersion, ch.Version)
+	assert.Len(t, 0, ch.Entries)
+}
+
+func Test_ComposePAL(t *testing.T) {
+	// --- Given ---
+	p := color.Palette{
+		color.NRGBA{R: 0xff, A: 0xff},
+		color.NRGBA{G: 0xff, A: 0xff},
+		color.NRGBA{B: 0xff, A: 0xff},
+	}
+
+	// --- When ---
+	rif := ComposePAL(p, dlsInfo("Primary"))
+
+	// --- Then ---
+	assert.Equal(t, TypePAL, rif.Type())
+
+	dst := &bytes.Buffer{}
+	must.Value(rif.WriteTo(dst))
+
+	got := New(LoadData)
+	must.Value(got.ReadFrom(dst))
+	assert.Equal(t, TypePAL, got.Type())
+	data, ok := got.Chunks().First(IDdata).(*ChunkPAL)
+	assert.True(t, ok)
+	assert.Equal(t, p, data.Palette())
+	assert.NotNil(t, got.Chunks().First(IDLIST))
+}
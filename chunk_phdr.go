@@ -0,0 +1,109 @@
+package riff
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+)
+
+// IDphdr represents "phdr" (preset headers) sub-chunk ID of the "LIST pdta"
+// chunk.
+const IDphdr uint32 = 0x70686472
+
+// SF2PresetHeaderSize represents the size of the preset header record in bytes.
+const SF2PresetHeaderSize uint32 = 38
+
+// SF2PresetHeader represents the SoundFont preset header record.
+type SF2PresetHeader struct {
+	// Zero terminated preset name.
+	Name [20]byte
+
+	// MIDI program number of the preset.
+	Preset uint16
+
+	// MIDI bank number of the preset. The bank 128 is the percussion bank.
+	Bank uint16
+
+	// Index of the first preset zone in the "pbag" chunk.
+	BagIndex uint16
+
+	// Reserved for future use.
+	Library uint32
+
+	// Reserved for future use.
+	Genre uint32
+
+	// Reserved for future use.
+	Morphology uint32
+}
+
+// ChunkPHDR represents "phdr" chunk of the SoundFont file.
+type ChunkPHDR struct {
+	// Chunk size in bytes.
+	// The ID and extra padding byte is not counted in the chunk size.
+	size uint32
+
+	// Preset header records. The last one is the terminal "EOP" record.
+	Headers []SF2PresetHeader
+}
+
+// PHDRMake is a [Maker] function for creating [ChunkPHDR] instances.
+func PHDRMake() Chunk { return PHDR() }
+
+// PHDR returns a new instance of [ChunkPHDR].
+func PHDR() *ChunkPHDR {
+	return &ChunkPHDR{}
+}
+
+func (ch *ChunkPHDR) ID() uint32     { return IDphdr }
+func (ch *ChunkPHDR) Size() uint32   { return uint32(len(ch.Headers)) * SF2PresetHeaderSize }
+func (ch *ChunkPHDR) Type() uint32   { return 0 }
+func (ch *ChunkPHDR) Multi() bool    { return false }
+func (ch *ChunkPHDR) Chunks() Chunks { return nil }
+func (ch *ChunkPHDR) Raw() bool      { return false }
+
+func (ch *ChunkPHDR) ReadFrom(r io.Reader) (int64, error) {
+	var sum int64
+	var err error
+	if ch.size, err = ReadChunkSize(r); err != nil {
+		return sum, fmt.Errorf(errFmtDecode, Uint32(IDphdr), err)
+	}
+	sum += 4
+
+	if ch.size%SF2PresetHeaderSize != 0 {
+		return sum, fmt.Errorf(errFmtDecode, Uint32(IDphdr), ErrChunkSizeMismatch)
+	}
+
+	recs := make([]SF2PresetHeader, ch.size/SF2PresetHeaderSize)
+	if err = binary.Read(r, ByteOrder(r), recs); err != nil {
+		return sum, fmt.Errorf(errFmtDecode, Uint32(IDphdr), err)
+	}
+	ch.Headers = append(ch.Headers[:0], recs...)
+	sum += int64(ch.size)
+
+	return sum, nil
+}
+
+func (ch *ChunkPHDR) WriteTo(w io.Writer) (int64, error) {
+	var sum int64
+
+	ch.size = ch.Size()
+
+	n, err := WriteIDAndSize(w, IDphdr, ch.size)
+	sum += n
+	if err != nil {
+		return sum, fmt.Errorf(errFmtEncode, Uint32(IDphdr), err)
+	}
+
+	if err = binary.Write(w, ByteOrder(w), ch.Headers); err != nil {
+		return sum, fmt.Errorf(errFmtEncode, Uint32(IDphdr), err)
+	}
+	sum += int64(ch.size)
+
+	return sum, nil
+}
+
+func (ch *ChunkPHDR) Reset() {
+	ch.size = 0
+	ch.Headers = ch.Headers[:0]
+}
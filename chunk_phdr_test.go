@@ -0,0 +1,165 @@
+package riff
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/ctx42/testing/pkg/assert"
+	"github.com/ctx42/testing/pkg/kit/iokit"
+	"github.com/ctx42/testing/pkg/must"
+
+	"github.com/rzajac/riff/internal/test"
+)
+
+func phdrChunk(t *testing.T) io.Reader {
+	src := &bytes.Buffer{}
+	test.ReadFrom(t, src, Uint32(IDphdr))      // ( 0)  4 - Chunk ID
+	test.WriteUint32LE(t, src, 76)             // ( 4)  4 - Chunk size
+	test.WriteBytes(t, src, sf2NameB("Piano")) // ( 8) 20 - Name
+	test.WriteUint16LE(t, src, 1)              // (28)  2 - Preset
+	test.WriteUint16LE(t, src, 2)              // (30)  2 - Bank
+	test.WriteUint16LE(t, src, 0)              // (32)  2 - BagIndex
+	test.WriteUint32LE(t, src, 3)              // (34)  4 - Library
+	test.WriteUint32LE(t, src, 4)              // (38)  4 - Genre
+	test.WriteUint32LE(t, src, 5)              // (42)  4 - Morphology
+	test.WriteBytes(t, src, sf2NameB("EOP"))   // (46) 20 - Name
+	test.WriteUint16LE(t, src, 0)              // (66)  2 - Preset
+	test.WriteUint16LE(t, src, 0)              // (68)  2 - Bank
+	test.WriteUint16LE(t, src, 1)              // (70)  2 - BagIndex
+	test.WriteUint32LE(t, src, 0)              // (72)  4 - Library
+	test.WriteUint32LE(t, src, 0)              // (76)  4 - Genre
+	test.WriteUint32LE(t, src, 0)              // (80)  4 - Morphology
+	// Total length: 8+76=84
+	return src
+}
+
+// sf2NameB returns the name field of the SoundFont record as a slice.
+func sf2NameB(name string) []byte {
+	b := sf2Name(name)
+	return b[:]
+}
+
+func Test_ChunkPHDR_PHDR(t *testing.T) {
+	// --- When ---
+	ch := PHDR()
+
+	// --- Then ---
+	assert.Equal(t, IDphdr, ch.ID())
+	assert.Equal(t, uint32(0), ch.Size())
+	assert.Equal(t, uint32(0), ch.Type())
+	assert.False(t, ch.Multi())
+	assert.Nil(t, ch.Chunks())
+	assert.False(t, ch.Raw())
+	assert.Len(t, 0, ch.Headers)
+}
+
+func Test_ChunkPHDR_ReadFrom(t *testing.T) {
+	// --- Given ---
+	src := phdrChunk(t)
+	test.Skip4B(t, src) // Skip chunk ID.
+
+	// --- When ---
+	ch := PHDR()
+	n, err := ch.ReadFrom(src)
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, int64(80), n)
+	assert.Equal(t, uint32(76), ch.Size())
+	assert.Len(t, 2, ch.Headers)
+	exp := SF2PresetHeader{
+		Name:       sf2Name("Piano"),
+		Preset:     1,
+		Bank:       2,
+		Library:    3,
+		Genre:      4,
+		Morphology: 5,
+	}
+	assert.Equal(t, exp, ch.Headers[0])
+	assert.Equal(t, uint16(1), ch.Headers[1].BagIndex)
+	assert.True(t, test.IsAllRead(src))
+}
+
+func Test_ChunkPHDR_ReadFrom_SizeMismatch(t *testing.T) {
+	// --- Given ---
+	src := &bytes.Buffer{}
+	test.WriteUint32LE(t, src, SF2PresetHeaderSize+1)
+
+	// --- When ---
+	_, err := PHDR().ReadFrom(src)
+
+	// --- Then ---
+	assert.ErrorIs(t, ErrChunkSizeMismatch, err)
+}
+
+func Test_ChunkPHDR_ReadFrom_Errors(t *testing.T) {
+	// Reading less than 80 bytes should always result in an error.
+	for _, i := range []int{1, 4, 5, 41, 79} {
+		// --- Given ---
+		src := phdrChunk(t)
+		test.Skip4B(t, src) // Skip chunk ID.
+
+		// --- When ---
+		_, err := PHDR().ReadFrom(io.LimitReader(src, int64(i)))
+
+		// --- Then ---
+		assert.Error(t, err)
+	}
+}
+
+func Test_ChunkPHDR_WriteTo(t *testing.T) {
+	// --- Given ---
+	ch := PHDR()
+	ch.Headers = []SF2PresetHeader{
+		{
+			Name:       sf2Name("Piano"),
+			Preset:     1,
+			Bank:       2,
+			Library:    3,
+			Genre:      4,
+			Morphology: 5,
+		},
+		{Name: sf2Name("EOP"), BagIndex: 1},
+	}
+
+	// --- When ---
+	dst := &bytes.Buffer{}
+	n, err := ch.WriteTo(dst)
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, int64(84), n)
+	exp := must.Value(io.ReadAll(phdrChunk(t)))
+	assert.Equal(t, exp, dst.Bytes())
+}
+
+func Test_ChunkPHDR_WriteTo_Errors(t *testing.T) {
+	for _, i := range []int{1, 4, 8, 50} {
+		// --- Given ---
+		ch := PHDR()
+		ch.Headers = []SF2PresetHeader{{}, {}}
+
+		// --- When ---
+		_, err := ch.WriteTo(iokit.ErrWriter(&bytes.Buffer{}, i))
+
+		// --- Then ---
+		assert.Error(t, err)
+	}
+}
+
+func Test_ChunkPHDR_Reset(t *testing.T) {
+	// --- Given ---
+	src := phdrChunk(t)
+	test.Skip4B(t, src) // Skip chunk ID.
+	ch := PHDR()
+	_, err := ch.ReadFrom(src)
+	assert.NoError(t, err)
+
+	// --- When ---
+	ch.Reset()
+
+	// --- Then ---
+	assert.Equal(t, uint32(0), ch.Size())
+	assert.Len(t, 0, ch.Headers)
+}
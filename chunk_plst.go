@@ -0,0 +1,116 @@
+package riff
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+)
+
+// IDplst represents "plst" chunk ID.
+const IDplst uint32 = 0x706c7374
+
+// PLSTChunkSize represents the size of plst chunk static part in bytes.
+// Does not count ID and segments.
+const PLSTChunkSize uint32 = 4
+
+// PlaySegmentSize represents the size of a single play segment in bytes.
+const PlaySegmentSize uint32 = 12
+
+// PlaySegment represents a single segment in [ChunkPLST].
+type PlaySegment struct {
+	// The Cue Point ID specifies the cue point (defined in [ChunkCUE])
+	// at which the segment starts.
+	CuePointID uint32
+
+	// The length specifies the length of the segment in samples.
+	Length uint32
+
+	// The loops specifies the number of times to play the segment.
+	Loops uint32
+}
+
+// ChunkPLST represents the "plst" chunk. The playlist specifies a play
+// order for a series of cue points.
+type ChunkPLST struct {
+	// List of segments in play order.
+	Segments []PlaySegment
+}
+
+// PLSTMake is a [Maker] function for creating [ChunkPLST] instances.
+func PLSTMake() Chunk { return PLST() }
+
+// PLST returns a new instance of [ChunkPLST].
+func PLST() *ChunkPLST {
+	return &ChunkPLST{}
+}
+
+func (ch *ChunkPLST) ID() uint32     { return IDplst }
+func (ch *ChunkPLST) Size() uint32   { return PLSTChunkSize + uint32(len(ch.Segments))*PlaySegmentSize }
+func (ch *ChunkPLST) Type() uint32   { return 0 }
+func (ch *ChunkPLST) Multi() bool    { return false }
+func (ch *ChunkPLST) Chunks() Chunks { return nil }
+func (ch *ChunkPLST) Raw() bool      { return false }
+
+func (ch *ChunkPLST) ReadFrom(r io.Reader) (int64, error) {
+	bo := ByteOrder(r)
+	var sum int64
+	var size uint32
+	if err := binary.Read(r, bo, &size); err != nil {
+		return sum, fmt.Errorf(errFmtDecode, Uint32(IDplst), err)
+	}
+	sum += 4
+
+	if size < PLSTChunkSize {
+		return sum, fmt.Errorf(errFmtDecode, Uint32(IDplst), ErrTooShort)
+	}
+
+	var cnt uint32
+	if err := binary.Read(r, bo, &cnt); err != nil {
+		return sum, fmt.Errorf(errFmtDecode, Uint32(IDplst), err)
+	}
+	sum += int64(PLSTChunkSize)
+
+	if uint64(size) != uint64(PLSTChunkSize)+uint64(cnt)*uint64(PlaySegmentSize) {
+		return sum, fmt.Errorf(errFmtDecode, Uint32(IDplst), ErrChunkSizeMismatch)
+	}
+
+	for i := 0; i < int(cnt); i++ {
+		var seg PlaySegment
+		if err := binary.Read(r, bo, &seg); err != nil {
+			return sum, fmt.Errorf(errFmtDecode, Uint32(IDplst), err)
+		}
+		sum += int64(PlaySegmentSize)
+		ch.Segments = append(ch.Segments, seg)
+	}
+
+	return sum, nil
+}
+
+func (ch *ChunkPLST) WriteTo(w io.Writer) (int64, error) {
+	bo := ByteOrder(w)
+	var sum int64
+
+	n, err := WriteIDAndSize(w, IDplst, ch.Size())
+	sum += n
+	if err != nil {
+		return sum, fmt.Errorf(errFmtEncode, Uint32(IDplst), err)
+	}
+
+	if err = binary.Write(w, bo, uint32(len(ch.Segments))); err != nil {
+		return sum, fmt.Errorf(errFmtEncode, Uint32(IDplst), err)
+	}
+	sum += int64(PLSTChunkSize)
+
+	for _, seg := range ch.Segments {
+		if err = binary.Write(w, bo, seg); err != nil {
+			return sum, fmt.Errorf(errFmtEncode, Uint32(IDplst), err)
+		}
+		sum += int64(PlaySegmentSize)
+	}
+
+	return sum, nil
+}
+
+func (ch *ChunkPLST) Reset() {
+	ch.Segments = ch.Segments[:0]
+}
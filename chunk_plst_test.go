@@ -0,0 +1,158 @@
+package riff
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/ctx42/testing/pkg/assert"
+	"github.com/ctx42/testing/pkg/kit/iokit"
+	"github.com/ctx42/testing/pkg/must"
+
+	"github.com/rzajac/riff/internal/test"
+)
+
+func plstChunkTwoSegments(t *testing.T) io.Reader {
+	src := &bytes.Buffer{}
+	test.ReadFrom(t, src, Uint32(IDplst)) // ( 0) 4 - Chunk ID
+	test.WriteUint32LE(t, src, 28)        // ( 4) 4 - Chunk size
+	test.WriteUint32LE(t, src, 2)         // ( 8) 4 - Number of segments
+	test.WriteUint32LE(t, src, 2)         // (12) 4 - Cue point ID
+	test.WriteUint32LE(t, src, 1)         // (16) 4 - Length
+	test.WriteUint32LE(t, src, 2)         // (20) 4 - Loops
+	test.WriteUint32LE(t, src, 1)         // (24) 4 - Cue point ID
+	test.WriteUint32LE(t, src, 3)         // (28) 4 - Length
+	test.WriteUint32LE(t, src, 1)         // (32) 4 - Loops
+	// Total length: 8+4+2*12=36
+	return src
+}
+
+func Test_ChunkPLST_PLST(t *testing.T) {
+	// --- When ---
+	ch := PLST()
+
+	// --- Then ---
+	assert.Equal(t, IDplst, ch.ID())
+	assert.Equal(t, uint32(4), ch.Size())
+	assert.Equal(t, uint32(0), ch.Type())
+	assert.False(t, ch.Multi())
+	assert.Nil(t, ch.Chunks())
+	assert.False(t, ch.Raw())
+}
+
+func Test_ChunkPLST_ReadFrom(t *testing.T) {
+	// --- Given ---
+	src := plstChunkTwoSegments(t)
+	test.Skip4B(t, src) // Skip chunk ID.
+
+	// --- When ---
+	ch := PLST()
+	n, err := ch.ReadFrom(src)
+
+	// --- Then ---
+	assert.NoError(t, err)
+
+	assert.Equal(t, int64(32), n)
+	assert.Equal(t, uint32(28), ch.Size())
+	exp := []PlaySegment{
+		{CuePointID: 2, Length: 1, Loops: 2},
+		{CuePointID: 1, Length: 3, Loops: 1},
+	}
+	assert.Equal(t, exp, ch.Segments)
+	assert.True(t, test.IsAllRead(src))
+}
+
+func Test_ChunkPLST_ReadFrom_Errors(t *testing.T) {
+	// Reading less than 32 bytes should always result in an error.
+	for i := 1; i < 32; i++ {
+		// --- Given ---
+		src := plstChunkTwoSegments(t)
+		test.Skip4B(t, src) // Skip chunk ID.
+
+		// --- When ---
+		_, err := PLST().ReadFrom(io.LimitReader(src, int64(i)))
+
+		// --- Then ---
+		assert.Error(t, err)
+	}
+}
+
+func Test_ChunkPLST_ReadFrom_TooShortError(t *testing.T) {
+	// --- Given ---
+	src := &bytes.Buffer{}
+	test.WriteUint32LE(t, src, 3)
+
+	// --- When ---
+	n, err := PLST().ReadFrom(src)
+
+	// --- Then ---
+	assert.ErrorIs(t, ErrTooShort, err)
+	assert.ErrorContain(t, "plst chunk", err)
+	assert.Equal(t, int64(4), n)
+}
+
+func Test_ChunkPLST_ReadFrom_SizeMismatchError(t *testing.T) {
+	// --- Given ---
+	src := &bytes.Buffer{}
+	test.WriteUint32LE(t, src, 16)
+	test.WriteUint32LE(t, src, 2)
+
+	// --- When ---
+	n, err := PLST().ReadFrom(src)
+
+	// --- Then ---
+	assert.ErrorIs(t, ErrChunkSizeMismatch, err)
+	assert.Equal(t, int64(8), n)
+}
+
+func Test_ChunkPLST_WriteTo(t *testing.T) {
+	// --- Given ---
+	src := plstChunkTwoSegments(t)
+	test.Skip4B(t, src) // Skip chunk ID.
+
+	ch := PLST()
+	_, err := ch.ReadFrom(src)
+	assert.NoError(t, err)
+
+	// --- When ---
+	dst := &bytes.Buffer{}
+	n, err := ch.WriteTo(dst)
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, int64(36), n)
+	exp := must.Value(io.ReadAll(plstChunkTwoSegments(t)))
+	assert.Equal(t, exp, dst.Bytes())
+}
+
+func Test_ChunkPLST_WriteTo_Errors(t *testing.T) {
+	// Writing less than 36 bytes should always result in an error.
+	for i := 36; i > 0; i-- {
+		// --- Given ---
+		src := plstChunkTwoSegments(t)
+		test.Skip4B(t, src) // Skip chunk ID.
+
+		ch := PLST()
+		_, err := ch.ReadFrom(src)
+		assert.NoError(t, err)
+
+		// --- When ---
+		_, err = ch.WriteTo(iokit.ErrWriter(&bytes.Buffer{}, i))
+
+		// --- Then ---
+		assert.Error(t, err)
+	}
+}
+
+func Test_ChunkPLST_Reset(t *testing.T) {
+	// --- Given ---
+	ch := PLST()
+	ch.Segments = []PlaySegment{{CuePointID: 1}}
+
+	// --- When ---
+	ch.Reset()
+
+	// --- Then ---
+	assert.Equal(t, uint32(4), ch.Size())
+	assert.Len(t, 0, ch.Segments)
+}
@@ -0,0 +1,141 @@
+package riff
+
+import (
+	"fmt"
+	"io"
+)
+
+// IDptbl represents "ptbl" (pool table) chunk ID.
+const IDptbl uint32 = 0x7074626c
+
+// PTBLChunkSize represents the size of ptbl chunk static part in bytes.
+const PTBLChunkSize uint32 = 8
+
+// ChunkPTBL represents "ptbl" chunk of the DLS file. It maps the wave
+// link table indexes to the waves in the "LIST wvpl" chunk.
+type ChunkPTBL struct {
+	// Chunk size in bytes.
+	// The ID and extra padding byte is not counted in the chunk size.
+	size uint32
+
+	// Size of the static part in bytes. Should be 8.
+	HeaderSize uint32
+
+	// Number of cues.
+	CueCount uint32
+
+	// Offsets of the "LIST wave" chunks relative to the beginning of the
+	// "LIST wvpl" chunk data (after the list type). Decoded only when
+	// HeaderSize is 8.
+	Cues []uint32
+
+	// Bytes following the static part which are not decoded cues.
+	extra []byte
+}
+
+// PTBLMake is a [Maker] function for creating [ChunkPTBL] instances.
+func PTBLMake() Chunk { return PTBL() }
+
+// PTBL returns a new instance of [ChunkPTBL].
+func PTBL() *ChunkPTBL {
+	return &ChunkPTBL{size: PTBLChunkSize, HeaderSize: PTBLChunkSize}
+}
+
+func (ch *ChunkPTBL) ID() uint32 { return IDptbl }
+func (ch *ChunkPTBL) Size() uint32 {
+	return PTBLChunkSize + uint32(len(ch.Cues))*4 + uint32(len(ch.extra))
+}
+func (ch *ChunkPTBL) Type() uint32   { return 0 }
+func (ch *ChunkPTBL) Multi() bool    { return false }
+func (ch *ChunkPTBL) Chunks() Chunks { return nil }
+func (ch *ChunkPTBL) Raw() bool      { return false }
+
+func (ch *ChunkPTBL) ReadFrom(r io.Reader) (int64, error) {
+	bo := ByteOrder(r)
+	var sum int64
+	var err error
+	if ch.size, err = ReadChunkSize(r); err != nil {
+		return sum, fmt.Errorf(errFmtDecode, Uint32(IDptbl), err)
+	}
+	sum += 4
+
+	if ch.size < PTBLChunkSize {
+		return sum, fmt.Errorf(errFmtDecode, Uint32(IDptbl), ErrTooShort)
+	}
+
+	buf := make([]byte, ch.size)
+	in, err := io.ReadFull(r, buf)
+	sum += int64(in)
+	if err != nil {
+		return sum, fmt.Errorf(errFmtDecode, Uint32(IDptbl), err)
+	}
+
+	ch.HeaderSize = bo.Uint32(buf)
+	ch.CueCount = bo.Uint32(buf[4:])
+	buf = buf[PTBLChunkSize:]
+
+	if ch.HeaderSize == PTBLChunkSize {
+		if uint64(ch.CueCount)*4 > uint64(len(buf)) {
+			return sum, fmt.Errorf(errFmtDecode, Uint32(IDptbl), ErrChunkSizeMismatch)
+		}
+		for i := 0; i < int(ch.CueCount); i++ {
+			ch.Cues = append(ch.Cues, bo.Uint32(buf[i*4:]))
+		}
+		buf = buf[int(ch.CueCount)*4:]
+	}
+	ch.extra = append(ch.extra[:0], buf...)
+
+	n, err := ReadPaddingIf(r, ch.size)
+	sum += n
+	if err != nil {
+		return sum, fmt.Errorf(errFmtDecode, Uint32(IDptbl), err)
+	}
+
+	return sum, nil
+}
+
+func (ch *ChunkPTBL) WriteTo(w io.Writer) (int64, error) {
+	bo := ByteOrder(w)
+	var sum int64
+
+	ch.size = ch.Size()
+	if ch.HeaderSize == PTBLChunkSize {
+		ch.CueCount = uint32(len(ch.Cues))
+	}
+
+	n, err := WriteIDAndSize(w, IDptbl, ch.size)
+	sum += n
+	if err != nil {
+		return sum, fmt.Errorf(errFmtEncode, Uint32(IDptbl), err)
+	}
+
+	buf := make([]byte, 0, ch.size)
+	buf = bo.AppendUint32(buf, ch.HeaderSize)
+	buf = bo.AppendUint32(buf, ch.CueCount)
+	for _, c := range ch.Cues {
+		buf = bo.AppendUint32(buf, c)
+	}
+	buf = append(buf, ch.extra...)
+
+	in, err := w.Write(buf)
+	sum += int64(in)
+	if err != nil {
+		return sum, fmt.Errorf(errFmtEncode, Uint32(IDptbl), err)
+	}
+
+	n, err = WritePaddingIf(w, ch.size)
+	sum += n
+	if err != nil {
+		return sum, fmt.Errorf(errFmtEncode, Uint32(IDptbl), err)
+	}
+
+	return sum, nil
+}
+
+func (ch *ChunkPTBL) Reset() {
+	ch.size = PTBLChunkSize
+	ch.HeaderSize = PTBLChunkSize
+	ch.CueCount = 0
+	ch.Cues = ch.Cues[:0]
+	ch.extra = ch.extra[:0]
+}
@@ -0,0 +1,159 @@
+package riff
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/ctx42/testing/pkg/assert"
+	"github.com/ctx42/testing/pkg/kit/iokit"
+	"github.com/ctx42/testing/pkg/must"
+
+	"github.com/rzajac/riff/internal/test"
+)
+
+func ptblChunk(t *testing.T) io.Reader {
+	src := &bytes.Buffer{}
+	test.ReadFrom(t, src, Uint32(IDptbl)) // ( 0) 4 - Chunk ID
+	test.WriteUint32LE(t, src, 16)        // ( 4) 4 - Chunk size
+	test.WriteUint32LE(t, src, 8)         // ( 8) 4 - HeaderSize
+	test.WriteUint32LE(t, src, 2)         // (12) 4 - CueCount
+	test.WriteUint32LE(t, src, 0)         // (16) 4 - Cue
+	test.WriteUint32LE(t, src, 64)        // (20) 4 - Cue
+	// Total length: 8+16=24
+	return src
+}
+
+func Test_ChunkPTBL_PTBL(t *testing.T) {
+	// --- When ---
+	ch := PTBL()
+
+	// --- Then ---
+	assert.Equal(t, IDptbl, ch.ID())
+	assert.Equal(t, PTBLChunkSize, ch.Size())
+	assert.Equal(t, uint32(0), ch.Type())
+	assert.False(t, ch.Multi())
+	assert.Nil(t, ch.Chunks())
+	assert.False(t, ch.Raw())
+	assert.Equal(t, PTBLChunkSize, ch.HeaderSize)
+}
+
+func Test_ChunkPTBL_ReadFrom(t *testing.T) {
+	// --- Given ---
+	src := ptblChunk(t)
+	test.Skip4B(t, src) // Skip chunk ID.
+
+	// --- When ---
+	ch := PTBL()
+	n, err := ch.ReadFrom(src)
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, int64(20), n)
+	assert.Equal(t, uint32(16), ch.Size())
+	assert.Equal(t, uint32(2), ch.CueCount)
+	assert.Equal(t, []uint32{0, 64}, ch.Cues)
+	assert.True(t, test.IsAllRead(src))
+}
+
+func Test_ChunkPTBL_ReadFrom_UnknownHeader(t *testing.T) {
+	// --- Given ---
+	b := must.Value(io.ReadAll(ptblChunk(t)))
+	le.PutUint32(b[8:], 12)
+
+	// --- When ---
+	ch := PTBL()
+	_, err := ch.ReadFrom(bytes.NewReader(b[4:]))
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Len(t, 0, ch.Cues)
+
+	dst := &bytes.Buffer{}
+	must.Value(ch.WriteTo(dst))
+	assert.Equal(t, b, dst.Bytes())
+}
+
+func Test_ChunkPTBL_ReadFrom_Errors(t *testing.T) {
+	// Reading less than 20 bytes should always result in an error.
+	for _, i := range []int{1, 4, 5, 19} {
+		// --- Given ---
+		src := ptblChunk(t)
+		test.Skip4B(t, src) // Skip chunk ID.
+
+		// --- When ---
+		_, err := PTBL().ReadFrom(io.LimitReader(src, int64(i)))
+
+		// --- Then ---
+		assert.Error(t, err)
+	}
+}
+
+func Test_ChunkPTBL_ReadFrom_Invalid(t *testing.T) {
+	t.Run("too short", func(t *testing.T) {
+		// --- Given ---
+		src := &bytes.Buffer{}
+		test.WriteUint32LE(t, src, 7)
+
+		// --- When ---
+		_, err := PTBL().ReadFrom(src)
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrTooShort, err)
+	})
+
+	t.Run("cue count", func(t *testing.T) {
+		// --- Given ---
+		b := must.Value(io.ReadAll(ptblChunk(t)))
+		le.PutUint32(b[12:], 3)
+
+		// --- When ---
+		_, err := PTBL().ReadFrom(bytes.NewReader(b[4:]))
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrChunkSizeMismatch, err)
+	})
+}
+
+func Test_ChunkPTBL_WriteTo(t *testing.T) {
+	// --- Given ---
+	ch := PTBL()
+	ch.Cues = []uint32{0, 64}
+
+	// --- When ---
+	dst := &bytes.Buffer{}
+	n, err := ch.WriteTo(dst)
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, int64(24), n)
+	exp := must.Value(io.ReadAll(ptblChunk(t)))
+	assert.Equal(t, exp, dst.Bytes())
+}
+
+func Test_ChunkPTBL_WriteTo_Errors(t *testing.T) {
+	for _, i := range []int{1, 4, 8, 15} {
+		// --- When ---
+		_, err := PTBL().WriteTo(iokit.ErrWriter(&bytes.Buffer{}, i))
+
+		// --- Then ---
+		assert.Error(t, err)
+	}
+}
+
+func Test_ChunkPTBL_Reset(t *testing.T) {
+	// --- Given ---
+	src := ptblChunk(t)
+	test.Skip4B(t, src) // Skip chunk ID.
+	ch := PTBL()
+	_, err := ch.ReadFrom(src)
+	assert.NoError(t, err)
+
+	// --- When ---
+	ch.Reset()
+
+	// --- Then ---
+	assert.Equal(t, PTBLChunkSize, ch.Size())
+	assert.Equal(t, uint32(0), ch.CueCount)
+	assert.Len(t, 0, ch.Cues)
+}
@@ -0,0 +1,93 @@
+package riff
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+)
+
+// IDrate represents "rate" (animated cursor display rates) chunk ID.
+const IDrate uint32 = 0x72617465
+
+// ChunkRATE represents "rate" chunk of the animated cursor file. It holds
+// the display rate of each animation step.
+type ChunkRATE struct {
+	// Chunk size in bytes.
+	// The ID and extra padding byte is not counted in the chunk size.
+	size uint32
+
+	// Display rate of each step in jiffies (1/60 of a second).
+	Rates []uint32
+}
+
+// RATEMake is a [Maker] function for creating [ChunkRATE] instances.
+func RATEMake() Chunk { return RATE() }
+
+// RATE returns a new instance of [ChunkRATE].
+func RATE() *ChunkRATE {
+	return &ChunkRATE{}
+}
+
+func (ch *ChunkRATE) ID() uint32     { return IDrate }
+func (ch *ChunkRATE) Size() uint32   { return uint32(len(ch.Rates)) * 4 }
+func (ch *ChunkRATE) Type() uint32   { return 0 }
+func (ch *ChunkRATE) Multi() bool    { return false }
+func (ch *ChunkRATE) Chunks() Chunks { return nil }
+func (ch *ChunkRATE) Raw() bool      { return false }
+
+func (ch *ChunkRATE) ReadFrom(r io.Reader) (int64, error) {
+	var sum int64
+	var err error
+	if ch.size, err = ReadChunkSize(r); err != nil {
+		return sum, fmt.Errorf(errFmtDecode, Uint32(IDrate), err)
+	}
+	sum += 4
+
+	if ch.Rates, err = readUint32s(r, ch.size, ch.Rates); err != nil {
+		return sum, fmt.Errorf(errFmtDecode, Uint32(IDrate), err)
+	}
+	sum += int64(ch.size)
+
+	return sum, nil
+}
+
+func (ch *ChunkRATE) WriteTo(w io.Writer) (int64, error) {
+	var sum int64
+
+	ch.size = ch.Size()
+
+	n, err := WriteIDAndSize(w, IDrate, ch.size)
+	sum += n
+	if err != nil {
+		return sum, fmt.Errorf(errFmtEncode, Uint32(IDrate), err)
+	}
+
+	if err = binary.Write(w, ByteOrder(w), ch.Rates); err != nil {
+		return sum, fmt.Errorf(errFmtEncode, Uint32(IDrate), err)
+	}
+	sum += int64(ch.size)
+
+	return sum, nil
+}
+
+func (ch *ChunkRATE) Reset() {
+	ch.size = 0
+	ch.Rates = ch.Rates[:0]
+}
+
+// readUint32s reads size bytes of uint32 values in the byte order of r
+// appending them to dst. The size must be a multiple of four.
+func readUint32s(r io.Reader, size uint32, dst []uint32) ([]uint32, error) {
+	if size%4 != 0 {
+		return dst, ErrChunkSizeMismatch
+	}
+	buf := make([]byte, size)
+	if _, err := io.ReadFull(r, buf); err != nil {
+		return dst, err
+	}
+	bo := ByteOrder(r)
+	for i := 0; i < len(buf); i += 4 {
+		dst = append(dst, bo.Uint32(buf[i:]))
+	}
+	return dst, nil
+}
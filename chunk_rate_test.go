@@ -0,0 +1,128 @@
+package riff
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/ctx42/testing/pkg/assert"
+	"github.com/ctx42/testing/pkg/kit/iokit"
+	"github.com/ctx42/testing/pkg/must"
+
+	"github.com/rzajac/riff/internal/test"
+)
+
+func rateChunk(t *testing.T) io.Reader {
+	src := &bytes.Buffer{}
+	test.ReadFrom(t, src, Uint32(IDrate)) // ( 0) 4 - Chunk ID
+	test.WriteUint32LE(t, src, 12)        // ( 4) 4 - Chunk size
+	test.WriteUint32LE(t, src, 6)         // ( 8) 4 - Rate
+	test.WriteUint32LE(t, src, 12)        // (12) 4 - Rate
+	test.WriteUint32LE(t, src, 6)         // (16) 4 - Rate
+	// Total length: 8+12=20
+	return src
+}
+
+func Test_ChunkRATE_RATE(t *testing.T) {
+	// --- When ---
+	ch := RATE()
+
+	// --- Then ---
+	assert.Equal(t, IDrate, ch.ID())
+	assert.Equal(t, uint32(0), ch.Size())
+	assert.Equal(t, uint32(0), ch.Type())
+	assert.False(t, ch.Multi())
+	assert.Nil(t, ch.Chunks())
+	assert.False(t, ch.Raw())
+}
+
+func Test_ChunkRATE_ReadFrom(t *testing.T) {
+	// --- Given ---
+	src := rateChunk(t)
+	test.Skip4B(t, src) // Skip chunk ID.
+
+	// --- When ---
+	ch := RATE()
+	n, err := ch.ReadFrom(src)
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, int64(16), n)
+	assert.Equal(t, uint32(12), ch.Size())
+	assert.Equal(t, []uint32{6, 12, 6}, ch.Rates)
+	assert.True(t, test.IsAllRead(src))
+}
+
+func Test_ChunkRATE_ReadFrom_Errors(t *testing.T) {
+	// Reading less than 16 bytes should always result in an error.
+	for _, i := range []int{1, 4, 5, 15} {
+		// --- Given ---
+		src := rateChunk(t)
+		test.Skip4B(t, src) // Skip chunk ID.
+
+		// --- When ---
+		_, err := RATE().ReadFrom(io.LimitReader(src, int64(i)))
+
+		// --- Then ---
+		assert.Error(t, err)
+	}
+}
+
+func Test_ChunkRATE_ReadFrom_SizeMismatch(t *testing.T) {
+	// --- Given ---
+	src := &bytes.Buffer{}
+	test.WriteUint32LE(t, src, 6)
+	test.WriteBytes(t, src, make([]byte, 6))
+
+	// --- When ---
+	_, err := RATE().ReadFrom(src)
+
+	// --- Then ---
+	assert.ErrorIs(t, ErrChunkSizeMismatch, err)
+}
+
+func Test_ChunkRATE_WriteTo(t *testing.T) {
+	// --- Given ---
+	ch := RATE()
+	ch.Rates = []uint32{6, 12, 6}
+
+	// --- When ---
+	dst := &bytes.Buffer{}
+	n, err := ch.WriteTo(dst)
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, int64(20), n)
+	exp := must.Value(io.ReadAll(rateChunk(t)))
+	assert.Equal(t, exp, dst.Bytes())
+}
+
+func Test_ChunkRATE_WriteTo_Errors(t *testing.T) {
+	for _, i := range []int{1, 4, 8, 19} {
+		// --- Given ---
+		ch := RATE()
+		ch.Rates = []uint32{6, 12, 6}
+
+		// --- When ---
+		_, err := ch.WriteTo(iokit.ErrWriter(&bytes.Buffer{}, i))
+
+		// --- Then ---
+		assert.Error(t, err)
+	}
+}
+
+func Test_ChunkRATE_Reset(t *testing.T) {
+	// --- Given ---
+	src := rateChunk(t)
+	test.Skip4B(t, src) // Skip chunk ID.
+	ch := RATE()
+	_, err := ch.ReadFrom(src)
+	assert.NoError(t, err)
+
+	// --- When ---
+	ch.Reset()
+
+	// --- Then ---
+	assert.Equal(t, uint32(0), ch.Size())
+	assert.Len(t, 0, ch.Rates)
+}
@@ -61,7 +61,7 @@ func (ch *ChunkRAWC) Body() io.Reader {
 func (ch *ChunkRAWC) ReadFrom(r io.Reader) (int64, error) {
 	var sum int64
 
-	if err := binary.Read(r, le, &ch.size); err != nil {
+	if err := binary.Read(r, ByteOrder(r), &ch.size); err != nil {
 		return sum, fmt.Errorf(errFmtDecode, linkids(idRAWC, ch.id), err)
 	}
 	sum += 4
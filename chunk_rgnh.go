@@ -0,0 +1,168 @@
+package riff
+
+import (
+	"fmt"
+	"io"
+)
+
+// IDrgnh represents "rgnh" (region header) sub-chunk ID of the DLS region.
+const IDrgnh uint32 = 0x72676e68
+
+// RGNHChunkSize represents the size of rgnh chunk static part in bytes.
+const RGNHChunkSize uint32 = 12
+
+// RGNHSelfNonExclusive represents the region header option allowing the
+// note to be played again without stopping the previous one.
+const RGNHSelfNonExclusive uint16 = 0x0001
+
+// ChunkRGNH represents "rgnh" chunk of the DLS region.
+type ChunkRGNH struct {
+	// Chunk size in bytes.
+	// The ID and extra padding byte is not counted in the chunk size.
+	size uint32
+
+	// Lowest MIDI key of the region.
+	KeyLow uint16
+
+	// Highest MIDI key of the region.
+	KeyHigh uint16
+
+	// Lowest MIDI velocity of the region.
+	VelocityLow uint16
+
+	// Highest MIDI velocity of the region.
+	VelocityHigh uint16
+
+	// Region options (e.g. [RGNHSelfNonExclusive]).
+	Options uint16
+
+	// Key group of the region. Regions in the same non-zero group stop
+	// each other (e.g. open and closed hi-hat).
+	KeyGroup uint16
+
+	// Editing layer of the region (DLS level 2). Used only when HasLayer
+	// is set.
+	Layer uint16
+
+	// True when the chunk has the Layer field.
+	HasLayer bool
+
+	// Bytes following the static part.
+	extra []byte
+}
+
+// RGNHMake is a [Maker] function for creating [ChunkRGNH] instances.
+func RGNHMake() Chunk { return RGNH() }
+
+// RGNH returns a new instance of [ChunkRGNH].
+func RGNH() *ChunkRGNH {
+	return &ChunkRGNH{size: RGNHChunkSize}
+}
+
+func (ch *ChunkRGNH) ID() uint32 { return IDrgnh }
+func (ch *ChunkRGNH) Size() uint32 {
+	size := RGNHChunkSize + uint32(len(ch.extra))
+	if ch.HasLayer {
+		size += 2
+	}
+	return size
+}
+func (ch *ChunkRGNH) Type() uint32   { return 0 }
+func (ch *ChunkRGNH) Multi() bool    { return true }
+func (ch *ChunkRGNH) Chunks() Chunks { return nil }
+func (ch *ChunkRGNH) Raw() bool      { return false }
+
+func (ch *ChunkRGNH) ReadFrom(r io.Reader) (int64, error) {
+	bo := ByteOrder(r)
+	var sum int64
+	var err error
+	if ch.size, err = ReadChunkSize(r); err != nil {
+		return sum, fmt.Errorf(errFmtDecode, Uint32(IDrgnh), err)
+	}
+	sum += 4
+
+	if ch.size < RGNHChunkSize {
+		return sum, fmt.Errorf(errFmtDecode, Uint32(IDrgnh), ErrTooShort)
+	}
+
+	buf := make([]byte, ch.size)
+	in, err := io.ReadFull(r, buf)
+	sum += int64(in)
+	if err != nil {
+		return sum, fmt.Errorf(errFmtDecode, Uint32(IDrgnh), err)
+	}
+
+	ch.KeyLow = bo.Uint16(buf)
+	ch.KeyHigh = bo.Uint16(buf[2:])
+	ch.VelocityLow = bo.Uint16(buf[4:])
+	ch.VelocityHigh = bo.Uint16(buf[6:])
+	ch.Options = bo.Uint16(buf[8:])
+	ch.KeyGroup = bo.Uint16(buf[10:])
+	buf = buf[RGNHChunkSize:]
+
+	if ch.HasLayer = len(buf) >= 2; ch.HasLayer {
+		ch.Layer = bo.Uint16(buf)
+		buf = buf[2:]
+	}
+	ch.extra = append(ch.extra[:0], buf...)
+
+	n, err := ReadPaddingIf(r, ch.size)
+	sum += n
+	if err != nil {
+		return sum, fmt.Errorf(errFmtDecode, Uint32(IDrgnh), err)
+	}
+
+	return sum, nil
+}
+
+func (ch *ChunkRGNH) WriteTo(w io.Writer) (int64, error) {
+	bo := ByteOrder(w)
+	var sum int64
+
+	ch.size = ch.Size()
+
+	n, err := WriteIDAndSize(w, IDrgnh, ch.size)
+	sum += n
+	if err != nil {
+		return sum, fmt.Errorf(errFmtEncode, Uint32(IDrgnh), err)
+	}
+
+	buf := make([]byte, 0, ch.size)
+	buf = bo.AppendUint16(buf, ch.KeyLow)
+	buf = bo.AppendUint16(buf, ch.KeyHigh)
+	buf = bo.AppendUint16(buf, ch.VelocityLow)
+	buf = bo.AppendUint16(buf, ch.VelocityHigh)
+	buf = bo.AppendUint16(buf, ch.Options)
+	buf = bo.AppendUint16(buf, ch.KeyGroup)
+	if ch.HasLayer {
+		buf = bo.AppendUint16(buf, ch.Layer)
+	}
+	buf = append(buf, ch.extra...)
+
+	in, err := w.Write(buf)
+	sum += int64(in)
+	if err != nil {
+		return sum, fmt.Errorf(errFmtEncode, Uint32(IDrgnh), err)
+	}
+
+	n, err = WritePaddingIf(w, ch.size)
+	sum += n
+	if err != nil {
+		return sum, fmt.Errorf(errFmtEncode, Uint32(IDrgnh), err)
+	}
+
+	return sum, nil
+}
+
+func (ch *ChunkRGNH) Reset() {
+	ch.size = RGNHChunkSize
+	ch.KeyLow = 0
+	ch.KeyHigh = 0
+	ch.VelocityLow = 0
+	ch.VelocityHigh = 0
+	ch.Options = 0
+	ch.KeyGroup = 0
+	ch.Layer = 0
+	ch.HasLayer = false
+	ch.extra = ch.extra[:0]
+}
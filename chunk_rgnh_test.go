@@ -0,0 +1,157 @@
+package riff
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/ctx42/testing/pkg/assert"
+	"github.com/ctx42/testing/pkg/kit/iokit"
+	"github.com/ctx42/testing/pkg/must"
+
+	"github.com/rzajac/riff/internal/test"
+)
+
+func rgnhChunk(t *testing.T) io.Reader {
+	src := &bytes.Buffer{}
+	test.ReadFrom(t, src, Uint32(IDrgnh))            // ( 0) 4 - Chunk ID
+	test.WriteUint32LE(t, src, 14)                   // ( 4) 4 - Chunk size
+	test.WriteUint16LE(t, src, 36)                   // ( 8) 2 - KeyLow
+	test.WriteUint16LE(t, src, 48)                   // (10) 2 - KeyHigh
+	test.WriteUint16LE(t, src, 0)                    // (12) 2 - VelocityLow
+	test.WriteUint16LE(t, src, 127)                  // (14) 2 - VelocityHigh
+	test.WriteUint16LE(t, src, RGNHSelfNonExclusive) // (16) 2 - Options
+	test.WriteUint16LE(t, src, 1)                    // (18) 2 - KeyGroup
+	test.WriteUint16LE(t, src, 2)                    // (20) 2 - Layer
+	// Total length: 8+14=22
+	return src
+}
+
+func Test_ChunkRGNH_RGNH(t *testing.T) {
+	// --- When ---
+	ch := RGNH()
+
+	// --- Then ---
+	assert.Equal(t, IDrgnh, ch.ID())
+	assert.Equal(t, RGNHChunkSize, ch.Size())
+	assert.Equal(t, uint32(0), ch.Type())
+	assert.True(t, ch.Multi())
+	assert.Nil(t, ch.Chunks())
+	assert.False(t, ch.Raw())
+	assert.False(t, ch.HasLayer)
+}
+
+func Test_ChunkRGNH_ReadFrom(t *testing.T) {
+	// --- Given ---
+	src := rgnhChunk(t)
+	test.Skip4B(t, src) // Skip chunk ID.
+
+	// --- When ---
+	ch := RGNH()
+	n, err := ch.ReadFrom(src)
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, int64(18), n)
+	assert.Equal(t, uint32(14), ch.Size())
+	assert.Equal(t, uint16(36), ch.KeyLow)
+	assert.Equal(t, uint16(48), ch.KeyHigh)
+	assert.Equal(t, uint16(0), ch.VelocityLow)
+	assert.Equal(t, uint16(127), ch.VelocityHigh)
+	assert.Equal(t, RGNHSelfNonExclusive, ch.Options)
+	assert.Equal(t, uint16(1), ch.KeyGroup)
+	assert.True(t, ch.HasLayer)
+	assert.Equal(t, uint16(2), ch.Layer)
+	assert.True(t, test.IsAllRead(src))
+}
+
+func Test_ChunkRGNH_ReadFrom_DLS1(t *testing.T) {
+	// --- Given ---
+	b := must.Value(io.ReadAll(rgnhChunk(t)))
+	le.PutUint32(b[4:], 12)
+
+	// --- When ---
+	ch := RGNH()
+	n, err := ch.ReadFrom(bytes.NewReader(b[4:20]))
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, int64(16), n)
+	assert.Equal(t, RGNHChunkSize, ch.Size())
+	assert.False(t, ch.HasLayer)
+	assert.Equal(t, uint16(0), ch.Layer)
+}
+
+func Test_ChunkRGNH_ReadFrom_Errors(t *testing.T) {
+	// Reading less than 18 bytes should always result in an error.
+	for _, i := range []int{1, 4, 5, 17} {
+		// --- Given ---
+		src := rgnhChunk(t)
+		test.Skip4B(t, src) // Skip chunk ID.
+
+		// --- When ---
+		_, err := RGNH().ReadFrom(io.LimitReader(src, int64(i)))
+
+		// --- Then ---
+		assert.Error(t, err)
+	}
+}
+
+func Test_ChunkRGNH_ReadFrom_TooShortError(t *testing.T) {
+	// --- Given ---
+	src := &bytes.Buffer{}
+	test.WriteUint32LE(t, src, 11)
+
+	// --- When ---
+	_, err := RGNH().ReadFrom(src)
+
+	// --- Then ---
+	assert.ErrorIs(t, ErrTooShort, err)
+}
+
+func Test_ChunkRGNH_WriteTo(t *testing.T) {
+	// --- Given ---
+	src := rgnhChunk(t)
+	test.Skip4B(t, src) // Skip chunk ID.
+
+	ch := RGNH()
+	_, err := ch.ReadFrom(src)
+	assert.NoError(t, err)
+
+	// --- When ---
+	dst := &bytes.Buffer{}
+	n, err := ch.WriteTo(dst)
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, int64(22), n)
+	exp := must.Value(io.ReadAll(rgnhChunk(t)))
+	assert.Equal(t, exp, dst.Bytes())
+}
+
+func Test_ChunkRGNH_WriteTo_Errors(t *testing.T) {
+	for _, i := range []int{1, 4, 8, 19} {
+		// --- When ---
+		_, err := RGNH().WriteTo(iokit.ErrWriter(&bytes.Buffer{}, i))
+
+		// --- Then ---
+		assert.Error(t, err)
+	}
+}
+
+func Test_ChunkRGNH_Reset(t *testing.T) {
+	// --- Given ---
+	src := rgnhChunk(t)
+	test.Skip4B(t, src) // Skip chunk ID.
+	ch := RGNH()
+	_, err := ch.ReadFrom(src)
+	assert.NoError(t, err)
+
+	// --- When ---
+	ch.Reset()
+
+	// --- Then ---
+	assert.Equal(t, RGNHChunkSize, ch.Size())
+	assert.Equal(t, uint16(0), ch.KeyHigh)
+	assert.False(t, ch.HasLayer)
+}
@@ -0,0 +1,100 @@
+package riff
+
+import (
+	"fmt"
+	"io"
+)
+
+// IDsm24 represents "sm24" (24-bit sample data) sub-chunk ID of the
+// "LIST sdta" chunk.
+const IDsm24 uint32 = 0x736d3234
+
+// ChunkSDTA represents "smpl" or "sm24" sample data chunk of the SoundFont
+// "LIST sdta" chunk. The "smpl" chunk holds 16-bit little-endian sample
+// data points and the "sm24" chunk the least significant bytes of the
+// 24-bit data points.
+type ChunkSDTA struct {
+	// Chunk ID.
+	id uint32
+
+	// Chunk size in bytes.
+	// The ID and extra padding byte is not counted in the chunk size.
+	size uint32
+
+	// The sample data. It's nil in SkipData mode.
+	data []byte
+}
+
+// SDTAMake returns [IDMaker] function for creating [ChunkSDTA] instances.
+func SDTAMake(load bool) IDMaker {
+	return func(id uint32) Chunk {
+		return SDTA(id, load)
+	}
+}
+
+// SDTA returns a new instance of [ChunkSDTA] for given ID ([IDsmpl] or
+// [IDsm24]). If load is false the sample data will not be loaded into
+// memory.
+func SDTA(id uint32, load bool) *ChunkSDTA {
+	ch := &ChunkSDTA{id: id}
+	if load {
+		ch.data = make([]byte, 0, 1<<15)
+	}
+	return ch
+}
+
+func (ch *ChunkSDTA) ID() uint32     { return ch.id }
+func (ch *ChunkSDTA) Size() uint32   { return ch.size }
+func (ch *ChunkSDTA) Type() uint32   { return 0 }
+func (ch *ChunkSDTA) Multi() bool    { return false }
+func (ch *ChunkSDTA) Chunks() Chunks { return nil }
+func (ch *ChunkSDTA) Raw() bool      { return false }
+
+// Data returns the sample data. It returns nil in SkipData mode.
+func (ch *ChunkSDTA) Data() []byte { return ch.data }
+
+// SetData sets the sample data. It will return [ErrSkipDataMode] if in
+// SkipData mode.
+func (ch *ChunkSDTA) SetData(data []byte) error {
+	if ch.data == nil {
+		return ErrSkipDataMode
+	}
+	ch.data = grow(ch.data, len(data))
+	copy(ch.data, data)
+	ch.size = uint32(len(data))
+	return nil
+}
+
+func (ch *ChunkSDTA) ReadFrom(r io.Reader) (int64, error) {
+	var sum int64
+	var err error
+	if ch.size, err = ReadChunkSize(r); err != nil {
+		return sum, fmt.Errorf(errFmtDecode, linkids(IDsdta, ch.id), err)
+	}
+	sum += 4
+
+	var n int64
+	ch.data, n, err = readBitstream(r, ch.size, nil, ch.data)
+	sum += n
+	if err != nil {
+		return sum, fmt.Errorf(errFmtDecode, linkids(IDsdta, ch.id), err)
+	}
+
+	return sum, nil
+}
+
+func (ch *ChunkSDTA) WriteTo(w io.Writer) (int64, error) {
+	if ch.data == nil {
+		return 0, ErrSkipDataMode
+	}
+	n, err := writeBitstream(w, ch.id, ch.data)
+	if err != nil {
+		return n, fmt.Errorf(errFmtEncode, linkids(IDsdta, ch.id), err)
+	}
+	return n, nil
+}
+
+func (ch *ChunkSDTA) Reset() {
+	ch.size = 0
+	ch.data = ch.data[:0]
+}
@@ -0,0 +1,189 @@
+package riff
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/ctx42/testing/pkg/assert"
+	"github.com/ctx42/testing/pkg/kit/iokit"
+	"github.com/ctx42/testing/pkg/must"
+
+	"github.com/rzajac/riff/internal/test"
+)
+
+func sdtaChunk(t *testing.T, id uint32) io.Reader {
+	src := &bytes.Buffer{}
+	test.ReadFrom(t, src, Uint32(id))                 // ( 0) 4 - Chunk ID
+	test.WriteUint32LE(t, src, 3)                     // ( 4) 4 - Chunk size
+	test.WriteBytes(t, src, []byte{0x01, 0x02, 0x03}) // ( 8) 3 - Data
+	test.WriteByte(t, src, 0)                         // (11) 1 - Padding byte
+	// Total length: 8+3+1=12
+	return src
+}
+
+func Test_ChunkSDTA_SDTA_SkipDataMode(t *testing.T) {
+	// --- When ---
+	ch := SDTA(IDsmpl, SkipData)
+
+	// --- Then ---
+	assert.Equal(t, IDsmpl, ch.ID())
+	assert.Equal(t, uint32(0), ch.Size())
+	assert.Equal(t, uint32(0), ch.Type())
+	assert.False(t, ch.Multi())
+	assert.Nil(t, ch.Chunks())
+	assert.False(t, ch.Raw())
+	assert.Nil(t, ch.Data())
+}
+
+func Test_ChunkSDTA_SDTA_LoadDataMode(t *testing.T) {
+	// --- When ---
+	ch := SDTA(IDsm24, LoadData)
+
+	// --- Then ---
+	assert.Equal(t, IDsm24, ch.ID())
+	assert.Equal(t, uint32(0), ch.Size())
+	assert.NotNil(t, ch.Data())
+	assert.Len(t, 0, ch.Data())
+}
+
+func Test_ChunkSDTA_SDTAMake(t *testing.T) {
+	// --- When ---
+	ch := SDTAMake(LoadData)(IDsm24)
+
+	// --- Then ---
+	assert.Equal(t, IDsm24, ch.ID())
+}
+
+func Test_ChunkSDTA_ReadFrom(t *testing.T) {
+	t.Run("load data", func(t *testing.T) {
+		// --- Given ---
+		src := sdtaChunk(t, IDsmpl)
+		test.Skip4B(t, src) // Skip chunk ID.
+
+		// --- When ---
+		ch := SDTA(IDsmpl, LoadData)
+		n, err := ch.ReadFrom(src)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, int64(8), n)
+		assert.Equal(t, uint32(3), ch.Size())
+		assert.Equal(t, []byte{0x01, 0x02, 0x03}, ch.Data())
+		assert.True(t, test.IsAllRead(src))
+	})
+
+	t.Run("skip data", func(t *testing.T) {
+		// --- Given ---
+		src := sdtaChunk(t, IDsmpl)
+		test.Skip4B(t, src) // Skip chunk ID.
+
+		// --- When ---
+		ch := SDTA(IDsmpl, SkipData)
+		n, err := ch.ReadFrom(src)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, int64(8), n)
+		assert.Equal(t, uint32(3), ch.Size())
+		assert.Nil(t, ch.Data())
+		assert.True(t, test.IsAllRead(src))
+	})
+}
+
+func Test_ChunkSDTA_ReadFrom_Errors(t *testing.T) {
+	// Reading less than 8 bytes should always result in an error.
+	for _, i := range []int{1, 4, 5, 7} {
+		// --- Given ---
+		src := sdtaChunk(t, IDsmpl)
+		test.Skip4B(t, src) // Skip chunk ID.
+
+		// --- When ---
+		_, err := SDTA(IDsmpl, LoadData).ReadFrom(io.LimitReader(src, int64(i)))
+
+		// --- Then ---
+		assert.Error(t, err)
+	}
+}
+
+func Test_ChunkSDTA_SetData(t *testing.T) {
+	t.Run("load data", func(t *testing.T) {
+		// --- Given ---
+		ch := SDTA(IDsmpl, LoadData)
+
+		// --- When ---
+		err := ch.SetData([]byte{0x01, 0x02})
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, uint32(2), ch.Size())
+		assert.Equal(t, []byte{0x01, 0x02}, ch.Data())
+	})
+
+	t.Run("skip data", func(t *testing.T) {
+		// --- Given ---
+		ch := SDTA(IDsmpl, SkipData)
+
+		// --- When ---
+		err := ch.SetData([]byte{0x01, 0x02})
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrSkipDataMode, err)
+	})
+}
+
+func Test_ChunkSDTA_WriteTo(t *testing.T) {
+	// --- Given ---
+	ch := SDTA(IDsm24, LoadData)
+	must.Nil(ch.SetData([]byte{0x01, 0x02, 0x03}))
+
+	// --- When ---
+	dst := &bytes.Buffer{}
+	n, err := ch.WriteTo(dst)
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, int64(12), n)
+	exp := must.Value(io.ReadAll(sdtaChunk(t, IDsm24)))
+	assert.Equal(t, exp, dst.Bytes())
+}
+
+func Test_ChunkSDTA_WriteTo_SkipDataMode(t *testing.T) {
+	// --- When ---
+	n, err := SDTA(IDsmpl, SkipData).WriteTo(&bytes.Buffer{})
+
+	// --- Then ---
+	assert.ErrorIs(t, ErrSkipDataMode, err)
+	assert.Equal(t, int64(0), n)
+}
+
+func Test_ChunkSDTA_WriteTo_Errors(t *testing.T) {
+	for _, i := range []int{1, 4, 8, 10} {
+		// --- Given ---
+		ch := SDTA(IDsmpl, LoadData)
+		must.Nil(ch.SetData([]byte{0x01, 0x02, 0x03}))
+
+		// --- When ---
+		_, err := ch.WriteTo(iokit.ErrWriter(&bytes.Buffer{}, i))
+
+		// --- Then ---
+		assert.Error(t, err)
+	}
+}
+
+func Test_ChunkSDTA_Reset(t *testing.T) {
+	// --- Given ---
+	src := sdtaChunk(t, IDsmpl)
+	test.Skip4B(t, src) // Skip chunk ID.
+	ch := SDTA(IDsmpl, LoadData)
+	_, err := ch.ReadFrom(src)
+	assert.NoError(t, err)
+
+	// --- When ---
+	ch.Reset()
+
+	// --- Then ---
+	assert.Equal(t, IDsmpl, ch.ID())
+	assert.Equal(t, uint32(0), ch.Size())
+	assert.Len(t, 0, ch.Data())
+}
@@ -0,0 +1,76 @@
+package riff
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+)
+
+// IDseq represents "seq " (animated cursor sequence) chunk ID.
+const IDseq uint32 = 0x73657120
+
+// ChunkSEQ represents "seq " chunk of the animated cursor file. It holds
+// the frame index of each animation step.
+type ChunkSEQ struct {
+	// Chunk size in bytes.
+	// The ID and extra padding byte is not counted in the chunk size.
+	size uint32
+
+	// Frame index of each step.
+	Frames []uint32
+}
+
+// SEQMake is a [Maker] function for creating [ChunkSEQ] instances.
+func SEQMake() Chunk { return SEQ() }
+
+// SEQ returns a new instance of [ChunkSEQ].
+func SEQ() *ChunkSEQ {
+	return &ChunkSEQ{}
+}
+
+func (ch *ChunkSEQ) ID() uint32     { return IDseq }
+func (ch *ChunkSEQ) Size() uint32   { return uint32(len(ch.Frames)) * 4 }
+func (ch *ChunkSEQ) Type() uint32   { return 0 }
+func (ch *ChunkSEQ) Multi() bool    { return false }
+func (ch *ChunkSEQ) Chunks() Chunks { return nil }
+func (ch *ChunkSEQ) Raw() bool      { return false }
+
+func (ch *ChunkSEQ) ReadFrom(r io.Reader) (int64, error) {
+	var sum int64
+	var err error
+	if ch.size, err = ReadChunkSize(r); err != nil {
+		return sum, fmt.Errorf(errFmtDecode, Uint32(IDseq), err)
+	}
+	sum += 4
+
+	if ch.Frames, err = readUint32s(r, ch.size, ch.Frames); err != nil {
+		return sum, fmt.Errorf(errFmtDecode, Uint32(IDseq), err)
+	}
+	sum += int64(ch.size)
+
+	return sum, nil
+}
+
+func (ch *ChunkSEQ) WriteTo(w io.Writer) (int64, error) {
+	var sum int64
+
+	ch.size = ch.Size()
+
+	n, err := WriteIDAndSize(w, IDseq, ch.size)
+	sum += n
+	if err != nil {
+		return sum, fmt.Errorf(errFmtEncode, Uint32(IDseq), err)
+	}
+
+	if err = binary.Write(w, ByteOrder(w), ch.Frames); err != nil {
+		return sum, fmt.Errorf(errFmtEncode, Uint32(IDseq), err)
+	}
+	sum += int64(ch.size)
+
+	return sum, nil
+}
+
+func (ch *ChunkSEQ) Reset() {
+	ch.size = 0
+	ch.Frames = ch.Frames[:0]
+}
@@ -0,0 +1,139 @@
+package riff
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+)
+
+// IDshdr represents "shdr" (sample headers) sub-chunk ID of the "LIST pdta"
+// chunk.
+const IDshdr uint32 = 0x73686472
+
+// SF2SampleHeaderSize represents the size of the sample header record in bytes.
+const SF2SampleHeaderSize uint32 = 46
+
+// SoundFont sample types.
+const (
+	// SF2SampleMono represents the mono sample.
+	SF2SampleMono uint16 = 0x0001
+
+	// SF2SampleRight represents the right channel of the stereo sample.
+	SF2SampleRight uint16 = 0x0002
+
+	// SF2SampleLeft represents the left channel of the stereo sample.
+	SF2SampleLeft uint16 = 0x0004
+
+	// SF2SampleLinked represents the sample of the linked samples chain.
+	SF2SampleLinked uint16 = 0x0008
+
+	// SF2SampleROM represents the sample stored in the sound ROM.
+	SF2SampleROM uint16 = 0x8000
+)
+
+// SF2SampleHeader represents the SoundFont sample header record. The
+// sample positions are indexes of the sample data points in the "smpl"
+// chunk.
+type SF2SampleHeader struct {
+	// Zero terminated sample name.
+	Name [20]byte
+
+	// Index of the first sample data point.
+	Start uint32
+
+	// Index of the first sample data point after the sample.
+	End uint32
+
+	// Index of the first sample data point of the loop.
+	StartLoop uint32
+
+	// Index of the first sample data point after the loop.
+	EndLoop uint32
+
+	// Sample rate in Hz.
+	SampleRate uint32
+
+	// MIDI key number of the recorded pitch of the sample.
+	OriginalPitch uint8
+
+	// Pitch correction in cents.
+	PitchCorrection int8
+
+	// Index of the linked sample header (e.g. the other channel of the
+	// stereo sample).
+	SampleLink uint16
+
+	// Sample type (see SF2Sample* constants).
+	SampleType uint16
+}
+
+// ChunkSHDR represents "shdr" chunk of the SoundFont file.
+type ChunkSHDR struct {
+	// Chunk size in bytes.
+	// The ID and extra padding byte is not counted in the chunk size.
+	size uint32
+
+	// Sample header records. The last one is the terminal "EOS" record.
+	Headers []SF2SampleHeader
+}
+
+// SHDRMake is a [Maker] function for creating [ChunkSHDR] instances.
+func SHDRMake() Chunk { return SHDR() }
+
+// SHDR returns a new instance of [ChunkSHDR].
+func SHDR() *ChunkSHDR {
+	return &ChunkSHDR{}
+}
+
+func (ch *ChunkSHDR) ID() uint32     { return IDshdr }
+func (ch *ChunkSHDR) Size() uint32   { return uint32(len(ch.Headers)) * SF2SampleHeaderSize }
+func (ch *ChunkSHDR) Type() uint32   { return 0 }
+func (ch *ChunkSHDR) Multi() bool    { return false }
+func (ch *ChunkSHDR) Chunks() Chunks { return nil }
+func (ch *ChunkSHDR) Raw() bool      { return false }
+
+func (ch *ChunkSHDR) ReadFrom(r io.Reader) (int64, error) {
+	var sum int64
+	var err error
+	if ch.size, err = ReadChunkSize(r); err != nil {
+		return sum, fmt.Errorf(errFmtDecode, Uint32(IDshdr), err)
+	}
+	sum += 4
+
+	if ch.size%SF2SampleHeaderSize != 0 {
+		return sum, fmt.Errorf(errFmtDecode, Uint32(IDshdr), ErrChunkSizeMismatch)
+	}
+
+	recs := make([]SF2SampleHeader, ch.size/SF2SampleHeaderSize)
+	if err = binary.Read(r, ByteOrder(r), recs); err != nil {
+		return sum, fmt.Errorf(errFmtDecode, Uint32(IDshdr), err)
+	}
+	ch.Headers = append(ch.Headers[:0], recs...)
+	sum += int64(ch.size)
+
+	return sum, nil
+}
+
+func (ch *ChunkSHDR) WriteTo(w io.Writer) (int64, error) {
+	var sum int64
+
+	ch.size = ch.Size()
+
+	n, err := WriteIDAndSize(w, IDshdr, ch.size)
+	sum += n
+	if err != nil {
+		return sum, fmt.Errorf(errFmtEncode, Uint32(IDshdr), err)
+	}
+
+	if err = binary.Write(w, ByteOrder(w), ch.Headers); err != nil {
+		return sum, fmt.Errorf(errFmtEncode, Uint32(IDshdr), err)
+	}
+	sum += int64(ch.size)
+
+	return sum, nil
+}
+
+func (ch *ChunkSHDR) Reset() {
+	ch.size = 0
+	ch.Headers = ch.Headers[:0]
+}
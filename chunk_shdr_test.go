@@ -0,0 +1,158 @@
+package riff
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/ctx42/testing/pkg/assert"
+	"github.com/ctx42/testing/pkg/kit/iokit"
+	"github.com/ctx42/testing/pkg/must"
+
+	"github.com/rzajac/riff/internal/test"
+)
+
+func shdrChunk(t *testing.T) io.Reader {
+	src := &bytes.Buffer{}
+	test.ReadFrom(t, src, Uint32(IDshdr))     // ( 0)  4 - Chunk ID
+	test.WriteUint32LE(t, src, 46)            // ( 4)  4 - Chunk size
+	test.WriteBytes(t, src, sf2NameB("Kick")) // ( 8) 20 - Name
+	test.WriteUint32LE(t, src, 0)             // (28)  4 - Start
+	test.WriteUint32LE(t, src, 100)           // (32)  4 - End
+	test.WriteUint32LE(t, src, 10)            // (36)  4 - StartLoop
+	test.WriteUint32LE(t, src, 90)            // (40)  4 - EndLoop
+	test.WriteUint32LE(t, src, 44100)         // (44)  4 - SampleRate
+	test.WriteByte(t, src, 60)                // (48)  1 - OriginalPitch
+	test.WriteByte(t, src, 0xfb)              // (49)  1 - PitchCorrection
+	test.WriteUint16LE(t, src, 0)             // (50)  2 - SampleLink
+	test.WriteUint16LE(t, src, 1)             // (52)  2 - SampleType
+	// Total length: 8+46=54
+	return src
+}
+
+func Test_ChunkSHDR_SHDR(t *testing.T) {
+	// --- When ---
+	ch := SHDR()
+
+	// --- Then ---
+	assert.Equal(t, IDshdr, ch.ID())
+	assert.Equal(t, uint32(0), ch.Size())
+	assert.Equal(t, uint32(0), ch.Type())
+	assert.False(t, ch.Multi())
+	assert.Nil(t, ch.Chunks())
+	assert.False(t, ch.Raw())
+	assert.Len(t, 0, ch.Headers)
+}
+
+func Test_ChunkSHDR_ReadFrom(t *testing.T) {
+	// --- Given ---
+	src := shdrChunk(t)
+	test.Skip4B(t, src) // Skip chunk ID.
+
+	// --- When ---
+	ch := SHDR()
+	n, err := ch.ReadFrom(src)
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, int64(50), n)
+	assert.Equal(t, uint32(46), ch.Size())
+	exp := []SF2SampleHeader{
+		{
+			Name:            sf2Name("Kick"),
+			End:             100,
+			StartLoop:       10,
+			EndLoop:         90,
+			SampleRate:      44100,
+			OriginalPitch:   60,
+			PitchCorrection: -5,
+			SampleType:      SF2SampleMono,
+		},
+	}
+	assert.Equal(t, exp, ch.Headers)
+	assert.True(t, test.IsAllRead(src))
+}
+
+func Test_ChunkSHDR_ReadFrom_SizeMismatch(t *testing.T) {
+	// --- Given ---
+	src := &bytes.Buffer{}
+	test.WriteUint32LE(t, src, SF2SampleHeaderSize-1)
+
+	// --- When ---
+	_, err := SHDR().ReadFrom(src)
+
+	// --- Then ---
+	assert.ErrorIs(t, ErrChunkSizeMismatch, err)
+}
+
+func Test_ChunkSHDR_ReadFrom_Errors(t *testing.T) {
+	// Reading less than 50 bytes should always result in an error.
+	for _, i := range []int{1, 4, 5, 30, 49} {
+		// --- Given ---
+		src := shdrChunk(t)
+		test.Skip4B(t, src) // Skip chunk ID.
+
+		// --- When ---
+		_, err := SHDR().ReadFrom(io.LimitReader(src, int64(i)))
+
+		// --- Then ---
+		assert.Error(t, err)
+	}
+}
+
+func Test_ChunkSHDR_WriteTo(t *testing.T) {
+	// --- Given ---
+	ch := SHDR()
+	ch.Headers = []SF2SampleHeader{
+		{
+			Name:            sf2Name("Kick"),
+			End:             100,
+			StartLoop:       10,
+			EndLoop:         90,
+			SampleRate:      44100,
+			OriginalPitch:   60,
+			PitchCorrection: -5,
+			SampleType:      SF2SampleMono,
+		},
+	}
+
+	// --- When ---
+	dst := &bytes.Buffer{}
+	n, err := ch.WriteTo(dst)
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, int64(54), n)
+	exp := must.Value(io.ReadAll(shdrChunk(t)))
+	assert.Equal(t, exp, dst.Bytes())
+}
+
+func Test_ChunkSHDR_WriteTo_Errors(t *testing.T) {
+	for _, i := range []int{1, 4, 8, 30} {
+		// --- Given ---
+		ch := SHDR()
+		ch.Headers = []SF2SampleHeader{{}}
+
+		// --- When ---
+		_, err := ch.WriteTo(iokit.ErrWriter(&bytes.Buffer{}, i))
+
+		// --- Then ---
+		assert.Error(t, err)
+	}
+}
+
+func Test_ChunkSHDR_Reset(t *testing.T) {
+	// --- Given ---
+	src := shdrChunk(t)
+	test.Skip4B(t, src) // Skip chunk ID.
+	ch := SHDR()
+	_, err := ch.ReadFrom(src)
+	assert.NoError(t, err)
+
+	// --- When ---
+	ch.Reset()
+
+	// --- Then ---
+	assert.Equal(t, uint32(0), ch.Size())
+	assert.Len(t, 0, ch.Headers)
+}
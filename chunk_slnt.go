@@ -0,0 +1,83 @@
+package riff
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+)
+
+// IDslnt represents "slnt" chunk ID.
+const IDslnt uint32 = 0x736c6e74
+
+// SLNTChunkSize represents the size of slnt chunk in bytes.
+// Does not count ID.
+const SLNTChunkSize uint32 = 4
+
+// ChunkSLNT represents the "slnt" chunk which is always contained inside
+// the "LIST wavl" chunk. It represents silence - the number of samples
+// through which playback should be silent.
+type ChunkSLNT struct {
+	// Chunk size in bytes.
+	// The ID and extra padding byte is not counted in the chunk size.
+	size uint32
+
+	// The number of silent samples.
+	Samples uint32
+}
+
+// SLNTMake is a [Maker] function for creating [ChunkSLNT] instances.
+func SLNTMake() Chunk { return SLNT() }
+
+// SLNT returns a new instance of [ChunkSLNT].
+func SLNT() *ChunkSLNT {
+	return &ChunkSLNT{size: SLNTChunkSize}
+}
+
+func (ch *ChunkSLNT) ID() uint32     { return IDslnt }
+func (ch *ChunkSLNT) Size() uint32   { return ch.size }
+func (ch *ChunkSLNT) Type() uint32   { return 0 }
+func (ch *ChunkSLNT) Multi() bool    { return true }
+func (ch *ChunkSLNT) Chunks() Chunks { return nil }
+func (ch *ChunkSLNT) Raw() bool      { return false }
+
+func (ch *ChunkSLNT) ReadFrom(r io.Reader) (int64, error) {
+	bo := ByteOrder(r)
+	var sum int64
+	if err := binary.Read(r, bo, &ch.size); err != nil {
+		return sum, fmt.Errorf(errFmtDecode, linkids(IDwavl, IDslnt), err)
+	}
+	sum += 4
+
+	if ch.size != SLNTChunkSize {
+		return sum, fmt.Errorf(errFmtDecode, linkids(IDwavl, IDslnt), ErrChunkSizeMismatch)
+	}
+
+	if err := binary.Read(r, bo, &ch.Samples); err != nil {
+		return sum, fmt.Errorf(errFmtDecode, linkids(IDwavl, IDslnt), err)
+	}
+	sum += int64(SLNTChunkSize)
+
+	return sum, nil
+}
+
+func (ch *ChunkSLNT) WriteTo(w io.Writer) (int64, error) {
+	var sum int64
+
+	n, err := WriteIDAndSize(w, IDslnt, SLNTChunkSize)
+	sum += n
+	if err != nil {
+		return sum, fmt.Errorf(errFmtEncode, linkids(IDwavl, IDslnt), err)
+	}
+
+	if err = binary.Write(w, ByteOrder(w), ch.Samples); err != nil {
+		return sum, fmt.Errorf(errFmtEncode, linkids(IDwavl, IDslnt), err)
+	}
+	sum += int64(SLNTChunkSize)
+
+	return sum, nil
+}
+
+func (ch *ChunkSLNT) Reset() {
+	ch.size = SLNTChunkSize
+	ch.Samples = 0
+}
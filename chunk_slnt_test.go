@@ -0,0 +1,129 @@
+package riff
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/ctx42/testing/pkg/assert"
+	"github.com/ctx42/testing/pkg/kit/iokit"
+	"github.com/ctx42/testing/pkg/must"
+
+	"github.com/rzajac/riff/internal/test"
+)
+
+func slntChunk(t *testing.T) io.Reader {
+	src := &bytes.Buffer{}
+	test.ReadFrom(t, src, Uint32(IDslnt)) // ( 0) 4 - Chunk ID
+	test.WriteUint32LE(t, src, 4)         // ( 4) 4 - Chunk size
+	test.WriteUint32LE(t, src, 1000)      // ( 8) 4 - Samples
+	// Total length: 8+4=12
+	return src
+}
+
+func Test_ChunkSLNT_SLNT(t *testing.T) {
+	// --- When ---
+	ch := SLNT()
+
+	// --- Then ---
+	assert.Equal(t, IDslnt, ch.ID())
+	assert.Equal(t, uint32(4), ch.Size())
+	assert.Equal(t, uint32(0), ch.Type())
+	assert.True(t, ch.Multi())
+	assert.Nil(t, ch.Chunks())
+	assert.False(t, ch.Raw())
+}
+
+func Test_ChunkSLNT_ReadFrom(t *testing.T) {
+	// --- Given ---
+	src := slntChunk(t)
+	test.Skip4B(t, src) // Skip chunk ID.
+
+	// --- When ---
+	ch := SLNT()
+	n, err := ch.ReadFrom(src)
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, int64(8), n)
+	assert.Equal(t, uint32(4), ch.Size())
+	assert.Equal(t, uint32(1000), ch.Samples)
+	assert.True(t, test.IsAllRead(src))
+}
+
+func Test_ChunkSLNT_ReadFrom_Errors(t *testing.T) {
+	// Reading less than 8 bytes should always result in an error.
+	for i := 1; i < 8; i++ {
+		// --- Given ---
+		src := slntChunk(t)
+		test.Skip4B(t, src) // Skip chunk ID.
+
+		// --- When ---
+		_, err := SLNT().ReadFrom(io.LimitReader(src, int64(i)))
+
+		// --- Then ---
+		assert.Error(t, err)
+	}
+}
+
+func Test_ChunkSLNT_ReadFrom_SizeMismatchError(t *testing.T) {
+	// --- Given ---
+	src := &bytes.Buffer{}
+	test.WriteUint32LE(t, src, 5)
+
+	// --- When ---
+	n, err := SLNT().ReadFrom(src)
+
+	// --- Then ---
+	assert.ErrorIs(t, ErrChunkSizeMismatch, err)
+	assert.ErrorContain(t, "wavl:slnt chunk", err)
+	assert.Equal(t, int64(4), n)
+}
+
+func Test_ChunkSLNT_WriteTo(t *testing.T) {
+	// --- Given ---
+	src := slntChunk(t)
+	test.Skip4B(t, src) // Skip chunk ID.
+
+	ch := SLNT()
+	_, err := ch.ReadFrom(src)
+	assert.NoError(t, err)
+
+	// --- When ---
+	dst := &bytes.Buffer{}
+	n, err := ch.WriteTo(dst)
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, int64(12), n)
+	exp := must.Value(io.ReadAll(slntChunk(t)))
+	assert.Equal(t, exp, dst.Bytes())
+}
+
+func Test_ChunkSLNT_WriteTo_Errors(t *testing.T) {
+	// Writing less than 12 bytes should always result in an error.
+	for i := 12; i > 0; i-- {
+		// --- Given ---
+		ch := SLNT()
+		ch.Samples = 1000
+
+		// --- When ---
+		_, err := ch.WriteTo(iokit.ErrWriter(&bytes.Buffer{}, i))
+
+		// --- Then ---
+		assert.Error(t, err)
+	}
+}
+
+func Test_ChunkSLNT_Reset(t *testing.T) {
+	// --- Given ---
+	ch := SLNT()
+	ch.Samples = 1000
+
+	// --- When ---
+	ch.Reset()
+
+	// --- Then ---
+	assert.Equal(t, uint32(4), ch.Size())
+	assert.Equal(t, uint32(0), ch.Samples)
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/binary"
 	"fmt"
 	"io"
+	"math"
 )
 
 // IDsmpl represents "smpl" chunk ID.
@@ -141,8 +142,9 @@ func (ch *ChunkSMPL) SamplerData() io.Reader {
 }
 
 func (ch *ChunkSMPL) ReadFrom(r io.Reader) (int64, error) {
+	bo := ByteOrder(r)
 	var sum int64
-	if err := binary.Read(r, le, &ch.size); err != nil {
+	if err := binary.Read(r, bo, &ch.size); err != nil {
 		return sum, fmt.Errorf(errFmtDecode, Uint32(IDsmpl), err)
 	}
 	sum += 4
@@ -151,7 +153,7 @@ func (ch *ChunkSMPL) ReadFrom(r io.Reader) (int64, error) {
 		return sum, fmt.Errorf(errFmtDecode, Uint32(IDsmpl), ErrTooShort)
 	}
 
-	if err := binary.Read(r, le, &ch.smplStatic); err != nil {
+	if err := binary.Read(r, bo, &ch.smplStatic); err != nil {
 		return sum, fmt.Errorf(errFmtDecode, Uint32(IDsmpl), err)
 	}
 	sum += int64(SMPLChunkSize)
@@ -165,7 +167,7 @@ func (ch *ChunkSMPL) ReadFrom(r io.Reader) (int64, error) {
 	for i := 0; i < int(ch.SampleLoopCnt); i++ {
 		loop := sampleLoopPool.Get().(*SampleLoop) // nolint: forcetypeassert
 		loop.Reset()
-		if err := binary.Read(r, le, loop); err != nil {
+		if err := binary.Read(r, bo, loop); err != nil {
 			return 0, fmt.Errorf(errFmtDecode, Uint32(IDsmpl), err)
 		}
 		ch.SampleLoops = append(ch.SampleLoops, loop)
@@ -189,6 +191,7 @@ func (ch *ChunkSMPL) ReadFrom(r io.Reader) (int64, error) {
 }
 
 func (ch *ChunkSMPL) WriteTo(w io.Writer) (int64, error) {
+	bo := ByteOrder(w)
 	var sum int64
 
 	size := SMPLChunkSize +
@@ -204,13 +207,13 @@ func (ch *ChunkSMPL) WriteTo(w io.Writer) (int64, error) {
 		return sum, fmt.Errorf(errFmtEncode, Uint32(IDsmpl), err)
 	}
 
-	if err = binary.Write(w, le, ch.smplStatic); err != nil {
+	if err = binary.Write(w, bo, ch.smplStatic); err != nil {
 		return sum, fmt.Errorf(errFmtEncode, Uint32(IDsmpl), err)
 	}
 	sum += int64(SMPLChunkSize)
 
 	for i := 0; i < len(ch.SampleLoops); i++ {
-		if err = binary.Write(w, le, ch.SampleLoops[i]); err != nil {
+		if err = binary.Write(w, bo, ch.SampleLoops[i]); err != nil {
 			return sum, fmt.Errorf(errFmtEncode, Uint32(IDsmpl), err)
 		}
 		sum += int64(SampleLoopCntSize)
@@ -218,7 +221,7 @@ func (ch *ChunkSMPL) WriteTo(w io.Writer) (int64, error) {
 
 	lsd := len(ch.sampleData)
 	if lsd > 0 {
-		if err = binary.Write(w, le, ch.sampleData); err != nil {
+		if err = binary.Write(w, bo, ch.sampleData); err != nil {
 			return sum, fmt.Errorf(errFmtEncode, Uint32(IDsmpl), err)
 		}
 		sum += int64(lsd)
@@ -233,6 +236,33 @@ func (ch *ChunkSMPL) WriteTo(w io.Writer) (int64, error) {
 	return sum, nil
 }
 
+// setPitch sets the MIDI unity note and pitch fraction from the note and
+// the fine-tuning in cents (e.g. AIFF detune or SoundFont pitch
+// correction). The fine-tuning is applied on playback, so the recorded
+// pitch is the note minus the fine-tuning.
+func (ch *ChunkSMPL) setPitch(note uint8, fine int8) {
+	unity := int(note)
+	cents := -int(fine)
+	if cents < 0 {
+		unity--
+		cents += 100
+	}
+	ch.MIDIUnityNote = uint32(max(unity, 0))
+	ch.MIDIPitchFraction = uint32(float64(cents) / 100 * (1 << 32))
+}
+
+// pitch returns the note and the fine-tuning in cents from the MIDI unity
+// note and pitch fraction. It's the reverse of [ChunkSMPL.setPitch].
+func (ch *ChunkSMPL) pitch() (uint8, int8) {
+	unity := int(ch.MIDIUnityNote)
+	cents := int(math.Round(float64(ch.MIDIPitchFraction) / (1 << 32) * 100))
+	if cents >= 50 {
+		unity++
+		cents -= 100
+	}
+	return uint8(min(max(unity, 0), 127)), int8(-cents)
+}
+
 func (ch *ChunkSMPL) Reset() {
 	ch.size = 0
 	ch.Manufacturer = 0
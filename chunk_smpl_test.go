@@ -412,3 +412,65 @@ func Test_ChunkSMPL_WriteTo_Errors(t *testing.T) {
 		}
 	}
 }
+
+func Test_ChunkSMPL_setPitch(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		note     uint8
+		fine     int8
+		expUnity uint32
+		expFrac  uint32
+	}{
+		{"no fine-tuning", 60, 0, 60, 0},
+		{"tuned down", 60, -50, 60, 0x80000000},
+		{"tuned up", 60, 25, 59, 0xc0000000},
+		{"lowest note tuned up", 0, 10, 0, 0xe6666666},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- Given ---
+			ch := SMPL()
+
+			// --- When ---
+			ch.setPitch(tc.note, tc.fine)
+
+			// --- Then ---
+			assert.Equal(t, tc.expUnity, ch.MIDIUnityNote)
+			assert.Equal(t, tc.expFrac, ch.MIDIPitchFraction)
+		})
+	}
+}
+
+func Test_ChunkSMPL_pitch(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		unity   uint32
+		frac    uint32
+		expNote uint8
+		expFine int8
+	}{
+		{"no fraction", 60, 0, 60, 0},
+		{"below half", 60, 0x40000000, 60, -25},
+		{"half", 60, 0x80000000, 61, 50},
+		{"above highest note", 200, 0, 127, 0},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- Given ---
+			ch := SMPL()
+			ch.MIDIUnityNote = tc.unity
+			ch.MIDIPitchFraction = tc.frac
+
+			// --- When ---
+			note, fine := ch.pitch()
+
+			// --- Then ---
+			assert.Equal(t, tc.expNote, note)
+			assert.Equal(t, tc.expFine, fine)
+		})
+	}
+}
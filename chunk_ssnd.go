@@ -0,0 +1,176 @@
+package riff
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+)
+
+// IDSSND represents "SSND" (sound data) chunk ID of the AIFF file.
+const IDSSND uint32 = 0x53534e44
+
+// SSNDChunkSize represents the size of the "SSND" chunk static part in
+// bytes. Does not count ID and sound data bytes.
+const SSNDChunkSize uint32 = 8
+
+// ChunkSSND represents the "SSND" chunk of the AIFF file holding the sample
+// frames.
+type ChunkSSND struct {
+	// Chunk size in bytes.
+	// The ID and extra padding byte is not counted in the chunk size.
+	size uint32
+
+	// Offset in bytes of the first sample frame in the sound data. Most
+	// applications set it to zero.
+	Offset uint32
+
+	// Size in bytes of the blocks the sound data is aligned to. Most
+	// applications set it to zero.
+	BlockSize uint32
+
+	// Sound data including the Offset bytes. It's nil in [SkipData] mode.
+	data []byte
+}
+
+// SSNDMake returns [Maker] function for creating [ChunkSSND] instances.
+func SSNDMake(load bool) Maker {
+	return func() Chunk {
+		return SSND(load)
+	}
+}
+
+// SSND returns a new instance of [ChunkSSND]. If load is false the sound
+// data will not be loaded into memory.
+func SSND(load bool) *ChunkSSND {
+	ch := &ChunkSSND{size: SSNDChunkSize}
+	if load {
+		ch.data = make([]byte, 0, 1<<15)
+	}
+	return ch
+}
+
+func (ch *ChunkSSND) ID() uint32     { return IDSSND }
+func (ch *ChunkSSND) Size() uint32   { return ch.size }
+func (ch *ChunkSSND) Type() uint32   { return 0 }
+func (ch *ChunkSSND) Multi() bool    { return false }
+func (ch *ChunkSSND) Chunks() Chunks { return nil }
+func (ch *ChunkSSND) Raw() bool      { return false }
+
+// Data returns reader for the sound data including the Offset bytes. If in
+// [SkipData] mode, an empty reader is returned.
+func (ch *ChunkSSND) Data() io.Reader {
+	return bytes.NewReader(ch.data)
+}
+
+// Samples returns the sample frames, the sound data without the Offset
+// bytes. It returns nil in [SkipData] mode.
+func (ch *ChunkSSND) Samples() []byte {
+	if ch.data == nil {
+		return nil
+	}
+	return ch.data[min(int(ch.Offset), len(ch.data)):]
+}
+
+// SetData sets the sound data bytes including the Offset bytes. It will
+// return [ErrSkipDataMode] if in [SkipData] mode.
+func (ch *ChunkSSND) SetData(data []byte) error {
+	if ch.data == nil {
+		return ErrSkipDataMode
+	}
+	ch.data = grow(ch.data, len(data))
+	copy(ch.data, data)
+	ch.size = SSNDChunkSize + uint32(len(data))
+	return nil
+}
+
+func (ch *ChunkSSND) ReadFrom(r io.Reader) (int64, error) {
+	bo := ByteOrder(r)
+	var sum int64
+	var err error
+	if ch.size, err = ReadChunkSize(r); err != nil {
+		return sum, fmt.Errorf(errFmtDecode, Uint32(IDSSND), err)
+	}
+	sum += 4
+
+	if ch.size < SSNDChunkSize {
+		return sum, fmt.Errorf(errFmtDecode, Uint32(IDSSND), ErrTooShort)
+	}
+
+	var buf [SSNDChunkSize]byte
+	in, err := io.ReadFull(r, buf[:])
+	sum += int64(in)
+	if err != nil {
+		return sum, fmt.Errorf(errFmtDecode, Uint32(IDSSND), err)
+	}
+	ch.Offset = bo.Uint32(buf[:])
+	ch.BlockSize = bo.Uint32(buf[4:])
+
+	if ch.data == nil {
+		rs := RealSize(ch.size) - SSNDChunkSize // Skip padding byte if present.
+		if err = SkipN(r, rs); err != nil {
+			return sum, fmt.Errorf(errFmtDecode, Uint32(IDSSND), err)
+		}
+		sum += int64(rs)
+		return sum, nil
+	}
+
+	ch.data = grow(ch.data, int(ch.size-SSNDChunkSize))
+	in, err = io.ReadFull(r, ch.data)
+	sum += int64(in)
+	if err != nil {
+		return sum, fmt.Errorf(errFmtDecode, Uint32(IDSSND), err)
+	}
+
+	n, err := ReadPaddingIf(r, ch.size)
+	sum += n
+	if err != nil {
+		return sum, fmt.Errorf(errFmtDecode, Uint32(IDSSND), err)
+	}
+
+	return sum, nil
+}
+
+func (ch *ChunkSSND) WriteTo(w io.Writer) (int64, error) {
+	if ch.data == nil {
+		return 0, ErrSkipDataMode
+	}
+
+	bo := ByteOrder(w)
+	var sum int64
+
+	n, err := WriteIDAndSize(w, IDSSND, ch.size)
+	sum += n
+	if err != nil {
+		return sum, fmt.Errorf(errFmtEncode, Uint32(IDSSND), err)
+	}
+
+	buf := bo.AppendUint32(make([]byte, 0, SSNDChunkSize), ch.Offset)
+	buf = bo.AppendUint32(buf, ch.BlockSize)
+	in, err := w.Write(buf)
+	sum += int64(in)
+	if err != nil {
+		return sum, fmt.Errorf(errFmtEncode, Uint32(IDSSND), err)
+	}
+
+	in, err = w.Write(ch.data)
+	sum += int64(in)
+	if err != nil {
+		return sum, fmt.Errorf(errFmtEncode, Uint32(IDSSND), err)
+	}
+
+	n, err = WritePaddingIf(w, ch.size)
+	sum += n
+	if err != nil {
+		return sum, fmt.Errorf(errFmtEncode, Uint32(IDSSND), err)
+	}
+
+	return sum, nil
+}
+
+// Reset resets the chunk so it can be reused.
+func (ch *ChunkSSND) Reset() {
+	ch.size = SSNDChunkSize
+	ch.Offset = 0
+	ch.BlockSize = 0
+	ch.data = ch.data[:0]
+}
@@ -0,0 +1,196 @@
+package riff
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/ctx42/testing/pkg/assert"
+	"github.com/ctx42/testing/pkg/kit/iokit"
+	"github.com/ctx42/testing/pkg/must"
+
+	"github.com/rzajac/riff/internal/test"
+)
+
+func ssndChunk(t *testing.T) io.Reader {
+	src := &bytes.Buffer{}
+	test.ReadFrom(t, src, Uint32(IDSSND))          // ( 0) 4 - Chunk ID
+	test.WriteUint32BE(t, src, 13)                 // ( 4) 4 - Chunk size
+	test.WriteUint32BE(t, src, 2)                  // ( 8) 4 - Offset
+	test.WriteUint32BE(t, src, 0)                  // (12) 4 - Block size
+	test.WriteBytes(t, src, []byte{0, 0, 1, 2, 3}) // (16) 5 - Data
+	test.WriteByte(t, src, 0)                      // (21) 1 - Padding
+	// Total length: 8+13+1=22
+	return src
+}
+
+func Test_ChunkSSND_SSND(t *testing.T) {
+	// --- When ---
+	ch := SSND(LoadData)
+
+	// --- Then ---
+	assert.Equal(t, IDSSND, ch.ID())
+	assert.Equal(t, SSNDChunkSize, ch.Size())
+	assert.Equal(t, uint32(0), ch.Type())
+	assert.False(t, ch.Multi())
+	assert.Nil(t, ch.Chunks())
+	assert.False(t, ch.Raw())
+	assert.NotNil(t, ch.data)
+}
+
+func Test_ChunkSSND_SSND_SkipDataMode(t *testing.T) {
+	// --- When ---
+	ch := SSND(SkipData)
+
+	// --- Then ---
+	assert.Nil(t, ch.data)
+	assert.Nil(t, ch.Samples())
+}
+
+func Test_ChunkSSND_ReadFrom(t *testing.T) {
+	// --- Given ---
+	src := ssndChunk(t)
+	test.Skip4B(t, src) // Skip chunk ID.
+
+	// --- When ---
+	ch := SSND(LoadData)
+	n, err := ch.ReadFrom(OrderReader(src, be))
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, int64(18), n)
+	assert.Equal(t, uint32(13), ch.Size())
+	assert.Equal(t, uint32(2), ch.Offset)
+	assert.Equal(t, uint32(0), ch.BlockSize)
+	assert.Equal(t, []byte{0, 0, 1, 2, 3}, must.Value(io.ReadAll(ch.Data())))
+	assert.Equal(t, []byte{1, 2, 3}, ch.Samples())
+	assert.True(t, test.IsAllRead(src))
+}
+
+func Test_ChunkSSND_ReadFrom_SkipDataMode(t *testing.T) {
+	// --- Given ---
+	src := ssndChunk(t)
+	test.Skip4B(t, src) // Skip chunk ID.
+
+	// --- When ---
+	ch := SSND(SkipData)
+	n, err := ch.ReadFrom(OrderReader(src, be))
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, int64(18), n)
+	assert.Equal(t, uint32(13), ch.Size())
+	assert.Equal(t, uint32(2), ch.Offset)
+	assert.Nil(t, ch.data)
+	assert.True(t, test.IsAllRead(src))
+}
+
+func Test_ChunkSSND_ReadFrom_Errors(t *testing.T) {
+	// Reading less than 18 bytes should always result in an error.
+	for _, load := range []bool{LoadData, SkipData} {
+		for _, i := range []int{1, 4, 5, 12, 14, 17} {
+			// --- Given ---
+			src := ssndChunk(t)
+			test.Skip4B(t, src) // Skip chunk ID.
+
+			// --- When ---
+			_, err := SSND(load).ReadFrom(OrderReader(io.LimitReader(src, int64(i)), be))
+
+			// --- Then ---
+			assert.Error(t, err)
+		}
+	}
+}
+
+func Test_ChunkSSND_ReadFrom_TooShort(t *testing.T) {
+	// --- Given ---
+	src := &bytes.Buffer{}
+	test.WriteUint32BE(t, src, 7)
+
+	// --- When ---
+	_, err := SSND(LoadData).ReadFrom(OrderReader(src, be))
+
+	// --- Then ---
+	assert.ErrorIs(t, ErrTooShort, err)
+}
+
+func Test_ChunkSSND_SetData(t *testing.T) {
+	t.Run("load data", func(t *testing.T) {
+		// --- Given ---
+		ch := SSND(LoadData)
+
+		// --- When ---
+		err := ch.SetData([]byte{1, 2, 3})
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, uint32(11), ch.Size())
+		assert.Equal(t, []byte{1, 2, 3}, ch.Samples())
+	})
+
+	t.Run("skip data", func(t *testing.T) {
+		// --- When ---
+		err := SSND(SkipData).SetData([]byte{1, 2, 3})
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrSkipDataMode, err)
+	})
+}
+
+func Test_ChunkSSND_WriteTo(t *testing.T) {
+	// --- Given ---
+	ch := SSND(LoadData)
+	ch.Offset = 2
+	must.Nil(ch.SetData([]byte{0, 0, 1, 2, 3}))
+
+	// --- When ---
+	dst := &bytes.Buffer{}
+	n, err := ch.WriteTo(OrderWriter(dst, be))
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, int64(22), n)
+	exp := must.Value(io.ReadAll(ssndChunk(t)))
+	assert.Equal(t, exp, dst.Bytes())
+}
+
+func Test_ChunkSSND_WriteTo_SkipDataMode(t *testing.T) {
+	// --- When ---
+	n, err := SSND(SkipData).WriteTo(&bytes.Buffer{})
+
+	// --- Then ---
+	assert.ErrorIs(t, ErrSkipDataMode, err)
+	assert.Equal(t, int64(0), n)
+}
+
+func Test_ChunkSSND_WriteTo_Errors(t *testing.T) {
+	for _, i := range []int{1, 4, 8, 14, 20} {
+		// --- Given ---
+		ch := SSND(LoadData)
+		must.Nil(ch.SetData([]byte{1, 2, 3}))
+
+		// --- When ---
+		_, err := ch.WriteTo(iokit.ErrWriter(&bytes.Buffer{}, i))
+
+		// --- Then ---
+		assert.Error(t, err)
+	}
+}
+
+func Test_ChunkSSND_Reset(t *testing.T) {
+	// --- Given ---
+	src := ssndChunk(t)
+	test.Skip4B(t, src) // Skip chunk ID.
+	ch := SSND(LoadData)
+	_, err := ch.ReadFrom(OrderReader(src, be))
+	assert.NoError(t, err)
+
+	// --- When ---
+	ch.Reset()
+
+	// --- Then ---
+	assert.Equal(t, SSNDChunkSize, ch.Size())
+	assert.Equal(t, uint32(0), ch.Offset)
+	assert.Equal(t, uint32(0), ch.BlockSize)
+	assert.Equal(t, []byte{}, ch.Samples())
+}
@@ -0,0 +1,265 @@
+package riff
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+)
+
+// IDstrf represents "strf" (stream format) sub-chunk ID of the
+// "LIST strl" chunk.
+const IDstrf uint32 = 0x73747266
+
+// BitmapInfoSize represents the size of BITMAPINFOHEADER structure in bytes.
+const BitmapInfoSize uint32 = 40
+
+// BitmapInfo represents BITMAPINFOHEADER structure describing the format of
+// the video stream.
+type BitmapInfo struct {
+	// Size of the structure as written in the header. When zero
+	// [BitmapInfoSize] is written.
+	HeaderSize uint32
+
+	// Width of the frame in pixels.
+	Width int32
+
+	// Height of the frame in pixels. Positive for bottom-up bitmaps,
+	// negative for top-down.
+	Height int32
+
+	// Number of planes. Always 1.
+	Planes uint16
+
+	// Number of bits per pixel.
+	BitCount uint16
+
+	// FourCC of the codec (e.g. "cvid"). Uses the same byte order as chunk
+	// IDs. Zero for uncompressed RGB.
+	Compression uint32
+
+	// Size of the image in bytes.
+	SizeImage uint32
+
+	// Horizontal resolution in pixels per meter.
+	XPelsPerMeter int32
+
+	// Vertical resolution in pixels per meter.
+	YPelsPerMeter int32
+
+	// Number of color indices in the color table.
+	ClrUsed uint32
+
+	// Number of color indices required for displaying the bitmap.
+	ClrImportant uint32
+
+	// Bytes following the structure (e.g. color table or codec data).
+	Extra []byte
+}
+
+// ChunkSTRF represents "strf" chunk of the AVI file. The format of the
+// chunk depends on the stream type of the preceding "strh" chunk. For
+// video streams it is decoded to [BitmapInfo], for audio streams to
+// [ChunkFMT]. For other stream types, or when the format can't be
+// decoded, the data is kept as is.
+type ChunkSTRF struct {
+	// Chunk size in bytes.
+	// The ID and extra padding byte is not counted in the chunk size.
+	size uint32
+
+	// Stream type (see Stream* constants).
+	StreamType uint32
+
+	// Video stream format (only for [StreamVideo] streams).
+	Video *BitmapInfo
+
+	// Audio stream format (only for [StreamAudio] streams).
+	Audio *ChunkFMT
+
+	// Stream format data when it's not decoded.
+	data []byte
+
+	// Byte order of the stream format data.
+	order Order
+}
+
+// STRFMake returns [Maker] function for creating [ChunkSTRF] instances for
+// the stream type.
+func STRFMake(streamType uint32) Maker {
+	return func() Chunk { return STRF(streamType) }
+}
+
+// STRF returns a new instance of [ChunkSTRF] for the stream type.
+func STRF(streamType uint32) *ChunkSTRF {
+	return &ChunkSTRF{StreamType: streamType, order: le}
+}
+
+func (ch *ChunkSTRF) ID() uint32     { return IDstrf }
+func (ch *ChunkSTRF) Size() uint32   { return uint32(len(ch.body(ch.order))) }
+func (ch *ChunkSTRF) Type() uint32   { return 0 }
+func (ch *ChunkSTRF) Multi() bool    { return false }
+func (ch *ChunkSTRF) Chunks() Chunks { return nil }
+func (ch *ChunkSTRF) Raw() bool      { return false }
+
+// precededBy sets the stream type from the "strh" chunk preceding the
+// chunk in the "LIST strl" or to zero when it's missing.
+func (ch *ChunkSTRF) precededBy(chs Chunks) {
+	ch.StreamType = 0
+	if strh, ok := chs.First(IDstrh).(*ChunkSTRH); ok {
+		ch.StreamType = strh.FccType
+	}
+}
+
+// Data returns stream format data.
+func (ch *ChunkSTRF) Data() []byte { return ch.body(ch.order) }
+
+// body returns the chunk body to write in given byte order.
+func (ch *ChunkSTRF) body(order Order) []byte {
+	switch {
+	case ch.Video != nil:
+		return ch.Video.bytes(order)
+
+	case ch.Audio != nil:
+		buf := &bytes.Buffer{}
+		if _, err := ch.Audio.WriteTo(OrderWriter(buf, order)); err != nil {
+			return nil
+		}
+		b := buf.Bytes()
+		return b[8 : 8+order.Uint32(b[4:])]
+	}
+	return ch.data
+}
+
+// decode decodes the stream format data.
+func (ch *ChunkSTRF) decode() {
+	ch.Video = nil
+	ch.Audio = nil
+
+	switch ch.StreamType {
+	case StreamVideo:
+		if bi, ok := parseBitmapInfo(ch.data, ch.order); ok {
+			ch.Video = bi
+		}
+
+	case StreamAudio:
+		src := &bytes.Buffer{}
+		src.Write(ch.order.AppendUint32(nil, uint32(len(ch.data))))
+		src.Write(ch.data)
+		cf := FMT()
+		if _, err := cf.ReadFrom(OrderReader(src, ch.order)); err == nil && src.Len() == 0 {
+			ch.Audio = cf
+		}
+	}
+
+	// Keep the format undecoded if it doesn't round-trip exactly.
+	if !bytes.Equal(ch.body(ch.order), ch.data) {
+		ch.Video = nil
+		ch.Audio = nil
+	}
+}
+
+func (ch *ChunkSTRF) ReadFrom(r io.Reader) (int64, error) {
+	var sum int64
+	ch.order = ByteOrder(r)
+	if err := binary.Read(r, ch.order, &ch.size); err != nil {
+		return sum, fmt.Errorf(errFmtDecode, linkids(IDstrl, IDstrf), err)
+	}
+	sum += 4
+
+	ch.data = grow(ch.data, int(ch.size))
+	in, err := io.ReadFull(r, ch.data)
+	sum += int64(in)
+	if err != nil {
+		return sum, fmt.Errorf(errFmtDecode, linkids(IDstrl, IDstrf), err)
+	}
+	ch.decode()
+
+	n, err := ReadPaddingIf(r, ch.size)
+	sum += n
+	if err != nil {
+		return sum, fmt.Errorf(errFmtDecode, linkids(IDstrl, IDstrf), err)
+	}
+
+	return sum, nil
+}
+
+func (ch *ChunkSTRF) WriteTo(w io.Writer) (int64, error) {
+	var sum int64
+
+	body := ch.body(ByteOrder(w))
+	ch.size = uint32(len(body))
+
+	n, err := WriteIDAndSize(w, IDstrf, ch.size)
+	sum += n
+	if err != nil {
+		return sum, fmt.Errorf(errFmtEncode, linkids(IDstrl, IDstrf), err)
+	}
+
+	in, err := w.Write(body)
+	sum += int64(in)
+	if err != nil {
+		return sum, fmt.Errorf(errFmtEncode, linkids(IDstrl, IDstrf), err)
+	}
+
+	n, err = WritePaddingIf(w, ch.size)
+	sum += n
+	if err != nil {
+		return sum, fmt.Errorf(errFmtEncode, linkids(IDstrl, IDstrf), err)
+	}
+
+	return sum, nil
+}
+
+func (ch *ChunkSTRF) Reset() {
+	ch.size = 0
+	ch.StreamType = 0
+	ch.Video = nil
+	ch.Audio = nil
+	ch.data = ch.data[:0]
+	ch.order = le
+}
+
+// parseBitmapInfo parses BITMAPINFOHEADER structure in given byte order.
+func parseBitmapInfo(b []byte, order Order) (*BitmapInfo, bool) {
+	if len(b) < int(BitmapInfoSize) {
+		return nil, false
+	}
+	bi := &BitmapInfo{
+		HeaderSize:    order.Uint32(b),
+		Width:         int32(order.Uint32(b[4:])),
+		Height:        int32(order.Uint32(b[8:])),
+		Planes:        order.Uint16(b[12:]),
+		BitCount:      order.Uint16(b[14:]),
+		Compression:   be.Uint32(b[16:]),
+		SizeImage:     order.Uint32(b[20:]),
+		XPelsPerMeter: int32(order.Uint32(b[24:])),
+		YPelsPerMeter: int32(order.Uint32(b[28:])),
+		ClrUsed:       order.Uint32(b[32:]),
+		ClrImportant:  order.Uint32(b[36:]),
+	}
+	if len(b) > int(BitmapInfoSize) {
+		bi.Extra = append([]byte(nil), b[BitmapInfoSize:]...)
+	}
+	return bi, true
+}
+
+// bytes encodes BITMAPINFOHEADER structure in given byte order.
+func (bi *BitmapInfo) bytes(order Order) []byte {
+	hs := bi.HeaderSize
+	if hs == 0 {
+		hs = BitmapInfoSize
+	}
+	b := make([]byte, 0, int(BitmapInfoSize)+len(bi.Extra))
+	b = order.AppendUint32(b, hs)
+	b = order.AppendUint32(b, uint32(bi.Width))
+	b = order.AppendUint32(b, uint32(bi.Height))
+	b = order.AppendUint16(b, bi.Planes)
+	b = order.AppendUint16(b, bi.BitCount)
+	b = be.AppendUint32(b, bi.Compression)
+	b = order.AppendUint32(b, bi.SizeImage)
+	b = order.AppendUint32(b, uint32(bi.XPelsPerMeter))
+	b = order.AppendUint32(b, uint32(bi.YPelsPerMeter))
+	b = order.AppendUint32(b, bi.ClrUsed)
+	b = order.AppendUint32(b, bi.ClrImportant)
+	return append(b, bi.Extra...)
+}
@@ -0,0 +1,405 @@
+package riff
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/ctx42/testing/pkg/assert"
+	"github.com/ctx42/testing/pkg/kit/iokit"
+	"github.com/ctx42/testing/pkg/must"
+
+	"github.com/rzajac/riff/internal/test"
+)
+
+func strfChunkVideo(t *testing.T) io.Reader {
+	src := &bytes.Buffer{}
+	test.ReadFrom(t, src, Uint32(IDstrf))    // ( 0) 4 - Chunk ID
+	test.WriteUint32LE(t, src, 43)           // ( 4) 4 - Chunk size
+	test.WriteUint32LE(t, src, 40)           // ( 8) 4 - HeaderSize
+	test.WriteUint32LE(t, src, 80)           // (12) 4 - Width
+	test.WriteUint32LE(t, src, 0xffffffc4)   // (16) 4 - Height (-60)
+	test.WriteUint16LE(t, src, 1)            // (20) 2 - Planes
+	test.WriteUint16LE(t, src, 24)           // (22) 2 - BitCount
+	test.WriteBytes(t, src, []byte("cvid"))  // (24) 4 - Compression
+	test.WriteUint32LE(t, src, 10575)        // (28) 4 - SizeImage
+	test.WriteUint32LE(t, src, 0)            // (32) 4 - XPelsPerMeter
+	test.WriteUint32LE(t, src, 0)            // (36) 4 - YPelsPerMeter
+	test.WriteUint32LE(t, src, 0)            // (40) 4 - ClrUsed
+	test.WriteUint32LE(t, src, 0)            // (44) 4 - ClrImportant
+	test.WriteBytes(t, src, []byte{1, 2, 3}) // (48) 3 - Extra
+	test.WriteByte(t, src, 0)                // (51) 1 - Padding byte
+	// Total length: 8+43+1=52
+	return src
+}
+
+func strfChunkVideoBE(t *testing.T) io.Reader {
+	src := &bytes.Buffer{}
+	test.ReadFrom(t, src, Uint32(IDstrf))   // ( 0) 4 - Chunk ID
+	test.WriteUint32BE(t, src, 40)          // ( 4) 4 - Chunk size
+	test.WriteUint32BE(t, src, 40)          // ( 8) 4 - HeaderSize
+	test.WriteUint32BE(t, src, 320)         // (12) 4 - Width
+	test.WriteUint32BE(t, src, 240)         // (16) 4 - Height
+	test.WriteUint16BE(t, src, 1)           // (20) 2 - Planes
+	test.WriteUint16BE(t, src, 24)          // (22) 2 - BitCount
+	test.WriteBytes(t, src, []byte("cvid")) // (24) 4 - Compression
+	test.WriteUint32BE(t, src, 230400)      // (28) 4 - SizeImage
+	test.WriteUint32BE(t, src, 0)           // (32) 4 - XPelsPerMeter
+	test.WriteUint32BE(t, src, 0)           // (36) 4 - YPelsPerMeter
+	test.WriteUint32BE(t, src, 0)           // (40) 4 - ClrUsed
+	test.WriteUint32BE(t, src, 0)           // (44) 4 - ClrImportant
+	// Total length: 8+40=48
+	return src
+}
+
+func strfChunkAudio(t *testing.T) io.Reader {
+	src := &bytes.Buffer{}
+	test.ReadFrom(t, src, Uint32(IDstrf)) // ( 0) 4 - Chunk ID
+	test.WriteUint32LE(t, src, 16)        // ( 4) 4 - Chunk size
+	test.WriteUint16LE(t, src, CompPCM)   // ( 8) 2 - CompCode
+	test.WriteUint16LE(t, src, 1)         // (10) 2 - ChannelCnt
+	test.WriteUint32LE(t, src, 22050)     // (12) 4 - SampleRate
+	test.WriteUint32LE(t, src, 22050)     // (16) 4 - AvgByteRate
+	test.WriteUint16LE(t, src, 1)         // (20) 2 - BlockAlign
+	test.WriteUint16LE(t, src, 8)         // (22) 2 - BitsPerSample
+	// Total length: 8+16=24
+	return src
+}
+
+func listChunkType_strl(t *testing.T) io.Reader {
+	src := &bytes.Buffer{}
+	test.ReadFrom(t, src, Uint32(IDLIST))    // (  0)  4 - Chunk ID
+	test.WriteUint32LE(t, src, 136)          // (  4)  4 - Chunk size
+	test.WriteUint32BE(t, src, IDstrl)       // (  8)  4 - Type
+	test.ReadFrom(t, src, strhChunk(t))      // ( 12) 64 - strh
+	test.ReadFrom(t, src, strfChunkVideo(t)) // ( 76) 52 - strf
+	test.ReadFrom(t, src, strnChunk(t))      // (128) 16 - strn
+	// Total length: 8+136=144
+	return src
+}
+
+func Test_ChunkSTRF_STRF(t *testing.T) {
+	// --- When ---
+	ch := STRF(StreamVideo)
+
+	// --- Then ---
+	assert.Equal(t, IDstrf, ch.ID())
+	assert.Equal(t, uint32(0), ch.Size())
+	assert.Equal(t, uint32(0), ch.Type())
+	assert.False(t, ch.Multi())
+	assert.Nil(t, ch.Chunks())
+	assert.False(t, ch.Raw())
+	assert.Equal(t, StreamVideo, ch.StreamType)
+	assert.Nil(t, ch.Video)
+	assert.Nil(t, ch.Audio)
+}
+
+func Test_ChunkSTRF_precededBy(t *testing.T) {
+	t.Run("strh", func(t *testing.T) {
+		// --- Given ---
+		strh := STRH()
+		strh.FccType = StreamAudio
+		ch := STRF(StreamVideo)
+
+		// --- When ---
+		ch.precededBy(Chunks{strh})
+
+		// --- Then ---
+		assert.Equal(t, StreamAudio, ch.StreamType)
+	})
+
+	t.Run("missing strh", func(t *testing.T) {
+		// --- Given ---
+		ch := STRF(StreamVideo)
+
+		// --- When ---
+		ch.precededBy(nil)
+
+		// --- Then ---
+		assert.Equal(t, uint32(0), ch.StreamType)
+	})
+}
+
+func Test_ChunkSTRF_ReadFrom_Video(t *testing.T) {
+	// --- Given ---
+	src := strfChunkVideo(t)
+	test.Skip4B(t, src) // Skip chunk ID.
+
+	// --- When ---
+	ch := STRF(StreamVideo)
+	n, err := ch.ReadFrom(src)
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, int64(48), n)
+	assert.Equal(t, uint32(43), ch.Size())
+	assert.Nil(t, ch.Audio)
+	assert.NotNil(t, ch.Video)
+	assert.Equal(t, uint32(40), ch.Video.HeaderSize)
+	assert.Equal(t, int32(80), ch.Video.Width)
+	assert.Equal(t, int32(-60), ch.Video.Height)
+	assert.Equal(t, uint16(1), ch.Video.Planes)
+	assert.Equal(t, uint16(24), ch.Video.BitCount)
+	assert.Equal(t, "cvid", Uint32(ch.Video.Compression).String())
+	assert.Equal(t, uint32(10575), ch.Video.SizeImage)
+	assert.Equal(t, []byte{1, 2, 3}, ch.Video.Extra)
+	assert.True(t, test.IsAllRead(src))
+}
+
+func Test_ChunkSTRF_ReadFrom_VideoBE(t *testing.T) {
+	// --- Given ---
+	src := strfChunkVideoBE(t)
+	test.Skip4B(t, src) // Skip chunk ID.
+
+	// --- When ---
+	ch := STRF(StreamVideo)
+	n, err := ch.ReadFrom(OrderReader(src, be))
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, int64(44), n)
+	assert.NotNil(t, ch.Video)
+	assert.Equal(t, uint32(40), ch.Video.HeaderSize)
+	assert.Equal(t, int32(320), ch.Video.Width)
+	assert.Equal(t, int32(240), ch.Video.Height)
+	assert.Equal(t, uint16(24), ch.Video.BitCount)
+	assert.Equal(t, "cvid", Uint32(ch.Video.Compression).String())
+	assert.Equal(t, uint32(230400), ch.Video.SizeImage)
+	assert.True(t, test.IsAllRead(src))
+}
+
+func Test_ChunkSTRF_ReadFrom_Audio(t *testing.T) {
+	// --- Given ---
+	src := strfChunkAudio(t)
+	test.Skip4B(t, src) // Skip chunk ID.
+
+	// --- When ---
+	ch := STRF(StreamAudio)
+	n, err := ch.ReadFrom(src)
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, int64(20), n)
+	assert.Equal(t, uint32(16), ch.Size())
+	assert.Nil(t, ch.Video)
+	assert.NotNil(t, ch.Audio)
+	assert.Equal(t, CompPCM, ch.Audio.CompCode)
+	assert.Equal(t, uint16(1), ch.Audio.ChannelCnt)
+	assert.Equal(t, uint32(22050), ch.Audio.SampleRate)
+	assert.Equal(t, uint16(8), ch.Audio.BitsPerSample)
+	assert.True(t, test.IsAllRead(src))
+}
+
+func Test_ChunkSTRF_ReadFrom_NotDecoded(t *testing.T) {
+	t.Run("other stream type", func(t *testing.T) {
+		// --- Given ---
+		src := strfChunkAudio(t)
+		test.Skip4B(t, src) // Skip chunk ID.
+
+		// --- When ---
+		ch := STRF(StreamText)
+		_, err := ch.ReadFrom(src)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Nil(t, ch.Video)
+		assert.Nil(t, ch.Audio)
+		assert.Len(t, 16, ch.Data())
+	})
+
+	t.Run("video format too short", func(t *testing.T) {
+		// --- Given ---
+		src := strfChunkAudio(t)
+		test.Skip4B(t, src) // Skip chunk ID.
+
+		// --- When ---
+		ch := STRF(StreamVideo)
+		_, err := ch.ReadFrom(src)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Nil(t, ch.Video)
+		assert.Len(t, 16, ch.Data())
+	})
+
+	t.Run("invalid audio format", func(t *testing.T) {
+		// --- Given ---
+		src := &bytes.Buffer{}
+		test.WriteUint32LE(t, src, 20)
+		test.WriteBytes(t, src, make([]byte, 16))
+		test.WriteUint16LE(t, src, 8) // Extra size doesn't match.
+		test.WriteUint16LE(t, src, 0)
+
+		// --- When ---
+		ch := STRF(StreamAudio)
+		_, err := ch.ReadFrom(src)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Nil(t, ch.Audio)
+		assert.Len(t, 20, ch.Data())
+	})
+}
+
+func Test_ChunkSTRF_ReadFrom_Errors(t *testing.T) {
+	// Reading less than 48 bytes should always result in an error.
+	for _, i := range []int{1, 4, 5, 20, 46, 47} {
+		// --- Given ---
+		src := strfChunkVideo(t)
+		test.Skip4B(t, src) // Skip chunk ID.
+
+		// --- When ---
+		_, err := STRF(StreamVideo).ReadFrom(io.LimitReader(src, int64(i)))
+
+		// --- Then ---
+		assert.Error(t, err)
+	}
+}
+
+func Test_ChunkSTRF_WriteTo(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		typ uint32
+		src func(t *testing.T) io.Reader
+		n   int64
+	}{
+		{"video", StreamVideo, strfChunkVideo, 52},
+		{"audio", StreamAudio, strfChunkAudio, 24},
+		{"other", StreamMIDI, strfChunkAudio, 24},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- Given ---
+			src := tc.src(t)
+			test.Skip4B(t, src) // Skip chunk ID.
+
+			ch := STRF(tc.typ)
+			_, err := ch.ReadFrom(src)
+			assert.NoError(t, err)
+
+			// --- When ---
+			dst := &bytes.Buffer{}
+			n, err := ch.WriteTo(dst)
+
+			// --- Then ---
+			assert.NoError(t, err)
+			assert.Equal(t, tc.n, n)
+			exp := must.Value(io.ReadAll(tc.src(t)))
+			assert.Equal(t, exp, dst.Bytes())
+		})
+	}
+}
+
+func Test_ChunkSTRF_WriteTo_ByteOrder(t *testing.T) {
+	// --- Given ---
+	src := strfChunkVideoBE(t)
+	test.Skip4B(t, src) // Skip chunk ID.
+
+	ch := STRF(StreamVideo)
+	_, err := ch.ReadFrom(OrderReader(src, be))
+	assert.NoError(t, err)
+
+	// --- When ---
+	dst := &bytes.Buffer{}
+	n, err := ch.WriteTo(OrderWriter(dst, be))
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, int64(48), n)
+	exp := must.Value(io.ReadAll(strfChunkVideoBE(t)))
+	assert.Equal(t, exp, dst.Bytes())
+
+	dst.Reset()
+	must.Value(ch.WriteTo(dst))
+	got := STRF(StreamVideo)
+	must.Value(got.ReadFrom(bytes.NewReader(dst.Bytes()[4:])))
+	assert.Equal(t, ch.Video, got.Video)
+}
+
+func Test_ChunkSTRF_WriteTo_Edited(t *testing.T) {
+	t.Run("video", func(t *testing.T) {
+		// --- Given ---
+		ch := STRF(StreamVideo)
+		ch.Video = &BitmapInfo{Width: 320, Height: 240, Planes: 1}
+
+		// --- When ---
+		dst := &bytes.Buffer{}
+		n, err := ch.WriteTo(dst)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, int64(48), n)
+		assert.Equal(t, uint32(40), ch.Size())
+
+		got := STRF(StreamVideo)
+		_, err = got.ReadFrom(bytes.NewReader(dst.Bytes()[4:]))
+		assert.NoError(t, err)
+		assert.Equal(t, uint32(40), got.Video.HeaderSize)
+		assert.Equal(t, int32(320), got.Video.Width)
+		assert.Equal(t, int32(240), got.Video.Height)
+	})
+
+	t.Run("audio", func(t *testing.T) {
+		// --- Given ---
+		src := strfChunkAudio(t)
+		test.Skip4B(t, src) // Skip chunk ID.
+
+		ch := STRF(StreamAudio)
+		_, err := ch.ReadFrom(src)
+		assert.NoError(t, err)
+
+		ch.Audio.SetExtra([]byte{1, 2})
+
+		// --- When ---
+		dst := &bytes.Buffer{}
+		n, err := ch.WriteTo(dst)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, int64(28), n)
+		assert.Equal(t, uint32(20), ch.Size())
+
+		got := STRF(StreamAudio)
+		_, err = got.ReadFrom(bytes.NewReader(dst.Bytes()[4:]))
+		assert.NoError(t, err)
+		assert.Equal(t, []byte{1, 2}, must.Value(io.ReadAll(got.Audio.Extra())))
+	})
+}
+
+func Test_ChunkSTRF_WriteTo_Errors(t *testing.T) {
+	for _, i := range []int{1, 4, 8, 50, 51} {
+		// --- Given ---
+		src := strfChunkVideo(t)
+		test.Skip4B(t, src) // Skip chunk ID.
+
+		ch := STRF(StreamVideo)
+		_, err := ch.ReadFrom(src)
+		assert.NoError(t, err)
+
+		// --- When ---
+		_, err = ch.WriteTo(iokit.ErrWriter(&bytes.Buffer{}, i))
+
+		// --- Then ---
+		assert.Error(t, err)
+	}
+}
+
+func Test_ChunkSTRF_Reset(t *testing.T) {
+	// --- Given ---
+	src := strfChunkVideo(t)
+	test.Skip4B(t, src) // Skip chunk ID.
+	ch := STRF(StreamVideo)
+	_, err := ch.ReadFrom(src)
+	assert.NoError(t, err)
+
+	// --- When ---
+	ch.Reset()
+
+	// --- Then ---
+	assert.Equal(t, uint32(0), ch.Size())
+	assert.Equal(t, uint32(0), ch.StreamType)
+	assert.Nil(t, ch.Video)
+	assert.Nil(t, ch.Audio)
+}
@@ -0,0 +1,217 @@
+package riff
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+	"time"
+)
+
+// IDstrl represents "strl" (stream list) type of the LIST chunk.
+const IDstrl uint32 = 0x7374726c
+
+// IDstrh represents "strh" (stream header) sub-chunk ID of the
+// "LIST strl" chunk.
+const IDstrh uint32 = 0x73747268
+
+// STRHChunkSize represents the size of strh chunk static part in bytes.
+const STRHChunkSize uint32 = 56
+
+// AVI stream types.
+const (
+	// StreamVideo represents "vids" video stream type.
+	StreamVideo uint32 = 0x76696473
+
+	// StreamAudio represents "auds" audio stream type.
+	StreamAudio uint32 = 0x61756473
+
+	// StreamMIDI represents "mids" MIDI stream type.
+	StreamMIDI uint32 = 0x6d696473
+
+	// StreamText represents "txts" text stream type.
+	StreamText uint32 = 0x74787473
+)
+
+// AVIRect represents destination rectangle of the video stream.
+type AVIRect struct {
+	Left   int16
+	Top    int16
+	Right  int16
+	Bottom int16
+}
+
+// strhStatic represents chunk static data following the stream type and
+// handler FourCCs. This struct is defined separately to allow for binary
+// decoding / encoding in one call to binary.Read / binary.Write.
+type strhStatic struct {
+	// Stream flags.
+	Flags uint32
+
+	// Priority of the stream type.
+	Priority uint16
+
+	// Language tag.
+	Language uint16
+
+	// How far audio data is skewed ahead of the video frames in
+	// interleaved files.
+	InitialFrames uint32
+
+	// Time scale. Rate divided by Scale gives the number of samples per
+	// second (for video the frame rate).
+	Scale uint32
+
+	// Time rate.
+	Rate uint32
+
+	// Starting time of the stream in Rate / Scale units.
+	Start uint32
+
+	// Length of the stream in Rate / Scale units.
+	Length uint32
+
+	// Suggested buffer size for reading the stream.
+	SuggestedBufferSize uint32
+
+	// Quality of the data in the stream (0-10000 or -1 for default).
+	Quality uint32
+
+	// Size of a single sample of data or zero if samples vary in size.
+	SampleSize uint32
+
+	// Destination rectangle for a text or video stream.
+	Frame AVIRect
+}
+
+// ChunkSTRH represents "strh" chunk (AVIStreamHeader) of the AVI file.
+type ChunkSTRH struct {
+	// Chunk size in bytes.
+	// The ID and extra padding byte is not counted in the chunk size.
+	size uint32
+
+	// Stream type (see Stream* constants).
+	FccType uint32
+
+	// FourCC of the codec (e.g. "cvid") or zero.
+	FccHandler uint32
+
+	strhStatic
+
+	// Bytes following the static part.
+	extra []byte
+}
+
+// STRHMake is a [Maker] function for creating [ChunkSTRH] instances.
+func STRHMake() Chunk { return STRH() }
+
+// STRH returns a new instance of [ChunkSTRH].
+func STRH() *ChunkSTRH {
+	return &ChunkSTRH{size: STRHChunkSize}
+}
+
+func (ch *ChunkSTRH) ID() uint32     { return IDstrh }
+func (ch *ChunkSTRH) Size() uint32   { return ch.size }
+func (ch *ChunkSTRH) Type() uint32   { return 0 }
+func (ch *ChunkSTRH) Multi() bool    { return false }
+func (ch *ChunkSTRH) Chunks() Chunks { return nil }
+func (ch *ChunkSTRH) Raw() bool      { return false }
+
+// SampleRate returns number of samples per second (for video streams it's
+// the frame rate). Returns zero when the scale is not set.
+func (ch *ChunkSTRH) SampleRate() float64 {
+	if ch.Scale == 0 {
+		return 0
+	}
+	return float64(ch.Rate) / float64(ch.Scale)
+}
+
+// Duration returns the duration of the stream.
+func (ch *ChunkSTRH) Duration() time.Duration {
+	if ch.Rate == 0 {
+		return 0
+	}
+	sec := float64(ch.Length) * float64(ch.Scale) / float64(ch.Rate)
+	return time.Duration(math.Round(sec * float64(time.Second)))
+}
+
+func (ch *ChunkSTRH) ReadFrom(r io.Reader) (int64, error) {
+	bo := ByteOrder(r)
+	var sum int64
+	if err := binary.Read(r, bo, &ch.size); err != nil {
+		return sum, fmt.Errorf(errFmtDecode, linkids(IDstrl, IDstrh), err)
+	}
+	sum += 4
+
+	if ch.size < STRHChunkSize {
+		return sum, fmt.Errorf(errFmtDecode, linkids(IDstrl, IDstrh), ErrTooShort)
+	}
+
+	if err := binary.Read(r, be, &ch.FccType); err != nil {
+		return sum, fmt.Errorf(errFmtDecode, linkids(IDstrl, IDstrh), err)
+	}
+	if err := binary.Read(r, be, &ch.FccHandler); err != nil {
+		return sum, fmt.Errorf(errFmtDecode, linkids(IDstrl, IDstrh), err)
+	}
+	if err := binary.Read(r, bo, &ch.strhStatic); err != nil {
+		return sum, fmt.Errorf(errFmtDecode, linkids(IDstrl, IDstrh), err)
+	}
+	sum += int64(STRHChunkSize)
+
+	ch.extra = grow(ch.extra, int(ch.size-STRHChunkSize))
+	in, err := io.ReadFull(r, ch.extra)
+	sum += int64(in)
+	if err != nil {
+		return sum, fmt.Errorf(errFmtDecode, linkids(IDstrl, IDstrh), err)
+	}
+
+	n, err := ReadPaddingIf(r, ch.size)
+	sum += n
+	if err != nil {
+		return sum, fmt.Errorf(errFmtDecode, linkids(IDstrl, IDstrh), err)
+	}
+
+	return sum, nil
+}
+
+func (ch *ChunkSTRH) WriteTo(w io.Writer) (int64, error) {
+	var sum int64
+
+	ch.size = STRHChunkSize + uint32(len(ch.extra))
+
+	n, err := WriteIDAndSize(w, IDstrh, ch.size)
+	sum += n
+	if err != nil {
+		return sum, fmt.Errorf(errFmtEncode, linkids(IDstrl, IDstrh), err)
+	}
+
+	if err = binary.Write(w, be, [2]uint32{ch.FccType, ch.FccHandler}); err != nil {
+		return sum, fmt.Errorf(errFmtEncode, linkids(IDstrl, IDstrh), err)
+	}
+	if err = binary.Write(w, ByteOrder(w), ch.strhStatic); err != nil {
+		return sum, fmt.Errorf(errFmtEncode, linkids(IDstrl, IDstrh), err)
+	}
+	sum += int64(STRHChunkSize)
+
+	in, err := w.Write(ch.extra)
+	sum += int64(in)
+	if err != nil {
+		return sum, fmt.Errorf(errFmtEncode, linkids(IDstrl, IDstrh), err)
+	}
+
+	n, err = WritePaddingIf(w, ch.size)
+	sum += n
+	if err != nil {
+		return sum, fmt.Errorf(errFmtEncode, linkids(IDstrl, IDstrh), err)
+	}
+
+	return sum, nil
+}
+
+func (ch *ChunkSTRH) Reset() {
+	ch.size = STRHChunkSize
+	ch.FccType = 0
+	ch.FccHandler = 0
+	ch.strhStatic = strhStatic{}
+	ch.extra = ch.extra[:0]
+}
@@ -0,0 +1,158 @@
+package riff
+
+import (
+	"bytes"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/ctx42/testing/pkg/assert"
+	"github.com/ctx42/testing/pkg/kit/iokit"
+	"github.com/ctx42/testing/pkg/must"
+
+	"github.com/rzajac/riff/internal/test"
+)
+
+func strhChunk(t *testing.T) io.Reader {
+	src := &bytes.Buffer{}
+	test.ReadFrom(t, src, Uint32(IDstrh))                     // ( 0) 4 - Chunk ID
+	test.WriteUint32LE(t, src, 56)                            // ( 4) 4 - Chunk size
+	test.WriteUint32BE(t, src, StreamVideo)                   // ( 8) 4 - FccType
+	test.WriteBytes(t, src, []byte("cvid"))                   // (12) 4 - FccHandler
+	test.WriteUint32LE(t, src, 0)                             // (16) 4 - Flags
+	test.WriteUint16LE(t, src, 0)                             // (20) 2 - Priority
+	test.WriteUint16LE(t, src, 0)                             // (22) 2 - Language
+	test.WriteUint32LE(t, src, 0)                             // (24) 4 - InitialFrames
+	test.WriteUint32LE(t, src, 33333)                         // (28) 4 - Scale
+	test.WriteUint32LE(t, src, 500000)                        // (32) 4 - Rate
+	test.WriteUint32LE(t, src, 0)                             // (36) 4 - Start
+	test.WriteUint32LE(t, src, 50)                            // (40) 4 - Length
+	test.WriteUint32LE(t, src, 2916)                          // (44) 4 - SuggestedBufferSize
+	test.WriteUint32LE(t, src, 10000)                         // (48) 4 - Quality
+	test.WriteUint32LE(t, src, 0)                             // (52) 4 - SampleSize
+	test.WriteBytes(t, src, []byte{0, 0, 0, 0, 80, 0, 60, 0}) // (56) 8 - Frame
+	// Total length: 8+56=64
+	return src
+}
+
+func Test_ChunkSTRH_STRH(t *testing.T) {
+	// --- When ---
+	ch := STRH()
+
+	// --- Then ---
+	assert.Equal(t, IDstrh, ch.ID())
+	assert.Equal(t, STRHChunkSize, ch.Size())
+	assert.Equal(t, uint32(0), ch.Type())
+	assert.False(t, ch.Multi())
+	assert.Nil(t, ch.Chunks())
+	assert.False(t, ch.Raw())
+	assert.Equal(t, 0.0, ch.SampleRate())
+	assert.Equal(t, time.Duration(0), ch.Duration())
+}
+
+func Test_ChunkSTRH_ReadFrom(t *testing.T) {
+	// --- Given ---
+	src := strhChunk(t)
+	test.Skip4B(t, src) // Skip chunk ID.
+
+	// --- When ---
+	ch := STRH()
+	n, err := ch.ReadFrom(src)
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, int64(60), n)
+	assert.Equal(t, uint32(56), ch.Size())
+	assert.Equal(t, StreamVideo, ch.FccType)
+	assert.Equal(t, "cvid", Uint32(ch.FccHandler).String())
+	assert.Equal(t, uint32(33333), ch.Scale)
+	assert.Equal(t, uint32(500000), ch.Rate)
+	assert.Equal(t, uint32(50), ch.Length)
+	assert.Equal(t, uint32(10000), ch.Quality)
+	assert.Equal(t, AVIRect{Right: 80, Bottom: 60}, ch.Frame)
+	assert.Epsilon(t, 15.0, 0.001, ch.SampleRate())
+	assert.Equal(t, 3333300*time.Microsecond, ch.Duration())
+	assert.True(t, test.IsAllRead(src))
+}
+
+func Test_ChunkSTRH_ReadFrom_Errors(t *testing.T) {
+	// Reading less than 60 bytes should always result in an error.
+	for _, i := range []int{1, 4, 5, 8, 12, 20, 59} {
+		// --- Given ---
+		src := strhChunk(t)
+		test.Skip4B(t, src) // Skip chunk ID.
+
+		// --- When ---
+		_, err := STRH().ReadFrom(io.LimitReader(src, int64(i)))
+
+		// --- Then ---
+		assert.Error(t, err)
+	}
+}
+
+func Test_ChunkSTRH_ReadFrom_TooShortError(t *testing.T) {
+	// --- Given ---
+	src := &bytes.Buffer{}
+	test.WriteUint32LE(t, src, 48)
+
+	// --- When ---
+	_, err := STRH().ReadFrom(src)
+
+	// --- Then ---
+	assert.ErrorIs(t, ErrTooShort, err)
+}
+
+func Test_ChunkSTRH_WriteTo(t *testing.T) {
+	// --- Given ---
+	src := strhChunk(t)
+	test.Skip4B(t, src) // Skip chunk ID.
+
+	ch := STRH()
+	_, err := ch.ReadFrom(src)
+	assert.NoError(t, err)
+
+	// --- When ---
+	dst := &bytes.Buffer{}
+	n, err := ch.WriteTo(dst)
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, int64(64), n)
+	exp := must.Value(io.ReadAll(strhChunk(t)))
+	assert.Equal(t, exp, dst.Bytes())
+}
+
+func Test_ChunkSTRH_WriteTo_Errors(t *testing.T) {
+	for _, i := range []int{1, 4, 8, 12, 20, 63} {
+		// --- Given ---
+		src := strhChunk(t)
+		test.Skip4B(t, src) // Skip chunk ID.
+
+		ch := STRH()
+		_, err := ch.ReadFrom(src)
+		assert.NoError(t, err)
+
+		// --- When ---
+		_, err = ch.WriteTo(iokit.ErrWriter(&bytes.Buffer{}, i))
+
+		// --- Then ---
+		assert.Error(t, err)
+	}
+}
+
+func Test_ChunkSTRH_Reset(t *testing.T) {
+	// --- Given ---
+	src := strhChunk(t)
+	test.Skip4B(t, src) // Skip chunk ID.
+	ch := STRH()
+	_, err := ch.ReadFrom(src)
+	assert.NoError(t, err)
+
+	// --- When ---
+	ch.Reset()
+
+	// --- Then ---
+	assert.Equal(t, STRHChunkSize, ch.Size())
+	assert.Equal(t, uint32(0), ch.FccType)
+	assert.Equal(t, uint32(0), ch.Rate)
+}
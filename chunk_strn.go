@@ -0,0 +1,101 @@
+package riff
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+)
+
+// IDstrn represents "strn" (stream name) sub-chunk ID of the "LIST strl"
+// chunk.
+const IDstrn uint32 = 0x7374726e
+
+// ChunkSTRN represents "strn" chunk of the AVI file holding zero
+// terminated name of the stream.
+type ChunkSTRN struct {
+	// Chunk size in bytes.
+	// The ID and extra padding byte is not counted in the chunk size.
+	size uint32
+
+	// Stream name.
+	text []byte
+}
+
+// STRNMake is a [Maker] function for creating [ChunkSTRN] instances.
+func STRNMake() Chunk { return STRN() }
+
+// STRN returns a new instance of [ChunkSTRN].
+func STRN() *ChunkSTRN {
+	return &ChunkSTRN{}
+}
+
+func (ch *ChunkSTRN) ID() uint32     { return IDstrn }
+func (ch *ChunkSTRN) Size() uint32   { return ch.size }
+func (ch *ChunkSTRN) Type() uint32   { return 0 }
+func (ch *ChunkSTRN) Multi() bool    { return false }
+func (ch *ChunkSTRN) Chunks() Chunks { return nil }
+func (ch *ChunkSTRN) Raw() bool      { return false }
+
+// Name returns the stream name.
+func (ch *ChunkSTRN) Name() string {
+	return string(bytes.TrimRight(ch.text, "\x00"))
+}
+
+// SetName sets the stream name.
+func (ch *ChunkSTRN) SetName(name string) {
+	ch.text = append(append(ch.text[:0], name...), 0)
+	ch.size = uint32(len(ch.text))
+}
+
+func (ch *ChunkSTRN) ReadFrom(r io.Reader) (int64, error) {
+	var sum int64
+	if err := binary.Read(r, ByteOrder(r), &ch.size); err != nil {
+		return sum, fmt.Errorf(errFmtDecode, linkids(IDstrl, IDstrn), err)
+	}
+	sum += 4
+
+	ch.text = grow(ch.text, int(ch.size))
+	in, err := io.ReadFull(r, ch.text)
+	sum += int64(in)
+	if err != nil {
+		return sum, fmt.Errorf(errFmtDecode, linkids(IDstrl, IDstrn), err)
+	}
+
+	n, err := ReadPaddingIf(r, ch.size)
+	sum += n
+	if err != nil {
+		return sum, fmt.Errorf(errFmtDecode, linkids(IDstrl, IDstrn), err)
+	}
+
+	return sum, nil
+}
+
+func (ch *ChunkSTRN) WriteTo(w io.Writer) (int64, error) {
+	var sum int64
+
+	n, err := WriteIDAndSize(w, IDstrn, ch.size)
+	sum += n
+	if err != nil {
+		return sum, fmt.Errorf(errFmtEncode, linkids(IDstrl, IDstrn), err)
+	}
+
+	in, err := w.Write(ch.text)
+	sum += int64(in)
+	if err != nil {
+		return sum, fmt.Errorf(errFmtEncode, linkids(IDstrl, IDstrn), err)
+	}
+
+	n, err = WritePaddingIf(w, ch.size)
+	sum += n
+	if err != nil {
+		return sum, fmt.Errorf(errFmtEncode, linkids(IDstrl, IDstrn), err)
+	}
+
+	return sum, nil
+}
+
+func (ch *ChunkSTRN) Reset() {
+	ch.size = 0
+	ch.text = ch.text[:0]
+}
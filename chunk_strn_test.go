@@ -0,0 +1,136 @@
+package riff
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/ctx42/testing/pkg/assert"
+	"github.com/ctx42/testing/pkg/kit/iokit"
+	"github.com/ctx42/testing/pkg/must"
+
+	"github.com/rzajac/riff/internal/test"
+)
+
+func strnChunk(t *testing.T) io.Reader {
+	src := &bytes.Buffer{}
+	test.ReadFrom(t, src, Uint32(IDstrn))        // ( 0) 4 - Chunk ID
+	test.WriteUint32LE(t, src, 7)                // ( 4) 4 - Chunk size
+	test.WriteBytes(t, src, []byte("Video\x00")) // ( 8) 6 - Name
+	test.WriteByte(t, src, 0)                    // (14) 1 - Extra zero
+	test.WriteByte(t, src, 0)                    // (15) 1 - Padding byte
+	// Total length: 8+7+1=16
+	return src
+}
+
+func Test_ChunkSTRN_STRN(t *testing.T) {
+	// --- When ---
+	ch := STRN()
+
+	// --- Then ---
+	assert.Equal(t, IDstrn, ch.ID())
+	assert.Equal(t, uint32(0), ch.Size())
+	assert.Equal(t, uint32(0), ch.Type())
+	assert.False(t, ch.Multi())
+	assert.Nil(t, ch.Chunks())
+	assert.False(t, ch.Raw())
+	assert.Equal(t, "", ch.Name())
+}
+
+func Test_ChunkSTRN_ReadFrom(t *testing.T) {
+	// --- Given ---
+	src := strnChunk(t)
+	test.Skip4B(t, src) // Skip chunk ID.
+
+	// --- When ---
+	ch := STRN()
+	n, err := ch.ReadFrom(src)
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, int64(12), n)
+	assert.Equal(t, uint32(7), ch.Size())
+	assert.Equal(t, "Video", ch.Name())
+	assert.True(t, test.IsAllRead(src))
+}
+
+func Test_ChunkSTRN_ReadFrom_Errors(t *testing.T) {
+	// Reading less than 12 bytes should always result in an error.
+	for _, i := range []int{1, 4, 5, 11} {
+		// --- Given ---
+		src := strnChunk(t)
+		test.Skip4B(t, src) // Skip chunk ID.
+
+		// --- When ---
+		_, err := STRN().ReadFrom(io.LimitReader(src, int64(i)))
+
+		// --- Then ---
+		assert.Error(t, err)
+	}
+}
+
+func Test_ChunkSTRN_SetName(t *testing.T) {
+	// --- Given ---
+	ch := STRN()
+
+	// --- When ---
+	ch.SetName("Audio")
+
+	// --- Then ---
+	assert.Equal(t, uint32(6), ch.Size())
+	assert.Equal(t, "Audio", ch.Name())
+}
+
+func Test_ChunkSTRN_WriteTo(t *testing.T) {
+	// --- Given ---
+	src := strnChunk(t)
+	test.Skip4B(t, src) // Skip chunk ID.
+
+	ch := STRN()
+	_, err := ch.ReadFrom(src)
+	assert.NoError(t, err)
+
+	// --- When ---
+	dst := &bytes.Buffer{}
+	n, err := ch.WriteTo(dst)
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, int64(16), n)
+	exp := must.Value(io.ReadAll(strnChunk(t)))
+	assert.Equal(t, exp, dst.Bytes())
+}
+
+func Test_ChunkSTRN_WriteTo_Errors(t *testing.T) {
+	for _, i := range []int{1, 4, 8, 14, 15} {
+		// --- Given ---
+		src := strnChunk(t)
+		test.Skip4B(t, src) // Skip chunk ID.
+
+		ch := STRN()
+		_, err := ch.ReadFrom(src)
+		assert.NoError(t, err)
+
+		// --- When ---
+		_, err = ch.WriteTo(iokit.ErrWriter(&bytes.Buffer{}, i))
+
+		// --- Then ---
+		assert.Error(t, err)
+	}
+}
+
+func Test_ChunkSTRN_Reset(t *testing.T) {
+	// --- Given ---
+	src := strnChunk(t)
+	test.Skip4B(t, src) // Skip chunk ID.
+	ch := STRN()
+	_, err := ch.ReadFrom(src)
+	assert.NoError(t, err)
+
+	// --- When ---
+	ch.Reset()
+
+	// --- Then ---
+	assert.Equal(t, uint32(0), ch.Size())
+	assert.Equal(t, "", ch.Name())
+}
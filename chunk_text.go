@@ -0,0 +1,116 @@
+package riff
+
+import (
+	"fmt"
+	"io"
+)
+
+// IDs of the AIFF text chunks.
+const (
+	// IDNAME represents "NAME" (name of the sampled sound) chunk ID.
+	IDNAME uint32 = 0x4e414d45
+
+	// IDAUTH represents "AUTH" (author) chunk ID.
+	IDAUTH uint32 = 0x41555448
+
+	// IDCopyright represents "(c) " (copyright notice) chunk ID.
+	IDCopyright uint32 = 0x28632920
+
+	// IDANNO represents "ANNO" (annotation) chunk ID. The file may have
+	// more than one annotation chunk.
+	IDANNO uint32 = 0x414e4e4f
+)
+
+// ChunkTEXT represents one of the AIFF text chunks (NAME, AUTH, "(c) " and
+// ANNO). The text is not zero terminated.
+type ChunkTEXT struct {
+	// Chunk ID.
+	id uint32
+
+	// Chunk size in bytes.
+	// The ID and extra padding byte is not counted in the chunk size.
+	size uint32
+
+	// Text bytes.
+	text []byte
+}
+
+// TEXTMake returns [Maker] function for creating [ChunkTEXT] instances
+// with given ID.
+func TEXTMake(id uint32) Maker {
+	return func() Chunk { return TEXT(id) }
+}
+
+// TEXT returns a new instance of [ChunkTEXT] with given ID.
+func TEXT(id uint32) *ChunkTEXT {
+	return &ChunkTEXT{id: id}
+}
+
+func (ch *ChunkTEXT) ID() uint32     { return ch.id }
+func (ch *ChunkTEXT) Size() uint32   { return ch.size }
+func (ch *ChunkTEXT) Type() uint32   { return 0 }
+func (ch *ChunkTEXT) Multi() bool    { return ch.id == IDANNO }
+func (ch *ChunkTEXT) Chunks() Chunks { return nil }
+func (ch *ChunkTEXT) Raw() bool      { return false }
+
+// Text returns the chunk text.
+func (ch *ChunkTEXT) Text() string { return string(ch.text) }
+
+// SetText sets the chunk text.
+func (ch *ChunkTEXT) SetText(s string) {
+	ch.text = append(ch.text[:0], s...)
+	ch.size = uint32(len(ch.text))
+}
+
+func (ch *ChunkTEXT) ReadFrom(r io.Reader) (int64, error) {
+	var sum int64
+	var err error
+	if ch.size, err = ReadChunkSize(r); err != nil {
+		return sum, fmt.Errorf(errFmtDecode, Uint32(ch.id), err)
+	}
+	sum += 4
+
+	ch.text = grow(ch.text, int(ch.size))
+	in, err := io.ReadFull(r, ch.text)
+	sum += int64(in)
+	if err != nil {
+		return sum, fmt.Errorf(errFmtDecode, Uint32(ch.id), err)
+	}
+
+	n, err := ReadPaddingIf(r, ch.size)
+	sum += n
+	if err != nil {
+		return sum, fmt.Errorf(errFmtDecode, Uint32(ch.id), err)
+	}
+
+	return sum, nil
+}
+
+func (ch *ChunkTEXT) WriteTo(w io.Writer) (int64, error) {
+	var sum int64
+
+	n, err := WriteIDAndSize(w, ch.id, ch.size)
+	sum += n
+	if err != nil {
+		return sum, fmt.Errorf(errFmtEncode, Uint32(ch.id), err)
+	}
+
+	in, err := w.Write(ch.text)
+	sum += int64(in)
+	if err != nil {
+		return sum, fmt.Errorf(errFmtEncode, Uint32(ch.id), err)
+	}
+
+	n, err = WritePaddingIf(w, ch.size)
+	sum += n
+	if err != nil {
+		return sum, fmt.Errorf(errFmtEncode, Uint32(ch.id), err)
+	}
+
+	return sum, nil
+}
+
+func (ch *ChunkTEXT) Reset() {
+	ch.size = 0
+	ch.text = ch.text[:0]
+}
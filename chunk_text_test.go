@@ -0,0 +1,118 @@
+package riff
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/ctx42/testing/pkg/assert"
+	"github.com/ctx42/testing/pkg/kit/iokit"
+	"github.com/ctx42/testing/pkg/must"
+
+	"github.com/rzajac/riff/internal/test"
+)
+
+func textChunk(t *testing.T) io.Reader {
+	src := &bytes.Buffer{}
+	test.ReadFrom(t, src, Uint32(IDNAME))    // ( 0) 4 - Chunk ID
+	test.WriteUint32BE(t, src, 5)            // ( 4) 4 - Chunk size
+	test.WriteBytes(t, src, []byte("bloop")) // ( 8) 5 - Text
+	test.WriteByte(t, src, 0)                // (13) 1 - Padding
+	// Total length: 8+5+1=14
+	return src
+}
+
+func Test_ChunkTEXT_TEXT(t *testing.T) {
+	// --- When ---
+	ch := TEXT(IDNAME)
+
+	// --- Then ---
+	assert.Equal(t, IDNAME, ch.ID())
+	assert.Equal(t, uint32(0), ch.Size())
+	assert.Equal(t, uint32(0), ch.Type())
+	assert.False(t, ch.Multi())
+	assert.Nil(t, ch.Chunks())
+	assert.False(t, ch.Raw())
+}
+
+func Test_ChunkTEXT_Multi(t *testing.T) {
+	assert.True(t, TEXT(IDANNO).Multi())
+	assert.False(t, TEXT(IDAUTH).Multi())
+	assert.False(t, TEXT(IDCopyright).Multi())
+}
+
+func Test_ChunkTEXT_ReadFrom(t *testing.T) {
+	// --- Given ---
+	src := textChunk(t)
+	test.Skip4B(t, src) // Skip chunk ID.
+
+	// --- When ---
+	ch := TEXT(IDNAME)
+	n, err := ch.ReadFrom(OrderReader(src, be))
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, int64(10), n)
+	assert.Equal(t, uint32(5), ch.Size())
+	assert.Equal(t, "bloop", ch.Text())
+	assert.True(t, test.IsAllRead(src))
+}
+
+func Test_ChunkTEXT_ReadFrom_Errors(t *testing.T) {
+	// Reading less than 10 bytes should always result in an error.
+	for _, i := range []int{1, 4, 5, 9} {
+		// --- Given ---
+		src := textChunk(t)
+		test.Skip4B(t, src) // Skip chunk ID.
+
+		// --- When ---
+		_, err := TEXT(IDNAME).ReadFrom(OrderReader(io.LimitReader(src, int64(i)), be))
+
+		// --- Then ---
+		assert.Error(t, err)
+	}
+}
+
+func Test_ChunkTEXT_WriteTo(t *testing.T) {
+	// --- Given ---
+	ch := TEXT(IDNAME)
+	ch.SetText("bloop")
+
+	// --- When ---
+	dst := &bytes.Buffer{}
+	n, err := ch.WriteTo(OrderWriter(dst, be))
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, int64(14), n)
+	exp := must.Value(io.ReadAll(textChunk(t)))
+	assert.Equal(t, exp, dst.Bytes())
+}
+
+func Test_ChunkTEXT_WriteTo_Errors(t *testing.T) {
+	for _, i := range []int{1, 4, 8, 12} {
+		// --- Given ---
+		ch := TEXT(IDNAME)
+		ch.SetText("bloop")
+
+		// --- When ---
+		_, err := ch.WriteTo(iokit.ErrWriter(&bytes.Buffer{}, i))
+
+		// --- Then ---
+		assert.Error(t, err)
+	}
+}
+
+func Test_ChunkTEXT_Reset(t *testing.T) {
+	// --- Given ---
+	ch := TEXT(IDNAME)
+	ch.SetText("bloop")
+
+	// --- When ---
+	ch.Reset()
+
+	// --- Then ---
+	assert.Equal(t, IDNAME, ch.ID())
+	assert.Equal(t, uint32(0), ch.Size())
+	assert.Equal(t, "", ch.Text())
+}
@@ -0,0 +1,113 @@
+package riff
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+)
+
+// IDVHDR represents "VHDR" (voice header) chunk ID of the 8SVX file.
+const IDVHDR uint32 = 0x56484452
+
+// VHDRChunkSize represents the size of the "VHDR" chunk in bytes.
+const VHDRChunkSize uint32 = 20
+
+// 8SVX sample data compression types.
+const (
+	SVXCompNone     uint8 = 0 // Not compressed.
+	SVXCompFibDelta uint8 = 1 // Fibonacci-delta encoding.
+)
+
+// SVXVolumeUnity represents the full volume of the 8SVX voice.
+const SVXVolumeUnity uint32 = 0x10000
+
+// ChunkVHDR represents the "VHDR" chunk of the 8SVX file describing the
+// sampled voice.
+type ChunkVHDR struct {
+	// Number of samples in the high octave one-shot part.
+	OneShotHiSamples uint32
+
+	// Number of samples in the high octave repeat part.
+	RepeatHiSamples uint32
+
+	// Number of samples per cycle in the high octave or zero if unknown.
+	SamplesPerHiCycle uint32
+
+	// Sampling rate in samples per second.
+	SamplesPerSec uint16
+
+	// Number of octaves of waveforms in the "BODY" chunk.
+	Octaves uint8
+
+	// Sample data compression (see SVXComp* constants).
+	Compression uint8
+
+	// Playback volume as 16.16 fixed point number, [SVXVolumeUnity] is
+	// the full volume.
+	Volume uint32
+}
+
+// VHDRMake is a [Maker] function for creating [ChunkVHDR] instances.
+func VHDRMake() Chunk { return VHDR() }
+
+// VHDR returns a new instance of [ChunkVHDR].
+func VHDR() *ChunkVHDR {
+	return &ChunkVHDR{}
+}
+
+func (ch *ChunkVHDR) ID() uint32     { return IDVHDR }
+func (ch *ChunkVHDR) Size() uint32   { return VHDRChunkSize }
+func (ch *ChunkVHDR) Type() uint32   { return 0 }
+func (ch *ChunkVHDR) Multi() bool    { return false }
+func (ch *ChunkVHDR) Chunks() Chunks { return nil }
+func (ch *ChunkVHDR) Raw() bool      { return false }
+
+// Octave returns the offset and the number of samples of the octave in the
+// "BODY" chunk. The octave zero is the highest one, each next octave has
+// twice as many samples.
+func (ch *ChunkVHDR) Octave(oct int) (uint32, uint32) {
+	hi := ch.OneShotHiSamples + ch.RepeatHiSamples
+	// Octaves are stored one after another: hi, 2*hi, 4*hi, ...
+	return hi * (1<<oct - 1), hi << oct
+}
+
+func (ch *ChunkVHDR) ReadFrom(r io.Reader) (int64, error) {
+	var sum int64
+	size, err := ReadChunkSize(r)
+	if err != nil {
+		return sum, fmt.Errorf(errFmtDecode, Uint32(IDVHDR), err)
+	}
+	sum += 4
+
+	if size != VHDRChunkSize {
+		return sum, fmt.Errorf(errFmtDecode, Uint32(IDVHDR), ErrChunkSizeMismatch)
+	}
+
+	if err = binary.Read(r, ByteOrder(r), ch); err != nil {
+		return sum, fmt.Errorf(errFmtDecode, Uint32(IDVHDR), err)
+	}
+	sum += int64(VHDRChunkSize)
+
+	return sum, nil
+}
+
+func (ch *ChunkVHDR) WriteTo(w io.Writer) (int64, error) {
+	var sum int64
+
+	n, err := WriteIDAndSize(w, IDVHDR, VHDRChunkSize)
+	sum += n
+	if err != nil {
+		return sum, fmt.Errorf(errFmtEncode, Uint32(IDVHDR), err)
+	}
+
+	if err = binary.Write(w, ByteOrder(w), ch); err != nil {
+		return sum, fmt.Errorf(errFmtEncode, Uint32(IDVHDR), err)
+	}
+	sum += int64(VHDRChunkSize)
+
+	return sum, nil
+}
+
+func (ch *ChunkVHDR) Reset() {
+	*ch = ChunkVHDR{}
+}
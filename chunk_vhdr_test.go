@@ -0,0 +1,155 @@
+package riff
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/ctx42/testing/pkg/assert"
+	"github.com/ctx42/testing/pkg/kit/iokit"
+	"github.com/ctx42/testing/pkg/must"
+
+	"github.com/rzajac/riff/internal/test"
+)
+
+func vhdrChunk(t *testing.T) io.Reader {
+	src := &bytes.Buffer{}
+	test.ReadFrom(t, src, Uint32(IDVHDR))      // ( 0) 4 - Chunk ID
+	test.WriteUint32BE(t, src, 20)             // ( 4) 4 - Chunk size
+	test.WriteUint32BE(t, src, 4)              // ( 8) 4 - OneShotHiSamples
+	test.WriteUint32BE(t, src, 2)              // (12) 4 - RepeatHiSamples
+	test.WriteUint32BE(t, src, 0)              // (16) 4 - SamplesPerHiCycle
+	test.WriteUint16BE(t, src, 8363)           // (20) 2 - SamplesPerSec
+	test.WriteByte(t, src, 2)                  // (22) 1 - Octaves
+	test.WriteByte(t, src, SVXCompFibDelta)    // (23) 1 - Compression
+	test.WriteUint32BE(t, src, SVXVolumeUnity) // (24) 4 - Volume
+	// Total length: 8+20=28
+	return src
+}
+
+func Test_ChunkVHDR_VHDR(t *testing.T) {
+	// --- When ---
+	ch := VHDR()
+
+	// --- Then ---
+	assert.Equal(t, IDVHDR, ch.ID())
+	assert.Equal(t, VHDRChunkSize, ch.Size())
+	assert.Equal(t, uint32(0), ch.Type())
+	assert.False(t, ch.Multi())
+	assert.Nil(t, ch.Chunks())
+	assert.False(t, ch.Raw())
+}
+
+func Test_ChunkVHDR_ReadFrom(t *testing.T) {
+	// --- Given ---
+	src := vhdrChunk(t)
+	test.Skip4B(t, src) // Skip chunk ID.
+
+	// --- When ---
+	ch := VHDR()
+	n, err := ch.ReadFrom(OrderReader(src, be))
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, int64(24), n)
+	assert.Equal(t, uint32(4), ch.OneShotHiSamples)
+	assert.Equal(t, uint32(2), ch.RepeatHiSamples)
+	assert.Equal(t, uint32(0), ch.SamplesPerHiCycle)
+	assert.Equal(t, uint16(8363), ch.SamplesPerSec)
+	assert.Equal(t, uint8(2), ch.Octaves)
+	assert.Equal(t, SVXCompFibDelta, ch.Compression)
+	assert.Equal(t, SVXVolumeUnity, ch.Volume)
+	assert.True(t, test.IsAllRead(src))
+}
+
+func Test_ChunkVHDR_ReadFrom_Errors(t *testing.T) {
+	// Reading less than 24 bytes should always result in an error.
+	for _, i := range []int{1, 4, 5, 12, 23} {
+		// --- Given ---
+		src := vhdrChunk(t)
+		test.Skip4B(t, src) // Skip chunk ID.
+
+		// --- When ---
+		_, err := VHDR().ReadFrom(OrderReader(io.LimitReader(src, int64(i)), be))
+
+		// --- Then ---
+		assert.Error(t, err)
+	}
+}
+
+func Test_ChunkVHDR_ReadFrom_SizeMismatch(t *testing.T) {
+	// --- Given ---
+	src := &bytes.Buffer{}
+	test.WriteUint32BE(t, src, 19)
+
+	// --- When ---
+	_, err := VHDR().ReadFrom(OrderReader(src, be))
+
+	// --- Then ---
+	assert.ErrorIs(t, ErrChunkSizeMismatch, err)
+}
+
+func Test_ChunkVHDR_Octave(t *testing.T) {
+	// --- Given ---
+	ch := VHDR()
+	ch.OneShotHiSamples = 4
+	ch.RepeatHiSamples = 2
+
+	// --- When ---
+	off0, cnt0 := ch.Octave(0)
+	off1, cnt1 := ch.Octave(1)
+	off2, cnt2 := ch.Octave(2)
+
+	// --- Then ---
+	assert.Equal(t, uint32(0), off0)
+	assert.Equal(t, uint32(6), cnt0)
+	assert.Equal(t, uint32(6), off1)
+	assert.Equal(t, uint32(12), cnt1)
+	assert.Equal(t, uint32(18), off2)
+	assert.Equal(t, uint32(24), cnt2)
+}
+
+func Test_ChunkVHDR_WriteTo(t *testing.T) {
+	// --- Given ---
+	src := vhdrChunk(t)
+	test.Skip4B(t, src) // Skip chunk ID.
+
+	ch := VHDR()
+	_, err := ch.ReadFrom(OrderReader(src, be))
+	assert.NoError(t, err)
+
+	// --- When ---
+	dst := &bytes.Buffer{}
+	n, err := ch.WriteTo(OrderWriter(dst, be))
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, int64(28), n)
+	exp := must.Value(io.ReadAll(vhdrChunk(t)))
+	assert.Equal(t, exp, dst.Bytes())
+}
+
+func Test_ChunkVHDR_WriteTo_Errors(t *testing.T) {
+	for _, i := range []int{1, 4, 8, 27} {
+		// --- When ---
+		_, err := VHDR().WriteTo(iokit.ErrWriter(&bytes.Buffer{}, i))
+
+		// --- Then ---
+		assert.Error(t, err)
+	}
+}
+
+func Test_ChunkVHDR_Reset(t *testing.T) {
+	// --- Given ---
+	src := vhdrChunk(t)
+	test.Skip4B(t, src) // Skip chunk ID.
+	ch := VHDR()
+	_, err := ch.ReadFrom(OrderReader(src, be))
+	assert.NoError(t, err)
+
+	// --- When ---
+	ch.Reset()
+
+	// --- Then ---
+	assert.Equal(t, &ChunkVHDR{}, ch)
+}
@@ -0,0 +1,140 @@
+package riff
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+)
+
+// IDVP8 represents "VP8 " (lossy WebP bitstream) chunk ID.
+const IDVP8 uint32 = 0x56503820
+
+// VP8HeaderSize represents the size of the VP8 key frame header in bytes.
+// It consists of the 3-byte frame tag, 3-byte start code and two 2-byte
+// dimensions.
+const VP8HeaderSize uint32 = 10
+
+// vp8StartCode is the start code of the VP8 key frame.
+var vp8StartCode = []byte{0x9d, 0x01, 0x2a}
+
+// ChunkVP8 represents "VP8 " chunk holding the lossy WebP bitstream. Only
+// the frame header is decoded.
+type ChunkVP8 struct {
+	// Chunk size in bytes.
+	// The ID and extra padding byte is not counted in the chunk size.
+	size uint32
+
+	// The key frame header.
+	head [VP8HeaderSize]byte
+
+	// The bitstream including the header. It's nil in SkipData mode.
+	data []byte
+}
+
+// VP8Make returns [Maker] function for [ChunkVP8] instances.
+func VP8Make(load bool) Maker {
+	return func() Chunk {
+		return VP8(load)
+	}
+}
+
+// VP8 returns a new instance of [ChunkVP8]. If load is false the bitstream
+// will not be loaded into memory.
+func VP8(load bool) *ChunkVP8 {
+	ch := &ChunkVP8{}
+	if load {
+		ch.data = make([]byte, 0, 1<<12)
+	}
+	return ch
+}
+
+func (ch *ChunkVP8) ID() uint32     { return IDVP8 }
+func (ch *ChunkVP8) Size() uint32   { return ch.size }
+func (ch *ChunkVP8) Type() uint32   { return 0 }
+func (ch *ChunkVP8) Multi() bool    { return true }
+func (ch *ChunkVP8) Chunks() Chunks { return nil }
+func (ch *ChunkVP8) Raw() bool      { return false }
+
+// Width returns the image width in pixels.
+func (ch *ChunkVP8) Width() uint32 {
+	return uint32(le.Uint16(ch.head[6:]) & 0x3fff)
+}
+
+// Height returns the image height in pixels.
+func (ch *ChunkVP8) Height() uint32 {
+	return uint32(le.Uint16(ch.head[8:]) & 0x3fff)
+}
+
+// Data returns the bitstream. It returns nil in SkipData mode.
+func (ch *ChunkVP8) Data() []byte { return ch.data }
+
+// SetData sets the bitstream. It will return [ErrSkipDataMode] if in
+// SkipData mode.
+func (ch *ChunkVP8) SetData(data []byte) error {
+	if ch.data == nil {
+		return ErrSkipDataMode
+	}
+	if err := ch.decode(data); err != nil {
+		return err
+	}
+	ch.data = grow(ch.data, len(data))
+	copy(ch.data, data)
+	ch.size = uint32(len(data))
+	return nil
+}
+
+// decode decodes the key frame header from the beginning of b.
+func (ch *ChunkVP8) decode(b []byte) error {
+	if len(b) < int(VP8HeaderSize) {
+		return ErrTooShort
+	}
+	// Bit 0 of the frame tag is zero for key frames.
+	if b[0]&0x01 != 0 || !bytes.Equal(b[3:6], vp8StartCode) {
+		return fmt.Errorf("not a key frame: %w", ErrWebPInvalid)
+	}
+	copy(ch.head[:], b)
+	return nil
+}
+
+func (ch *ChunkVP8) ReadFrom(r io.Reader) (int64, error) {
+	var sum int64
+	var err error
+	if ch.size, err = ReadChunkSize(r); err != nil {
+		return sum, fmt.Errorf(errFmtDecode, Uint32(IDVP8), err)
+	}
+	sum += 4
+
+	if ch.size < VP8HeaderSize {
+		return sum, fmt.Errorf(errFmtDecode, Uint32(IDVP8), ErrTooShort)
+	}
+
+	var n int64
+	ch.data, n, err = readBitstream(r, ch.size, ch.head[:], ch.data)
+	sum += n
+	if err != nil {
+		return sum, fmt.Errorf(errFmtDecode, Uint32(IDVP8), err)
+	}
+
+	if err = ch.decode(ch.head[:]); err != nil {
+		return sum, fmt.Errorf(errFmtDecode, Uint32(IDVP8), err)
+	}
+
+	return sum, nil
+}
+
+func (ch *ChunkVP8) WriteTo(w io.Writer) (int64, error) {
+	if ch.data == nil {
+		return 0, ErrSkipDataMode
+	}
+	n, err := writeBitstream(w, IDVP8, ch.data)
+	if err != nil {
+		return n, fmt.Errorf(errFmtEncode, Uint32(IDVP8), err)
+	}
+	return n, nil
+}
+
+func (ch *ChunkVP8) Reset() {
+	ch.size = 0
+	ch.head = [VP8HeaderSize]byte{}
+	ch.data = ch.data[:0]
+}
@@ -0,0 +1,200 @@
+package riff
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/ctx42/testing/pkg/assert"
+	"github.com/ctx42/testing/pkg/kit/iokit"
+	"github.com/ctx42/testing/pkg/must"
+
+	"github.com/rzajac/riff/internal/test"
+)
+
+// vp8Bitstream returns VP8 key frame of 400x300 pixels.
+func vp8Bitstream() []byte {
+	return []byte{
+		0x10, 0x02, 0x00, // Frame tag.
+		0x9d, 0x01, 0x2a, // Start code.
+		0x90, 0x01, // Width.
+		0x2c, 0x01, // Height.
+		0x01, 0x02, 0x03, // Compressed data.
+	}
+}
+
+func vp8Chunk(t *testing.T) io.Reader {
+	src := &bytes.Buffer{}
+	test.ReadFrom(t, src, Uint32(IDVP8))    // ( 0)  4 - Chunk ID
+	test.WriteUint32LE(t, src, 13)          // ( 4)  4 - Chunk size
+	test.WriteBytes(t, src, vp8Bitstream()) // ( 8) 13 - Bitstream
+	test.WriteByte(t, src, 0)               // (21)  1 - Padding byte
+	// Total length: 8+14=22
+	return src
+}
+
+func Test_ChunkVP8_VP8(t *testing.T) {
+	// --- When ---
+	ch := VP8(LoadData)
+
+	// --- Then ---
+	assert.Equal(t, IDVP8, ch.ID())
+	assert.Equal(t, uint32(0), ch.Size())
+	assert.Equal(t, uint32(0), ch.Type())
+	assert.True(t, ch.Multi())
+	assert.Nil(t, ch.Chunks())
+	assert.False(t, ch.Raw())
+	assert.NotNil(t, ch.Data())
+	assert.Nil(t, VP8(SkipData).Data())
+}
+
+func Test_ChunkVP8_ReadFrom(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		load bool
+		exp  []byte
+	}{
+		{"load", LoadData, vp8Bitstream()},
+		{"skip", SkipData, nil},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- Given ---
+			src := vp8Chunk(t)
+			test.Skip4B(t, src) // Skip chunk ID.
+
+			// --- When ---
+			ch := VP8(tc.load)
+			n, err := ch.ReadFrom(src)
+
+			// --- Then ---
+			assert.NoError(t, err)
+			assert.Equal(t, int64(18), n)
+			assert.Equal(t, uint32(13), ch.Size())
+			assert.Equal(t, uint32(400), ch.Width())
+			assert.Equal(t, uint32(300), ch.Height())
+			assert.Equal(t, tc.exp, ch.Data())
+			assert.True(t, test.IsAllRead(src))
+		})
+	}
+}
+
+func Test_ChunkVP8_ReadFrom_Errors(t *testing.T) {
+	// Reading less than 18 bytes should always result in an error.
+	for _, i := range []int{1, 4, 5, 13, 17} {
+		// --- Given ---
+		src := vp8Chunk(t)
+		test.Skip4B(t, src) // Skip chunk ID.
+
+		// --- When ---
+		_, err := VP8(LoadData).ReadFrom(io.LimitReader(src, int64(i)))
+
+		// --- Then ---
+		assert.Error(t, err)
+	}
+}
+
+func Test_ChunkVP8_ReadFrom_Invalid(t *testing.T) {
+	t.Run("too short", func(t *testing.T) {
+		// --- Given ---
+		src := &bytes.Buffer{}
+		test.WriteUint32LE(t, src, 9)
+
+		// --- When ---
+		_, err := VP8(LoadData).ReadFrom(src)
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrTooShort, err)
+	})
+
+	t.Run("not key frame", func(t *testing.T) {
+		// --- Given ---
+		b := must.Value(io.ReadAll(vp8Chunk(t)))
+		b[8] |= 0x01
+
+		// --- When ---
+		_, err := VP8(LoadData).ReadFrom(bytes.NewReader(b[4:]))
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrWebPInvalid, err)
+	})
+}
+
+func Test_ChunkVP8_SetData(t *testing.T) {
+	// --- Given ---
+	ch := VP8(LoadData)
+
+	// --- When ---
+	err := ch.SetData(vp8Bitstream())
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(13), ch.Size())
+	assert.Equal(t, uint32(400), ch.Width())
+	assert.Equal(t, uint32(300), ch.Height())
+}
+
+func Test_ChunkVP8_SetData_Errors(t *testing.T) {
+	assert.ErrorIs(t, ErrSkipDataMode, VP8(SkipData).SetData(vp8Bitstream()))
+	assert.ErrorIs(t, ErrTooShort, VP8(LoadData).SetData([]byte{0x10}))
+	assert.ErrorIs(t, ErrWebPInvalid, VP8(LoadData).SetData(make([]byte, 10)))
+}
+
+func Test_ChunkVP8_WriteTo(t *testing.T) {
+	// --- Given ---
+	src := vp8Chunk(t)
+	test.Skip4B(t, src) // Skip chunk ID.
+
+	ch := VP8(LoadData)
+	_, err := ch.ReadFrom(src)
+	assert.NoError(t, err)
+
+	// --- When ---
+	dst := &bytes.Buffer{}
+	n, err := ch.WriteTo(dst)
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, int64(22), n)
+	exp := must.Value(io.ReadAll(vp8Chunk(t)))
+	assert.Equal(t, exp, dst.Bytes())
+}
+
+func Test_ChunkVP8_WriteTo_Errors(t *testing.T) {
+	for _, i := range []int{1, 4, 8, 21} {
+		// --- Given ---
+		ch := VP8(LoadData)
+		assert.NoError(t, ch.SetData(vp8Bitstream()))
+
+		// --- When ---
+		_, err := ch.WriteTo(iokit.ErrWriter(&bytes.Buffer{}, i))
+
+		// --- Then ---
+		assert.Error(t, err)
+	}
+}
+
+func Test_ChunkVP8_WriteTo_SkipData(t *testing.T) {
+	// --- When ---
+	n, err := VP8(SkipData).WriteTo(&bytes.Buffer{})
+
+	// --- Then ---
+	assert.ErrorIs(t, ErrSkipDataMode, err)
+	assert.Equal(t, int64(0), n)
+}
+
+func Test_ChunkVP8_Reset(t *testing.T) {
+	// --- Given ---
+	ch := VP8(LoadData)
+	assert.NoError(t, ch.SetData(vp8Bitstream()))
+
+	// --- When ---
+	ch.Reset()
+
+	// --- Then ---
+	assert.Equal(t, uint32(0), ch.Size())
+	assert.Equal(t, uint32(0), ch.Width())
+	assert.Len(t, 0, ch.Data())
+}
@@ -0,0 +1,143 @@
+package riff
+
+import (
+	"fmt"
+	"io"
+)
+
+// IDVP8L represents "VP8L" (lossless WebP bitstream) chunk ID.
+const IDVP8L uint32 = 0x5650384c
+
+// VP8LHeaderSize represents the size of the VP8L header in bytes. It
+// consists of the 1-byte signature and 4 bytes of the image dimensions,
+// alpha hint and version.
+const VP8LHeaderSize uint32 = 5
+
+// VP8LSignature represents the signature byte of the VP8L bitstream.
+const VP8LSignature byte = 0x2f
+
+// ChunkVP8L represents "VP8L" chunk holding the lossless WebP bitstream.
+// Only the header is decoded.
+type ChunkVP8L struct {
+	// Chunk size in bytes.
+	// The ID and extra padding byte is not counted in the chunk size.
+	size uint32
+
+	// The bitstream header.
+	head [VP8LHeaderSize]byte
+
+	// The bitstream including the header. It's nil in SkipData mode.
+	data []byte
+}
+
+// VP8LMake returns [Maker] function for [ChunkVP8L] instances.
+func VP8LMake(load bool) Maker {
+	return func() Chunk {
+		return VP8L(load)
+	}
+}
+
+// VP8L returns a new instance of [ChunkVP8L]. If load is false the
+// bitstream will not be loaded into memory.
+func VP8L(load bool) *ChunkVP8L {
+	ch := &ChunkVP8L{}
+	if load {
+		ch.data = make([]byte, 0, 1<<12)
+	}
+	return ch
+}
+
+func (ch *ChunkVP8L) ID() uint32     { return IDVP8L }
+func (ch *ChunkVP8L) Size() uint32   { return ch.size }
+func (ch *ChunkVP8L) Type() uint32   { return 0 }
+func (ch *ChunkVP8L) Multi() bool    { return true }
+func (ch *ChunkVP8L) Chunks() Chunks { return nil }
+func (ch *ChunkVP8L) Raw() bool      { return false }
+
+// bits returns the 32 bits following the signature.
+func (ch *ChunkVP8L) bits() uint32 { return le.Uint32(ch.head[1:]) }
+
+// Width returns the image width in pixels.
+func (ch *ChunkVP8L) Width() uint32 { return ch.bits()&0x3fff + 1 }
+
+// Height returns the image height in pixels.
+func (ch *ChunkVP8L) Height() uint32 { return ch.bits()>>14&0x3fff + 1 }
+
+// HasAlpha returns the hint if the image uses alpha channel.
+func (ch *ChunkVP8L) HasAlpha() bool { return ch.bits()>>28&0x01 != 0 }
+
+// Version returns the bitstream version.
+func (ch *ChunkVP8L) Version() uint8 { return uint8(ch.bits() >> 29) }
+
+// Data returns the bitstream. It returns nil in SkipData mode.
+func (ch *ChunkVP8L) Data() []byte { return ch.data }
+
+// SetData sets the bitstream. It will return [ErrSkipDataMode] if in
+// SkipData mode.
+func (ch *ChunkVP8L) SetData(data []byte) error {
+	if ch.data == nil {
+		return ErrSkipDataMode
+	}
+	if err := ch.decode(data); err != nil {
+		return err
+	}
+	ch.data = grow(ch.data, len(data))
+	copy(ch.data, data)
+	ch.size = uint32(len(data))
+	return nil
+}
+
+// decode decodes the header from the beginning of b.
+func (ch *ChunkVP8L) decode(b []byte) error {
+	if len(b) < int(VP8LHeaderSize) {
+		return ErrTooShort
+	}
+	if b[0] != VP8LSignature {
+		return fmt.Errorf("invalid signature: %w", ErrWebPInvalid)
+	}
+	copy(ch.head[:], b)
+	return nil
+}
+
+func (ch *ChunkVP8L) ReadFrom(r io.Reader) (int64, error) {
+	var sum int64
+	var err error
+	if ch.size, err = ReadChunkSize(r); err != nil {
+		return sum, fmt.Errorf(errFmtDecode, Uint32(IDVP8L), err)
+	}
+	sum += 4
+
+	if ch.size < VP8LHeaderSize {
+		return sum, fmt.Errorf(errFmtDecode, Uint32(IDVP8L), ErrTooShort)
+	}
+
+	var n int64
+	ch.data, n, err = readBitstream(r, ch.size, ch.head[:], ch.data)
+	sum += n
+	if err != nil {
+		return sum, fmt.Errorf(errFmtDecode, Uint32(IDVP8L), err)
+	}
+
+	if err = ch.decode(ch.head[:]); err != nil {
+		return sum, fmt.Errorf(errFmtDecode, Uint32(IDVP8L), err)
+	}
+
+	return sum, nil
+}
+
+func (ch *ChunkVP8L) WriteTo(w io.Writer) (int64, error) {
+	if ch.data == nil {
+		return 0, ErrSkipDataMode
+	}
+	n, err := writeBitstream(w, IDVP8L, ch.data)
+	if err != nil {
+		return n, fmt.Errorf(errFmtEncode, Uint32(IDVP8L), err)
+	}
+	return n, nil
+}
+
+func (ch *ChunkVP8L) Reset() {
+	ch.size = 0
+	ch.head = [VP8LHeaderSize]byte{}
+	ch.data = ch.data[:0]
+}
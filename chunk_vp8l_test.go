@@ -0,0 +1,190 @@
+package riff
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/ctx42/testing/pkg/assert"
+	"github.com/ctx42/testing/pkg/kit/iokit"
+	"github.com/ctx42/testing/pkg/must"
+
+	"github.com/rzajac/riff/internal/test"
+)
+
+// vp8lBitstream returns VP8L image of 400x300 pixels using alpha channel.
+func vp8lBitstream() []byte {
+	b := []byte{VP8LSignature, 0, 0, 0, 0, 0xaa, 0xbb}
+	le.PutUint32(b[1:], 399|299<<14|1<<28)
+	return b
+}
+
+func vp8lChunk(t *testing.T) io.Reader {
+	src := &bytes.Buffer{}
+	test.ReadFrom(t, src, Uint32(IDVP8L))    // ( 0) 4 - Chunk ID
+	test.WriteUint32LE(t, src, 7)            // ( 4) 4 - Chunk size
+	test.WriteBytes(t, src, vp8lBitstream()) // ( 8) 7 - Bitstream
+	test.WriteByte(t, src, 0)                // (15) 1 - Padding byte
+	// Total length: 8+8=16
+	return src
+}
+
+func Test_ChunkVP8L_VP8L(t *testing.T) {
+	// --- When ---
+	ch := VP8L(LoadData)
+
+	// --- Then ---
+	assert.Equal(t, IDVP8L, ch.ID())
+	assert.Equal(t, uint32(0), ch.Size())
+	assert.Equal(t, uint32(0), ch.Type())
+	assert.True(t, ch.Multi())
+	assert.Nil(t, ch.Chunks())
+	assert.False(t, ch.Raw())
+	assert.Nil(t, VP8L(SkipData).Data())
+}
+
+func Test_ChunkVP8L_ReadFrom(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		load bool
+		exp  []byte
+	}{
+		{"load", LoadData, vp8lBitstream()},
+		{"skip", SkipData, nil},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- Given ---
+			src := vp8lChunk(t)
+			test.Skip4B(t, src) // Skip chunk ID.
+
+			// --- When ---
+			ch := VP8L(tc.load)
+			n, err := ch.ReadFrom(src)
+
+			// --- Then ---
+			assert.NoError(t, err)
+			assert.Equal(t, int64(12), n)
+			assert.Equal(t, uint32(7), ch.Size())
+			assert.Equal(t, uint32(400), ch.Width())
+			assert.Equal(t, uint32(300), ch.Height())
+			assert.True(t, ch.HasAlpha())
+			assert.Equal(t, uint8(0), ch.Version())
+			assert.Equal(t, tc.exp, ch.Data())
+			assert.True(t, test.IsAllRead(src))
+		})
+	}
+}
+
+func Test_ChunkVP8L_ReadFrom_Errors(t *testing.T) {
+	// Reading less than 12 bytes should always result in an error.
+	for _, i := range []int{1, 4, 5, 8, 11} {
+		// --- Given ---
+		src := vp8lChunk(t)
+		test.Skip4B(t, src) // Skip chunk ID.
+
+		// --- When ---
+		_, err := VP8L(LoadData).ReadFrom(io.LimitReader(src, int64(i)))
+
+		// --- Then ---
+		assert.Error(t, err)
+	}
+}
+
+func Test_ChunkVP8L_ReadFrom_Invalid(t *testing.T) {
+	t.Run("too short", func(t *testing.T) {
+		// --- Given ---
+		src := &bytes.Buffer{}
+		test.WriteUint32LE(t, src, 4)
+
+		// --- When ---
+		_, err := VP8L(LoadData).ReadFrom(src)
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrTooShort, err)
+	})
+
+	t.Run("signature", func(t *testing.T) {
+		// --- Given ---
+		b := must.Value(io.ReadAll(vp8lChunk(t)))
+		b[8] = 0
+
+		// --- When ---
+		_, err := VP8L(LoadData).ReadFrom(bytes.NewReader(b[4:]))
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrWebPInvalid, err)
+	})
+}
+
+func Test_ChunkVP8L_SetData(t *testing.T) {
+	// --- Given ---
+	ch := VP8L(LoadData)
+
+	// --- When ---
+	err := ch.SetData(vp8lBitstream())
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(7), ch.Size())
+	assert.Equal(t, uint32(400), ch.Width())
+}
+
+func Test_ChunkVP8L_SetData_Errors(t *testing.T) {
+	assert.ErrorIs(t, ErrSkipDataMode, VP8L(SkipData).SetData(vp8lBitstream()))
+	assert.ErrorIs(t, ErrTooShort, VP8L(LoadData).SetData([]byte{VP8LSignature}))
+	assert.ErrorIs(t, ErrWebPInvalid, VP8L(LoadData).SetData(make([]byte, 5)))
+}
+
+func Test_ChunkVP8L_WriteTo(t *testing.T) {
+	// --- Given ---
+	src := vp8lChunk(t)
+	test.Skip4B(t, src) // Skip chunk ID.
+
+	ch := VP8L(LoadData)
+	_, err := ch.ReadFrom(src)
+	assert.NoError(t, err)
+
+	// --- When ---
+	dst := &bytes.Buffer{}
+	n, err := ch.WriteTo(dst)
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, int64(16), n)
+	exp := must.Value(io.ReadAll(vp8lChunk(t)))
+	assert.Equal(t, exp, dst.Bytes())
+}
+
+func Test_ChunkVP8L_WriteTo_Errors(t *testing.T) {
+	for _, i := range []int{1, 4, 8, 15} {
+		// --- Given ---
+		ch := VP8L(LoadData)
+		assert.NoError(t, ch.SetData(vp8lBitstream()))
+
+		// --- When ---
+		_, err := ch.WriteTo(iokit.ErrWriter(&bytes.Buffer{}, i))
+
+		// --- Then ---
+		assert.Error(t, err)
+	}
+
+	_, err := VP8L(SkipData).WriteTo(&bytes.Buffer{})
+	assert.ErrorIs(t, ErrSkipDataMode, err)
+}
+
+func Test_ChunkVP8L_Reset(t *testing.T) {
+	// --- Given ---
+	ch := VP8L(LoadData)
+	assert.NoError(t, ch.SetData(vp8lBitstream()))
+
+	// --- When ---
+	ch.Reset()
+
+	// --- Then ---
+	assert.Equal(t, uint32(0), ch.Size())
+	assert.False(t, ch.HasAlpha())
+	assert.Len(t, 0, ch.Data())
+}
@@ -0,0 +1,157 @@
+package riff
+
+import (
+	"fmt"
+	"io"
+)
+
+// IDVP8X represents "VP8X" (extended WebP format header) chunk ID.
+const IDVP8X uint32 = 0x56503858
+
+// VP8XChunkSize represents the size of VP8X chunk static part in bytes.
+const VP8XChunkSize uint32 = 10
+
+// WebP features flags of the VP8X chunk.
+const (
+	// VP8XAnimation is set when the image is animated.
+	VP8XAnimation uint8 = 0x02
+
+	// VP8XXMP is set when the image has "XMP " chunk.
+	VP8XXMP uint8 = 0x04
+
+	// VP8XEXIF is set when the image has "EXIF" chunk.
+	VP8XEXIF uint8 = 0x08
+
+	// VP8XAlpha is set when any of the frames has transparency.
+	VP8XAlpha uint8 = 0x10
+
+	// VP8XICC is set when the image has "ICCP" chunk.
+	VP8XICC uint8 = 0x20
+)
+
+// ChunkVP8X represents "VP8X" chunk of the extended format WebP image.
+type ChunkVP8X struct {
+	// Chunk size in bytes.
+	// The ID and extra padding byte is not counted in the chunk size.
+	size uint32
+
+	// Bitwise combination of VP8X* feature flags.
+	Flags uint8
+
+	// Reserved bytes following the flags.
+	reserved [3]byte
+
+	// Width of the canvas in pixels.
+	CanvasWidth uint32
+
+	// Height of the canvas in pixels.
+	CanvasHeight uint32
+
+	// Bytes following the static part.
+	extra []byte
+}
+
+// VP8XMake is a [Maker] function for creating [ChunkVP8X] instances.
+func VP8XMake() Chunk { return VP8X() }
+
+// VP8X returns a new instance of [ChunkVP8X].
+func VP8X() *ChunkVP8X {
+	return &ChunkVP8X{size: VP8XChunkSize}
+}
+
+func (ch *ChunkVP8X) ID() uint32     { return IDVP8X }
+func (ch *ChunkVP8X) Size() uint32   { return ch.size }
+func (ch *ChunkVP8X) Type() uint32   { return 0 }
+func (ch *ChunkVP8X) Multi() bool    { return false }
+func (ch *ChunkVP8X) Chunks() Chunks { return nil }
+func (ch *ChunkVP8X) Raw() bool      { return false }
+
+// Has returns true if all the feature flags are set.
+func (ch *ChunkVP8X) Has(flags uint8) bool {
+	return ch.Flags&flags == flags
+}
+
+// Set sets or clears the feature flags.
+func (ch *ChunkVP8X) Set(flags uint8, on bool) {
+	if on {
+		ch.Flags |= flags
+		return
+	}
+	ch.Flags &^= flags
+}
+
+func (ch *ChunkVP8X) ReadFrom(r io.Reader) (int64, error) {
+	var sum int64
+	var err error
+	if ch.size, err = ReadChunkSize(r); err != nil {
+		return sum, fmt.Errorf(errFmtDecode, Uint32(IDVP8X), err)
+	}
+	sum += 4
+
+	if ch.size < VP8XChunkSize {
+		return sum, fmt.Errorf(errFmtDecode, Uint32(IDVP8X), ErrTooShort)
+	}
+
+	buf := make([]byte, ch.size)
+	in, err := io.ReadFull(r, buf)
+	sum += int64(in)
+	if err != nil {
+		return sum, fmt.Errorf(errFmtDecode, Uint32(IDVP8X), err)
+	}
+
+	ch.Flags = buf[0]
+	copy(ch.reserved[:], buf[1:4])
+	ch.CanvasWidth = uint24(buf[4:]) + 1
+	ch.CanvasHeight = uint24(buf[7:]) + 1
+	ch.extra = grow(ch.extra, len(buf)-int(VP8XChunkSize))
+	copy(ch.extra, buf[VP8XChunkSize:])
+
+	n, err := ReadPaddingIf(r, ch.size)
+	sum += n
+	if err != nil {
+		return sum, fmt.Errorf(errFmtDecode, Uint32(IDVP8X), err)
+	}
+
+	return sum, nil
+}
+
+func (ch *ChunkVP8X) WriteTo(w io.Writer) (int64, error) {
+	var sum int64
+
+	buf := make([]byte, VP8XChunkSize, VP8XChunkSize+uint32(len(ch.extra)))
+	buf[0] = ch.Flags
+	copy(buf[1:4], ch.reserved[:])
+	putUint24(buf[4:], ch.CanvasWidth-1)
+	putUint24(buf[7:], ch.CanvasHeight-1)
+	buf = append(buf, ch.extra...)
+	ch.size = uint32(len(buf))
+
+	n, err := WriteIDAndSize(w, IDVP8X, ch.size)
+	sum += n
+	if err != nil {
+		return sum, fmt.Errorf(errFmtEncode, Uint32(IDVP8X), err)
+	}
+
+	in, err := w.Write(buf)
+	sum += int64(in)
+	if err != nil {
+		return sum, fmt.Errorf(errFmtEncode, Uint32(IDVP8X), err)
+	}
+
+	n, err = WritePaddingIf(w, ch.size)
+	sum += n
+	if err != nil {
+		return sum, fmt.Errorf(errFmtEncode, Uint32(IDVP8X), err)
+	}
+
+	return sum, nil
+}
+
+func (ch *ChunkVP8X) Reset() {
+	ch.size = VP8XChunkSize
+	ch.Flags = 0
+	ch.reserved = [3]byte{}
+	ch.CanvasWidth = 0
+	ch.CanvasHeight = 0
+	ch.extra = ch.extra[:0]
+}
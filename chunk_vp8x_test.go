@@ -0,0 +1,172 @@
+package riff
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/ctx42/testing/pkg/assert"
+	"github.com/ctx42/testing/pkg/kit/iokit"
+	"github.com/ctx42/testing/pkg/must"
+
+	"github.com/rzajac/riff/internal/test"
+)
+
+func vp8xChunk(t *testing.T) io.Reader {
+	src := &bytes.Buffer{}
+	test.ReadFrom(t, src, Uint32(IDVP8X))             // ( 0) 4 - Chunk ID
+	test.WriteUint32LE(t, src, 10)                    // ( 4) 4 - Chunk size
+	test.WriteByte(t, src, VP8XICC|VP8XAlpha)         // ( 8) 1 - Flags
+	test.WriteBytes(t, src, []byte{0, 0, 0})          // ( 9) 3 - Reserved
+	test.WriteBytes(t, src, []byte{0x8f, 0x01, 0x00}) // (12) 3 - Canvas width - 1
+	test.WriteBytes(t, src, []byte{0x2b, 0x01, 0x00}) // (15) 3 - Canvas height - 1
+	// Total length: 8+10=18
+	return src
+}
+
+func Test_ChunkVP8X_VP8X(t *testing.T) {
+	// --- When ---
+	ch := VP8X()
+
+	// --- Then ---
+	assert.Equal(t, IDVP8X, ch.ID())
+	assert.Equal(t, VP8XChunkSize, ch.Size())
+	assert.Equal(t, uint32(0), ch.Type())
+	assert.False(t, ch.Multi())
+	assert.Nil(t, ch.Chunks())
+	assert.False(t, ch.Raw())
+}
+
+func Test_ChunkVP8X_ReadFrom(t *testing.T) {
+	// --- Given ---
+	src := vp8xChunk(t)
+	test.Skip4B(t, src) // Skip chunk ID.
+
+	// --- When ---
+	ch := VP8X()
+	n, err := ch.ReadFrom(src)
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, int64(14), n)
+	assert.Equal(t, VP8XChunkSize, ch.Size())
+	assert.True(t, ch.Has(VP8XICC|VP8XAlpha))
+	assert.False(t, ch.Has(VP8XAnimation))
+	assert.Equal(t, uint32(400), ch.CanvasWidth)
+	assert.Equal(t, uint32(300), ch.CanvasHeight)
+	assert.True(t, test.IsAllRead(src))
+}
+
+func Test_ChunkVP8X_ReadFrom_Errors(t *testing.T) {
+	// Reading less than 14 bytes should always result in an error.
+	for _, i := range []int{1, 4, 5, 13} {
+		// --- Given ---
+		src := vp8xChunk(t)
+		test.Skip4B(t, src) // Skip chunk ID.
+
+		// --- When ---
+		_, err := VP8X().ReadFrom(io.LimitReader(src, int64(i)))
+
+		// --- Then ---
+		assert.Error(t, err)
+	}
+}
+
+func Test_ChunkVP8X_ReadFrom_TooShortError(t *testing.T) {
+	// --- Given ---
+	src := &bytes.Buffer{}
+	test.WriteUint32LE(t, src, 9)
+
+	// --- When ---
+	_, err := VP8X().ReadFrom(src)
+
+	// --- Then ---
+	assert.ErrorIs(t, ErrTooShort, err)
+}
+
+func Test_ChunkVP8X_Set(t *testing.T) {
+	// --- Given ---
+	ch := VP8X()
+
+	// --- When ---
+	ch.Set(VP8XEXIF|VP8XXMP, true)
+	ch.Set(VP8XXMP, false)
+
+	// --- Then ---
+	assert.Equal(t, VP8XEXIF, ch.Flags)
+}
+
+func Test_ChunkVP8X_WriteTo(t *testing.T) {
+	// --- Given ---
+	src := vp8xChunk(t)
+	test.Skip4B(t, src) // Skip chunk ID.
+
+	ch := VP8X()
+	_, err := ch.ReadFrom(src)
+	assert.NoError(t, err)
+
+	// --- When ---
+	dst := &bytes.Buffer{}
+	n, err := ch.WriteTo(dst)
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, int64(18), n)
+	exp := must.Value(io.ReadAll(vp8xChunk(t)))
+	assert.Equal(t, exp, dst.Bytes())
+}
+
+func Test_ChunkVP8X_WriteTo_Extra(t *testing.T) {
+	// --- Given ---
+	b := must.Value(io.ReadAll(vp8xChunk(t)))
+	b = append(b, 1, 2, 3, 0)
+	le.PutUint32(b[4:], 13)
+
+	ch := VP8X()
+	_, err := ch.ReadFrom(bytes.NewReader(b[4:]))
+	assert.NoError(t, err)
+
+	// --- When ---
+	dst := &bytes.Buffer{}
+	n, err := ch.WriteTo(dst)
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, int64(22), n)
+	assert.Equal(t, b, dst.Bytes())
+}
+
+func Test_ChunkVP8X_WriteTo_Errors(t *testing.T) {
+	for _, i := range []int{1, 4, 8, 17} {
+		// --- Given ---
+		src := vp8xChunk(t)
+		test.Skip4B(t, src) // Skip chunk ID.
+
+		ch := VP8X()
+		_, err := ch.ReadFrom(src)
+		assert.NoError(t, err)
+
+		// --- When ---
+		_, err = ch.WriteTo(iokit.ErrWriter(&bytes.Buffer{}, i))
+
+		// --- Then ---
+		assert.Error(t, err)
+	}
+}
+
+func Test_ChunkVP8X_Reset(t *testing.T) {
+	// --- Given ---
+	src := vp8xChunk(t)
+	test.Skip4B(t, src) // Skip chunk ID.
+	ch := VP8X()
+	_, err := ch.ReadFrom(src)
+	assert.NoError(t, err)
+
+	// --- When ---
+	ch.Reset()
+
+	// --- Then ---
+	assert.Equal(t, VP8XChunkSize, ch.Size())
+	assert.Equal(t, uint8(0), ch.Flags)
+	assert.Equal(t, uint32(0), ch.CanvasWidth)
+}
@@ -0,0 +1,167 @@
+package riff
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+)
+
+// ChunkW64 represents the Sony Wave64 chunk with GUID not mapping to the
+// RIFF chunk ID (e.g. Sony "marker" or "summarylist" chunks) or the chunk
+// of 4 GiB or more. It can be written to the Wave64 file with [WriteW64]
+// or, when the GUID maps to the chunk ID, to the RF64 file with
+// [WriteRF64].
+type ChunkW64 struct {
+	// Chunk GUID.
+	GUID GUID
+
+	// Chunk size in bytes.
+	// The header and padding bytes are not counted in the chunk size.
+	size uint64
+
+	// Chunk data. It's nil in SkipData mode.
+	data []byte
+}
+
+// W64 returns a new instance of [ChunkW64] with the GUID. If load is false
+// the data will not be loaded into memory.
+func W64(g GUID, load bool) *ChunkW64 {
+	ch := &ChunkW64{GUID: g}
+	if load {
+		ch.data = make([]byte, 0)
+	}
+	return ch
+}
+
+// ID returns the first four bytes of the GUID.
+func (ch *ChunkW64) ID() uint32 { return be.Uint32(ch.GUID[:]) }
+
+// Size returns the chunk size clipped to [math.MaxUint32].
+func (ch *ChunkW64) Size() uint32 { return uint32(min(ch.size, math.MaxUint32)) }
+
+func (ch *ChunkW64) Type() uint32   { return 0 }
+func (ch *ChunkW64) Multi() bool    { return true }
+func (ch *ChunkW64) Chunks() Chunks { return nil }
+func (ch *ChunkW64) Raw() bool      { return true }
+
+// Data returns the chunk data. It returns nil in SkipData mode.
+func (ch *ChunkW64) Data() []byte { return ch.data }
+
+// SetData sets the chunk data. It will return [ErrSkipDataMode] if in
+// SkipData mode.
+func (ch *ChunkW64) SetData(data []byte) error {
+	if ch.data == nil {
+		return ErrSkipDataMode
+	}
+	ch.data = grow(ch.data, len(data))
+	copy(ch.data, data)
+	ch.size = uint64(len(data))
+	return nil
+}
+
+// ReadFrom reads the chunk starting right after the GUID: the 64-bit size
+// including the header followed by the data. The padding is not read.
+func (ch *ChunkW64) ReadFrom(r io.Reader) (int64, error) {
+	var size uint64
+	if err := binary.Read(r, le, &size); err != nil {
+		return 0, fmt.Errorf(errFmtDecode, ch.GUID, err)
+	}
+	if size < W64HeaderSize {
+		return 8, fmt.Errorf(errFmtDecode, ch.GUID, ErrTooShort)
+	}
+	n, err := ch.readBody(r, size-W64HeaderSize)
+	return 8 + n, err
+}
+
+// readBody reads size bytes of the chunk body.
+func (ch *ChunkW64) readBody(r io.Reader, size uint64) (int64, error) {
+	ch.size = size
+	if ch.data == nil {
+		var sum int64
+		for size > 0 {
+			n := uint32(min(size, 1<<30))
+			if err := SkipN(r, n); err != nil {
+				return sum, fmt.Errorf(errFmtDecode, ch.GUID, err)
+			}
+			sum += int64(n)
+			size -= uint64(n)
+		}
+		return sum, nil
+	}
+
+	// The buffer grows as the data is read, so the size declared by
+	// a truncated file doesn't allocate memory up front.
+	buf := bytes.NewBuffer(ch.data[:0])
+	n, err := io.CopyN(buf, r, int64(min(size, math.MaxInt64)))
+	ch.data = buf.Bytes()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			err = io.ErrUnexpectedEOF
+		}
+		return n, fmt.Errorf(errFmtDecode, ch.GUID, err)
+	}
+	return n, nil
+}
+
+// WriteTo always returns [ErrUnsupportedFormat] as the chunk can't be
+// written to the RIFF file. Use [WriteW64] or [WriteRF64] instead.
+func (ch *ChunkW64) WriteTo(_ io.Writer) (int64, error) {
+	return 0, fmt.Errorf(errFmtEncode, ch.GUID, ErrUnsupportedFormat)
+}
+
+// writeW64 writes the chunk in Wave64 format with the padding.
+func (ch *ChunkW64) writeW64(w io.Writer) (int64, error) {
+	if ch.data == nil {
+		return 0, ErrSkipDataMode
+	}
+
+	head := make([]byte, 0, W64HeaderSize)
+	head = append(head, ch.GUID[:]...)
+	head = le.AppendUint64(head, W64HeaderSize+uint64(len(ch.data)))
+
+	var sum int64
+	pad := make([]byte, w64Pad(uint64(len(ch.data))))
+	for _, p := range [][]byte{head, ch.data, pad} {
+		n, err := w.Write(p)
+		sum += int64(n)
+		if err != nil {
+			return sum, fmt.Errorf(errFmtEncode, ch.GUID, err)
+		}
+	}
+	return sum, nil
+}
+
+// writeRF64 writes the chunk in RF64 format with the chunk ID made from the
+// GUID. The size of chunks of 4 GiB or more is written as 0xFFFFFFFF, the
+// real size is stored in the "ds64" chunk (see [WriteRF64]).
+func (ch *ChunkW64) writeRF64(w io.Writer) (int64, error) {
+	if ch.data == nil {
+		return 0, ErrSkipDataMode
+	}
+	id, _ := W64ID(ch.GUID)
+	size := uint64(len(ch.data))
+
+	head := make([]byte, 0, 8)
+	head = be.AppendUint32(head, id)
+	head = le.AppendUint32(head, uint32(min(size, uint64(rf64SizeMax))))
+
+	var sum int64
+	for _, p := range [][]byte{head, ch.data, make([]byte, size%2)} {
+		n, err := w.Write(p)
+		sum += int64(n)
+		if err != nil {
+			return sum, fmt.Errorf(errFmtEncode, Uint32(id), err)
+		}
+	}
+	return sum, nil
+}
+
+func (ch *ChunkW64) Reset() {
+	ch.size = 0
+	if ch.data != nil {
+		ch.data = ch.data[:0]
+	}
+}
@@ -0,0 +1,211 @@
+package riff
+
+import (
+	"bytes"
+	"io"
+	"math"
+	"testing"
+
+	"github.com/ctx42/testing/pkg/assert"
+	"github.com/ctx42/testing/pkg/kit/iokit"
+	"github.com/ctx42/testing/pkg/must"
+
+	"github.com/rzajac/riff/internal/test"
+)
+
+// w64Marker represents Sony Wave64 "marker" chunk GUID
+// {ABF76256-392D-11D2-86C7-00C04F8EDB8A}.
+var w64Marker = GUID{
+	0x56, 0x62, 0xf7, 0xab, 0x2d, 0x39, 0xd2, 0x11,
+	0x86, 0xc7, 0x00, 0xc0, 0x4f, 0x8e, 0xdb, 0x8a,
+}
+
+func w64Chunk(t *testing.T) io.Reader {
+	src := &bytes.Buffer{}
+	test.WriteBytes(t, src, w64Marker[:])               // ( 0) 16 - GUID
+	test.WriteBytes(t, src, le.AppendUint64(nil, 24+4)) // (16)  8 - Chunk size
+	test.WriteBytes(t, src, []byte{1, 2, 3, 4})         // (24)  4 - Data
+	test.WriteBytes(t, src, []byte{0, 0, 0, 0})         // (28)  4 - Padding
+	// Total length: 24+4+4=32
+	return src
+}
+
+func Test_ChunkW64_W64_SkipDataMode(t *testing.T) {
+	// --- When ---
+	ch := W64(w64Marker, SkipData)
+
+	// --- Then ---
+	assert.Equal(t, uint32(0x5662f7ab), ch.ID())
+	assert.Equal(t, w64Marker, ch.GUID)
+	assert.Equal(t, uint32(0), ch.Size())
+	assert.Equal(t, uint32(0), ch.Type())
+	assert.True(t, ch.Multi())
+	assert.Nil(t, ch.Chunks())
+	assert.True(t, ch.Raw())
+	assert.Nil(t, ch.Data())
+}
+
+func Test_ChunkW64_W64_LoadDataMode(t *testing.T) {
+	// --- When ---
+	ch := W64(w64Marker, LoadData)
+
+	// --- Then ---
+	assert.NotNil(t, ch.Data())
+	assert.Len(t, 0, ch.Data())
+}
+
+func Test_ChunkW64_Size_Clipped(t *testing.T) {
+	// --- Given ---
+	ch := W64(w64Marker, SkipData)
+	ch.size = 1 << 33
+
+	// --- When ---
+	have := ch.Size()
+
+	// --- Then ---
+	assert.Equal(t, uint32(math.MaxUint32), have)
+}
+
+func Test_ChunkW64_ReadFrom(t *testing.T) {
+	t.Run("load data", func(t *testing.T) {
+		// --- Given ---
+		src := w64Chunk(t)
+		must.Value(io.ReadFull(src, make([]byte, 16))) // Skip GUID.
+
+		// --- When ---
+		ch := W64(w64Marker, LoadData)
+		n, err := ch.ReadFrom(src)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, int64(12), n)
+		assert.Equal(t, uint32(4), ch.Size())
+		assert.Equal(t, []byte{1, 2, 3, 4}, ch.Data())
+	})
+
+	t.Run("skip data", func(t *testing.T) {
+		// --- Given ---
+		src := w64Chunk(t)
+		must.Value(io.ReadFull(src, make([]byte, 16))) // Skip GUID.
+
+		// --- When ---
+		ch := W64(w64Marker, SkipData)
+		n, err := ch.ReadFrom(src)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, int64(12), n)
+		assert.Equal(t, uint32(4), ch.Size())
+		assert.Nil(t, ch.Data())
+	})
+}
+
+func Test_ChunkW64_ReadFrom_Errors(t *testing.T) {
+	t.Run("too short", func(t *testing.T) {
+		// --- Given ---
+		src := bytes.NewReader(le.AppendUint64(nil, 23))
+
+		// --- When ---
+		_, err := W64(w64Marker, LoadData).ReadFrom(src)
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrTooShort, err)
+	})
+
+	// Reading less than 12 bytes should always result in an error.
+	for _, i := range []int{1, 7, 8, 11} {
+		// --- Given ---
+		src := w64Chunk(t)
+		must.Value(io.ReadFull(src, make([]byte, 16))) // Skip GUID.
+
+		// --- When ---
+		_, err := W64(w64Marker, LoadData).ReadFrom(io.LimitReader(src, int64(i)))
+
+		// --- Then ---
+		assert.Error(t, err)
+	}
+}
+
+func Test_ChunkW64_SetData(t *testing.T) {
+	t.Run("load data", func(t *testing.T) {
+		// --- Given ---
+		ch := W64(w64Marker, LoadData)
+
+		// --- When ---
+		err := ch.SetData([]byte{1, 2, 3})
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, uint32(3), ch.Size())
+		assert.Equal(t, []byte{1, 2, 3}, ch.Data())
+	})
+
+	t.Run("skip data", func(t *testing.T) {
+		// --- When ---
+		err := W64(w64Marker, SkipData).SetData([]byte{1})
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrSkipDataMode, err)
+	})
+}
+
+func Test_ChunkW64_WriteTo(t *testing.T) {
+	// --- When ---
+	n, err := W64(w64Marker, LoadData).WriteTo(&bytes.Buffer{})
+
+	// --- Then ---
+	assert.ErrorIs(t, ErrUnsupportedFormat, err)
+	assert.Equal(t, int64(0), n)
+}
+
+func Test_ChunkW64_writeW64(t *testing.T) {
+	// --- Given ---
+	ch := W64(w64Marker, LoadData)
+	must.Nil(ch.SetData([]byte{1, 2, 3, 4}))
+
+	// --- When ---
+	dst := &bytes.Buffer{}
+	n, err := ch.writeW64(dst)
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, int64(32), n)
+	exp := must.Value(io.ReadAll(w64Chunk(t)))
+	assert.Equal(t, exp, dst.Bytes())
+}
+
+func Test_ChunkW64_writeW64_Errors(t *testing.T) {
+	t.Run("skip data", func(t *testing.T) {
+		// --- When ---
+		_, err := W64(w64Marker, SkipData).writeW64(&bytes.Buffer{})
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrSkipDataMode, err)
+	})
+
+	for _, i := range []int{1, 16, 24, 28} {
+		// --- Given ---
+		ch := W64(w64Marker, LoadData)
+		must.Nil(ch.SetData([]byte{1, 2, 3, 4}))
+
+		// --- When ---
+		_, err := ch.writeW64(iokit.ErrWriter(&bytes.Buffer{}, i))
+
+		// --- Then ---
+		assert.Error(t, err)
+	}
+}
+
+func Test_ChunkW64_Reset(t *testing.T) {
+	// --- Given ---
+	ch := W64(w64Marker, LoadData)
+	must.Nil(ch.SetData([]byte{1, 2, 3, 4}))
+
+	// --- When ---
+	ch.Reset()
+
+	// --- Then ---
+	assert.Equal(t, w64Marker, ch.GUID)
+	assert.Equal(t, uint32(0), ch.Size())
+	assert.Len(t, 0, ch.Data())
+}
@@ -0,0 +1,106 @@
+package riff
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+)
+
+// WaveINSTChunkSize represents the size of the WAVE "inst" chunk in bytes.
+const WaveINSTChunkSize uint32 = 7
+
+// ChunkWaveINST represents the "inst" (instrument) chunk of the WAVE file
+// describing how the waveform should be played as a sampled musical
+// instrument.
+type ChunkWaveINST struct {
+	// MIDI note number at which the waveform plays back without pitch
+	// modification.
+	UnshiftedNote uint8
+
+	// Pitch alteration, in cents (-50 to +50), applied on playback.
+	FineTune int8
+
+	// Gain in decibels applied on playback.
+	Gain int8
+
+	// Lowest MIDI note number the instrument should be played at.
+	LowNote uint8
+
+	// Highest MIDI note number the instrument should be played at.
+	HighNote uint8
+
+	// Lowest MIDI velocity the instrument should be played at.
+	LowVelocity uint8
+
+	// Highest MIDI velocity the instrument should be played at.
+	HighVelocity uint8
+}
+
+// WaveINSTMake is a [Maker] function for creating [ChunkWaveINST]
+// instances.
+func WaveINSTMake() Chunk { return WaveINST() }
+
+// WaveINST returns a new instance of [ChunkWaveINST].
+func WaveINST() *ChunkWaveINST {
+	return &ChunkWaveINST{}
+}
+
+func (ch *ChunkWaveINST) ID() uint32     { return IDinst }
+func (ch *ChunkWaveINST) Size() uint32   { return WaveINSTChunkSize }
+func (ch *ChunkWaveINST) Type() uint32   { return 0 }
+func (ch *ChunkWaveINST) Multi() bool    { return false }
+func (ch *ChunkWaveINST) Chunks() Chunks { return nil }
+func (ch *ChunkWaveINST) Raw() bool      { return false }
+
+func (ch *ChunkWaveINST) ReadFrom(r io.Reader) (int64, error) {
+	var sum int64
+	size, err := ReadChunkSize(r)
+	if err != nil {
+		return sum, fmt.Errorf(errFmtDecode, linkids(TypeWAVE, IDinst), err)
+	}
+	sum += 4
+
+	if size != WaveINSTChunkSize {
+		return sum, fmt.Errorf(errFmtDecode, linkids(TypeWAVE, IDinst), ErrChunkSizeMismatch)
+	}
+
+	if err = binary.Read(r, ByteOrder(r), ch); err != nil {
+		return sum, fmt.Errorf(errFmtDecode, linkids(TypeWAVE, IDinst), err)
+	}
+	sum += int64(WaveINSTChunkSize)
+
+	n, err := ReadPaddingIf(r, size)
+	sum += n
+	if err != nil {
+		return sum, fmt.Errorf(errFmtDecode, linkids(TypeWAVE, IDinst), err)
+	}
+
+	return sum, nil
+}
+
+func (ch *ChunkWaveINST) WriteTo(w io.Writer) (int64, error) {
+	var sum int64
+
+	n, err := WriteIDAndSize(w, IDinst, WaveINSTChunkSize)
+	sum += n
+	if err != nil {
+		return sum, fmt.Errorf(errFmtEncode, linkids(TypeWAVE, IDinst), err)
+	}
+
+	if err = binary.Write(w, ByteOrder(w), ch); err != nil {
+		return sum, fmt.Errorf(errFmtEncode, linkids(TypeWAVE, IDinst), err)
+	}
+	sum += int64(WaveINSTChunkSize)
+
+	n, err = WritePaddingIf(w, WaveINSTChunkSize)
+	sum += n
+	if err != nil {
+		return sum, fmt.Errorf(errFmtEncode, linkids(TypeWAVE, IDinst), err)
+	}
+
+	return sum, nil
+}
+
+func (ch *ChunkWaveINST) Reset() {
+	*ch = ChunkWaveINST{}
+}
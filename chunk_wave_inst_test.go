@@ -0,0 +1,130 @@
+package riff
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/ctx42/testing/pkg/assert"
+	"github.com/ctx42/testing/pkg/kit/iokit"
+	"github.com/ctx42/testing/pkg/must"
+
+	"github.com/rzajac/riff/internal/test"
+)
+
+func waveInstChunk(t *testing.T) io.Reader {
+	src := &bytes.Buffer{}
+	test.ReadFrom(t, src, Uint32(IDinst))                           // ( 0) 4 - Chunk ID
+	test.WriteUint32LE(t, src, 7)                                   // ( 4) 4 - Chunk size
+	test.WriteBytes(t, src, []byte{60, 0xfb, 0xfd, 0, 127, 1, 127}) // ( 8) 7 - Instrument
+	test.WriteByte(t, src, 0)                                       // (15) 1 - Padding
+	// Total length: 8+7+1=16
+	return src
+}
+
+func Test_ChunkWaveINST_WaveINST(t *testing.T) {
+	// --- When ---
+	ch := WaveINST()
+
+	// --- Then ---
+	assert.Equal(t, IDinst, ch.ID())
+	assert.Equal(t, WaveINSTChunkSize, ch.Size())
+	assert.Equal(t, uint32(0), ch.Type())
+	assert.False(t, ch.Multi())
+	assert.Nil(t, ch.Chunks())
+	assert.False(t, ch.Raw())
+}
+
+func Test_ChunkWaveINST_ReadFrom(t *testing.T) {
+	// --- Given ---
+	src := waveInstChunk(t)
+	test.Skip4B(t, src) // Skip chunk ID.
+
+	// --- When ---
+	ch := WaveINST()
+	n, err := ch.ReadFrom(src)
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, int64(12), n)
+	assert.Equal(t, uint8(60), ch.UnshiftedNote)
+	assert.Equal(t, int8(-5), ch.FineTune)
+	assert.Equal(t, int8(-3), ch.Gain)
+	assert.Equal(t, uint8(0), ch.LowNote)
+	assert.Equal(t, uint8(127), ch.HighNote)
+	assert.Equal(t, uint8(1), ch.LowVelocity)
+	assert.Equal(t, uint8(127), ch.HighVelocity)
+	assert.True(t, test.IsAllRead(src))
+}
+
+func Test_ChunkWaveINST_ReadFrom_Errors(t *testing.T) {
+	// Reading less than 12 bytes should always result in an error.
+	for _, i := range []int{1, 4, 5, 10, 11} {
+		// --- Given ---
+		src := waveInstChunk(t)
+		test.Skip4B(t, src) // Skip chunk ID.
+
+		// --- When ---
+		_, err := WaveINST().ReadFrom(io.LimitReader(src, int64(i)))
+
+		// --- Then ---
+		assert.Error(t, err)
+	}
+}
+
+func Test_ChunkWaveINST_ReadFrom_SizeMismatch(t *testing.T) {
+	// --- Given ---
+	src := &bytes.Buffer{}
+	test.WriteUint32LE(t, src, 8)
+
+	// --- When ---
+	_, err := WaveINST().ReadFrom(src)
+
+	// --- Then ---
+	assert.ErrorIs(t, ErrChunkSizeMismatch, err)
+}
+
+func Test_ChunkWaveINST_WriteTo(t *testing.T) {
+	// --- Given ---
+	src := waveInstChunk(t)
+	test.Skip4B(t, src) // Skip chunk ID.
+
+	ch := WaveINST()
+	_, err := ch.ReadFrom(src)
+	assert.NoError(t, err)
+
+	// --- When ---
+	dst := &bytes.Buffer{}
+	n, err := ch.WriteTo(dst)
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, int64(16), n)
+	exp := must.Value(io.ReadAll(waveInstChunk(t)))
+	assert.Equal(t, exp, dst.Bytes())
+}
+
+func Test_ChunkWaveINST_WriteTo_Errors(t *testing.T) {
+	for _, i := range []int{1, 4, 8, 15} {
+		// --- When ---
+		_, err := WaveINST().WriteTo(iokit.ErrWriter(&bytes.Buffer{}, i))
+
+		// --- Then ---
+		assert.Error(t, err)
+	}
+}
+
+func Test_ChunkWaveINST_Reset(t *testing.T) {
+	// --- Given ---
+	src := waveInstChunk(t)
+	test.Skip4B(t, src) // Skip chunk ID.
+	ch := WaveINST()
+	_, err := ch.ReadFrom(src)
+	assert.NoError(t, err)
+
+	// --- When ---
+	ch.Reset()
+
+	// --- Then ---
+	assert.Equal(t, &ChunkWaveINST{}, ch)
+}
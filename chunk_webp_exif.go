@@ -0,0 +1,82 @@
+package riff
+
+import (
+	"fmt"
+	"io"
+)
+
+// IDEXIF represents "EXIF" (Exif metadata) chunk ID of the WebP image.
+const IDEXIF uint32 = 0x45584946
+
+// ChunkWebPEXIF represents "EXIF" chunk of the WebP image holding the Exif
+// metadata in the TIFF format.
+type ChunkWebPEXIF struct {
+	// Chunk size in bytes.
+	// The ID and extra padding byte is not counted in the chunk size.
+	size uint32
+
+	// Exif metadata bytes.
+	data []byte
+}
+
+// WebPEXIFMake is a [Maker] function for creating [ChunkWebPEXIF] instances.
+func WebPEXIFMake() Chunk { return WebPEXIF() }
+
+// WebPEXIF returns a new instance of [ChunkWebPEXIF].
+func WebPEXIF() *ChunkWebPEXIF {
+	return &ChunkWebPEXIF{}
+}
+
+func (ch *ChunkWebPEXIF) ID() uint32     { return IDEXIF }
+func (ch *ChunkWebPEXIF) Size() uint32   { return ch.size }
+func (ch *ChunkWebPEXIF) Type() uint32   { return 0 }
+func (ch *ChunkWebPEXIF) Multi() bool    { return false }
+func (ch *ChunkWebPEXIF) Chunks() Chunks { return nil }
+func (ch *ChunkWebPEXIF) Raw() bool      { return false }
+
+// Data returns the Exif metadata bytes.
+func (ch *ChunkWebPEXIF) Data() []byte { return ch.data }
+
+// SetData sets the Exif metadata bytes.
+func (ch *ChunkWebPEXIF) SetData(data []byte) {
+	ch.data = grow(ch.data, len(data))
+	copy(ch.data, data)
+	ch.size = uint32(len(data))
+}
+
+func (ch *ChunkWebPEXIF) ReadFrom(r io.Reader) (int64, error) {
+	var sum int64
+	var err error
+	if ch.size, err = ReadChunkSize(r); err != nil {
+		return sum, fmt.Errorf(errFmtDecode, Uint32(IDEXIF), err)
+	}
+	sum += 4
+
+	ch.data = grow(ch.data, int(ch.size))
+	in, err := io.ReadFull(r, ch.data)
+	sum += int64(in)
+	if err != nil {
+		return sum, fmt.Errorf(errFmtDecode, Uint32(IDEXIF), err)
+	}
+
+	n, err := ReadPaddingIf(r, ch.size)
+	sum += n
+	if err != nil {
+		return sum, fmt.Errorf(errFmtDecode, Uint32(IDEXIF), err)
+	}
+
+	return sum, nil
+}
+
+func (ch *ChunkWebPEXIF) WriteTo(w io.Writer) (int64, error) {
+	n, err := writeBitstream(w, IDEXIF, ch.data)
+	if err != nil {
+		return n, fmt.Errorf(errFmtEncode, Uint32(IDEXIF), err)
+	}
+	return n, nil
+}
+
+func (ch *ChunkWebPEXIF) Reset() {
+	ch.size = 0
+	ch.data = ch.data[:0]
+}
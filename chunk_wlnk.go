@@ -0,0 +1,132 @@
+package riff
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+)
+
+// IDwlnk represents "wlnk" (wave link) sub-chunk ID of the DLS region.
+const IDwlnk uint32 = 0x776c6e6b
+
+// WLNKChunkSize represents the size of wlnk chunk static part in bytes.
+const WLNKChunkSize uint32 = 12
+
+// WLNKPhaseMaster represents the wave link option set for the master
+// region of the phase locked group.
+const WLNKPhaseMaster uint16 = 0x0001
+
+// wlnkStatic represents chunk static data (always there).
+// This struct is defined separately to allow for binary
+// decoding / encoding in one call to binary.Read / binary.Write.
+type wlnkStatic struct {
+	// Wave link options (e.g. [WLNKPhaseMaster]).
+	Options uint16
+
+	// Group of the phase locked regions. Zero if not grouped.
+	PhaseGroup uint16
+
+	// Channel bitmask (bit 0 is left, bit 1 is right and so on).
+	Channel uint32
+
+	// Index of the wave in the pool table ("ptbl" chunk).
+	TableIndex uint32
+}
+
+// ChunkWLNK represents "wlnk" chunk of the DLS region. It links the
+// region to the wave in the wave pool.
+type ChunkWLNK struct {
+	// Chunk size in bytes.
+	// The ID and extra padding byte is not counted in the chunk size.
+	size uint32
+
+	wlnkStatic
+
+	// Bytes following the static part.
+	extra []byte
+}
+
+// WLNKMake is a [Maker] function for creating [ChunkWLNK] instances.
+func WLNKMake() Chunk { return WLNK() }
+
+// WLNK returns a new instance of [ChunkWLNK].
+func WLNK() *ChunkWLNK {
+	return &ChunkWLNK{size: WLNKChunkSize}
+}
+
+func (ch *ChunkWLNK) ID() uint32     { return IDwlnk }
+func (ch *ChunkWLNK) Size() uint32   { return ch.size }
+func (ch *ChunkWLNK) Type() uint32   { return 0 }
+func (ch *ChunkWLNK) Multi() bool    { return true }
+func (ch *ChunkWLNK) Chunks() Chunks { return nil }
+func (ch *ChunkWLNK) Raw() bool      { return false }
+
+func (ch *ChunkWLNK) ReadFrom(r io.Reader) (int64, error) {
+	bo := ByteOrder(r)
+	var sum int64
+	if err := binary.Read(r, bo, &ch.size); err != nil {
+		return sum, fmt.Errorf(errFmtDecode, Uint32(IDwlnk), err)
+	}
+	sum += 4
+
+	if ch.size < WLNKChunkSize {
+		return sum, fmt.Errorf(errFmtDecode, Uint32(IDwlnk), ErrTooShort)
+	}
+
+	if err := binary.Read(r, bo, &ch.wlnkStatic); err != nil {
+		return sum, fmt.Errorf(errFmtDecode, Uint32(IDwlnk), err)
+	}
+	sum += int64(WLNKChunkSize)
+
+	ch.extra = grow(ch.extra, int(ch.size-WLNKChunkSize))
+	in, err := io.ReadFull(r, ch.extra)
+	sum += int64(in)
+	if err != nil {
+		return sum, fmt.Errorf(errFmtDecode, Uint32(IDwlnk), err)
+	}
+
+	n, err := ReadPaddingIf(r, ch.size)
+	sum += n
+	if err != nil {
+		return sum, fmt.Errorf(errFmtDecode, Uint32(IDwlnk), err)
+	}
+
+	return sum, nil
+}
+
+func (ch *ChunkWLNK) WriteTo(w io.Writer) (int64, error) {
+	var sum int64
+
+	ch.size = WLNKChunkSize + uint32(len(ch.extra))
+
+	n, err := WriteIDAndSize(w, IDwlnk, ch.size)
+	sum += n
+	if err != nil {
+		return sum, fmt.Errorf(errFmtEncode, Uint32(IDwlnk), err)
+	}
+
+	if err = binary.Write(w, ByteOrder(w), ch.wlnkStatic); err != nil {
+		return sum, fmt.Errorf(errFmtEncode, Uint32(IDwlnk), err)
+	}
+	sum += int64(WLNKChunkSize)
+
+	in, err := w.Write(ch.extra)
+	sum += int64(in)
+	if err != nil {
+		return sum, fmt.Errorf(errFmtEncode, Uint32(IDwlnk), err)
+	}
+
+	n, err = WritePaddingIf(w, ch.size)
+	sum += n
+	if err != nil {
+		return sum, fmt.Errorf(errFmtEncode, Uint32(IDwlnk), err)
+	}
+
+	return sum, nil
+}
+
+func (ch *ChunkWLNK) Reset() {
+	ch.size = WLNKChunkSize
+	ch.wlnkStatic = wlnkStatic{}
+	ch.extra = ch.extra[:0]
+}
@@ -0,0 +1,132 @@
+package riff
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/ctx42/testing/pkg/assert"
+	"github.com/ctx42/testing/pkg/kit/iokit"
+	"github.com/ctx42/testing/pkg/must"
+
+	"github.com/rzajac/riff/internal/test"
+)
+
+func wlnkChunk(t *testing.T) io.Reader {
+	src := &bytes.Buffer{}
+	test.ReadFrom(t, src, Uint32(IDwlnk))       // ( 0) 4 - Chunk ID
+	test.WriteUint32LE(t, src, 12)              // ( 4) 4 - Chunk size
+	test.WriteUint16LE(t, src, WLNKPhaseMaster) // ( 8) 2 - Options
+	test.WriteUint16LE(t, src, 1)               // (10) 2 - PhaseGroup
+	test.WriteUint32LE(t, src, 1)               // (12) 4 - Channel
+	test.WriteUint32LE(t, src, 3)               // (16) 4 - TableIndex
+	// Total length: 8+12=20
+	return src
+}
+
+func Test_ChunkWLNK_WLNK(t *testing.T) {
+	// --- When ---
+	ch := WLNK()
+
+	// --- Then ---
+	assert.Equal(t, IDwlnk, ch.ID())
+	assert.Equal(t, WLNKChunkSize, ch.Size())
+	assert.Equal(t, uint32(0), ch.Type())
+	assert.True(t, ch.Multi())
+	assert.Nil(t, ch.Chunks())
+	assert.False(t, ch.Raw())
+}
+
+func Test_ChunkWLNK_ReadFrom(t *testing.T) {
+	// --- Given ---
+	src := wlnkChunk(t)
+	test.Skip4B(t, src) // Skip chunk ID.
+
+	// --- When ---
+	ch := WLNK()
+	n, err := ch.ReadFrom(src)
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, int64(16), n)
+	assert.Equal(t, WLNKChunkSize, ch.Size())
+	assert.Equal(t, WLNKPhaseMaster, ch.Options)
+	assert.Equal(t, uint16(1), ch.PhaseGroup)
+	assert.Equal(t, uint32(1), ch.Channel)
+	assert.Equal(t, uint32(3), ch.TableIndex)
+	assert.True(t, test.IsAllRead(src))
+}
+
+func Test_ChunkWLNK_ReadFrom_Errors(t *testing.T) {
+	// Reading less than 16 bytes should always result in an error.
+	for _, i := range []int{1, 4, 5, 15} {
+		// --- Given ---
+		src := wlnkChunk(t)
+		test.Skip4B(t, src) // Skip chunk ID.
+
+		// --- When ---
+		_, err := WLNK().ReadFrom(io.LimitReader(src, int64(i)))
+
+		// --- Then ---
+		assert.Error(t, err)
+	}
+}
+
+func Test_ChunkWLNK_ReadFrom_TooShortError(t *testing.T) {
+	// --- Given ---
+	src := &bytes.Buffer{}
+	test.WriteUint32LE(t, src, 11)
+
+	// --- When ---
+	_, err := WLNK().ReadFrom(src)
+
+	// --- Then ---
+	assert.ErrorIs(t, ErrTooShort, err)
+}
+
+func Test_ChunkWLNK_WriteTo(t *testing.T) {
+	// --- Given ---
+	src := wlnkChunk(t)
+	test.Skip4B(t, src) // Skip chunk ID.
+
+	ch := WLNK()
+	_, err := ch.ReadFrom(src)
+	assert.NoError(t, err)
+
+	// --- When ---
+	dst := &bytes.Buffer{}
+	n, err := ch.WriteTo(dst)
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, int64(20), n)
+	exp := must.Value(io.ReadAll(wlnkChunk(t)))
+	assert.Equal(t, exp, dst.Bytes())
+}
+
+func Test_ChunkWLNK_WriteTo_Errors(t *testing.T) {
+	for _, i := range []int{1, 4, 8, 19} {
+		// --- When ---
+		_, err := WLNK().WriteTo(iokit.ErrWriter(&bytes.Buffer{}, i))
+
+		// --- Then ---
+		assert.Error(t, err)
+	}
+}
+
+func Test_ChunkWLNK_Reset(t *testing.T) {
+	// --- Given ---
+	src := wlnkChunk(t)
+	test.Skip4B(t, src) // Skip chunk ID.
+	ch := WLNK()
+	_, err := ch.ReadFrom(src)
+	assert.NoError(t, err)
+
+	// --- When ---
+	ch.Reset()
+
+	// --- Then ---
+	assert.Equal(t, WLNKChunkSize, ch.Size())
+	assert.Equal(t, uint16(0), ch.Options)
+	assert.Equal(t, uint32(0), ch.TableIndex)
+}
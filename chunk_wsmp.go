@@ -0,0 +1,209 @@
+package riff
+
+import (
+	"fmt"
+	"io"
+)
+
+// IDwsmp represents "wsmp" (wave sample) sub-chunk ID of the DLS region
+// and wave.
+const IDwsmp uint32 = 0x77736d70
+
+// WSMPChunkSize represents the size of wsmp chunk static part in bytes.
+const WSMPChunkSize uint32 = 20
+
+// WSMPLoopSize represents the size of the wsmp loop in bytes.
+const WSMPLoopSize uint32 = 16
+
+// Wave sample options.
+const (
+	// WSMPNoTruncation disallows truncating the sample.
+	WSMPNoTruncation uint32 = 0x0001
+
+	// WSMPNoCompression disallows lossy compression of the sample.
+	WSMPNoCompression uint32 = 0x0002
+)
+
+// DLS wave sample loop types.
+const (
+	// DLSLoopForward represents the loop played until the note is off.
+	DLSLoopForward uint32 = 0
+
+	// DLSLoopRelease represents the loop played also after the note is
+	// off (DLS level 2).
+	DLSLoopRelease uint32 = 1
+)
+
+// DLSLoop represents the wave sample loop.
+type DLSLoop struct {
+	// Loop type (see DLSLoop* constants).
+	Type uint32
+
+	// Start of the loop in samples.
+	Start uint32
+
+	// Length of the loop in samples.
+	Length uint32
+}
+
+// ChunkWSMP represents "wsmp" chunk of the DLS file. It defines how the
+// wave is played.
+type ChunkWSMP struct {
+	// Chunk size in bytes.
+	// The ID and extra padding byte is not counted in the chunk size.
+	size uint32
+
+	// Size of the static part in bytes. Should be 20.
+	HeaderSize uint32
+
+	// MIDI note at which the wave is played at its original sample rate.
+	UnityNote uint16
+
+	// Tuning offset in 1/65536 of a cent.
+	FineTune int16
+
+	// Attenuation in 1/65536 of a decibel.
+	Attenuation int32
+
+	// Bitwise combination of WSMP* options.
+	Options uint32
+
+	// Number of sample loops.
+	LoopCount uint32
+
+	// Sample loops. Decoded only when HeaderSize is 20.
+	Loops []DLSLoop
+
+	// Bytes following the static part which are not decoded loops.
+	extra []byte
+}
+
+// WSMPMake is a [Maker] function for creating [ChunkWSMP] instances.
+func WSMPMake() Chunk { return WSMP() }
+
+// WSMP returns a new instance of [ChunkWSMP].
+func WSMP() *ChunkWSMP {
+	return &ChunkWSMP{size: WSMPChunkSize, HeaderSize: WSMPChunkSize}
+}
+
+func (ch *ChunkWSMP) ID() uint32 { return IDwsmp }
+func (ch *ChunkWSMP) Size() uint32 {
+	return WSMPChunkSize + uint32(len(ch.Loops))*WSMPLoopSize + uint32(len(ch.extra))
+}
+func (ch *ChunkWSMP) Type() uint32   { return 0 }
+func (ch *ChunkWSMP) Multi() bool    { return true }
+func (ch *ChunkWSMP) Chunks() Chunks { return nil }
+func (ch *ChunkWSMP) Raw() bool      { return false }
+
+func (ch *ChunkWSMP) ReadFrom(r io.Reader) (int64, error) {
+	bo := ByteOrder(r)
+	var sum int64
+	var err error
+	if ch.size, err = ReadChunkSize(r); err != nil {
+		return sum, fmt.Errorf(errFmtDecode, Uint32(IDwsmp), err)
+	}
+	sum += 4
+
+	if ch.size < WSMPChunkSize {
+		return sum, fmt.Errorf(errFmtDecode, Uint32(IDwsmp), ErrTooShort)
+	}
+
+	buf := make([]byte, ch.size)
+	in, err := io.ReadFull(r, buf)
+	sum += int64(in)
+	if err != nil {
+		return sum, fmt.Errorf(errFmtDecode, Uint32(IDwsmp), err)
+	}
+
+	ch.HeaderSize = bo.Uint32(buf)
+	ch.UnityNote = bo.Uint16(buf[4:])
+	ch.FineTune = int16(bo.Uint16(buf[6:]))
+	ch.Attenuation = int32(bo.Uint32(buf[8:]))
+	ch.Options = bo.Uint32(buf[12:])
+	ch.LoopCount = bo.Uint32(buf[16:])
+	buf = buf[WSMPChunkSize:]
+
+	if ch.HeaderSize == WSMPChunkSize {
+		if uint64(ch.LoopCount)*uint64(WSMPLoopSize) > uint64(len(buf)) {
+			return sum, fmt.Errorf(errFmtDecode, Uint32(IDwsmp), ErrChunkSizeMismatch)
+		}
+		for i := 0; i < int(ch.LoopCount); i++ {
+			b := buf[i*int(WSMPLoopSize):]
+			if bo.Uint32(b) != WSMPLoopSize {
+				return sum, fmt.Errorf(errFmtDecode, Uint32(IDwsmp), ErrUnsupportedFormat)
+			}
+			ch.Loops = append(ch.Loops, DLSLoop{
+				Type:   bo.Uint32(b[4:]),
+				Start:  bo.Uint32(b[8:]),
+				Length: bo.Uint32(b[12:]),
+			})
+		}
+		buf = buf[int(ch.LoopCount)*int(WSMPLoopSize):]
+	}
+	ch.extra = append(ch.extra[:0], buf...)
+
+	n, err := ReadPaddingIf(r, ch.size)
+	sum += n
+	if err != nil {
+		return sum, fmt.Errorf(errFmtDecode, Uint32(IDwsmp), err)
+	}
+
+	return sum, nil
+}
+
+func (ch *ChunkWSMP) WriteTo(w io.Writer) (int64, error) {
+	bo := ByteOrder(w)
+	var sum int64
+
+	ch.size = ch.Size()
+	if ch.HeaderSize == WSMPChunkSize {
+		ch.LoopCount = uint32(len(ch.Loops))
+	}
+
+	n, err := WriteIDAndSize(w, IDwsmp, ch.size)
+	sum += n
+	if err != nil {
+		return sum, fmt.Errorf(errFmtEncode, Uint32(IDwsmp), err)
+	}
+
+	buf := make([]byte, 0, ch.size)
+	buf = bo.AppendUint32(buf, ch.HeaderSize)
+	buf = bo.AppendUint16(buf, ch.UnityNote)
+	buf = bo.AppendUint16(buf, uint16(ch.FineTune))
+	buf = bo.AppendUint32(buf, uint32(ch.Attenuation))
+	buf = bo.AppendUint32(buf, ch.Options)
+	buf = bo.AppendUint32(buf, ch.LoopCount)
+	for _, l := range ch.Loops {
+		buf = bo.AppendUint32(buf, WSMPLoopSize)
+		buf = bo.AppendUint32(buf, l.Type)
+		buf = bo.AppendUint32(buf, l.Start)
+		buf = bo.AppendUint32(buf, l.Length)
+	}
+	buf = append(buf, ch.extra...)
+
+	in, err := w.Write(buf)
+	sum += int64(in)
+	if err != nil {
+		return sum, fmt.Errorf(errFmtEncode, Uint32(IDwsmp), err)
+	}
+
+	n, err = WritePaddingIf(w, ch.size)
+	sum += n
+	if err != nil {
+		return sum, fmt.Errorf(errFmtEncode, Uint32(IDwsmp), err)
+	}
+
+	return sum, nil
+}
+
+func (ch *ChunkWSMP) Reset() {
+	ch.size = WSMPChunkSize
+	ch.HeaderSize = WSMPChunkSize
+	ch.UnityNote = 0
+	ch.FineTune = 0
+	ch.Attenuation = 0
+	ch.Options = 0
+	ch.LoopCount = 0
+	ch.Loops = ch.Loops[:0]
+	ch.extra = ch.extra[:0]
+}
@@ -0,0 +1,188 @@
+package riff
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/ctx42/testing/pkg/assert"
+	"github.com/ctx42/testing/pkg/kit/iokit"
+	"github.com/ctx42/testing/pkg/must"
+
+	"github.com/rzajac/riff/internal/test"
+)
+
+func wsmpChunk(t *testing.T) io.Reader {
+	src := &bytes.Buffer{}
+	test.ReadFrom(t, src, Uint32(IDwsmp))         // ( 0) 4 - Chunk ID
+	test.WriteUint32LE(t, src, 36)                // ( 4) 4 - Chunk size
+	test.WriteUint32LE(t, src, 20)                // ( 8) 4 - HeaderSize
+	test.WriteUint16LE(t, src, 60)                // (12) 2 - UnityNote
+	test.WriteUint16LE(t, src, 0xfff6)            // (14) 2 - FineTune (-10)
+	test.WriteUint32LE(t, src, 0xfffffc18)        // (16) 4 - Attenuation (-1000)
+	test.WriteUint32LE(t, src, WSMPNoCompression) // (20) 4 - Options
+	test.WriteUint32LE(t, src, 1)                 // (24) 4 - LoopCount
+	test.WriteUint32LE(t, src, 16)                // (28) 4 - Loop size
+	test.WriteUint32LE(t, src, DLSLoopRelease)    // (32) 4 - Loop type
+	test.WriteUint32LE(t, src, 100)               // (36) 4 - Loop start
+	test.WriteUint32LE(t, src, 200)               // (40) 4 - Loop length
+	// Total length: 8+36=44
+	return src
+}
+
+func Test_ChunkWSMP_WSMP(t *testing.T) {
+	// --- When ---
+	ch := WSMP()
+
+	// --- Then ---
+	assert.Equal(t, IDwsmp, ch.ID())
+	assert.Equal(t, WSMPChunkSize, ch.Size())
+	assert.Equal(t, uint32(0), ch.Type())
+	assert.True(t, ch.Multi())
+	assert.Nil(t, ch.Chunks())
+	assert.False(t, ch.Raw())
+	assert.Equal(t, WSMPChunkSize, ch.HeaderSize)
+}
+
+func Test_ChunkWSMP_ReadFrom(t *testing.T) {
+	// --- Given ---
+	src := wsmpChunk(t)
+	test.Skip4B(t, src) // Skip chunk ID.
+
+	// --- When ---
+	ch := WSMP()
+	n, err := ch.ReadFrom(src)
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, int64(40), n)
+	assert.Equal(t, uint32(36), ch.Size())
+	assert.Equal(t, uint16(60), ch.UnityNote)
+	assert.Equal(t, int16(-10), ch.FineTune)
+	assert.Equal(t, int32(-1000), ch.Attenuation)
+	assert.Equal(t, WSMPNoCompression, ch.Options)
+	assert.Equal(t, uint32(1), ch.LoopCount)
+	exp := []DLSLoop{{Type: DLSLoopRelease, Start: 100, Length: 200}}
+	assert.Equal(t, exp, ch.Loops)
+	assert.True(t, test.IsAllRead(src))
+}
+
+func Test_ChunkWSMP_ReadFrom_UnknownHeader(t *testing.T) {
+	// --- Given ---
+	b := must.Value(io.ReadAll(wsmpChunk(t)))
+	le.PutUint32(b[8:], 24)
+
+	// --- When ---
+	ch := WSMP()
+	_, err := ch.ReadFrom(bytes.NewReader(b[4:]))
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Len(t, 0, ch.Loops)
+	assert.Equal(t, uint32(36), ch.Size())
+
+	dst := &bytes.Buffer{}
+	must.Value(ch.WriteTo(dst))
+	assert.Equal(t, b, dst.Bytes())
+}
+
+func Test_ChunkWSMP_ReadFrom_Errors(t *testing.T) {
+	// Reading less than 40 bytes should always result in an error.
+	for _, i := range []int{1, 4, 5, 39} {
+		// --- Given ---
+		src := wsmpChunk(t)
+		test.Skip4B(t, src) // Skip chunk ID.
+
+		// --- When ---
+		_, err := WSMP().ReadFrom(io.LimitReader(src, int64(i)))
+
+		// --- Then ---
+		assert.Error(t, err)
+	}
+}
+
+func Test_ChunkWSMP_ReadFrom_Invalid(t *testing.T) {
+	t.Run("too short", func(t *testing.T) {
+		// --- Given ---
+		src := &bytes.Buffer{}
+		test.WriteUint32LE(t, src, 19)
+
+		// --- When ---
+		_, err := WSMP().ReadFrom(src)
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrTooShort, err)
+	})
+
+	t.Run("loop count", func(t *testing.T) {
+		// --- Given ---
+		b := must.Value(io.ReadAll(wsmpChunk(t)))
+		le.PutUint32(b[24:], 2)
+
+		// --- When ---
+		_, err := WSMP().ReadFrom(bytes.NewReader(b[4:]))
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrChunkSizeMismatch, err)
+	})
+
+	t.Run("loop size", func(t *testing.T) {
+		// --- Given ---
+		b := must.Value(io.ReadAll(wsmpChunk(t)))
+		le.PutUint32(b[28:], 20)
+
+		// --- When ---
+		_, err := WSMP().ReadFrom(bytes.NewReader(b[4:]))
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrUnsupportedFormat, err)
+	})
+}
+
+func Test_ChunkWSMP_WriteTo(t *testing.T) {
+	// --- Given ---
+	ch := WSMP()
+	ch.UnityNote = 60
+	ch.FineTune = -10
+	ch.Attenuation = -1000
+	ch.Options = WSMPNoCompression
+	ch.Loops = []DLSLoop{{Type: DLSLoopRelease, Start: 100, Length: 200}}
+
+	// --- When ---
+	dst := &bytes.Buffer{}
+	n, err := ch.WriteTo(dst)
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, int64(44), n)
+	exp := must.Value(io.ReadAll(wsmpChunk(t)))
+	assert.Equal(t, exp, dst.Bytes())
+}
+
+func Test_ChunkWSMP_WriteTo_Errors(t *testing.T) {
+	for _, i := range []int{1, 4, 8, 27} {
+		// --- When ---
+		_, err := WSMP().WriteTo(iokit.ErrWriter(&bytes.Buffer{}, i))
+
+		// --- Then ---
+		assert.Error(t, err)
+	}
+}
+
+func Test_ChunkWSMP_Reset(t *testing.T) {
+	// --- Given ---
+	src := wsmpChunk(t)
+	test.Skip4B(t, src) // Skip chunk ID.
+	ch := WSMP()
+	_, err := ch.ReadFrom(src)
+	assert.NoError(t, err)
+
+	// --- When ---
+	ch.Reset()
+
+	// --- Then ---
+	assert.Equal(t, WSMPChunkSize, ch.Size())
+	assert.Equal(t, WSMPChunkSize, ch.HeaderSize)
+	assert.Equal(t, uint16(0), ch.UnityNote)
+	assert.Len(t, 0, ch.Loops)
+}
@@ -0,0 +1,136 @@
+package riff
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+)
+
+// IDXMP represents "_PMX" chunk ID (XMP metadata).
+const IDXMP uint32 = 0x5f504d58
+
+// IDWebPXMP represents "XMP " chunk ID (XMP metadata of the WebP image).
+const IDWebPXMP uint32 = 0x584d5020
+
+// ChunkXMP represents "_PMX" chunk holding the XMP packet written by Adobe
+// applications or "XMP " chunk of the WebP image. Edited packets are
+// re-serialized keeping the properties not accessed with the helpers.
+type ChunkXMP struct {
+	// Chunk ID.
+	id uint32
+
+	// Chunk size in bytes.
+	// The ID and extra padding byte is not counted in the chunk size.
+	size uint32
+
+	// Chunk body as read from the file.
+	raw []byte
+
+	// Packet serialization right after decoding. Used to detect edits.
+	enc []byte
+
+	// Decoded XMP packet. It's nil when the chunk data is not a valid XMP
+	// packet, the data is then written back as read.
+	Packet *XMPPacket
+}
+
+// XMPMake is a [Maker] function for creating [ChunkXMP] instances.
+func XMPMake() Chunk { return XMP() }
+
+// XMP returns a new instance of [ChunkXMP] with an empty XMP packet.
+func XMP() *ChunkXMP {
+	return &ChunkXMP{id: IDXMP, Packet: NewXMP()}
+}
+
+// WebPXMPMake is a [Maker] function for creating [ChunkXMP] instances for
+// the "XMP " chunk of the WebP image.
+func WebPXMPMake() Chunk { return WebPXMP() }
+
+// WebPXMP returns a new instance of [ChunkXMP] for the "XMP " chunk of the
+// WebP image with an empty XMP packet.
+func WebPXMP() *ChunkXMP {
+	return &ChunkXMP{id: IDWebPXMP, Packet: NewXMP()}
+}
+
+func (ch *ChunkXMP) ID() uint32     { return ch.id }
+func (ch *ChunkXMP) Size() uint32   { return uint32(len(ch.XML())) }
+func (ch *ChunkXMP) Type() uint32   { return 0 }
+func (ch *ChunkXMP) Multi() bool    { return false }
+func (ch *ChunkXMP) Chunks() Chunks { return nil }
+func (ch *ChunkXMP) Raw() bool      { return false }
+
+// XML returns the XMP packet as XML document.
+func (ch *ChunkXMP) XML() []byte {
+	if ch.Packet == nil {
+		return ch.raw
+	}
+	enc := ch.Packet.Bytes()
+	if ch.enc != nil && bytes.Equal(enc, ch.enc) {
+		return ch.raw
+	}
+	return enc
+}
+
+func (ch *ChunkXMP) ReadFrom(r io.Reader) (int64, error) {
+	var sum int64
+	if err := binary.Read(r, ByteOrder(r), &ch.size); err != nil {
+		return sum, fmt.Errorf(errFmtDecode, Uint32(ch.id), err)
+	}
+	sum += 4
+
+	ch.raw = grow(ch.raw, int(ch.size))
+	in, err := io.ReadFull(r, ch.raw)
+	sum += int64(in)
+	if err != nil {
+		return sum, fmt.Errorf(errFmtDecode, Uint32(ch.id), err)
+	}
+
+	// Some writers terminate the packet with zero byte.
+	ch.Packet, ch.enc = nil, nil
+	if pkt, err := ParseXMP(bytes.TrimRight(ch.raw, "\x00")); err == nil {
+		ch.Packet, ch.enc = pkt, pkt.Bytes()
+	}
+
+	n, err := ReadPaddingIf(r, ch.size)
+	sum += n
+	if err != nil {
+		return sum, fmt.Errorf(errFmtDecode, Uint32(ch.id), err)
+	}
+
+	return sum, nil
+}
+
+func (ch *ChunkXMP) WriteTo(w io.Writer) (int64, error) {
+	var sum int64
+
+	body := ch.XML()
+	ch.size = uint32(len(body))
+
+	n, err := WriteIDAndSize(w, ch.id, ch.size)
+	sum += n
+	if err != nil {
+		return sum, fmt.Errorf(errFmtEncode, Uint32(ch.id), err)
+	}
+
+	in, err := w.Write(body)
+	sum += int64(in)
+	if err != nil {
+		return sum, fmt.Errorf(errFmtEncode, Uint32(ch.id), err)
+	}
+
+	n, err = WritePaddingIf(w, ch.size)
+	sum += n
+	if err != nil {
+		return sum, fmt.Errorf(errFmtEncode, Uint32(ch.id), err)
+	}
+
+	return sum, nil
+}
+
+func (ch *ChunkXMP) Reset() {
+	ch.size = 0
+	ch.raw = ch.raw[:0]
+	ch.enc = nil
+	ch.Packet = NewXMP()
+}
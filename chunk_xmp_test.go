@@ -0,0 +1,197 @@
+package riff
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/ctx42/testing/pkg/assert"
+	"github.com/ctx42/testing/pkg/kit/iokit"
+	"github.com/ctx42/testing/pkg/must"
+
+	"github.com/rzajac/riff/internal/test"
+)
+
+func xmpChunk(t *testing.T) io.Reader {
+	src := &bytes.Buffer{}
+	test.ReadFrom(t, src, Uint32(IDXMP))       // (   0)    4 - Chunk ID
+	test.WriteUint32LE(t, src, 1349)           // (   4)    4 - Chunk size
+	test.WriteBytes(t, src, []byte(xmpPacket)) // (   8) 1348 - XMP packet
+	test.WriteByte(t, src, 0)                  // (1356)    1 - Terminating zero
+	test.WriteByte(t, src, 0)                  // (1357)    1 - Padding byte
+	// Total length: 8+1349+1=1358
+	return src
+}
+
+func Test_ChunkXMP_XMP(t *testing.T) {
+	// --- When ---
+	ch := XMP()
+
+	// --- Then ---
+	assert.Equal(t, IDXMP, ch.ID())
+	assert.Equal(t, uint32(len(xmpSkeleton)), ch.Size())
+	assert.Equal(t, uint32(0), ch.Type())
+	assert.False(t, ch.Multi())
+	assert.Nil(t, ch.Chunks())
+	assert.False(t, ch.Raw())
+	assert.NotNil(t, ch.Packet)
+}
+
+func Test_ChunkXMP_WebPXMP(t *testing.T) {
+	// --- Given ---
+	src := xmpChunk(t)
+	test.Skip4B(t, src) // Skip chunk ID.
+
+	// --- When ---
+	ch := WebPXMP()
+	_, err := ch.ReadFrom(src)
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, IDWebPXMP, ch.ID())
+	assert.Equal(t, "Title", ch.Packet.Title())
+
+	ch.Reset()
+	assert.Equal(t, IDWebPXMP, ch.ID())
+
+	dst := &bytes.Buffer{}
+	_, err = ch.WriteTo(dst)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("XMP "), dst.Bytes()[:4])
+}
+
+func Test_ChunkXMP_ReadFrom(t *testing.T) {
+	// --- Given ---
+	src := xmpChunk(t)
+	test.Skip4B(t, src) // Skip chunk ID.
+
+	// --- When ---
+	ch := XMP()
+	n, err := ch.ReadFrom(src)
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1354), n)
+	assert.Equal(t, uint32(1349), ch.Size())
+	assert.Equal(t, "Title", ch.Packet.Title())
+	assert.Equal(t, append([]byte(xmpPacket), 0), ch.XML())
+	assert.True(t, test.IsAllRead(src))
+}
+
+func Test_ChunkXMP_ReadFrom_Errors(t *testing.T) {
+	// Reading less than 1354 bytes should always result in an error.
+	for _, i := range []int{1, 4, 5, 100, 1352, 1353} {
+		// --- Given ---
+		src := xmpChunk(t)
+		test.Skip4B(t, src) // Skip chunk ID.
+
+		// --- When ---
+		_, err := XMP().ReadFrom(io.LimitReader(src, int64(i)))
+
+		// --- Then ---
+		assert.Error(t, err)
+	}
+}
+
+func Test_ChunkXMP_ReadFrom_InvalidPacket(t *testing.T) {
+	// --- Given ---
+	src := &bytes.Buffer{}
+	test.WriteUint32LE(t, src, 4)
+	test.WriteBytes(t, src, []byte("<a/>"))
+
+	// --- When ---
+	ch := XMP()
+	n, err := ch.ReadFrom(src)
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, int64(8), n)
+	assert.Nil(t, ch.Packet)
+	assert.Equal(t, uint32(4), ch.Size())
+
+	dst := &bytes.Buffer{}
+	must.Value(ch.WriteTo(dst))
+	assert.Equal(t, []byte("_PMX\x04\x00\x00\x00<a/>"), dst.Bytes())
+}
+
+func Test_ChunkXMP_WriteTo(t *testing.T) {
+	// --- Given ---
+	src := xmpChunk(t)
+	test.Skip4B(t, src) // Skip chunk ID.
+
+	ch := XMP()
+	_, err := ch.ReadFrom(src)
+	assert.NoError(t, err)
+
+	// --- When ---
+	dst := &bytes.Buffer{}
+	n, err := ch.WriteTo(dst)
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1358), n)
+	exp := must.Value(io.ReadAll(xmpChunk(t)))
+	assert.Equal(t, exp, dst.Bytes())
+}
+
+func Test_ChunkXMP_WriteTo_Edited(t *testing.T) {
+	// --- Given ---
+	src := xmpChunk(t)
+	test.Skip4B(t, src) // Skip chunk ID.
+
+	ch := XMP()
+	_, err := ch.ReadFrom(src)
+	assert.NoError(t, err)
+
+	ch.Packet.SetTitle("New")
+
+	// --- When ---
+	dst := &bytes.Buffer{}
+	n, err := ch.WriteTo(dst)
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, int64(dst.Len()), n)
+	assert.Equal(t, ch.Size(), le.Uint32(dst.Bytes()[4:]))
+
+	got := XMP()
+	_, err = got.ReadFrom(bytes.NewReader(dst.Bytes()[4:]))
+	assert.NoError(t, err)
+	assert.Equal(t, "New", got.Packet.Title())
+	assert.Equal(t, []string{"Artist 1", "Artist 2"}, got.Packet.Creators())
+	assert.Len(t, 2, got.Packet.Markers())
+}
+
+func Test_ChunkXMP_WriteTo_Errors(t *testing.T) {
+	for _, i := range []int{1, 4, 8, 1356, 1357} {
+		// --- Given ---
+		src := xmpChunk(t)
+		test.Skip4B(t, src) // Skip chunk ID.
+
+		ch := XMP()
+		_, err := ch.ReadFrom(src)
+		assert.NoError(t, err)
+
+		// --- When ---
+		_, err = ch.WriteTo(iokit.ErrWriter(&bytes.Buffer{}, i))
+
+		// --- Then ---
+		assert.Error(t, err)
+	}
+}
+
+func Test_ChunkXMP_Reset(t *testing.T) {
+	// --- Given ---
+	src := xmpChunk(t)
+	test.Skip4B(t, src) // Skip chunk ID.
+	ch := XMP()
+	_, err := ch.ReadFrom(src)
+	assert.NoError(t, err)
+
+	// --- When ---
+	ch.Reset()
+
+	// --- Then ---
+	assert.Equal(t, uint32(len(xmpSkeleton)), ch.Size())
+	assert.Equal(t, "", ch.Packet.Title())
+}
@@ -0,0 +1,245 @@
+package riff
+
+import (
+	"encoding/binary"
+	"errors"
+	"io"
+	"math"
+)
+
+// Container IDs reported by [Detect] next to [IDRIFF], [IDRIFX] and
+// [IDFORM].
+const (
+	// IDRF64 represents "RF64" (EBU Tech 3306 64-bit RIFF) chunk ID.
+	IDRF64 uint32 = 0x52463634
+
+	// IDBW64 represents "BW64" (ITU-R BS.2088 Broadcast Wave 64) chunk ID.
+	IDBW64 uint32 = 0x42573634
+
+	// IDW64 represents the Sony Wave64 container. It's the first four
+	// bytes of the [W64GUIDRIFF] GUID ("riff").
+	IDW64 uint32 = 0x72696666
+)
+
+// MIMEUnknown represents the MIME type reported for the form types not
+// registered with [Detector.Register].
+const MIMEUnknown = "application/octet-stream"
+
+// Detection represents the result of the content sniffing.
+type Detection struct {
+	// Container ID: [IDRIFF], [IDRIFX], [IDRF64], [IDBW64], [IDW64] or
+	// [IDFORM].
+	Container uint32
+
+	// Form type (e.g. [TypeWAVE]).
+	FormType uint32
+
+	// MIME type registered for the form type or [MIMEUnknown].
+	MIME string
+
+	// The "fmt " chunk of the WAVE file, its CompCode field identifies the
+	// codec. It's nil for other form types or when the "fmt " chunk
+	// doesn't precede the "data" chunk.
+	FMT *ChunkFMT
+}
+
+// Detector detects the container, form type and MIME type of the files
+// by looking only at the file header and the chunk headers preceding the
+// "fmt " chunk of the WAVE files.
+type Detector struct {
+	// Map of form types and MIME types.
+	mime map[uint32]string
+}
+
+// NewDetector returns a new instance of [Detector] with MIME types
+// registered for the form types supported by the package.
+func NewDetector() *Detector {
+	return &Detector{
+		mime: map[uint32]string{
+			TypeWAVE: "audio/wav",
+			TypeAVI:  "video/x-msvideo",
+			TypeRMID: "audio/mid",
+			TypeWEBP: "image/webp",
+			TypeACON: "application/x-navi-animation",
+			TypeDLS:  "audio/dls",
+			TypeSFBK: "audio/x-soundfont",
+			TypePAL:  "application/x-riff-palette",
+			TypeCDDA: "application/x-cdf",
+			TypeAIFF: "audio/aiff",
+			TypeAIFC: "audio/aiff",
+			Type8SVX: "audio/x-8svx",
+		},
+	}
+}
+
+// Register registers MIME type for the form type. It replaces the MIME
+// type already registered for the form type.
+func (d *Detector) Register(formType uint32, mime string) {
+	d.mime[formType] = mime
+}
+
+// Detect detects the container, form type and MIME type of the file in r.
+// For the WAVE files, the chunks preceding the "fmt " chunk are skipped
+// (without reading their data when r implements [io.Seeker]) and the
+// "fmt " chunk is decoded. It returns [ErrUnknownFormat] if r doesn't
+// start with one of the supported containers.
+func (d *Detector) Detect(r io.Reader) (Detection, error) {
+	var det Detection
+	if err := ReadChunkID(r, &det.Container); err != nil {
+		return det, err
+	}
+
+	var order Order = le
+	switch det.Container {
+	case IDRIFF, IDRF64, IDBW64:
+	case IDRIFX, IDFORM:
+		order = be
+	case IDW64:
+		return d.detectW64(r, det)
+	default:
+		return Detection{}, ErrUnknownFormat
+	}
+
+	// Skip size, it's 0xFFFFFFFF for RF64 and BW64 and not needed anyway.
+	var head [8]byte
+	if _, err := io.ReadFull(r, head[:]); err != nil {
+		return Detection{}, err
+	}
+	det.FormType = be.Uint32(head[4:])
+	det.MIME = d.lookup(det.FormType)
+
+	if det.FormType != TypeWAVE || det.Container == IDFORM {
+		return det, nil
+	}
+
+	r = OrderReader(r, order)
+	var id uint32
+	for {
+		if err := ReadChunkID(r, &id); err != nil {
+			if errors.Is(err, io.EOF) {
+				return det, nil
+			}
+			return Detection{}, err
+		}
+		switch id {
+		case IDfmt:
+			ch := FMT()
+			if _, err := ch.ReadFrom(r); err != nil {
+				return Detection{}, err
+			}
+			det.FMT = ch
+			return det, nil
+
+		case IDdata:
+			return det, nil
+		}
+
+		size, err := ReadChunkSize(r)
+		if err != nil {
+			return Detection{}, err
+		}
+		if err = SkipN(r, RealSize(size)); err != nil {
+			return Detection{}, err
+		}
+	}
+}
+
+// detectW64 detects the form type of the Sony Wave64 file. It expects r
+// to be in a position right after the first four bytes of the file.
+func (d *Detector) detectW64(r io.Reader, det Detection) (Detection, error) {
+	var g GUID
+	be.PutUint32(g[:], IDW64)
+	if _, err := io.ReadFull(r, g[4:]); err != nil {
+		return Detection{}, err
+	}
+	if g != W64GUIDRIFF {
+		return Detection{}, ErrUnknownFormat
+	}
+
+	var size uint64
+	if err := binary.Read(r, le, &size); err != nil {
+		return Detection{}, err
+	}
+	if _, err := io.ReadFull(r, g[:]); err != nil {
+		return Detection{}, err
+	}
+	typ, ok := W64ID(g)
+	if !ok {
+		return Detection{}, ErrUnknownFormat
+	}
+	det.FormType = typ
+	det.MIME = d.lookup(typ)
+
+	if typ != TypeWAVE {
+		return det, nil
+	}
+
+	for {
+		if _, err := io.ReadFull(r, g[:]); err != nil {
+			if errors.Is(err, io.EOF) {
+				return det, nil
+			}
+			return Detection{}, err
+		}
+		if err := binary.Read(r, le, &size); err != nil {
+			return Detection{}, err
+		}
+		if size < W64HeaderSize {
+			return Detection{}, ErrTooShort
+		}
+		n := size - W64HeaderSize
+
+		id, _ := W64ID(g)
+		switch {
+		case id == IDdata:
+			return det, nil
+
+		case n+W64Align > math.MaxUint32:
+			// Chunks preceding the "fmt " chunk are small, give up.
+			return det, nil
+
+		case id == IDfmt:
+			ch := FMT()
+			if _, err := ch.ReadFrom(newW64Body(r, uint32(n))); err != nil {
+				return Detection{}, err
+			}
+			det.FMT = ch
+			return det, nil
+		}
+
+		if err := SkipN(r, uint32(n)+w64Pad(n)); err != nil {
+			return Detection{}, err
+		}
+	}
+}
+
+// DetectAt detects the container, form type and MIME type of the file in
+// r. See [Detector.Detect] for details.
+func (d *Detector) DetectAt(r io.ReaderAt) (Detection, error) {
+	return d.Detect(io.NewSectionReader(r, 0, math.MaxInt64))
+}
+
+// lookup returns MIME type registered for the form type or [MIMEUnknown].
+func (d *Detector) lookup(formType uint32) string {
+	if mime, ok := d.mime[formType]; ok {
+		return mime
+	}
+	return MIMEUnknown
+}
+
+// detector is the [Detector] used by [Detect], [DetectAt] and
+// [RegisterMIME] functions.
+var detector = NewDetector()
+
+// Detect detects the container, form type and MIME type of the file in r
+// using the default [Detector].
+func Detect(r io.Reader) (Detection, error) { return detector.Detect(r) }
+
+// DetectAt detects the container, form type and MIME type of the file in
+// r using the default [Detector].
+func DetectAt(r io.ReaderAt) (Detection, error) { return detector.DetectAt(r) }
+
+// RegisterMIME registers MIME type for the form type with the default
+// [Detector]. It's not safe to call it concurrently with [Detect] or
+// [DetectAt].
+func RegisterMIME(formType uint32, mime string) { detector.Register(formType, mime) }
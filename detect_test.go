@@ -0,0 +1,251 @@
+package riff
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"testing"
+
+	"github.com/ctx42/testing/pkg/assert"
+	"github.com/ctx42/testing/pkg/must"
+
+	"github.com/rzajac/riff/internal/test"
+)
+
+// waveHead returns the WAVE file with given container ID in the byte
+// order with the "ds64" like chunk preceding the 8-bit mono "fmt " chunk
+// and the empty "data" chunk. The file size is 0xFFFFFFFF as in RF64.
+func waveHead(t *testing.T, id uint32, order Order) []byte {
+	src := &bytes.Buffer{}
+	test.ReadFrom(t, src, Uint32(id))                         // ( 0)  4 - Container ID
+	test.WriteBytes(t, src, order.AppendUint32(nil, 1<<32-1)) // ( 4)  4 - Size
+	test.ReadFrom(t, src, Uint32(TypeWAVE))                   // ( 8)  4 - Form type
+	test.WriteBytes(t, src, []byte("ds64"))                   // (12)  4 - Chunk ID
+	test.WriteBytes(t, src, order.AppendUint32(nil, 3))       // (16)  4 - Chunk size
+	test.WriteBytes(t, src, []byte{1, 2, 3, 0})               // (20)  4 - Data and padding
+	test.WriteTo(t, OrderWriter(src, order), fmt8bitMono())   // (24) 24 - Format
+	test.ReadFrom(t, src, Uint32(IDdata))                     // (48)  4 - Chunk ID
+	test.WriteBytes(t, src, order.AppendUint32(nil, 1<<32-1)) // (52)  4 - Chunk size
+	// Total length: 56
+	return src.Bytes()
+}
+
+// nonSeeker hides the [io.Seeker] implementation of the reader.
+type nonSeeker struct{ io.Reader }
+
+func Test_Detect(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		path     string
+		expCont  uint32
+		expType  uint32
+		expMIME  string
+		expCodec uint16
+	}{
+		{"wav", "testdata/sample.wav", IDRIFF, TypeWAVE, "audio/wav", CompPCM},
+		{"wav mu-law", "testdata/8kulaw.wav", IDRIFF, TypeWAVE, "audio/wav", CompMULaw},
+		{"wav junk", "testdata/junkKick.wav", IDRIFF, TypeWAVE, "audio/wav", CompPCM},
+		{"avi", "testdata/sample.avi", IDRIFF, TypeAVI, "video/x-msvideo", 0},
+		{"rmi", "testdata/sample.rmi", IDRIFF, TypeRMID, "audio/mid", 0},
+		{"aiff", "testdata/bloop.aif", IDFORM, TypeAIFF, "audio/aiff", 0},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- Given ---
+			fil := must.Value(os.Open(tc.path))
+			defer func() { _ = fil.Close() }()
+
+			// --- When ---
+			have, err := Detect(fil)
+
+			// --- Then ---
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expCont, have.Container)
+			assert.Equal(t, tc.expType, have.FormType)
+			assert.Equal(t, tc.expMIME, have.MIME)
+			if tc.expType == TypeWAVE {
+				assert.NotNil(t, have.FMT)
+				assert.Equal(t, tc.expCodec, have.FMT.CompCode)
+			} else {
+				assert.Nil(t, have.FMT)
+			}
+		})
+	}
+}
+
+func Test_Detect_WAVEContainers(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		id    uint32
+		order Order
+	}{
+		{"RIFX", IDRIFX, be},
+		{"RF64", IDRF64, le},
+		{"BW64", IDBW64, le},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- Given ---
+			src := nonSeeker{bytes.NewReader(waveHead(t, tc.id, tc.order))}
+
+			// --- When ---
+			have, err := Detect(src)
+
+			// --- Then ---
+			assert.NoError(t, err)
+			assert.Equal(t, tc.id, have.Container)
+			assert.Equal(t, TypeWAVE, have.FormType)
+			assert.Equal(t, "audio/wav", have.MIME)
+			assert.Equal(t, fmt8bitMono(), have.FMT)
+		})
+	}
+}
+
+func Test_Detect_W64(t *testing.T) {
+	// --- Given ---
+	src := bytes.NewReader(w64File(t))
+
+	// --- When ---
+	have, err := Detect(src)
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, IDW64, have.Container)
+	assert.Equal(t, TypeWAVE, have.FormType)
+	assert.Equal(t, "audio/wav", have.MIME)
+	assert.Equal(t, fmt8bitMono(), have.FMT)
+}
+
+func Test_Detect_W64_SkipChunks(t *testing.T) {
+	// --- Given ---
+	junk := RAWC(IDJUNK, LoadData)
+	junk.size, junk.data = 3, []byte{1, 2, 3}
+
+	rif := New(LoadData)
+	rif.riffType = TypeWAVE
+	rif.chunks = Chunks{junk, fmt8bitMono()}
+	dst := &bytes.Buffer{}
+	must.Value(WriteW64(dst, rif))
+
+	// --- When ---
+	have, err := Detect(nonSeeker{dst})
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, fmt8bitMono(), have.FMT)
+}
+
+func Test_Detect_NoFMT(t *testing.T) {
+	// --- Given ---
+	src := waveHead(t, IDRIFF, le)[:24]
+
+	// --- When ---
+	have, err := Detect(bytes.NewReader(src))
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, TypeWAVE, have.FormType)
+	assert.Nil(t, have.FMT)
+}
+
+func Test_Detect_DataBeforeFMT(t *testing.T) {
+	// --- Given ---
+	src := &bytes.Buffer{}
+	test.WriteBytes(t, src, []byte("RIFF\x0c\x00\x00\x00WAVEdata\x00\x00\x00\x00"))
+
+	// --- When ---
+	have, err := Detect(src)
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Nil(t, have.FMT)
+}
+
+func Test_Detect_UnknownFormType(t *testing.T) {
+	// --- Given ---
+	src := bytes.NewReader([]byte("RIFF\x04\x00\x00\x00ABCD"))
+
+	// --- When ---
+	have, err := Detect(src)
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, StrToID("ABCD"), have.FormType)
+	assert.Equal(t, MIMEUnknown, have.MIME)
+}
+
+func Test_Detect_Errors(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		src []byte
+		exp error
+	}{
+		{"empty", nil, io.EOF},
+		{"not RIFF", []byte("OggS\x00\x02\x00\x00\x00\x00\x00\x00"), ErrUnknownFormat},
+		{"short header", []byte("RIFF\x04\x00\x00"), io.ErrUnexpectedEOF},
+		{"short chunk", waveHead(t, IDRIFF, le)[:14], io.ErrUnexpectedEOF},
+		{"short fmt", waveHead(t, IDRIFF, le)[:40], io.ErrUnexpectedEOF},
+		{"not W64", []byte("riff\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00"), ErrUnknownFormat},
+		{"short W64", W64GUIDRIFF[:10], io.ErrUnexpectedEOF},
+		{"W64 unknown type", append(append(W64GUIDRIFF[:], make([]byte, 8)...), w64Marker[:]...), ErrUnknownFormat},
+		{"W64 short chunk", w64File(t)[:50], io.ErrUnexpectedEOF},
+		{"W64 short fmt", w64File(t)[:70], io.ErrUnexpectedEOF},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			_, err := Detect(bytes.NewReader(tc.src))
+
+			// --- Then ---
+			assert.ErrorIs(t, tc.exp, err)
+		})
+	}
+}
+
+func Test_Detector_Register(t *testing.T) {
+	// --- Given ---
+	det := NewDetector()
+	src := []byte("RIFF\x04\x00\x00\x00ABCD")
+
+	// --- When ---
+	det.Register(StrToID("ABCD"), "application/x-abcd")
+
+	// --- Then ---
+	have := must.Value(det.Detect(bytes.NewReader(src)))
+	assert.Equal(t, "application/x-abcd", have.MIME)
+	have = must.Value(Detect(bytes.NewReader(src)))
+	assert.Equal(t, MIMEUnknown, have.MIME)
+}
+
+func Test_RegisterMIME(t *testing.T) {
+	// --- Given ---
+	src := []byte("FORMX\x00\x00\x00ILBM")
+	t.Cleanup(func() { delete(detector.mime, StrToID("ILBM")) })
+
+	// --- When ---
+	RegisterMIME(StrToID("ILBM"), "image/x-ilbm")
+
+	// --- Then ---
+	have := must.Value(Detect(bytes.NewReader(src)))
+	assert.Equal(t, IDFORM, have.Container)
+	assert.Equal(t, "image/x-ilbm", have.MIME)
+}
+
+func Test_DetectAt(t *testing.T) {
+	// --- Given ---
+	src := bytes.NewReader(waveHead(t, IDRF64, le))
+
+	// --- When ---
+	have, err := DetectAt(src)
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, IDRF64, have.Container)
+	assert.Equal(t, fmt8bitMono(), have.FMT)
+}
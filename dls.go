@@ -0,0 +1,275 @@
+package riff
+
+import (
+	"fmt"
+)
+
+// Types of the DLS file LIST chunks.
+const (
+	// IDlins represents "lins" (list of instruments) type of the LIST chunk.
+	IDlins uint32 = 0x6c696e73
+
+	// IDins represents "ins " (instrument) type of the LIST chunk.
+	IDins uint32 = 0x696e7320
+
+	// IDlrgn represents "lrgn" (list of regions) type of the LIST chunk.
+	IDlrgn uint32 = 0x6c72676e
+
+	// IDrgn represents "rgn " (DLS level 1 region) type of the LIST chunk.
+	IDrgn uint32 = 0x72676e20
+
+	// IDrgn2 represents "rgn2" (DLS level 2 region) type of the LIST chunk.
+	IDrgn2 uint32 = 0x72676e32
+
+	// IDlart represents "lart" (DLS level 1 articulators) type of the LIST
+	// chunk.
+	IDlart uint32 = 0x6c617274
+
+	// IDlar2 represents "lar2" (DLS level 2 articulators) type of the LIST
+	// chunk.
+	IDlar2 uint32 = 0x6c617232
+
+	// IDwvpl represents "wvpl" (wave pool) type of the LIST chunk.
+	IDwvpl uint32 = 0x7776706c
+
+	// IDwave represents "wave" (embedded wave file) type of the LIST chunk.
+	IDwave uint32 = 0x77617665
+)
+
+// DLSRegion represents the region of the DLS instrument. It maps the
+// range of keys and velocities to the wave.
+type DLSRegion struct {
+	// Region header.
+	Header *ChunkRGNH
+
+	// Wave sample parameters overriding the ones of the wave. Nil if
+	// the region doesn't have them.
+	Sample *ChunkWSMP
+
+	// Link to the wave in the wave pool.
+	Link *ChunkWLNK
+
+	// Articulators of the region.
+	Articulators []*ChunkART
+}
+
+// DLSInstrument represents the DLS instrument.
+type DLSInstrument struct {
+	// Instrument header.
+	Header *ChunkINSH
+
+	// Regions of the instrument.
+	Regions []*DLSRegion
+
+	// Articulators of the instrument.
+	Articulators []*ChunkART
+
+	// The "LIST INFO" chunk of the instrument. Nil if it doesn't have one.
+	Info *ChunkLIST
+}
+
+// DLSWave represents the wave from the DLS wave pool.
+type DLSWave struct {
+	// Offset of the "LIST wave" chunk relative to the beginning of the
+	// "LIST wvpl" chunk data as used by the pool table.
+	Offset uint32
+
+	// Format of the wave.
+	Format *ChunkFMT
+
+	// The wave fact chunk. Nil if the wave doesn't have one.
+	Fact *ChunkFACT
+
+	// The wave data.
+	Data *ChunkDATA
+
+	// Default wave sample parameters. Nil if the wave doesn't have them.
+	Sample *ChunkWSMP
+
+	// The "LIST INFO" chunk of the wave. Nil if it doesn't have one.
+	Info *ChunkLIST
+}
+
+// DLS represents decoded chunks of the "RIFF DLS " (Downloadable Sounds)
+// file.
+type DLS struct {
+	// Collection header.
+	Header *ChunkCOLH
+
+	// Pool table. Nil if the "ptbl" chunk is missing.
+	PoolTable *ChunkPTBL
+
+	// Instruments from the "LIST lins" chunk.
+	Instruments []*DLSInstrument
+
+	// Waves from the "LIST wvpl" chunk.
+	Waves []*DLSWave
+
+	// The "LIST INFO" chunk of the file. Nil if the file doesn't have one.
+	Info *ChunkLIST
+}
+
+// NewDLS returns chunks decoded from the DLS file.
+func NewDLS(rif *RIFF) (*DLS, error) {
+	if rif.Type() != TypeDLS {
+		return nil, fmt.Errorf("expected %s form got %s", Uint32(TypeDLS), Uint32(rif.Type()))
+	}
+
+	colh, ok := rif.Chunks().First(IDcolh).(*ChunkCOLH)
+	if !ok {
+		return nil, fmt.Errorf("missing %s chunk: %w", Uint32(IDcolh), ErrDLSInvalid)
+	}
+
+	dls := &DLS{Header: colh}
+	dls.PoolTable, _ = rif.Chunks().First(IDptbl).(*ChunkPTBL)
+	for _, lst := range lists(rif.Chunks()) {
+		switch lst.Type() {
+		case IDlins:
+			for _, ins := range lists(lst.Chunks()) {
+				if ins.Type() != IDins {
+					continue
+				}
+				dls.Instruments = append(dls.Instruments, newDLSInstrument(ins))
+			}
+		case IDwvpl:
+			var off uint32
+			for _, ch := range lst.Chunks() {
+				if wave, ok := ch.(*ChunkLIST); ok && wave.Type() == IDwave {
+					dls.Waves = append(dls.Waves, newDLSWave(wave, off))
+				}
+				off += Chunks{ch}.Size()
+			}
+		case IDINFO:
+			if dls.Info == nil {
+				dls.Info = lst
+			}
+		}
+	}
+
+	return dls, nil
+}
+
+// Wave returns the wave played by the region.
+func (dls *DLS) Wave(rgn *DLSRegion) (*DLSWave, error) {
+	if rgn.Link == nil {
+		return nil, fmt.Errorf("missing %s chunk: %w", Uint32(IDwlnk), ErrDLSInvalid)
+	}
+	if dls.PoolTable == nil {
+		return nil, fmt.Errorf("missing %s chunk: %w", Uint32(IDptbl), ErrDLSInvalid)
+	}
+	idx := int(rgn.Link.TableIndex)
+	if idx >= len(dls.PoolTable.Cues) {
+		return nil, fmt.Errorf("pool table index %d: %w", idx, ErrOutOfRange)
+	}
+	off := dls.PoolTable.Cues[idx]
+	for _, wave := range dls.Waves {
+		if wave.Offset == off {
+			return wave, nil
+		}
+	}
+	return nil, fmt.Errorf("no wave at offset %d: %w", off, ErrDLSInvalid)
+}
+
+// WAVE returns the wave as a standalone "RIFF WAVE" file. The returned
+// file shares the "fmt ", "fact" and "data" chunks with the DLS file. The
+// "LIST INFO" chunk of the wave, if present, is copied. It returns
+// [ErrSkipDataMode] if the DLS file was decoded in SkipData mode.
+func (wave *DLSWave) WAVE() (*RIFF, error) {
+	if wave.Format == nil || wave.Data == nil {
+		return nil, fmt.Errorf("wave at offset %d missing format or data: %w", wave.Offset, ErrDLSInvalid)
+	}
+	if wave.Data.data == nil {
+		return nil, ErrSkipDataMode
+	}
+
+	chs := Chunks{wave.Format}
+	if wave.Fact != nil {
+		chs = append(chs, wave.Fact)
+	}
+	chs = append(chs, wave.Data)
+	if wave.Info != nil {
+		chs = append(chs, copyINFO(wave.Info))
+	}
+
+	rif := Compose(chs)
+	rif.SetType(TypeWAVE)
+	return rif, nil
+}
+
+// newDLSInstrument returns instrument decoded from the "LIST ins " chunk.
+func newDLSInstrument(lst *ChunkLIST) *DLSInstrument {
+	ins := &DLSInstrument{}
+	ins.Header, _ = lst.Chunks().First(IDinsh).(*ChunkINSH)
+	for _, sub := range lists(lst.Chunks()) {
+		switch sub.Type() {
+		case IDlrgn:
+			for _, rgn := range lists(sub.Chunks()) {
+				if rgn.Type() != IDrgn && rgn.Type() != IDrgn2 {
+					continue
+				}
+				ins.Regions = append(ins.Regions, newDLSRegion(rgn))
+			}
+		case IDlart, IDlar2:
+			ins.Articulators = append(ins.Articulators, articulators(sub)...)
+		case IDINFO:
+			if ins.Info == nil {
+				ins.Info = sub
+			}
+		}
+	}
+	return ins
+}
+
+// newDLSRegion returns region decoded from the "LIST rgn " or "LIST rgn2"
+// chunk.
+func newDLSRegion(lst *ChunkLIST) *DLSRegion {
+	rgn := &DLSRegion{}
+	rgn.Header, _ = lst.Chunks().First(IDrgnh).(*ChunkRGNH)
+	rgn.Sample, _ = lst.Chunks().First(IDwsmp).(*ChunkWSMP)
+	rgn.Link, _ = lst.Chunks().First(IDwlnk).(*ChunkWLNK)
+	for _, sub := range lists(lst.Chunks()) {
+		if sub.Type() == IDlart || sub.Type() == IDlar2 {
+			rgn.Articulators = append(rgn.Articulators, articulators(sub)...)
+		}
+	}
+	return rgn
+}
+
+// newDLSWave returns wave decoded from the "LIST wave" chunk at offset off
+// of the wave pool.
+func newDLSWave(lst *ChunkLIST, off uint32) *DLSWave {
+	wave := &DLSWave{Offset: off}
+	wave.Format, _ = lst.Chunks().First(IDfmt).(*ChunkFMT)
+	wave.Fact, _ = lst.Chunks().First(IDfact).(*ChunkFACT)
+	wave.Data, _ = lst.Chunks().First(IDdata).(*ChunkDATA)
+	wave.Sample, _ = lst.Chunks().First(IDwsmp).(*ChunkWSMP)
+	for _, sub := range lists(lst.Chunks()) {
+		if sub.Type() == IDINFO && wave.Info == nil {
+			wave.Info = sub
+		}
+	}
+	return wave
+}
+
+// articulators returns articulator chunks of the "LIST lart" or
+// "LIST lar2" chunk.
+func articulators(lst *ChunkLIST) []*ChunkART {
+	var arts []*ChunkART
+	for _, ch := range lst.Chunks() {
+		if art, ok := ch.(*ChunkART); ok {
+			arts = append(arts, art)
+		}
+	}
+	return arts
+}
+
+// lists returns LIST chunks from chs.
+func lists(chs Chunks) []*ChunkLIST {
+	var lsts []*ChunkLIST
+	for _, ch := range chs {
+		if lst, ok := ch.(*ChunkLIST); ok {
+			lsts = append(lsts, lst)
+		}
+	}
+	return lsts
+}
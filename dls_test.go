@@ -0,0 +1,289 @@
+package riff
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/ctx42/testing/pkg/assert"
+	"github.com/ctx42/testing/pkg/must"
+)
+
+// dlsList returns the LIST chunk of given type with chs sub-chunks.
+func dlsList(typ uint32, chs ...Chunk) *ChunkLIST {
+	lst := LIST(LoadData, NewRegistry(RAWCMake(LoadData)))
+	lst.ListType = typ
+	lst.Modify(chs)
+	return lst
+}
+
+// dlsInfo returns the "LIST INFO" chunk with the title.
+func dlsInfo(title string) *ChunkLIST {
+	inam := INFO(LabINAM)
+	inam.text, inam.size = []byte(title), uint32(len(title))
+	return dlsList(IDINFO, inam)
+}
+
+// dlsWave returns the "LIST wave" chunk with given data.
+func dlsWave(data []byte, chs ...Chunk) *ChunkLIST {
+	dat := DATA(LoadData)
+	must.Nil(dat.SetData(data))
+	return dlsList(IDwave, append(Chunks{fmt8bitMono(), dat}, chs...)...)
+}
+
+// dlsFile returns the DLS file with one drum instrument having one region
+// playing the second wave of the wave pool.
+func dlsFile(t *testing.T) []byte {
+	t.Helper()
+
+	colh := COLH()
+	colh.Instruments = 1
+
+	insh := INSH()
+	insh.Regions = 1
+	insh.Bank = DLSBankDrums
+
+	rgnh := RGNH()
+	rgnh.KeyLow, rgnh.KeyHigh, rgnh.VelocityHigh = 36, 36, 127
+	rgnh.HasLayer = true
+
+	wlnk := WLNK()
+	wlnk.TableIndex = 1
+	wlnk.Channel = 1
+
+	art1 := ART(IDart1)
+	art1.Connections = []DLSConnection{{Source: 1, Destination: 3}}
+	art2 := ART(IDart2)
+	art2.Connections = []DLSConnection{{Destination: 4, Scale: 100}}
+
+	rgn := dlsList(IDrgn2, rgnh, WSMP(), wlnk, dlsList(IDlart, art1))
+	ins := dlsList(IDins, insh, dlsList(IDlrgn, rgn), dlsList(IDlar2, art2), dlsInfo("Kick"))
+
+	wave0 := dlsWave([]byte{1, 2, 3})
+	wsmp := WSMP()
+	wsmp.UnityNote = 36
+	wave1 := dlsWave([]byte{4, 5, 6, 7}, wsmp, dlsInfo("Kick sample"))
+
+	ptbl := PTBL()
+	ptbl.Cues = []uint32{0, Chunks{wave0}.Size()}
+
+	rif := Compose(Chunks{
+		colh,
+		dlsList(IDlins, ins),
+		ptbl,
+		dlsList(IDwvpl, wave0, wave1),
+		dlsInfo("Drums"),
+	})
+	rif.SetType(TypeDLS)
+
+	buf := &bytes.Buffer{}
+	must.Value(rif.WriteTo(buf))
+	return buf.Bytes()
+}
+
+// readDLS decodes the DLS file.
+func readDLS(t *testing.T, b []byte, load bool) *DLS {
+	t.Helper()
+	rif := New(load)
+	must.Value(rif.ReadFrom(bytes.NewReader(b)))
+	return must.Value(NewDLS(rif))
+}
+
+func Test_NewDLS(t *testing.T) {
+	// --- Given ---
+	rif := New(LoadData)
+	must.Value(rif.ReadFrom(bytes.NewReader(dlsFile(t))))
+
+	// --- When ---
+	dls, err := NewDLS(rif)
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(1), dls.Header.Instruments)
+	assert.Equal(t, []uint32{0, 48}, dls.PoolTable.Cues)
+	assert.NotNil(t, dls.Info)
+
+	assert.Len(t, 1, dls.Instruments)
+	ins := dls.Instruments[0]
+	assert.True(t, ins.Header.Drums())
+	assert.NotNil(t, ins.Info)
+	assert.Len(t, 1, ins.Articulators)
+	assert.Equal(t, IDart2, ins.Articulators[0].ID())
+
+	assert.Len(t, 1, ins.Regions)
+	rgn := ins.Regions[0]
+	assert.Equal(t, uint16(36), rgn.Header.KeyLow)
+	assert.True(t, rgn.Header.HasLayer)
+	assert.NotNil(t, rgn.Sample)
+	assert.Equal(t, uint32(1), rgn.Link.TableIndex)
+	assert.Len(t, 1, rgn.Articulators)
+	assert.Equal(t, IDart1, rgn.Articulators[0].ID())
+
+	assert.Len(t, 2, dls.Waves)
+	assert.Equal(t, uint32(0), dls.Waves[0].Offset)
+	assert.Nil(t, dls.Waves[0].Sample)
+	assert.Nil(t, dls.Waves[0].Info)
+	assert.Equal(t, uint32(48), dls.Waves[1].Offset)
+	assert.Equal(t, uint16(36), dls.Waves[1].Sample.UnityNote)
+	assert.NotNil(t, dls.Waves[1].Info)
+	assert.Equal(t, uint32(4), dls.Waves[1].Data.Size())
+	assert.Equal(t, CompPCM, dls.Waves[1].Format.CompCode)
+}
+
+func Test_NewDLS_RoundTrip(t *testing.T) {
+	// --- Given ---
+	b := dlsFile(t)
+	rif := New(LoadData)
+	must.Value(rif.ReadFrom(bytes.NewReader(b)))
+
+	// --- When ---
+	buf := &bytes.Buffer{}
+	_, err := rif.WriteTo(buf)
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, b, buf.Bytes())
+}
+
+func Test_NewDLS_Errors(t *testing.T) {
+	t.Run("form type", func(t *testing.T) {
+		// --- Given ---
+		rif := Compose(nil)
+		rif.SetType(TypeWAVE)
+
+		// --- When ---
+		dls, err := NewDLS(rif)
+
+		// --- Then ---
+		assert.ErrorEqual(t, "expected DLS  form got WAVE", err)
+		assert.Nil(t, dls)
+	})
+
+	t.Run("missing header", func(t *testing.T) {
+		// --- Given ---
+		rif := Compose(nil)
+		rif.SetType(TypeDLS)
+
+		// --- When ---
+		dls, err := NewDLS(rif)
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrDLSInvalid, err)
+		assert.Nil(t, dls)
+	})
+}
+
+func Test_DLS_Wave(t *testing.T) {
+	// --- Given ---
+	dls := readDLS(t, dlsFile(t), LoadData)
+
+	// --- When ---
+	wave, err := dls.Wave(dls.Instruments[0].Regions[0])
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Same(t, dls.Waves[1], wave)
+}
+
+func Test_DLS_Wave_Errors(t *testing.T) {
+	t.Run("missing link", func(t *testing.T) {
+		// --- Given ---
+		dls := readDLS(t, dlsFile(t), LoadData)
+
+		// --- When ---
+		wave, err := dls.Wave(&DLSRegion{})
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrDLSInvalid, err)
+		assert.Nil(t, wave)
+	})
+
+	t.Run("missing pool table", func(t *testing.T) {
+		// --- Given ---
+		dls := readDLS(t, dlsFile(t), LoadData)
+		dls.PoolTable = nil
+
+		// --- When ---
+		wave, err := dls.Wave(dls.Instruments[0].Regions[0])
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrDLSInvalid, err)
+		assert.Nil(t, wave)
+	})
+
+	t.Run("index out of range", func(t *testing.T) {
+		// --- Given ---
+		dls := readDLS(t, dlsFile(t), LoadData)
+		rgn := dls.Instruments[0].Regions[0]
+		rgn.Link.TableIndex = 2
+
+		// --- When ---
+		wave, err := dls.Wave(rgn)
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrOutOfRange, err)
+		assert.Nil(t, wave)
+	})
+
+	t.Run("no wave at offset", func(t *testing.T) {
+		// --- Given ---
+		dls := readDLS(t, dlsFile(t), LoadData)
+		dls.PoolTable.Cues[1] = 10
+
+		// --- When ---
+		wave, err := dls.Wave(dls.Instruments[0].Regions[0])
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrDLSInvalid, err)
+		assert.Nil(t, wave)
+	})
+}
+
+func Test_DLSWave_WAVE(t *testing.T) {
+	// --- Given ---
+	dls := readDLS(t, dlsFile(t), LoadData)
+	wave := dls.Waves[1]
+
+	// --- When ---
+	rif, err := wave.WAVE()
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, TypeWAVE, rif.Type())
+	assert.Same(t, wave.Format, rif.Chunks()[0])
+	assert.Same(t, wave.Data, rif.Chunks()[1])
+	assert.Equal(t, IDINFO, rif.Chunks()[2].Type())
+
+	buf := &bytes.Buffer{}
+	must.Value(rif.WriteTo(buf))
+	exp := Compose(Chunks{fmt8bitMono(), wave.Data, dlsInfo("Kick sample")})
+	exp.SetType(TypeWAVE)
+	want := &bytes.Buffer{}
+	must.Value(exp.WriteTo(want))
+	assert.Equal(t, want.Bytes(), buf.Bytes())
+}
+
+func Test_DLSWave_WAVE_Errors(t *testing.T) {
+	t.Run("missing format", func(t *testing.T) {
+		// --- Given ---
+		wave := &DLSWave{Data: DATA(LoadData)}
+
+		// --- When ---
+		rif, err := wave.WAVE()
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrDLSInvalid, err)
+		assert.Nil(t, rif)
+	})
+
+	t.Run("skip data mode", func(t *testing.T) {
+		// --- Given ---
+		dls := readDLS(t, dlsFile(t), SkipData)
+
+		// --- When ---
+		rif, err := dls.Waves[0].WAVE()
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrSkipDataMode, err)
+		assert.Nil(t, rif)
+	})
+}
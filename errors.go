@@ -9,6 +9,19 @@ var (
 	// ErrNotRIFF is returned when a file is not in the RIFF format.
 	ErrNotRIFF = errors.New("not RIFF file")
 
+	// ErrNotW64 is returned when a file is not in the Sony Wave64 format.
+	ErrNotW64 = errors.New("not Wave64 file")
+
+	// ErrNotRF64 is returned when a file is not in the RF64 or BW64 format.
+	ErrNotRF64 = errors.New("not RF64 file")
+
+	// ErrNotIFF is returned when a file is not in the EA IFF-85 format.
+	ErrNotIFF = errors.New("not IFF file")
+
+	// ErrUnknownFormat is returned when the file container is not
+	// recognized.
+	ErrUnknownFormat = errors.New("unknown file format")
+
 	// ErrTooShort is returned when a chunk or field is shorter than its
 	// defined length.
 	ErrTooShort = errors.New("length too short")
@@ -20,6 +33,50 @@ var (
 	// ErrSkipDataMode is returned when the decoder in [SkipData] mode
 	// is used in write context (e.x. calling WriteTo method).
 	ErrSkipDataMode = errors.New("decoder in meta only mode used in write context")
+
+	// ErrCueNotFound is returned when a chunk refers to a cue point which
+	// is not defined in the cue chunk.
+	ErrCueNotFound = errors.New("cue point not found")
+
+	// ErrOutOfRange is returned when an offset or length points outside
+	// the waveform data.
+	ErrOutOfRange = errors.New("out of range")
+
+	// ErrUnsupportedSeek is returned when a reader doesn't support the
+	// requested seek (e.g. seeking backwards).
+	ErrUnsupportedSeek = errors.New("unsupported seek")
+
+	// ErrUnsupportedFormat is returned when an operation is not supported
+	// for the waveform data format.
+	ErrUnsupportedFormat = errors.New("unsupported format")
+
+	// ErrID3Invalid is returned when the ID3v2 tag is malformed.
+	ErrID3Invalid = errors.New("invalid ID3v2 tag")
+
+	// ErrXMPInvalid is returned when the XMP packet is malformed.
+	ErrXMPInvalid = errors.New("invalid XMP packet")
+
+	// ErrSMFInvalid is returned when the Standard MIDI File is malformed.
+	ErrSMFInvalid = errors.New("invalid Standard MIDI File")
+
+	// ErrAVIInvalid is returned when the AVI file is malformed.
+	ErrAVIInvalid = errors.New("invalid AVI file")
+
+	// ErrWebPInvalid is returned when the WebP image is malformed.
+	ErrWebPInvalid = errors.New("invalid WebP image")
+
+	// ErrACONInvalid is returned when the animated cursor is malformed.
+	ErrACONInvalid = errors.New("invalid animated cursor")
+
+	// ErrDLSInvalid is returned when the DLS file is malformed.
+	ErrDLSInvalid = errors.New("invalid DLS file")
+
+	// ErrSF2Invalid is returned when the SoundFont bank is malformed.
+	ErrSF2Invalid = errors.New("invalid SoundFont bank")
+
+	// ErrIFFInvalid is returned when the EA IFF-85 group chunk is
+	// malformed.
+	ErrIFFInvalid = errors.New("invalid IFF group chunk")
 )
 
 // Error format strings.
@@ -0,0 +1,146 @@
+package riff
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// Forms represents a sequence of RIFF forms stored in one file (e.g.
+// OpenDML AVI file with "RIFF AVI " form followed by "RIFF AVIX" forms).
+type Forms []*RIFF
+
+// ReadForms reads all RIFF forms from r. Each form is decoded with an
+// instance of [RIFF] returned by [New]. See [RIFF.ReadForms] for details.
+func ReadForms(r io.Reader, load bool) (Forms, error) {
+	return New(load).ReadForms(r)
+}
+
+// ReadForms reads all RIFF forms from r. Each form is decoded with a new
+// instance of [RIFF] using the decoders registered with rif and its data
+// loading mode, and is limited to the size declared in its header. Both
+// "RIFF" and "RIFX" forms are supported.
+func (rif *RIFF) ReadForms(r io.Reader) (Forms, error) {
+	var fs Forms
+	for {
+		var id uint32
+		if err := ReadChunkID(r, &id); err != nil {
+			if errors.Is(err, io.EOF) && len(fs) > 0 {
+				return fs, nil
+			}
+			return nil, err
+		}
+		form := Bare(rif.reg)
+		form.load = rif.load
+		fr := r
+		switch id {
+		case IDRIFF:
+			form.order = le
+		case IDRIFX:
+			form.order = be
+			fr = OrderReader(r, be)
+		default:
+			return nil, fmt.Errorf("form %d: %w", len(fs), ErrNotRIFF)
+		}
+
+		if err := form.readForm(fr); err != nil {
+			return nil, fmt.Errorf("form %d: %w", len(fs), err)
+		}
+		fs = append(fs, form)
+	}
+}
+
+// readForm reads the form size, type and chunks limited to the form size.
+// It expects r to be in a position right after the "RIFF" ID.
+func (rif *RIFF) readForm(r io.Reader) error {
+	rif.Reset()
+
+	size, err := ReadChunkSize(r)
+	if err != nil {
+		return err
+	}
+	if size < 4 {
+		return fmt.Errorf(errFmtDecode, Uint32(IDRIFF), ErrTooShort)
+	}
+
+	if err = binary.Read(r, be, &rif.riffType); err != nil {
+		return fmt.Errorf(errFmtDecode, Uint32(IDRIFF), err)
+	}
+
+	n, err := rif.readChunks(limitReader(r, int64(size)-4))
+	if !errors.Is(err, io.EOF) {
+		return err
+	}
+	// The size is corrected for truncated forms.
+	rif.size = uint32(n) + 4
+
+	if _, err = ReadPaddingIf(r, size); err != nil && !errors.Is(err, io.EOF) {
+		return err
+	}
+	return nil
+}
+
+// First returns the first form of given type or nil.
+func (fs Forms) First(formType uint32) *RIFF {
+	for _, rif := range fs {
+		if rif.Type() == formType {
+			return rif
+		}
+	}
+	return nil
+}
+
+// WriteTo writes all the forms to w.
+func (fs Forms) WriteTo(w io.Writer) (int64, error) {
+	var sum int64
+	for _, rif := range fs {
+		n, err := rif.WriteTo(w)
+		sum += n
+		if err != nil {
+			return sum, err
+		}
+	}
+	return sum, nil
+}
+
+// limitReader returns a reader which reads at most n bytes from r. If r
+// implements [io.Seeker] so does the returned reader, which allows
+// skipping chunk data without reading it.
+func limitReader(r io.Reader, n int64) io.Reader {
+	var res io.Reader
+	lr := &io.LimitedReader{R: r, N: n}
+	if s, ok := r.(io.Seeker); ok {
+		res = &limitedSeeker{LimitedReader: lr, s: s}
+	} else {
+		res = lr
+	}
+	if _, ok := r.(byteOrderer); ok {
+		return OrderReader(res, ByteOrder(r))
+	}
+	return res
+}
+
+// limitedSeeker is [io.LimitedReader] supporting seeking forward relative
+// to the current position.
+type limitedSeeker struct {
+	*io.LimitedReader
+
+	// Underlying seeker.
+	s io.Seeker
+}
+
+func (ls *limitedSeeker) Seek(offset int64, whence int) (int64, error) {
+	if whence != io.SeekCurrent || offset < 0 {
+		return 0, errors.New("limitedSeeker: unsupported seek")
+	}
+	if offset > ls.N {
+		return 0, io.ErrUnexpectedEOF
+	}
+	pos, err := ls.s.Seek(offset, io.SeekCurrent)
+	if err != nil {
+		return pos, err
+	}
+	ls.N -= offset
+	return pos, nil
+}
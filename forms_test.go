@@ -0,0 +1,242 @@
+package riff
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"testing"
+
+	"github.com/ctx42/testing/pkg/assert"
+	"github.com/ctx42/testing/pkg/kit/iokit"
+	"github.com/ctx42/testing/pkg/must"
+)
+
+func Test_ReadForms(t *testing.T) {
+	// --- Given ---
+	b := odmlAVI(t)
+
+	// --- When ---
+	fs, err := ReadForms(bytes.NewReader(b), LoadData)
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Len(t, 2, fs)
+	assert.Equal(t, TypeAVI, fs[0].Type())
+	assert.Equal(t, TypeAVIX, fs[1].Type())
+	assert.Same(t, fs[1], fs.First(TypeAVIX))
+	assert.Nil(t, fs.First(TypeWAVE))
+
+	movi := fs[1].Chunks()[0]
+	assert.Equal(t, IDmovi, movi.Type())
+	assert.Type(t, &ChunkINDX{}, movi.Chunks()[2])
+}
+
+func Test_RIFF_ReadForms(t *testing.T) {
+	// --- Given ---
+	rif := Bare(NewRegistry(RAWCMake(LoadData)))
+
+	// --- When ---
+	fs, err := rif.ReadForms(bytes.NewReader(odmlAVI(t)))
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Len(t, 2, fs)
+	assert.Equal(t, TypeAVIX, fs[1].Type())
+	assert.Type(t, &ChunkRAWC{}, fs[1].Chunks()[0])
+	assert.Len(t, 0, rif.Chunks())
+}
+
+func Test_RIFF_ReadFrom_Forms(t *testing.T) {
+	// --- Given ---
+	b := odmlAVI(t)
+	rif := New(LoadData)
+
+	// --- When ---
+	n, err := rif.ReadFrom(bytes.NewReader(b))
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, int64(len(b)), n)
+	assert.Equal(t, TypeAVI, rif.Type())
+	assert.Equal(t, IDRIFF, rif.Chunks()[3].ID())
+	assert.Type(t, &ChunkRAWC{}, rif.Chunks()[3])
+}
+
+func Test_ReadForms_RIFX(t *testing.T) {
+	// --- Given ---
+	fs := must.Value(ReadForms(bytes.NewReader(odmlAVI(t)), LoadData))
+	for _, rif := range fs {
+		rif.SetByteOrder(be)
+	}
+	b := &bytes.Buffer{}
+	must.Value(fs.WriteTo(b))
+
+	// --- When ---
+	have, err := ReadForms(bytes.NewReader(b.Bytes()), LoadData)
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Len(t, 2, have)
+	assert.Equal(t, be, have[0].ByteOrder())
+	assert.Equal(t, be, have[1].ByteOrder())
+	assert.Equal(t, TypeAVIX, have[1].Type())
+	movi := have[1].Chunks()[0]
+	assert.Type(t, &ChunkINDX{}, movi.Chunks()[2])
+
+	for _, rif := range have {
+		rif.SetByteOrder(le)
+	}
+	dst := &bytes.Buffer{}
+	must.Value(have.WriteTo(dst))
+	assert.Equal(t, odmlAVI(t), dst.Bytes())
+}
+
+func Test_ReadForms_Single(t *testing.T) {
+	// --- Given ---
+	fil := must.Value(os.Open("testdata/kick.wav"))
+	t.Cleanup(func() { _ = fil.Close() })
+
+	// --- When ---
+	fs, err := ReadForms(fil, SkipData)
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Len(t, 1, fs)
+	assert.Equal(t, TypeWAVE, fs[0].Type())
+}
+
+func Test_ReadForms_Truncated(t *testing.T) {
+	// --- Given ---
+	b := sampleAVI(t)[:228752] // Cut the "idx1" chunk.
+
+	// --- When ---
+	fs, err := ReadForms(bytes.NewReader(b), SkipData)
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Len(t, 1, fs)
+	assert.Equal(t, uint32(len(b)-8), fs[0].Size())
+	assert.Nil(t, fs[0].Chunks().First(IDidx1))
+}
+
+func Test_ReadForms_Errors(t *testing.T) {
+	t.Run("empty", func(t *testing.T) {
+		// --- When ---
+		fs, err := ReadForms(bytes.NewReader(nil), SkipData)
+
+		// --- Then ---
+		assert.ErrorIs(t, io.EOF, err)
+		assert.Nil(t, fs)
+	})
+
+	t.Run("not RIFF", func(t *testing.T) {
+		// --- Given ---
+		b := append(odmlAVI(t), []byte("JUNK")...)
+
+		// --- When ---
+		fs, err := ReadForms(bytes.NewReader(b), SkipData)
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrNotRIFF, err)
+		assert.ErrorEqual(t, "form 2: not RIFF file", err)
+		assert.Nil(t, fs)
+	})
+
+	t.Run("too short", func(t *testing.T) {
+		// --- Given ---
+		b := []byte{'R', 'I', 'F', 'F', 2, 0, 0, 0, 'A', 'V'}
+
+		// --- When ---
+		fs, err := ReadForms(bytes.NewReader(b), SkipData)
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrTooShort, err)
+		assert.Nil(t, fs)
+	})
+
+	t.Run("chunk error", func(t *testing.T) {
+		// --- Given ---
+		b := []byte{
+			'R', 'I', 'F', 'F', 16, 0, 0, 0, 'W', 'A', 'V', 'E',
+			'f', 'm', 't', ' ', 4, 0, 0, 0, 1, 0, 1, 0,
+		}
+
+		// --- When ---
+		fs, err := ReadForms(bytes.NewReader(b), SkipData)
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrTooShort, err)
+		assert.ErrorEqual(t, "form 0: error decoding fmt  chunk: length too short", err)
+		assert.Nil(t, fs)
+	})
+}
+
+func Test_Forms_WriteTo(t *testing.T) {
+	// --- Given ---
+	b := odmlAVI(t)
+	fs := must.Value(ReadForms(bytes.NewReader(b), LoadData))
+
+	// --- When ---
+	dst := &bytes.Buffer{}
+	n, err := fs.WriteTo(dst)
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, int64(len(b)), n)
+	assert.Equal(t, b, dst.Bytes())
+}
+
+func Test_Forms_WriteTo_Errors(t *testing.T) {
+	// --- Given ---
+	b := odmlAVI(t)
+	fs := must.Value(ReadForms(bytes.NewReader(b), LoadData))
+
+	for _, i := range []int{1, 12, len(b) - 1} {
+		// --- When ---
+		_, err := fs.WriteTo(iokit.ErrWriter(&bytes.Buffer{}, i))
+
+		// --- Then ---
+		assert.Error(t, err)
+	}
+}
+
+func Test_limitReader(t *testing.T) {
+	t.Run("seeker", func(t *testing.T) {
+		// --- Given ---
+		r := limitReader(bytes.NewReader([]byte("abcdef")), 4)
+
+		// --- When ---
+		s, ok := r.(io.Seeker)
+
+		// --- Then ---
+		assert.True(t, ok)
+		_, err := s.Seek(2, io.SeekCurrent)
+		assert.NoError(t, err)
+		assert.Equal(t, "cd", string(must.Value(io.ReadAll(r))))
+	})
+
+	t.Run("seek errors", func(t *testing.T) {
+		// --- Given ---
+		s := limitReader(bytes.NewReader([]byte("abcdef")), 4).(io.Seeker)
+
+		// --- Then ---
+		_, err := s.Seek(5, io.SeekCurrent)
+		assert.ErrorIs(t, io.ErrUnexpectedEOF, err)
+		_, err = s.Seek(0, io.SeekStart)
+		assert.Error(t, err)
+		_, err = s.Seek(-1, io.SeekCurrent)
+		assert.Error(t, err)
+	})
+
+	t.Run("reader", func(t *testing.T) {
+		// --- Given ---
+		r := limitReader(iokit.ErrReader(bytes.NewReader([]byte("abcdef")), 100), 4)
+
+		// --- When ---
+		_, ok := r.(io.Seeker)
+
+		// --- Then ---
+		assert.False(t, ok)
+		assert.Equal(t, "abcd", string(must.Value(io.ReadAll(r))))
+	})
+}
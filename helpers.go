@@ -35,21 +35,26 @@ func ReadChunkID(r io.Reader, id *uint32) error {
 	return nil
 }
 
-// ReadChunkSize reads four-byte chunk size.
-// It returns [io.ErrUnexpectedEOF] if there are less than four bytes in
+// ReadChunkSize reads four-byte chunk size in the byte order of r
+// (see [ByteOrder]). It returns [io.ErrUnexpectedEOF] if there are less than four bytes in
 // the reader.
 func ReadChunkSize(r io.Reader) (uint32, error) {
 	var size uint32
-	if err := binary.Read(r, le, &size); err != nil {
+	if err := binary.Read(r, ByteOrder(r), &size); err != nil {
 		return 0, err
 	}
 	return size, nil
 }
 
 // LimitedRead reads size bytes to buf from r. It returns [io.ErrUnexpectedEOF]
-// if the number of read bytes is lower than size.
+// if the number of read bytes is lower than size. The byte order of r is
+// preserved.
 func LimitedRead(r io.Reader, size uint32, dst io.ReaderFrom) error {
-	n, err := dst.ReadFrom(io.LimitReader(r, int64(size)))
+	lr := io.LimitReader(r, int64(size))
+	if _, ok := r.(byteOrderer); ok {
+		lr = OrderReader(lr, ByteOrder(r))
+	}
+	n, err := dst.ReadFrom(lr)
 	if err != nil {
 		return err
 	}
@@ -106,14 +111,15 @@ func ReadPaddingIf(r io.Reader, size uint32) (int64, error) {
 	return n, nil
 }
 
-// WriteIDAndSize writes chunk id and size to writer w.
+// WriteIDAndSize writes chunk id and size to writer w. The size is written
+// in the byte order of w (see [ByteOrder]).
 func WriteIDAndSize(w io.Writer, id, size uint32) (int64, error) {
 	var sum int64
 	if err := binary.Write(w, be, id); err != nil {
 		return 0, err
 	}
 	sum += 4
-	if err := binary.Write(w, le, size); err != nil {
+	if err := binary.Write(w, ByteOrder(w), size); err != nil {
 		return sum, err
 	}
 	sum += 4
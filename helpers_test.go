@@ -104,6 +104,18 @@ func Test_ReadChunkSize(t *testing.T) {
 	assert.Equal(t, uint32(0x10), size)
 }
 
+func Test_ReadChunkSize_BigEndian(t *testing.T) {
+	// --- Given ---
+	src := OrderReader(bytes.NewReader([]byte{0x00, 0x00, 0x00, 0x10}), be)
+
+	// --- When ---
+	size, err := ReadChunkSize(src)
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(0x10), size)
+}
+
 func Test_ReadChunkSize_Error(t *testing.T) {
 	// --- Given ---
 	src := iokit.NewReaderMock(t)
@@ -130,6 +142,19 @@ func Test_LimitedRead(t *testing.T) {
 	assert.Equal(t, []byte{0, 1, 2}, iokit.ReadAllFromStart(dst))
 }
 
+func Test_LimitedRead_ByteOrder(t *testing.T) {
+	// --- Given ---
+	src := OrderReader(bytes.NewReader([]byte{0, 0, 0, 2, 0, 0}), be)
+
+	// --- When ---
+	dst := RAWC(IDJUNK, LoadData)
+	err := LimitedRead(src, 6, dst)
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(2), dst.Size())
+}
+
 func Test_LimitedRead_ErrUnexpectedEOF(t *testing.T) {
 	// --- Given ---
 	src := bytes.NewReader([]byte{0, 1, 2, 3})
@@ -268,6 +293,19 @@ func Test_WriteIDAndSize(t *testing.T) {
 	assert.Equal(t, exp, dst.Bytes())
 }
 
+func Test_WriteIDAndSize_BigEndian(t *testing.T) {
+	// --- Given ---
+	dst := &bytes.Buffer{}
+
+	// --- When ---
+	n, err := WriteIDAndSize(OrderWriter(dst, be), IDdata, 0x10)
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, int64(8), n)
+	assert.Equal(t, []byte("data\x00\x00\x00\x10"), dst.Bytes())
+}
+
 func Test_WriteIDAndSize_ErrorWritingID(t *testing.T) {
 	// --- Given ---
 	buf := &bytes.Buffer{}
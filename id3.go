@@ -0,0 +1,564 @@
+package riff
+
+import (
+	"bytes"
+	"fmt"
+	"unicode/utf16"
+	"unicode/utf8"
+)
+
+// ID3v2 frame IDs.
+const (
+	// ID3TIT2 represents the title frame ID "TIT2".
+	ID3TIT2 uint32 = 0x54495432
+
+	// ID3TPE1 represents the lead artist frame ID "TPE1".
+	ID3TPE1 uint32 = 0x54504531
+
+	// ID3TALB represents the album frame ID "TALB".
+	ID3TALB uint32 = 0x54414c42
+
+	// ID3TRCK represents the track number frame ID "TRCK".
+	ID3TRCK uint32 = 0x5452434b
+
+	// ID3TYER represents the year frame ID "TYER" (ID3v2.3).
+	ID3TYER uint32 = 0x54594552
+
+	// ID3TDRC represents the recording time frame ID "TDRC" (ID3v2.4).
+	ID3TDRC uint32 = 0x54445243
+
+	// ID3COMM represents the comment frame ID "COMM".
+	ID3COMM uint32 = 0x434f4d4d
+
+	// ID3TXXX represents the user defined text frame ID "TXXX".
+	ID3TXXX uint32 = 0x54585858
+
+	// ID3APIC represents the attached picture frame ID "APIC".
+	ID3APIC uint32 = 0x41504943
+)
+
+// ID3v2 text encodings.
+const (
+	ID3EncLatin1  byte = 0 // ISO-8859-1.
+	ID3EncUTF16   byte = 1 // UTF-16 with BOM.
+	ID3EncUTF16BE byte = 2 // UTF-16 big endian without BOM (ID3v2.4).
+	ID3EncUTF8    byte = 3 // UTF-8 (ID3v2.4).
+)
+
+// ID3v2 header and frame flags.
+const (
+	id3FlagUnsync   byte = 0x80 // Header: unsynchronisation.
+	id3FlagExtended byte = 0x40 // Header: extended header present.
+	id3FlagFooter   byte = 0x10 // Header: footer present (ID3v2.4).
+
+	id3FrameUnsync     uint16 = 0x0002 // Frame: unsynchronisation (ID3v2.4).
+	id3FrameDataLen    uint16 = 0x0001 // Frame: data length indicator (ID3v2.4).
+	id3TagHeaderSize          = 10
+	id3FrameHeaderSize        = 10
+)
+
+// ID3Frame represents a single ID3v2 frame.
+type ID3Frame struct {
+	// Frame ID (e.g. "TIT2").
+	ID uint32
+
+	// Frame flags. The unsynchronisation and data length indicator flags
+	// are cleared when the frame is decoded.
+	Flags uint16
+
+	// Frame data with unsynchronisation removed.
+	Data []byte
+}
+
+// ID3Picture represents an attached picture (APIC frame).
+type ID3Picture struct {
+	// MIME type of the image (e.g. "image/jpeg").
+	MIME string
+
+	// Picture type (e.g. 3 - front cover).
+	PictureType byte
+
+	// Picture description.
+	Description string
+
+	// Image data.
+	Data []byte
+}
+
+// ID3Tag represents ID3v2.3 or ID3v2.4 tag.
+type ID3Tag struct {
+	// Major version (3 or 4).
+	Version byte
+
+	// Revision number.
+	Revision byte
+
+	// Frames in order they appear in the tag.
+	Frames []ID3Frame
+
+	// Number of padding bytes after the frames.
+	Padding int
+}
+
+// NewID3Tag returns a new empty ID3v2 tag of given major version.
+func NewID3Tag(version byte) *ID3Tag {
+	return &ID3Tag{Version: version}
+}
+
+// ParseID3 parses ID3v2.3 or ID3v2.4 tag from b.
+func ParseID3(b []byte) (*ID3Tag, error) {
+	if len(b) < id3TagHeaderSize || string(b[:3]) != "ID3" {
+		return nil, ErrID3Invalid
+	}
+	tag := &ID3Tag{Version: b[3], Revision: b[4]}
+	if tag.Version != 3 && tag.Version != 4 {
+		return nil, fmt.Errorf("ID3v2.%d: %w", tag.Version, ErrUnsupportedFormat)
+	}
+	flags := b[5]
+	size, ok := syncsafe(b[6:10])
+	if !ok || int(size) > len(b)-id3TagHeaderSize {
+		return nil, ErrID3Invalid
+	}
+	body := b[id3TagHeaderSize : id3TagHeaderSize+int(size)]
+
+	if tag.Version == 3 && flags&id3FlagUnsync != 0 {
+		body = id3Resync(body)
+	}
+
+	if flags&id3FlagExtended != 0 {
+		if len(body) < 4 {
+			return nil, ErrID3Invalid
+		}
+		var ext int
+		if tag.Version == 3 {
+			ext = int(be.Uint32(body)) + 4
+		} else {
+			es, _ := syncsafe(body[:4])
+			ext = int(es)
+		}
+		if ext > len(body) {
+			return nil, ErrID3Invalid
+		}
+		body = body[ext:]
+	}
+
+	for len(body) >= id3FrameHeaderSize && body[0] != 0 {
+		frm := ID3Frame{
+			ID:    be.Uint32(body),
+			Flags: be.Uint16(body[8:]),
+		}
+		var fs uint32
+		if tag.Version == 3 {
+			fs = be.Uint32(body[4:])
+		} else if fs, ok = syncsafe(body[4:8]); !ok {
+			return nil, ErrID3Invalid
+		}
+		if int(fs) > len(body)-id3FrameHeaderSize {
+			return nil, ErrID3Invalid
+		}
+		data := body[id3FrameHeaderSize : id3FrameHeaderSize+int(fs)]
+		body = body[id3FrameHeaderSize+int(fs):]
+
+		if tag.Version == 4 {
+			if frm.Flags&id3FrameUnsync != 0 || flags&id3FlagUnsync != 0 {
+				data = id3Resync(data)
+			}
+			if frm.Flags&id3FrameDataLen != 0 {
+				if len(data) < 4 {
+					return nil, ErrID3Invalid
+				}
+				data = data[4:]
+			}
+			frm.Flags &^= id3FrameUnsync | id3FrameDataLen
+		}
+		frm.Data = append([]byte(nil), data...)
+		tag.Frames = append(tag.Frames, frm)
+	}
+	tag.Padding = len(body)
+
+	return tag, nil
+}
+
+// Bytes encodes the tag. The tag is written without unsynchronisation,
+// extended header and footer.
+func (tag *ID3Tag) Bytes() ([]byte, error) {
+	if tag.Version != 3 && tag.Version != 4 {
+		return nil, fmt.Errorf("ID3v2.%d: %w", tag.Version, ErrUnsupportedFormat)
+	}
+
+	body := &bytes.Buffer{}
+	hdr := make([]byte, id3FrameHeaderSize)
+	for _, frm := range tag.Frames {
+		be.PutUint32(hdr, frm.ID)
+		if tag.Version == 3 {
+			be.PutUint32(hdr[4:], uint32(len(frm.Data)))
+		} else if !putSyncsafe(hdr[4:8], uint32(len(frm.Data))) {
+			return nil, fmt.Errorf("frame %s: %w", Uint32(frm.ID), ErrOutOfRange)
+		}
+		be.PutUint16(hdr[8:], frm.Flags)
+		body.Write(hdr)
+		body.Write(frm.Data)
+	}
+	body.Write(make([]byte, tag.Padding))
+
+	out := make([]byte, id3TagHeaderSize, id3TagHeaderSize+body.Len())
+	copy(out, "ID3")
+	out[3] = tag.Version
+	out[4] = tag.Revision
+	if !putSyncsafe(out[6:10], uint32(body.Len())) {
+		return nil, fmt.Errorf("tag size: %w", ErrOutOfRange)
+	}
+	return append(out, body.Bytes()...), nil
+}
+
+// size returns the size of the tag encoded with [ID3Tag.Bytes].
+func (tag *ID3Tag) size() int {
+	n := id3TagHeaderSize + tag.Padding
+	for _, frm := range tag.Frames {
+		n += id3FrameHeaderSize + len(frm.Data)
+	}
+	return n
+}
+
+// clone returns a deep copy of the tag.
+func (tag *ID3Tag) clone() *ID3Tag {
+	cp := *tag
+	cp.Frames = make([]ID3Frame, len(tag.Frames))
+	for i, frm := range tag.Frames {
+		frm.Data = append([]byte(nil), frm.Data...)
+		cp.Frames[i] = frm
+	}
+	return &cp
+}
+
+// equal returns true if the tag is the same as other.
+func (tag *ID3Tag) equal(other *ID3Tag) bool {
+	if tag.Version != other.Version || tag.Revision != other.Revision ||
+		tag.Padding != other.Padding || len(tag.Frames) != len(other.Frames) {
+		return false
+	}
+	for i, frm := range tag.Frames {
+		oth := other.Frames[i]
+		if frm.ID != oth.ID || frm.Flags != oth.Flags || !bytes.Equal(frm.Data, oth.Data) {
+			return false
+		}
+	}
+	return true
+}
+
+// Frame returns the first frame with given ID or nil.
+func (tag *ID3Tag) Frame(id uint32) *ID3Frame {
+	for i := range tag.Frames {
+		if tag.Frames[i].ID == id {
+			return &tag.Frames[i]
+		}
+	}
+	return nil
+}
+
+// Remove removes all frames with given ID.
+func (tag *ID3Tag) Remove(id uint32) {
+	frms := tag.Frames[:0]
+	for _, frm := range tag.Frames {
+		if frm.ID != id {
+			frms = append(frms, frm)
+		}
+	}
+	tag.Frames = frms
+}
+
+// set replaces the first frame for which match returns true or appends
+// a new frame.
+func (tag *ID3Tag) set(id uint32, data []byte, match func(*ID3Frame) bool) {
+	for i := range tag.Frames {
+		if tag.Frames[i].ID == id && match(&tag.Frames[i]) {
+			tag.Frames[i].Data = data
+			return
+		}
+	}
+	tag.Frames = append(tag.Frames, ID3Frame{ID: id, Data: data})
+}
+
+// Text returns the value of a text frame (e.g. [ID3TIT2]). Returns empty
+// string if the frame doesn't exist. Multiple values (ID3v2.4) are
+// separated by "/".
+func (tag *ID3Tag) Text(id uint32) string {
+	frm := tag.Frame(id)
+	if frm == nil || len(frm.Data) == 0 {
+		return ""
+	}
+	txt := id3Decode(frm.Data[0], frm.Data[1:])
+	return joinNul(txt)
+}
+
+// SetText sets the value of a text frame.
+func (tag *ID3Tag) SetText(id uint32, val string) {
+	enc := tag.encodingFor(val)
+	data := append([]byte{enc}, id3Encode(enc, val)...)
+	tag.set(id, data, func(*ID3Frame) bool { return true })
+}
+
+// Year returns recording year from TYER (ID3v2.3) or TDRC (ID3v2.4) frame.
+func (tag *ID3Tag) Year() string {
+	if y := tag.Text(ID3TDRC); y != "" {
+		if len(y) > 4 {
+			y = y[:4]
+		}
+		return y
+	}
+	return tag.Text(ID3TYER)
+}
+
+// SetYear sets recording year using the frame appropriate for the tag
+// version.
+func (tag *ID3Tag) SetYear(year string) {
+	if tag.Version == 3 {
+		tag.SetText(ID3TYER, year)
+		return
+	}
+	tag.SetText(ID3TDRC, year)
+}
+
+// UserText returns the value of the TXXX frame with given description.
+func (tag *ID3Tag) UserText(desc string) (string, bool) {
+	for _, frm := range tag.Frames {
+		if frm.ID != ID3TXXX || len(frm.Data) == 0 {
+			continue
+		}
+		d, val := splitTerm(frm.Data[0], frm.Data[1:])
+		if id3Decode(frm.Data[0], d) == desc {
+			return joinNul(id3Decode(frm.Data[0], val)), true
+		}
+	}
+	return "", false
+}
+
+// SetUserText sets the value of the TXXX frame with given description.
+func (tag *ID3Tag) SetUserText(desc, val string) {
+	enc := tag.encodingFor(desc + val)
+	data := []byte{enc}
+	data = append(data, id3Encode(enc, desc)...)
+	data = append(data, id3Term(enc)...)
+	data = append(data, id3Encode(enc, val)...)
+	tag.set(ID3TXXX, data, func(frm *ID3Frame) bool {
+		if len(frm.Data) == 0 {
+			return false
+		}
+		d, _ := splitTerm(frm.Data[0], frm.Data[1:])
+		return id3Decode(frm.Data[0], d) == desc
+	})
+}
+
+// Comment returns language, short description and text of the first
+// COMM frame.
+func (tag *ID3Tag) Comment() (string, string, string) {
+	frm := tag.Frame(ID3COMM)
+	if frm == nil || len(frm.Data) < 4 {
+		return "", "", ""
+	}
+	lang := string(TrimZeroRight(frm.Data[1:4]))
+	desc, txt := splitTerm(frm.Data[0], frm.Data[4:])
+	return lang, id3Decode(frm.Data[0], desc), joinNul(id3Decode(frm.Data[0], txt))
+}
+
+// SetComment sets the first COMM frame. The lang is a three-letter ISO-639-2
+// language code (e.g. "eng").
+func (tag *ID3Tag) SetComment(lang, desc, txt string) {
+	enc := tag.encodingFor(desc + txt)
+	data := []byte{enc}
+	data = append(data, fixed3(lang)...)
+	data = append(data, id3Encode(enc, desc)...)
+	data = append(data, id3Term(enc)...)
+	data = append(data, id3Encode(enc, txt)...)
+	tag.set(ID3COMM, data, func(*ID3Frame) bool { return true })
+}
+
+// Pictures returns all attached pictures.
+func (tag *ID3Tag) Pictures() ([]ID3Picture, error) {
+	var pics []ID3Picture
+	for _, frm := range tag.Frames {
+		if frm.ID != ID3APIC {
+			continue
+		}
+		if len(frm.Data) < 1 {
+			return nil, ErrID3Invalid
+		}
+		enc := frm.Data[0]
+		idx := bytes.IndexByte(frm.Data[1:], 0)
+		if idx < 0 || 1+idx+2 > len(frm.Data) {
+			return nil, ErrID3Invalid
+		}
+		pic := ID3Picture{
+			MIME:        string(frm.Data[1 : 1+idx]),
+			PictureType: frm.Data[1+idx+1],
+		}
+		desc, data := splitTerm(enc, frm.Data[1+idx+2:])
+		pic.Description = id3Decode(enc, desc)
+		pic.Data = data
+		pics = append(pics, pic)
+	}
+	return pics, nil
+}
+
+// AddPicture adds attached picture frame.
+func (tag *ID3Tag) AddPicture(pic ID3Picture) {
+	enc := tag.encodingFor(pic.Description)
+	data := []byte{enc}
+	data = append(data, pic.MIME...)
+	data = append(data, 0, pic.PictureType)
+	data = append(data, id3Encode(enc, pic.Description)...)
+	data = append(data, id3Term(enc)...)
+	data = append(data, pic.Data...)
+	tag.Frames = append(tag.Frames, ID3Frame{ID: ID3APIC, Data: data})
+}
+
+// encodingFor returns text encoding able to represent s in the tag version.
+func (tag *ID3Tag) encodingFor(s string) byte {
+	for _, r := range s {
+		if r > 0xff {
+			if tag.Version == 4 {
+				return ID3EncUTF8
+			}
+			return ID3EncUTF16
+		}
+	}
+	return ID3EncLatin1
+}
+
+// id3Decode decodes text in given ID3 encoding.
+func id3Decode(enc byte, b []byte) string {
+	switch enc {
+	case ID3EncUTF16, ID3EncUTF16BE:
+		bigEndian := enc == ID3EncUTF16BE
+		if len(b) >= 2 && b[0] == 0xff && b[1] == 0xfe {
+			bigEndian, b = false, b[2:]
+		} else if len(b) >= 2 && b[0] == 0xfe && b[1] == 0xff {
+			bigEndian, b = true, b[2:]
+		}
+		u := make([]uint16, len(b)/2)
+		for i := range u {
+			if bigEndian {
+				u[i] = be.Uint16(b[2*i:])
+			} else {
+				u[i] = le.Uint16(b[2*i:])
+			}
+		}
+		return string(utf16.Decode(u))
+
+	case ID3EncUTF8:
+		return string(b)
+
+	default:
+		r := make([]rune, len(b))
+		for i, c := range b {
+			r[i] = rune(c)
+		}
+		return string(r)
+	}
+}
+
+// id3Encode encodes text using given ID3 encoding.
+func id3Encode(enc byte, s string) []byte {
+	switch enc {
+	case ID3EncUTF16:
+		out := []byte{0xff, 0xfe}
+		for _, u := range utf16.Encode([]rune(s)) {
+			out = le.AppendUint16(out, u)
+		}
+		return out
+
+	case ID3EncUTF16BE:
+		var out []byte
+		for _, u := range utf16.Encode([]rune(s)) {
+			out = be.AppendUint16(out, u)
+		}
+		return out
+
+	case ID3EncUTF8:
+		return []byte(s)
+
+	default:
+		out := make([]byte, 0, utf8.RuneCountInString(s))
+		for _, r := range s {
+			out = append(out, byte(r))
+		}
+		return out
+	}
+}
+
+// id3Term returns string terminator for given ID3 encoding.
+func id3Term(enc byte) []byte {
+	if enc == ID3EncUTF16 || enc == ID3EncUTF16BE {
+		return []byte{0, 0}
+	}
+	return []byte{0}
+}
+
+// splitTerm splits b at the first string terminator for given encoding.
+// Returns b and nil if there is no terminator.
+func splitTerm(enc byte, b []byte) ([]byte, []byte) {
+	if enc == ID3EncUTF16 || enc == ID3EncUTF16BE {
+		for i := 0; i+1 < len(b); i += 2 {
+			if b[i] == 0 && b[i+1] == 0 {
+				return b[:i], b[i+2:]
+			}
+		}
+		return b, nil
+	}
+	if idx := bytes.IndexByte(b, 0); idx >= 0 {
+		return b[:idx], b[idx+1:]
+	}
+	return b, nil
+}
+
+// joinNul trims trailing zero characters and joins values separated with
+// zero characters using "/".
+func joinNul(s string) string {
+	s = string(bytes.TrimRight([]byte(s), "\x00"))
+	return string(bytes.ReplaceAll([]byte(s), []byte{0}, []byte{'/'}))
+}
+
+// fixed3 returns s trimmed or zero padded to three bytes.
+func fixed3(s string) []byte {
+	b := make([]byte, 3)
+	copy(b, s)
+	return b
+}
+
+// id3Resync removes unsynchronisation scheme (0xff 0x00 -> 0xff) from b.
+func id3Resync(b []byte) []byte {
+	out := make([]byte, 0, len(b))
+	for i := 0; i < len(b); i++ {
+		out = append(out, b[i])
+		if b[i] == 0xff && i+1 < len(b) && b[i+1] == 0 {
+			i++
+		}
+	}
+	return out
+}
+
+// syncsafe decodes 28-bit synchsafe integer. Returns false if any of
+// the bytes has the most significant bit set.
+func syncsafe(b []byte) (uint32, bool) {
+	var v uint32
+	for _, c := range b[:4] {
+		if c&0x80 != 0 {
+			return 0, false
+		}
+		v = v<<7 | uint32(c)
+	}
+	return v, true
+}
+
+// putSyncsafe encodes v as 28-bit synchsafe integer. Returns false if v
+// doesn't fit in 28 bits.
+func putSyncsafe(b []byte, v uint32) bool {
+	if v >= 1<<28 {
+		return false
+	}
+	for i := 3; i >= 0; i-- {
+		b[i] = byte(v & 0x7f)
+		v >>= 7
+	}
+	return true
+}
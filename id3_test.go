@@ -0,0 +1,380 @@
+package riff
+
+import (
+	"testing"
+
+	"github.com/ctx42/testing/pkg/assert"
+	"github.com/ctx42/testing/pkg/must"
+)
+
+// id3v23 returns ID3v2.3 tag with given header flags and body.
+func id3v23(flags byte, body []byte) []byte {
+	b := []byte{'I', 'D', '3', 3, 0, flags, 0, 0, 0, 0}
+	putSyncsafe(b[6:], uint32(len(body)))
+	return append(b, body...)
+}
+
+func Test_ParseID3(t *testing.T) {
+	// --- Given ---
+	tag := NewID3Tag(3)
+	tag.SetText(ID3TIT2, "Title")
+	tag.SetText(ID3TALB, "Album")
+	tag.Padding = 3
+	b := must.Value(tag.Bytes())
+
+	// --- When ---
+	have, err := ParseID3(b)
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, tag, have)
+}
+
+func Test_ParseID3_v23Unsync(t *testing.T) {
+	// --- Given ---
+	body := []byte{
+		'T', 'I', 'T', '2', 0, 0, 0, 3, 0, 0, // Frame header.
+		0, 'A', 0xff, 0, // Frame data (unsynchronised).
+	}
+	b := id3v23(id3FlagUnsync, body)
+
+	// --- When ---
+	tag, err := ParseID3(b)
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, "Aÿ", tag.Text(ID3TIT2))
+	assert.Equal(t, 0, tag.Padding)
+}
+
+func Test_ParseID3_v23ExtendedHeader(t *testing.T) {
+	// --- Given ---
+	body := []byte{
+		0, 0, 0, 6, 0, 0, 0, 0, 0, 0, // Extended header.
+		'T', 'R', 'C', 'K', 0, 0, 0, 2, 0, 0, // Frame header.
+		0, '7', // Frame data.
+	}
+	b := id3v23(id3FlagExtended, body)
+
+	// --- When ---
+	tag, err := ParseID3(b)
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, "7", tag.Text(ID3TRCK))
+}
+
+func Test_ParseID3_Errors(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		b []byte
+	}{
+		{"too short", []byte("ID3")},
+		{"not ID3", []byte("TAG\x03\x00\x00\x00\x00\x00\x00")},
+		{"v2.2", []byte("ID3\x02\x00\x00\x00\x00\x00\x00")},
+		{"tag size not syncsafe", []byte("ID3\x03\x00\x00\x00\x00\x00\x80")},
+		{"tag too big", []byte("ID3\x03\x00\x00\x00\x00\x00\x01")},
+		{"frame too big", id3v23(0, []byte("TIT2\x00\x00\x00\x09\x00\x00\x00"))},
+		{"extended header too big", id3v23(id3FlagExtended, []byte{0, 0, 0, 9})},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			tag, err := ParseID3(tc.b)
+
+			// --- Then ---
+			assert.Error(t, err)
+			assert.Nil(t, tag)
+		})
+	}
+}
+
+func Test_ID3Tag_Bytes_UnsupportedVersion(t *testing.T) {
+	// --- Given ---
+	tag := NewID3Tag(2)
+
+	// --- When ---
+	b, err := tag.Bytes()
+
+	// --- Then ---
+	assert.ErrorIs(t, ErrUnsupportedFormat, err)
+	assert.Nil(t, b)
+}
+
+func Test_ID3Tag_size(t *testing.T) {
+	// --- Given ---
+	tag := NewID3Tag(4)
+	tag.SetText(ID3TIT2, "Title")
+	tag.Padding = 5
+
+	// --- When ---
+	have := tag.size()
+
+	// --- Then ---
+	assert.Equal(t, len(must.Value(tag.Bytes())), have)
+}
+
+func Test_ID3Tag_clone_equal(t *testing.T) {
+	// --- Given ---
+	tag := NewID3Tag(4)
+	tag.SetText(ID3TIT2, "Title")
+
+	// --- When ---
+	cp := tag.clone()
+
+	// --- Then ---
+	assert.True(t, tag.equal(cp))
+	cp.Frames[0].Data[1] = 'X'
+	assert.False(t, tag.equal(cp))
+	assert.Equal(t, "Title", tag.Text(ID3TIT2))
+}
+
+func Test_ID3Tag_Text(t *testing.T) {
+	t.Run("missing frame", func(t *testing.T) {
+		// --- Given ---
+		tag := NewID3Tag(4)
+
+		// --- When ---
+		have := tag.Text(ID3TIT2)
+
+		// --- Then ---
+		assert.Equal(t, "", have)
+	})
+
+	t.Run("multiple values", func(t *testing.T) {
+		// --- Given ---
+		tag := NewID3Tag(4)
+		tag.Frames = []ID3Frame{{ID: ID3TPE1, Data: []byte("\x03A\x00B\x00")}}
+
+		// --- When ---
+		have := tag.Text(ID3TPE1)
+
+		// --- Then ---
+		assert.Equal(t, "A/B", have)
+	})
+}
+
+func Test_ID3Tag_SetText(t *testing.T) {
+	t.Run("latin1", func(t *testing.T) {
+		// --- Given ---
+		tag := NewID3Tag(4)
+
+		// --- When ---
+		tag.SetText(ID3TIT2, "Café")
+
+		// --- Then ---
+		assert.Equal(t, []byte("\x00Caf\xe9"), tag.Frames[0].Data)
+		assert.Equal(t, "Café", tag.Text(ID3TIT2))
+	})
+
+	t.Run("v2.4 uses UTF-8", func(t *testing.T) {
+		// --- Given ---
+		tag := NewID3Tag(4)
+
+		// --- When ---
+		tag.SetText(ID3TIT2, "Zażółć")
+
+		// --- Then ---
+		assert.Equal(t, ID3EncUTF8, tag.Frames[0].Data[0])
+		assert.Equal(t, "Zażółć", tag.Text(ID3TIT2))
+	})
+
+	t.Run("v2.3 uses UTF-16", func(t *testing.T) {
+		// --- Given ---
+		tag := NewID3Tag(3)
+
+		// --- When ---
+		tag.SetText(ID3TIT2, "Zażółć")
+
+		// --- Then ---
+		assert.Equal(t, ID3EncUTF16, tag.Frames[0].Data[0])
+		assert.Equal(t, "Zażółć", tag.Text(ID3TIT2))
+	})
+
+	t.Run("replaces existing frame", func(t *testing.T) {
+		// --- Given ---
+		tag := NewID3Tag(4)
+		tag.SetText(ID3TIT2, "A")
+
+		// --- When ---
+		tag.SetText(ID3TIT2, "B")
+
+		// --- Then ---
+		assert.Len(t, 1, tag.Frames)
+		assert.Equal(t, "B", tag.Text(ID3TIT2))
+	})
+}
+
+func Test_ID3Tag_Year(t *testing.T) {
+	t.Run("v2.3", func(t *testing.T) {
+		// --- Given ---
+		tag := NewID3Tag(3)
+
+		// --- When ---
+		tag.SetYear("2001")
+
+		// --- Then ---
+		assert.Equal(t, "2001", tag.Text(ID3TYER))
+		assert.Equal(t, "2001", tag.Year())
+	})
+
+	t.Run("v2.4", func(t *testing.T) {
+		// --- Given ---
+		tag := NewID3Tag(4)
+
+		// --- When ---
+		tag.SetText(ID3TDRC, "2001-02-03")
+
+		// --- Then ---
+		assert.Equal(t, "2001", tag.Year())
+	})
+}
+
+func Test_ID3Tag_UserText(t *testing.T) {
+	// --- Given ---
+	tag := NewID3Tag(3)
+	tag.SetUserText("A", "1")
+	tag.SetUserText("Ł", "2")
+
+	// --- When ---
+	tag.SetUserText("A", "3")
+
+	// --- Then ---
+	assert.Len(t, 2, tag.Frames)
+	val, ok := tag.UserText("A")
+	assert.True(t, ok)
+	assert.Equal(t, "3", val)
+	val, ok = tag.UserText("Ł")
+	assert.True(t, ok)
+	assert.Equal(t, "2", val)
+	_, ok = tag.UserText("B")
+	assert.False(t, ok)
+}
+
+func Test_ID3Tag_Comment(t *testing.T) {
+	// --- Given ---
+	tag := NewID3Tag(4)
+
+	// --- When ---
+	tag.SetComment("eng", "desc", "text")
+
+	// --- Then ---
+	lang, desc, txt := tag.Comment()
+	assert.Equal(t, "eng", lang)
+	assert.Equal(t, "desc", desc)
+	assert.Equal(t, "text", txt)
+}
+
+func Test_ID3Tag_Comment_Missing(t *testing.T) {
+	// --- Given ---
+	tag := NewID3Tag(4)
+
+	// --- When ---
+	lang, desc, txt := tag.Comment()
+
+	// --- Then ---
+	assert.Equal(t, "", lang)
+	assert.Equal(t, "", desc)
+	assert.Equal(t, "", txt)
+}
+
+func Test_ID3Tag_Pictures(t *testing.T) {
+	// --- Given ---
+	tag := NewID3Tag(3)
+	pic := ID3Picture{
+		MIME:        "image/png",
+		PictureType: 3,
+		Description: "Okładka",
+		Data:        []byte{0x89, 'P', 'N', 'G', 0, 0},
+	}
+
+	// --- When ---
+	tag.AddPicture(pic)
+
+	// --- Then ---
+	pics, err := tag.Pictures()
+	assert.NoError(t, err)
+	assert.Equal(t, []ID3Picture{pic}, pics)
+}
+
+func Test_ID3Tag_Pictures_Invalid(t *testing.T) {
+	// --- Given ---
+	tag := NewID3Tag(4)
+	tag.Frames = []ID3Frame{{ID: ID3APIC, Data: []byte("\x00image/png")}}
+
+	// --- When ---
+	pics, err := tag.Pictures()
+
+	// --- Then ---
+	assert.ErrorIs(t, ErrID3Invalid, err)
+	assert.Nil(t, pics)
+}
+
+func Test_ID3Tag_Remove(t *testing.T) {
+	// --- Given ---
+	tag := NewID3Tag(4)
+	tag.SetText(ID3TIT2, "A")
+	tag.SetText(ID3TALB, "B")
+
+	// --- When ---
+	tag.Remove(ID3TIT2)
+
+	// --- Then ---
+	assert.Len(t, 1, tag.Frames)
+	assert.Nil(t, tag.Frame(ID3TIT2))
+	assert.Equal(t, "B", tag.Text(ID3TALB))
+}
+
+func Test_id3Decode(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		enc byte
+		b   []byte
+		exp string
+	}{
+		{"latin1", ID3EncLatin1, []byte{'a', 0xe9}, "aé"},
+		{"UTF-16 LE BOM", ID3EncUTF16, []byte{0xff, 0xfe, 'a', 0, 0x42, 0x01}, "ał"},
+		{"UTF-16 BE BOM", ID3EncUTF16, []byte{0xfe, 0xff, 0, 'a', 0x01, 0x42}, "ał"},
+		{"UTF-16BE", ID3EncUTF16BE, []byte{0, 'a', 0x01, 0x42}, "ał"},
+		{"UTF-8", ID3EncUTF8, []byte("ał"), "ał"},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			have := id3Decode(tc.enc, tc.b)
+
+			// --- Then ---
+			assert.Equal(t, tc.exp, have)
+		})
+	}
+}
+
+func Test_id3Encode(t *testing.T) {
+	for _, enc := range []byte{ID3EncUTF16, ID3EncUTF16BE, ID3EncUTF8} {
+		// --- When ---
+		b := id3Encode(enc, "ał")
+
+		// --- Then ---
+		assert.Equal(t, "ał", id3Decode(enc, b))
+	}
+}
+
+func Test_syncsafe(t *testing.T) {
+	// --- Given ---
+	b := make([]byte, 4)
+
+	// --- When ---
+	ok := putSyncsafe(b, 0x0fffffff)
+
+	// --- Then ---
+	assert.True(t, ok)
+	assert.Equal(t, []byte{0x7f, 0x7f, 0x7f, 0x7f}, b)
+	v, ok := syncsafe(b)
+	assert.True(t, ok)
+	assert.Equal(t, uint32(0x0fffffff), v)
+	assert.False(t, putSyncsafe(b, 1<<28))
+}
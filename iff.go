@@ -0,0 +1,282 @@
+package riff
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+)
+
+// IDs of the EA IFF-85 group chunks.
+const (
+	// IDFORM represents "FORM" chunk ID of the EA IFF-85 file.
+	IDFORM uint32 = 0x464f524d
+
+	// IDCAT represents "CAT " (concatenation) chunk ID of the EA IFF-85
+	// file.
+	IDCAT uint32 = 0x43415420
+
+	// IDPROP represents "PROP" (shared properties) chunk ID of the EA
+	// IFF-85 file.
+	IDPROP uint32 = 0x50524f50
+)
+
+// IsIFFGroup returns true if id is one of the EA IFF-85 group chunk IDs:
+// "FORM", "LIST", "CAT " or "PROP".
+func IsIFFGroup(id uint32) bool {
+	return id == IDFORM || id == IDLIST || id == IDCAT || id == IDPROP
+}
+
+// ChunkIFF represents one of the EA IFF-85 group chunks. The chunks
+// differ in what they may contain:
+//
+//   - FORM - the data chunks of the form type and the nested groups,
+//   - PROP - the data chunks shared by the forms of the PROP type,
+//   - LIST - the PROP chunks followed by the FORM, LIST and CAT chunks,
+//   - CAT  - the FORM, LIST and CAT chunks.
+//
+// The data chunks are decoded with the decoders registered for the form
+// type with [Registry.RegisterForm]. The IFF files are always big-endian.
+type ChunkIFF struct {
+	// Chunk ID.
+	id uint32
+
+	// Form type of the FORM and PROP chunks or the hint of the contents
+	// type of the LIST and CAT chunks ("    " when mixed).
+	GroupType uint32
+
+	// Sub chunks.
+	chunks Chunks
+
+	// Group chunk the chunk was decoded from or nil.
+	parent *ChunkIFF
+
+	// Registered chunk decoders.
+	reg *Registry
+
+	// When set to false decoder will try to skip reading the data.
+	load bool
+}
+
+// IFFMake returns [IDMaker] function for creating [ChunkIFF] instances.
+func IFFMake(load bool, reg *Registry) IDMaker {
+	return func(id uint32) Chunk {
+		return IFF(id, load, reg)
+	}
+}
+
+// IFF returns a new instance of [ChunkIFF] for given group chunk ID. If
+// reg is nil, the data chunks are decoded with [ChunkRAWC].
+func IFF(id uint32, load bool, reg *Registry) *ChunkIFF {
+	if reg == nil {
+		reg = NewRegistry(RAWCMake(load))
+	}
+	return &ChunkIFF{
+		id:   id,
+		reg:  reg,
+		load: load,
+	}
+}
+
+// ReadIFF reads the EA IFF-85 file from r. The file is a single FORM, LIST
+// or CAT chunk, its data chunks are decoded with the decoders registered by
+// [New]. It returns [ErrNotIFF] if the file doesn't start with a group
+// chunk ID.
+func ReadIFF(r io.Reader, load bool) (*ChunkIFF, error) {
+	var id uint32
+	if err := ReadChunkID(r, &id); err != nil {
+		return nil, err
+	}
+	if id != IDFORM && id != IDLIST && id != IDCAT {
+		return nil, ErrNotIFF
+	}
+	ch := IFF(id, load, New(load).reg)
+	if _, err := ch.ReadFrom(r); err != nil {
+		return nil, err
+	}
+	return ch, nil
+}
+
+func (ch *ChunkIFF) ID() uint32     { return ch.id }
+func (ch *ChunkIFF) Size() uint32   { return 4 + ch.chunks.Size() }
+func (ch *ChunkIFF) Type() uint32   { return ch.GroupType }
+func (ch *ChunkIFF) Multi() bool    { return true }
+func (ch *ChunkIFF) Chunks() Chunks { return ch.chunks }
+func (ch *ChunkIFF) Raw() bool      { return false }
+
+// Parent returns the group chunk the chunk was decoded from or nil.
+func (ch *ChunkIFF) Parent() *ChunkIFF { return ch.parent }
+
+// Property returns the data chunk with given ID of the FORM chunk. When
+// the form doesn't have the chunk, the PROP chunks of the form type in the
+// enclosing LIST chunks are searched starting from the nearest one. This
+// implements the IFF-85 property inheritance. It returns nil if the chunk
+// is not found.
+func (ch *ChunkIFF) Property(id uint32) Chunk {
+	if sub := ch.chunks.First(id); sub != nil {
+		return sub
+	}
+	for p := ch.parent; p != nil; p = p.parent {
+		if p.id != IDLIST {
+			continue
+		}
+		for _, sub := range p.chunks {
+			prop, ok := sub.(*ChunkIFF)
+			if !ok || prop.id != IDPROP || prop.GroupType != ch.GroupType {
+				continue
+			}
+			if found := prop.chunks.First(id); found != nil {
+				return found
+			}
+		}
+	}
+	return nil
+}
+
+// Forms returns the FORM chunks of given form type nested in the chunk in
+// depth-first order. The chunk itself is included if it matches.
+func (ch *ChunkIFF) Forms(formType uint32) []*ChunkIFF {
+	var forms []*ChunkIFF
+	if ch.id == IDFORM && ch.GroupType == formType {
+		forms = append(forms, ch)
+	}
+	for _, sub := range ch.chunks {
+		if grp, ok := sub.(*ChunkIFF); ok && grp.id != IDPROP {
+			forms = append(forms, grp.Forms(formType)...)
+		}
+	}
+	return forms
+}
+
+func (ch *ChunkIFF) ReadFrom(r io.Reader) (int64, error) {
+	var sum int64
+
+	r = OrderReader(r, be)
+	size, err := ReadChunkSize(r)
+	if err != nil {
+		return sum, fmt.Errorf(errFmtDecode, Uint32(ch.id), err)
+	}
+	sum += 4
+
+	if size < 4 {
+		return sum, fmt.Errorf(errFmtDecode, Uint32(ch.id), ErrTooShort)
+	}
+
+	if err = binary.Read(r, be, &ch.GroupType); err != nil {
+		return sum, fmt.Errorf(errFmtDecode, Uint32(ch.id), err)
+	}
+	sum += 4
+
+	var n int64
+	var id uint32
+	var dec Chunk
+	for sum-4 < int64(size) {
+		if err = ReadChunkID(r, &id); err != nil {
+			return sum, fmt.Errorf(errFmtDecode, Uint32(ch.id), err)
+		}
+		sum += 4
+
+		dec, err = ch.decoder(id)
+		if err != nil {
+			return sum, fmt.Errorf(errFmtDecode, linkids(ch.id, id), err)
+		}
+		dec.Reset()
+
+		n, err = dec.ReadFrom(r)
+		sum += n
+		if err != nil {
+			return sum, fmt.Errorf(errFmtDecode, linkids(ch.id, id), err)
+		}
+		ch.chunks = append(ch.chunks, dec)
+	}
+
+	if sum-4 != int64(size) {
+		return sum, fmt.Errorf(errFmtDecode, Uint32(ch.id), ErrChunkSizeMismatch)
+	}
+
+	n, err = ReadPaddingIf(r, size)
+	sum += n
+	if err != nil {
+		return sum, fmt.Errorf(errFmtDecode, Uint32(ch.id), err)
+	}
+
+	return sum, nil
+}
+
+// decoder returns decoder for the sub-chunk with given ID. It returns
+// [ErrIFFInvalid] if the group chunk can't contain the sub-chunk.
+func (ch *ChunkIFF) decoder(id uint32) (Chunk, error) {
+	group := IsIFFGroup(id)
+	switch {
+	case id == IDPROP && ch.id != IDLIST:
+		return nil, fmt.Errorf("%w: PROP outside of LIST", ErrIFFInvalid)
+	case group && ch.id == IDPROP:
+		return nil, fmt.Errorf("%w: group chunk in PROP", ErrIFFInvalid)
+	case !group && (ch.id == IDLIST || ch.id == IDCAT):
+		return nil, fmt.Errorf("%w: data chunk in %s", ErrIFFInvalid, Uint32(ch.id))
+	}
+
+	if group {
+		sub := IFF(id, ch.load, ch.reg)
+		sub.parent = ch
+		return sub, nil
+	}
+	// Decoders registered for the form type take precedence.
+	if dec := ch.reg.Form(ch.GroupType).GetNoRaw(id); dec != nil {
+		return dec, nil
+	}
+	return ch.reg.Get(id), nil
+}
+
+func (ch *ChunkIFF) WriteTo(w io.Writer) (int64, error) {
+	var sum int64
+	size := ch.Size()
+
+	w = OrderWriter(w, be)
+	n, err := WriteIDAndSize(w, ch.id, size)
+	sum += n
+	if err != nil {
+		return sum, fmt.Errorf(errFmtEncode, Uint32(ch.id), err)
+	}
+
+	if err = binary.Write(w, be, ch.GroupType); err != nil {
+		return sum, fmt.Errorf(errFmtEncode, Uint32(ch.id), err)
+	}
+	sum += 4
+
+	n, err = ch.chunks.WriteTo(w)
+	sum += n
+	if err != nil {
+		return sum, fmt.Errorf(errFmtEncode, Uint32(ch.id), err)
+	}
+
+	n, err = WritePaddingIf(w, size)
+	sum += n
+	if err != nil {
+		return sum, fmt.Errorf(errFmtEncode, Uint32(ch.id), err)
+	}
+
+	return sum, nil
+}
+
+// Reset resets the chunk so it can be reused. The group chunk ID is kept.
+func (ch *ChunkIFF) Reset() {
+	scoped := ch.reg.Form(ch.GroupType)
+	for _, sub := range ch.chunks {
+		if !IsIFFGroup(sub.ID()) {
+			scoped.Put(sub)
+		}
+	}
+	ch.GroupType = 0
+	ch.chunks = ch.chunks[:0]
+}
+
+// Modify set a new set of the chunks. The group chunks in chs become
+// children of the chunk for [ChunkIFF.Property] lookups.
+func (ch *ChunkIFF) Modify(chs Chunks) {
+	ch.chunks = chs
+	for _, sub := range chs {
+		if grp, ok := sub.(*ChunkIFF); ok {
+			grp.parent = ch
+		}
+	}
+}
@@ -0,0 +1,275 @@
+package riff
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/ctx42/testing/pkg/assert"
+	"github.com/ctx42/testing/pkg/kit/iokit"
+	"github.com/ctx42/testing/pkg/must"
+)
+
+// iffChunk returns the big-endian chunk with given ID and data. The odd
+// length data is padded.
+func iffChunk(id string, data ...[]byte) []byte {
+	body := bytes.Join(data, nil)
+	b := append([]byte(id), be.AppendUint32(nil, uint32(len(body)))...)
+	b = append(b, body...)
+	if len(body)%2 != 0 {
+		b = append(b, 0)
+	}
+	return b
+}
+
+// iffGroup returns the group chunk with given ID, type and sub-chunks.
+func iffGroup(id, typ string, chs ...[]byte) []byte {
+	return iffChunk(id, append([][]byte{[]byte(typ)}, chs...)...)
+}
+
+// svxVHDR returns the 8SVX "VHDR" chunk with given number of samples.
+func svxVHDR(samples uint32, comp uint8) []byte {
+	data := be.AppendUint32(nil, samples)
+	data = be.AppendUint32(data, 0)
+	data = be.AppendUint32(data, 0)
+	data = be.AppendUint16(data, 8000)
+	data = append(data, 1, comp)
+	data = be.AppendUint32(data, SVXVolumeUnity)
+	return iffChunk("VHDR", data)
+}
+
+// iffList returns the "LIST 8SVX" file with shared "PROP 8SVX" and two
+// voices, the second one nested in the "CAT " chunk with its own header.
+func iffList() []byte {
+	return iffGroup("LIST", "8SVX",
+		iffGroup("PROP", "8SVX", svxVHDR(3, SVXCompNone)),
+		iffGroup("FORM", "8SVX",
+			iffChunk("NAME", []byte("one")),
+			iffChunk("BODY", []byte{1, 2, 0xff}),
+		),
+		iffGroup("CAT ", "8SVX",
+			iffGroup("FORM", "8SVX",
+				svxVHDR(2, SVXCompFibDelta),
+				iffChunk("BODY", []byte{0, 10, 0x89}),
+			),
+		),
+	)
+}
+
+func Test_IsIFFGroup(t *testing.T) {
+	assert.True(t, IsIFFGroup(IDFORM))
+	assert.True(t, IsIFFGroup(IDLIST))
+	assert.True(t, IsIFFGroup(IDCAT))
+	assert.True(t, IsIFFGroup(IDPROP))
+	assert.False(t, IsIFFGroup(IDRIFF))
+	assert.False(t, IsIFFGroup(IDBODY))
+}
+
+func Test_ChunkIFF_IFF(t *testing.T) {
+	// --- When ---
+	ch := IFF(IDCAT, LoadData, nil)
+
+	// --- Then ---
+	assert.Equal(t, IDCAT, ch.ID())
+	assert.Equal(t, uint32(4), ch.Size())
+	assert.Equal(t, uint32(0), ch.Type())
+	assert.True(t, ch.Multi())
+	assert.Len(t, 0, ch.Chunks())
+	assert.False(t, ch.Raw())
+	assert.Nil(t, ch.Parent())
+	assert.NotNil(t, ch.reg)
+}
+
+func Test_ReadIFF(t *testing.T) {
+	// --- Given ---
+	src := iffList()
+
+	// --- When ---
+	lst, err := ReadIFF(bytes.NewReader(src), LoadData)
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, IDLIST, lst.ID())
+	assert.Equal(t, Type8SVX, lst.Type())
+	assert.Equal(t, uint32(len(src)-8), lst.Size())
+	assert.Equal(t, []uint32{IDPROP, IDFORM, IDCAT}, lst.Chunks().IDs())
+
+	prop := lst.Chunks()[0].(*ChunkIFF)
+	assert.Same(t, lst, prop.Parent())
+	assert.Equal(t, uint32(3), prop.Chunks().First(IDVHDR).(*ChunkVHDR).OneShotHiSamples)
+
+	forms := lst.Forms(Type8SVX)
+	assert.Len(t, 2, forms)
+	assert.Equal(t, "one", forms[0].Chunks().First(IDNAME).(*ChunkTEXT).Text())
+	assert.True(t, forms[0].Chunks().First(IDBODY).Raw())
+
+	dst := &bytes.Buffer{}
+	n, err := lst.WriteTo(dst)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(len(src)), n)
+	assert.Equal(t, src, dst.Bytes())
+}
+
+func Test_ReadIFF_NotIFF(t *testing.T) {
+	// --- Given ---
+	src := iffGroup("PROP", "8SVX")
+
+	// --- When ---
+	have, err := ReadIFF(bytes.NewReader(src), LoadData)
+
+	// --- Then ---
+	assert.ErrorIs(t, ErrNotIFF, err)
+	assert.Nil(t, have)
+}
+
+func Test_ChunkIFF_Property(t *testing.T) {
+	// --- Given ---
+	lst := must.Value(ReadIFF(bytes.NewReader(iffList()), LoadData))
+	forms := lst.Forms(Type8SVX)
+
+	// --- When ---
+	inherited := forms[0].Property(IDVHDR)
+	own := forms[1].Property(IDVHDR)
+
+	// --- Then ---
+	assert.Equal(t, uint32(3), inherited.(*ChunkVHDR).OneShotHiSamples)
+	assert.Equal(t, uint32(2), own.(*ChunkVHDR).OneShotHiSamples)
+	assert.Nil(t, forms[1].Property(IDNAME))
+}
+
+func Test_ChunkIFF_ReadFrom_Errors(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		src []byte
+		exp error
+	}{
+		{
+			"PROP in FORM",
+			iffGroup("FORM", "8SVX", iffGroup("PROP", "8SVX")),
+			ErrIFFInvalid,
+		},
+		{
+			"group in PROP",
+			iffGroup("LIST", "8SVX", iffGroup("PROP", "8SVX", iffGroup("FORM", "8SVX"))),
+			ErrIFFInvalid,
+		},
+		{
+			"data in CAT",
+			iffGroup("CAT ", "8SVX", iffChunk("BODY", []byte{1, 2})),
+			ErrIFFInvalid,
+		},
+		{
+			"data in LIST",
+			iffGroup("LIST", "8SVX", iffChunk("BODY", []byte{1, 2})),
+			ErrIFFInvalid,
+		},
+		{
+			"too short",
+			[]byte{'F', 'O', 'R', 'M', 0, 0, 0, 2, 0, 0},
+			ErrTooShort,
+		},
+		{
+			"size mismatch",
+			[]byte{'F', 'O', 'R', 'M', 0, 0, 0, 6, '8', 'S', 'V', 'X', 'B', 'O', 'D', 'Y', 0, 0, 0, 0},
+			ErrChunkSizeMismatch,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			_, err := ReadIFF(bytes.NewReader(tc.src), LoadData)
+
+			// --- Then ---
+			assert.ErrorIs(t, tc.exp, err)
+		})
+	}
+}
+
+func Test_ChunkIFF_ReadFrom_Truncated(t *testing.T) {
+	src := iffList()
+	for _, i := range []int{4, 6, 10, 20, len(src) - 1} {
+		// --- When ---
+		_, err := ReadIFF(io.LimitReader(bytes.NewReader(src), int64(i)), LoadData)
+
+		// --- Then ---
+		assert.Error(t, err)
+	}
+}
+
+func Test_ChunkIFF_WriteTo_Errors(t *testing.T) {
+	lst := must.Value(ReadIFF(bytes.NewReader(iffList()), LoadData))
+	for _, i := range []int{1, 4, 8, 12, 40} {
+		// --- When ---
+		_, err := lst.WriteTo(iokit.ErrWriter(&bytes.Buffer{}, i))
+
+		// --- Then ---
+		assert.Error(t, err)
+	}
+}
+
+func Test_ChunkIFF_Modify(t *testing.T) {
+	// --- Given ---
+	vhdr := VHDR()
+	prop := IFF(IDPROP, LoadData, nil)
+	prop.GroupType = Type8SVX
+	prop.Modify(Chunks{vhdr})
+
+	form := IFF(IDFORM, LoadData, nil)
+	form.GroupType = Type8SVX
+
+	lst := IFF(IDLIST, LoadData, nil)
+	lst.GroupType = Type8SVX
+
+	// --- When ---
+	lst.Modify(Chunks{prop, form})
+
+	// --- Then ---
+	assert.Same(t, lst, form.Parent())
+	assert.Same(t, vhdr, form.Property(IDVHDR))
+	assert.Equal(t, uint32(4+8+4+8+20+8+4), lst.Size())
+
+	dst := &bytes.Buffer{}
+	must.Value(lst.WriteTo(dst))
+	have := must.Value(ReadIFF(dst, LoadData))
+	assert.Equal(t, lst.Size(), have.Size())
+}
+
+func Test_ChunkIFF_Reset(t *testing.T) {
+	// --- Given ---
+	lst := must.Value(ReadIFF(bytes.NewReader(iffList()), LoadData))
+
+	// --- When ---
+	lst.Reset()
+
+	// --- Then ---
+	assert.Equal(t, IDLIST, lst.ID())
+	assert.Equal(t, uint32(4), lst.Size())
+	assert.Equal(t, uint32(0), lst.Type())
+	assert.Len(t, 0, lst.Chunks())
+}
+
+func Test_RIFF_ReadFrom_IFF_NestedGroups(t *testing.T) {
+	// --- Given ---
+	src := iffGroup("FORM", "ANIM",
+		iffGroup("FORM", "8SVX", svxVHDR(2, SVXCompNone), iffChunk("BODY", []byte{1, 2})),
+		iffChunk("ANNO", []byte("x")),
+	)
+
+	// --- When ---
+	rif := New(LoadData)
+	n, err := rif.ReadFrom(bytes.NewReader(src))
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, int64(len(src)), n)
+	assert.True(t, rif.IFF())
+	form := rif.Chunks().First(IDFORM).(*ChunkIFF)
+	assert.Equal(t, Type8SVX, form.Type())
+	assert.Equal(t, uint32(2), form.Chunks().First(IDVHDR).(*ChunkVHDR).OneShotHiSamples)
+
+	dst := &bytes.Buffer{}
+	must.Value(rif.WriteTo(dst))
+	assert.Equal(t, src, dst.Bytes())
+}
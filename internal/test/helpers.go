@@ -34,6 +34,15 @@ func WriteUint16LE(t *testing.T, dst io.Writer, v uint16) {
 	}
 }
 
+// WriteUint16BE writes v encoded using big endian to dst.
+// Calls t.Fatal() on error.
+func WriteUint16BE(t *testing.T, dst io.Writer, v uint16) {
+	t.Helper()
+	if err := binary.Write(dst, binary.BigEndian, &v); err != nil {
+		t.Fatal(err)
+	}
+}
+
 // ReadFrom writes to dst from src. Calls t.Fatal() on error.
 func ReadFrom(t *testing.T, dst io.ReaderFrom, src io.Reader) int64 {
 	t.Helper()
@@ -0,0 +1,72 @@
+package riff
+
+import (
+	"encoding/binary"
+	"io"
+)
+
+// IDRIFX represents "RIFX" (big-endian RIFF) chunk ID.
+const IDRIFX uint32 = 0x52494658
+
+// Order represents the byte order of the chunk data. Both
+// [binary.LittleEndian] and [binary.BigEndian] implement it.
+type Order interface {
+	binary.ByteOrder
+	binary.AppendByteOrder
+}
+
+// byteOrderer is implemented by readers and writers carrying the byte
+// order of the chunk data.
+type byteOrderer interface {
+	ByteOrder() Order
+}
+
+// orderReader is an [io.Reader] carrying the byte order of the chunk data.
+type orderReader struct {
+	io.Reader
+	order Order
+}
+
+func (r *orderReader) ByteOrder() Order { return r.order }
+
+// orderReadSeeker is an [io.ReadSeeker] carrying the byte order of the
+// chunk data.
+type orderReadSeeker struct {
+	io.ReadSeeker
+	order Order
+}
+
+func (r *orderReadSeeker) ByteOrder() Order { return r.order }
+
+// orderWriter is an [io.Writer] carrying the byte order of the chunk data.
+type orderWriter struct {
+	io.Writer
+	order Order
+}
+
+func (w *orderWriter) ByteOrder() Order { return w.order }
+
+// OrderReader returns the reader decoding chunks from r in given byte
+// order. The returned reader implements [io.Seeker] if r does.
+func OrderReader(r io.Reader, order Order) io.Reader {
+	if rs, ok := r.(io.ReadSeeker); ok {
+		return &orderReadSeeker{ReadSeeker: rs, order: order}
+	}
+	return &orderReader{Reader: r, order: order}
+}
+
+// OrderWriter returns the writer encoding chunks to w in given byte order.
+func OrderWriter(w io.Writer, order Order) io.Writer {
+	return &orderWriter{Writer: w, order: order}
+}
+
+// ByteOrder returns the byte order of the chunk data read from or written
+// to rw. It's little-endian unless rw was returned by [OrderReader] or
+// [OrderWriter]. Chunk decoders and encoders use it for all multibyte
+// fields, so one set of chunk types handles both RIFF and RIFX files.
+func ByteOrder(rw any) Order {
+	if bo, ok := rw.(byteOrderer); ok {
+		return bo.ByteOrder()
+	}
+	return le
+}
@@ -0,0 +1,54 @@
+package riff
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"testing"
+
+	"github.com/ctx42/testing/pkg/assert"
+)
+
+func Test_OrderReader(t *testing.T) {
+	t.Run("seeker", func(t *testing.T) {
+		// --- When ---
+		r := OrderReader(bytes.NewReader([]byte{1, 2}), binary.BigEndian)
+
+		// --- Then ---
+		assert.Equal(t, binary.BigEndian, ByteOrder(r))
+		_, ok := r.(io.Seeker)
+		assert.True(t, ok)
+	})
+
+	t.Run("not seeker", func(t *testing.T) {
+		// --- When ---
+		r := OrderReader(&bytes.Buffer{}, binary.BigEndian)
+
+		// --- Then ---
+		assert.Equal(t, binary.BigEndian, ByteOrder(r))
+		_, ok := r.(io.Seeker)
+		assert.False(t, ok)
+	})
+}
+
+func Test_OrderWriter(t *testing.T) {
+	// --- Given ---
+	dst := &bytes.Buffer{}
+
+	// --- When ---
+	w := OrderWriter(dst, binary.BigEndian)
+
+	// --- Then ---
+	assert.Equal(t, binary.BigEndian, ByteOrder(w))
+	_, err := w.Write([]byte{1})
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{1}, dst.Bytes())
+}
+
+func Test_ByteOrder_Default(t *testing.T) {
+	// --- When ---
+	have := ByteOrder(&bytes.Buffer{})
+
+	// --- Then ---
+	assert.Equal(t, binary.LittleEndian, have)
+}
@@ -0,0 +1,152 @@
+package riff
+
+import (
+	"fmt"
+	"math"
+)
+
+// DefaultPeakBlockSize is the default number of audio frames per peak frame.
+const DefaultPeakBlockSize uint32 = 256
+
+// GeneratePeaks computes the peak envelope of the waveform data described
+// by cf and returns it as [ChunkLEVL]. The samples are decoded in the
+// given byte order (see [RIFF.ByteOrder]). The format must be one of
+// [PeakFormat8] or [PeakFormat16], the pointsPerValue must be 1 (positive
+// peaks only) or 2 (positive and negative peaks). Each peak frame covers
+// blockSize audio frames. The peak points are absolute sample values
+// scaled to the full range of the peak format.
+//
+// Supported waveform formats are 8, 16, 24 and 32-bit integer PCM and
+// 32-bit IEEE float.
+func GeneratePeaks(data *ChunkDATA, cf *ChunkFMT, order Order, format, pointsPerValue, blockSize uint32) (*ChunkLEVL, error) {
+	if data.data == nil {
+		return nil, ErrSkipDataMode
+	}
+
+	var scale float64
+	switch format {
+	case PeakFormat8:
+		scale = math.MaxUint8
+	case PeakFormat16:
+		scale = math.MaxUint16
+	default:
+		return nil, fmt.Errorf("peak format %d: %w", format, ErrUnsupportedFormat)
+	}
+	if pointsPerValue != 1 && pointsPerValue != 2 {
+		return nil, fmt.Errorf("points per value %d: %w", pointsPerValue, ErrOutOfRange)
+	}
+	if blockSize == 0 {
+		return nil, fmt.Errorf("block size: %w", ErrOutOfRange)
+	}
+
+	dec, err := sampleDecoder(cf, order)
+	if err != nil {
+		return nil, err
+	}
+
+	chn := int(cf.ChannelCnt)
+	ba := int(cf.BlockAlign)
+	bps := ba / chn
+	frames := len(data.data) / ba
+
+	ch := LEVL()
+	ch.Format = format
+	ch.PointsPerValue = pointsPerValue
+	ch.BlockSize = blockSize
+	ch.PeakChannels = uint32(chn)
+	ch.PosPeakOfPeaks = math.MaxUint32
+
+	var pop float64 // Peak of peaks.
+	pos := make([]float64, chn)
+	neg := make([]float64, chn)
+	for start := 0; start < frames; start += int(blockSize) {
+		end := min(start+int(blockSize), frames)
+		clear(pos)
+		clear(neg)
+
+		for frm := start; frm < end; frm++ {
+			for c := 0; c < chn; c++ {
+				off := frm*ba + c*bps
+				v := dec(data.data[off : off+bps])
+				pos[c] = max(pos[c], v)
+				neg[c] = max(neg[c], -v)
+				if math.Abs(v) > pop {
+					pop = math.Abs(v)
+					ch.PosPeakOfPeaks = uint32(frm)
+				}
+			}
+		}
+
+		for c := 0; c < chn; c++ {
+			ch.Peaks = append(ch.Peaks, peakPoint(pos[c], scale))
+			if pointsPerValue == 2 {
+				ch.Peaks = append(ch.Peaks, peakPoint(neg[c], scale))
+			}
+		}
+		ch.PeakFrames++
+	}
+
+	if err = ch.updateSize(); err != nil {
+		return nil, err
+	}
+	return ch, nil
+}
+
+// peakPoint scales normalized absolute sample value v to the peak
+// point range.
+func peakPoint(v, scale float64) uint16 {
+	return uint16(math.Round(min(v, 1) * scale))
+}
+
+// sampleDecoder returns function decoding a single sample in the order
+// byte order to the value in range [-1, 1].
+func sampleDecoder(cf *ChunkFMT, order Order) (func(b []byte) float64, error) {
+	if cf.ChannelCnt == 0 || cf.BlockAlign == 0 ||
+		int(cf.BlockAlign)%int(cf.ChannelCnt) != 0 {
+		return nil, fmt.Errorf("invalid fmt block align: %w", ErrUnsupportedFormat)
+	}
+	bps := int(cf.BlockAlign) / int(cf.ChannelCnt)
+
+	switch cf.CompCode {
+	case CompNone, CompPCM, CompExtensible:
+		switch bps {
+		case 1:
+			return func(b []byte) float64 {
+				return (float64(b[0]) - 128) / 128
+			}, nil
+		case 2:
+			return func(b []byte) float64 {
+				return float64(int16(order.Uint16(b))) / (1 << 15)
+			}, nil
+		case 3:
+			if order == be {
+				return func(b []byte) float64 {
+					v := int32(uint32(b[0])<<24|uint32(b[1])<<16|uint32(b[2])<<8) >> 8
+					return float64(v) / (1 << 23)
+				}, nil
+			}
+			return func(b []byte) float64 {
+				v := int32(uint32(b[0])<<8|uint32(b[1])<<16|uint32(b[2])<<24) >> 8
+				return float64(v) / (1 << 23)
+			}, nil
+		case 4:
+			return func(b []byte) float64 {
+				return float64(int32(order.Uint32(b))) / (1 << 31)
+			}, nil
+		}
+
+	case CompFloat:
+		if bps == 4 {
+			return func(b []byte) float64 {
+				return float64(math.Float32frombits(order.Uint32(b)))
+			}, nil
+		}
+	}
+
+	return nil, fmt.Errorf(
+		"%w: 0x%04x with %d bytes per sample",
+		ErrUnsupportedFormat,
+		cf.CompCode,
+		bps,
+	)
+}
@@ -0,0 +1,186 @@
+package riff
+
+import (
+	"math"
+	"os"
+	"testing"
+
+	"github.com/ctx42/testing/pkg/assert"
+	"github.com/ctx42/testing/pkg/must"
+)
+
+func Test_GeneratePeaks_16bitStereo(t *testing.T) {
+	// --- Given ---
+	cf := FMT()
+	cf.CompCode = CompPCM
+	cf.ChannelCnt = 2
+	cf.BlockAlign = 4
+	cf.BitsPerSample = 16
+
+	data := DATA(LoadData)
+	body := []byte{
+		0x00, 0x40, 0x00, 0x00, // L: 16384, R: 0
+		0x00, 0x80, 0xff, 0x7f, // L: -32768, R: 32767
+		0x00, 0x00, 0x00, 0xc0, // L: 0, R: -16384
+	}
+	assert.NoError(t, data.SetData(body))
+
+	// --- When ---
+	ch, err := GeneratePeaks(data, cf, le, PeakFormat16, 2, 2)
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, PeakFormat16, ch.Format)
+	assert.Equal(t, uint32(2), ch.PointsPerValue)
+	assert.Equal(t, uint32(2), ch.BlockSize)
+	assert.Equal(t, uint32(2), ch.PeakChannels)
+	assert.Equal(t, uint32(2), ch.PeakFrames)
+	assert.Equal(t, uint32(1), ch.PosPeakOfPeaks)
+	exp := []uint16{
+		32768, 65535, 65533, 0, // Frame 0: L+, L-, R+, R-
+		0, 0, 0, 32768, // Frame 1: L+, L-, R+, R-
+	}
+	assert.Equal(t, exp, ch.Peaks)
+	assert.Equal(t, LEVLChunkSize+16, ch.Size())
+}
+
+func Test_GeneratePeaks_8bitPositiveOnly(t *testing.T) {
+	// --- Given ---
+	data := DATA(LoadData)
+	assert.NoError(t, data.SetData([]byte{0x80, 0xff, 0x00, 0xc0}))
+
+	// --- When ---
+	ch, err := GeneratePeaks(data, fmt8bitMono(), le, PeakFormat8, 1, 3)
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(2), ch.PeakFrames)
+	assert.Equal(t, uint32(2), ch.PosPeakOfPeaks)
+	assert.Equal(t, []uint16{253, 128}, ch.Peaks)
+	assert.Equal(t, LEVLChunkSize+2, ch.Size())
+}
+
+func Test_GeneratePeaks_Float(t *testing.T) {
+	// --- Given ---
+	cf := FMT()
+	cf.CompCode = CompFloat
+	cf.ChannelCnt = 1
+	cf.BlockAlign = 4
+	cf.BitsPerSample = 32
+
+	data := DATA(LoadData)
+	body := le.AppendUint32(nil, math.Float32bits(0.5))
+	body = le.AppendUint32(body, math.Float32bits(-2))
+	assert.NoError(t, data.SetData(body))
+
+	// --- When ---
+	ch, err := GeneratePeaks(data, cf, le, PeakFormat8, 2, 256)
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, []uint16{128, 255}, ch.Peaks)
+}
+
+func Test_GeneratePeaks_File(t *testing.T) {
+	// --- Given ---
+	rif := New(LoadData)
+	_, err := rif.ReadFrom(must.Value(os.Open("testdata/kick-16b441k.wav")))
+	assert.NoError(t, err)
+	cf := rif.Chunks().First(IDfmt).(*ChunkFMT)
+	data := rif.Chunks().First(IDdata).(*ChunkDATA)
+
+	// --- When ---
+	ch, err := GeneratePeaks(data, cf, rif.ByteOrder(), PeakFormat16, 2, DefaultPeakBlockSize)
+
+	// --- Then ---
+	assert.NoError(t, err)
+	frames := data.Size() / uint32(cf.BlockAlign)
+	exp := (frames + DefaultPeakBlockSize - 1) / DefaultPeakBlockSize
+	assert.Equal(t, exp, ch.PeakFrames)
+	assert.Len(t, int(exp*uint32(cf.ChannelCnt)*2), ch.Peaks)
+}
+
+func Test_GeneratePeaks_Errors(t *testing.T) {
+	data := DATA(LoadData)
+
+	t.Run("skip data mode", func(t *testing.T) {
+		// --- When ---
+		_, err := GeneratePeaks(DATA(SkipData), fmt8bitMono(), le, PeakFormat8, 1, 1)
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrSkipDataMode, err)
+	})
+
+	t.Run("peak format", func(t *testing.T) {
+		// --- When ---
+		_, err := GeneratePeaks(data, fmt8bitMono(), le, 3, 1, 1)
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrUnsupportedFormat, err)
+	})
+
+	t.Run("points per value", func(t *testing.T) {
+		// --- When ---
+		_, err := GeneratePeaks(data, fmt8bitMono(), le, PeakFormat8, 3, 1)
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrOutOfRange, err)
+	})
+
+	t.Run("block size", func(t *testing.T) {
+		// --- When ---
+		_, err := GeneratePeaks(data, fmt8bitMono(), le, PeakFormat8, 1, 0)
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrOutOfRange, err)
+	})
+
+	t.Run("compressed", func(t *testing.T) {
+		// --- Given ---
+		cf := fmt8bitMono()
+		cf.CompCode = 0x0011
+
+		// --- When ---
+		_, err := GeneratePeaks(data, cf, le, PeakFormat8, 1, 1)
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrUnsupportedFormat, err)
+	})
+}
+
+func Test_sampleDecoder(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		code  uint16
+		order Order
+		b     []byte
+		exp   float64
+	}{
+		{"16-bit le", CompPCM, le, []byte{0x00, 0xc0}, -0.5},
+		{"16-bit be", CompPCM, be, []byte{0xc0, 0x00}, -0.5},
+		{"24-bit le", CompPCM, le, []byte{0x00, 0x00, 0x40}, 0.5},
+		{"24-bit be", CompPCM, be, []byte{0x40, 0x00, 0x00}, 0.5},
+		{"32-bit le", CompPCM, le, []byte{0x00, 0x00, 0x00, 0xc0}, -0.5},
+		{"32-bit be", CompPCM, be, []byte{0xc0, 0x00, 0x00, 0x00}, -0.5},
+		{"float le", CompFloat, le, []byte{0x00, 0x00, 0x00, 0x3f}, 0.5},
+		{"float be", CompFloat, be, []byte{0x3f, 0x00, 0x00, 0x00}, 0.5},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- Given ---
+			cf := FMT()
+			cf.CompCode = tc.code
+			cf.ChannelCnt = 1
+			cf.BlockAlign = uint16(len(tc.b))
+
+			// --- When ---
+			dec, err := sampleDecoder(cf, tc.order)
+
+			// --- Then ---
+			assert.NoError(t, err)
+			assert.Equal(t, tc.exp, dec(tc.b))
+		})
+	}
+}
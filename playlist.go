@@ -0,0 +1,100 @@
+package riff
+
+import (
+	"fmt"
+	"math"
+)
+
+// PlayRange represents a contiguous range of waveform data to play.
+type PlayRange struct {
+	// The ID of the cue point the range starts at.
+	CuePointID uint32
+
+	// The first sample frame of the range.
+	Sample uint32
+
+	// The number of sample frames in the range.
+	Samples uint32
+
+	// The byte offset of the range in the data chunk.
+	Offset uint32
+
+	// The length of the range in bytes.
+	Length uint32
+
+	// The number of times to play the range.
+	Loops uint32
+}
+
+// Resolve resolves playlist segments to the concrete ranges of the
+// waveform data in playback order. Segments with zero loops are skipped.
+//
+// The cue points are expected to point to the single data chunk
+// (not a wave list).
+func (ch *ChunkPLST) Resolve(cue *ChunkCUE, cf *ChunkFMT, data *ChunkDATA) ([]PlayRange, error) {
+	if cf.BlockAlign == 0 {
+		return nil, fmt.Errorf("invalid fmt block align: %w", ErrOutOfRange)
+	}
+	ba := uint64(cf.BlockAlign)
+
+	var rngs []PlayRange
+	for _, seg := range ch.Segments {
+		cp, ok := cue.Point(seg.CuePointID)
+		if !ok {
+			return nil, fmt.Errorf("%w: %d", ErrCueNotFound, seg.CuePointID)
+		}
+
+		off := uint64(cp.BlockStart) + uint64(cp.SampleOffset)*ba
+		length := uint64(seg.Length) * ba
+		if off+length > uint64(data.Size()) {
+			return nil, fmt.Errorf("cue point %d: %w", cp.ID, ErrOutOfRange)
+		}
+
+		rng := PlayRange{
+			CuePointID: cp.ID,
+			Sample:     uint32(off / ba),
+			Samples:    seg.Length,
+			Offset:     uint32(off),
+			Length:     uint32(length),
+			Loops:      seg.Loops,
+		}
+		if rng.Loops > 0 {
+			rngs = append(rngs, rng)
+		}
+	}
+	return rngs, nil
+}
+
+// Render renders ranges of data, each repeated its number of loops, into
+// a new contiguous [ChunkDATA]. It returns [ErrSkipDataMode] if data was
+// decoded in [SkipData] mode and [ErrOutOfRange] if the rendered data
+// doesn't fit in the chunk.
+func Render(rngs []PlayRange, data *ChunkDATA) (*ChunkDATA, error) {
+	if data.data == nil {
+		return nil, ErrSkipDataMode
+	}
+
+	var size uint64
+	for _, rng := range rngs {
+		if uint64(rng.Offset)+uint64(rng.Length) > uint64(len(data.data)) {
+			return nil, fmt.Errorf("cue point %d: %w", rng.CuePointID, ErrOutOfRange)
+		}
+		size += uint64(rng.Length) * uint64(rng.Loops)
+		if size > math.MaxUint32 {
+			return nil, fmt.Errorf("cue point %d: %w", rng.CuePointID, ErrOutOfRange)
+		}
+	}
+
+	buf := make([]byte, 0, size)
+	for _, rng := range rngs {
+		for i := uint32(0); i < rng.Loops; i++ {
+			buf = append(buf, data.data[rng.Offset:rng.Offset+rng.Length]...)
+		}
+	}
+
+	dst := DATA(LoadData)
+	if err := dst.SetData(buf); err != nil {
+		return nil, err
+	}
+	return dst, nil
+}
@@ -0,0 +1,163 @@
+package riff
+
+import (
+	"io"
+	"testing"
+
+	"github.com/ctx42/testing/pkg/assert"
+	"github.com/ctx42/testing/pkg/must"
+
+	"github.com/rzajac/riff/internal/test"
+)
+
+// playlistFixture returns decoded plst, cue, fmt and data chunks. The data
+// chunk holds four 16-bit mono samples.
+func playlistFixture(t *testing.T) (*ChunkPLST, *ChunkCUE, *ChunkFMT, *ChunkDATA) {
+	t.Helper()
+
+	src := plstChunkTwoSegments(t)
+	test.Skip4B(t, src) // Skip chunk ID.
+	plst := PLST()
+	_, err := plst.ReadFrom(src)
+	assert.NoError(t, err)
+
+	src = cueChunkTwoPoints(t)
+	test.Skip4B(t, src) // Skip chunk ID.
+	cue := CUE()
+	_, err = cue.ReadFrom(src)
+	assert.NoError(t, err)
+
+	cf := FMT()
+	cf.CompCode = CompPCM
+	cf.ChannelCnt = 1
+	cf.BlockAlign = 2
+	cf.BitsPerSample = 16
+
+	data := DATA(LoadData)
+	assert.NoError(t, data.SetData([]byte{0, 0, 1, 1, 2, 2, 3, 3}))
+
+	return plst, cue, cf, data
+}
+
+func Test_ChunkPLST_Resolve(t *testing.T) {
+	// --- Given ---
+	plst, cue, cf, data := playlistFixture(t)
+
+	// --- When ---
+	have, err := plst.Resolve(cue, cf, data)
+
+	// --- Then ---
+	assert.NoError(t, err)
+	exp := []PlayRange{
+		{CuePointID: 2, Sample: 3, Samples: 1, Offset: 6, Length: 2, Loops: 2},
+		{CuePointID: 1, Sample: 0, Samples: 3, Offset: 0, Length: 6, Loops: 1},
+	}
+	assert.Equal(t, exp, have)
+}
+
+func Test_ChunkPLST_Resolve_ManyLoops(t *testing.T) {
+	// --- Given ---
+	plst, cue, cf, data := playlistFixture(t)
+	plst.Segments[0].Loops = 0xFFFFFFFF
+	plst.Segments[1].Loops = 0
+
+	// --- When ---
+	have, err := plst.Resolve(cue, cf, data)
+
+	// --- Then ---
+	assert.NoError(t, err)
+	exp := []PlayRange{
+		{CuePointID: 2, Sample: 3, Samples: 1, Offset: 6, Length: 2, Loops: 0xFFFFFFFF},
+	}
+	assert.Equal(t, exp, have)
+}
+
+func Test_ChunkPLST_Resolve_CueNotFound(t *testing.T) {
+	// --- Given ---
+	plst, cue, cf, data := playlistFixture(t)
+	plst.Segments[0].CuePointID = 100
+
+	// --- When ---
+	have, err := plst.Resolve(cue, cf, data)
+
+	// --- Then ---
+	assert.ErrorIs(t, ErrCueNotFound, err)
+	assert.Nil(t, have)
+}
+
+func Test_ChunkPLST_Resolve_OutOfRange(t *testing.T) {
+	// --- Given ---
+	plst, cue, cf, data := playlistFixture(t)
+	plst.Segments[0].Length = 2
+
+	// --- When ---
+	have, err := plst.Resolve(cue, cf, data)
+
+	// --- Then ---
+	assert.ErrorIs(t, ErrOutOfRange, err)
+	assert.Nil(t, have)
+}
+
+func Test_ChunkPLST_Resolve_ZeroBlockAlign(t *testing.T) {
+	// --- Given ---
+	plst, cue, cf, data := playlistFixture(t)
+	cf.BlockAlign = 0
+
+	// --- When ---
+	have, err := plst.Resolve(cue, cf, data)
+
+	// --- Then ---
+	assert.ErrorIs(t, ErrOutOfRange, err)
+	assert.Nil(t, have)
+}
+
+func Test_Render(t *testing.T) {
+	// --- Given ---
+	plst, cue, cf, data := playlistFixture(t)
+	rngs, err := plst.Resolve(cue, cf, data)
+	assert.NoError(t, err)
+
+	// --- When ---
+	have, err := Render(rngs, data)
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(10), have.Size())
+	exp := []byte{3, 3, 3, 3, 0, 0, 1, 1, 2, 2}
+	assert.Equal(t, exp, must.Value(io.ReadAll(have.Data())))
+}
+
+func Test_Render_SkipDataMode(t *testing.T) {
+	// --- When ---
+	have, err := Render(nil, DATA(SkipData))
+
+	// --- Then ---
+	assert.ErrorIs(t, ErrSkipDataMode, err)
+	assert.Nil(t, have)
+}
+
+func Test_Render_OutOfRange(t *testing.T) {
+	// --- Given ---
+	_, _, _, data := playlistFixture(t)
+	rngs := []PlayRange{{CuePointID: 1, Offset: 6, Length: 4, Loops: 1}}
+
+	// --- When ---
+	have, err := Render(rngs, data)
+
+	// --- Then ---
+	assert.ErrorIs(t, ErrOutOfRange, err)
+	assert.Nil(t, have)
+}
+
+func Test_Render_TooBig(t *testing.T) {
+	// --- Given ---
+	_, _, _, data := playlistFixture(t)
+	rngs := []PlayRange{{CuePointID: 1, Offset: 0, Length: 8, Loops: 0xFFFFFFFF}}
+
+	// --- When ---
+	have, err := Render(rngs, data)
+
+	// --- Then ---
+	assert.ErrorIs(t, ErrOutOfRange, err)
+	assert.Nil(t, have)
+}
@@ -10,6 +10,16 @@ type Registry struct {
 
 	// Maker for raw chunk decoder.
 	raw IDMaker
+
+	// Maker for decoders of chunk IDs without registered Maker functions
+	// (e.g. "LIST INFO" sub-chunks). It's nil when not set.
+	def IDMaker
+
+	// Registries of LIST sub-chunk decoders scoped to the list type.
+	lists map[uint32]*Registry
+
+	// Registries of chunk decoders scoped to the RIFF form type.
+	forms map[uint32]*Registry
 }
 
 // NewRegistry returns a new instance of [Registry].
@@ -18,6 +28,8 @@ func NewRegistry(raw IDMaker) *Registry {
 		makers: make(map[uint32]Maker, 4),
 		pool:   make(map[uint32][]Chunk, 4),
 		raw:    raw,
+		lists:  make(map[uint32]*Registry),
+		forms:  make(map[uint32]*Registry),
 	}
 }
 
@@ -32,6 +44,64 @@ func (reg *Registry) Register(id uint32, maker Maker) {
 	reg.makers[id] = maker
 }
 
+// RegisterList registers chunk decoder Maker function for the sub-chunk
+// of the LIST chunk of given type. The decoder is used only for sub-chunks
+// of that list type.
+func (reg *Registry) RegisterList(listType, id uint32, maker Maker) {
+	reg.List(listType).Register(id, maker)
+}
+
+// RegisterListDefault registers chunk decoder IDMaker function for the
+// sub-chunks of the LIST chunk of given type without decoders registered
+// with [Registry.RegisterList] (e.g. all the "LIST INFO" sub-chunks).
+func (reg *Registry) RegisterListDefault(listType uint32, maker IDMaker) {
+	reg.List(listType).def = maker
+}
+
+// HasList returns true if decoder for given sub-chunk ID of the LIST chunk
+// of given type is registered with [Registry.RegisterList] or
+// [Registry.RegisterListDefault].
+func (reg *Registry) HasList(listType, id uint32) bool {
+	scoped, ok := reg.lists[listType]
+	return ok && (scoped.Has(id) || scoped.def != nil)
+}
+
+// List returns registry scoped to the LIST chunk of given type. The
+// registry is created if it doesn't exist.
+func (reg *Registry) List(listType uint32) *Registry {
+	return reg.scoped(reg.lists, listType)
+}
+
+// RegisterForm registers chunk decoder Maker function for the chunk of the
+// RIFF file of given form type (e.g. [TypeWAVE]). The decoder is used only
+// for files of that form type.
+func (reg *Registry) RegisterForm(formType, id uint32, maker Maker) {
+	reg.Form(formType).Register(id, maker)
+}
+
+// HasForm returns true if decoder for given chunk ID of the RIFF file of
+// given form type is registered.
+func (reg *Registry) HasForm(formType, id uint32) bool {
+	scoped, ok := reg.forms[formType]
+	return ok && scoped.Has(id)
+}
+
+// Form returns registry scoped to the RIFF file of given form type. The
+// registry is created if it doesn't exist.
+func (reg *Registry) Form(formType uint32) *Registry {
+	return reg.scoped(reg.forms, formType)
+}
+
+// scoped returns registry for the key from m creating it if needed.
+func (reg *Registry) scoped(m map[uint32]*Registry, key uint32) *Registry {
+	scoped, ok := m[key]
+	if !ok {
+		scoped = NewRegistry(reg.raw)
+		m[key] = scoped
+	}
+	return scoped
+}
+
 // Put chunk decoder back to the pool so it can be reused.
 func (reg *Registry) Put(ch Chunk) {
 	id := ch.ID()
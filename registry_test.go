@@ -52,3 +52,60 @@ func Test_Registry_GetNoRaw(t *testing.T) {
 	// --- Then ---
 	assert.Nil(t, ch0)
 }
+
+func Test_Registry_RegisterList(t *testing.T) {
+	// --- Given ---
+	reg := NewRegistry(RAWCMake(LoadData))
+
+	// --- When ---
+	reg.RegisterList(IDadtl, IDlabl, LABLMake)
+
+	// --- Then ---
+	assert.True(t, reg.HasList(IDadtl, IDlabl))
+	assert.False(t, reg.HasList(IDINFO, IDlabl))
+	assert.False(t, reg.HasList(IDadtl, IDltxt))
+	assert.False(t, reg.Has(IDlabl))
+	assert.Type(t, &ChunkLABL{}, reg.List(IDadtl).Get(IDlabl))
+	assert.Type(t, &ChunkRAWC{}, reg.Get(IDlabl))
+}
+
+func Test_Registry_RegisterListDefault(t *testing.T) {
+	// --- Given ---
+	reg := NewRegistry(RAWCMake(LoadData))
+
+	// --- When ---
+	reg.RegisterListDefault(IDINFO, INFOMake(LoadData))
+
+	// --- Then ---
+	assert.True(t, reg.HasList(IDINFO, 0x49434d54))
+	assert.False(t, reg.HasList(IDadtl, 0x49434d54))
+	assert.Type(t, &ChunkINFO{}, reg.List(IDINFO).def(0x49434d54)) // ICMT
+}
+
+func Test_Registry_List(t *testing.T) {
+	// --- Given ---
+	reg := NewRegistry(RAWCMake(LoadData))
+
+	// --- When ---
+	have := reg.List(IDadtl)
+
+	// --- Then ---
+	assert.Same(t, have, reg.List(IDadtl))
+	assert.NotSame(t, have, reg.List(IDINFO))
+	assert.Type(t, &ChunkRAWC{}, have.Get(IDlabl))
+}
+
+func Test_Registry_RegisterForm(t *testing.T) {
+	// --- Given ---
+	reg := NewRegistry(RAWCMake(LoadData))
+
+	// --- When ---
+	reg.RegisterForm(TypeWAVE, IDfmt, FMTMake)
+
+	// --- Then ---
+	assert.True(t, reg.HasForm(TypeWAVE, IDfmt))
+	assert.False(t, reg.HasForm(TypeAVI, IDfmt))
+	assert.False(t, reg.Has(IDfmt))
+	assert.Type(t, &ChunkFMT{}, reg.Form(TypeWAVE).Get(IDfmt))
+	assert.Same(t, reg.Form(TypeWAVE), reg.Form(TypeWAVE))
+}
@@ -0,0 +1,219 @@
+package riff
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"math"
+)
+
+// IDds64 represents "ds64" (RF64 64-bit sizes) chunk ID.
+const IDds64 uint32 = 0x64733634
+
+// DS64ChunkSize represents the size of the "ds64" chunk static part in
+// bytes (without the table).
+const DS64ChunkSize uint32 = 28
+
+// rf64SizeMax represents the 32-bit size marking the size stored in the
+// "ds64" chunk.
+const rf64SizeMax uint32 = math.MaxUint32
+
+// ds64Entry represents the "ds64" table entry with the 64-bit size of the
+// chunk other than "data".
+type ds64Entry struct {
+	id   uint32
+	size uint64
+}
+
+// ReadRF64 reads the RF64 (EBU Tech 3306) or BW64 (ITU-R BS.2088) file from
+// r. The chunks are decoded with decoders registered by [New]. The chunks
+// of 4 GiB or more (e.g. "data" chunks of long recordings) are kept as
+// [ChunkW64] with the GUID made from the chunk ID (see [W64GUID]), so the
+// file can be converted to the Wave64 file with [WriteW64] and back with
+// [WriteRF64] without loss.
+func ReadRF64(r io.Reader, load bool) (*RIFF, error) {
+	var id uint32
+	if err := ReadChunkID(r, &id); err != nil {
+		return nil, err
+	}
+	if id != IDRF64 && id != IDBW64 {
+		return nil, ErrNotRF64
+	}
+
+	var head [16]byte
+	if _, err := io.ReadFull(r, head[:]); err != nil {
+		return nil, fmt.Errorf(errFmtDecode, Uint32(id), err)
+	}
+	if be.Uint32(head[8:]) != IDds64 {
+		return nil, fmt.Errorf("expected %s chunk got %s: %w", Uint32(IDds64), Uint32(be.Uint32(head[8:])), ErrNotRF64)
+	}
+
+	rif := New(load)
+	rif.riffType = be.Uint32(head[4:])
+
+	ds64Size := le.Uint32(head[12:])
+	if ds64Size < DS64ChunkSize {
+		return nil, fmt.Errorf(errFmtDecode, Uint32(IDds64), ErrTooShort)
+	}
+	ds64 := make([]byte, DS64ChunkSize)
+	if _, err := io.ReadFull(r, ds64); err != nil {
+		return nil, fmt.Errorf(errFmtDecode, Uint32(IDds64), err)
+	}
+	riffSize := le.Uint64(ds64)
+	dataSize := le.Uint64(ds64[8:])
+	cnt := le.Uint32(ds64[24:])
+	if uint64(cnt)*12 > uint64(ds64Size-DS64ChunkSize) {
+		return nil, fmt.Errorf(errFmtDecode, Uint32(IDds64), ErrTooShort)
+	}
+	table := make(map[uint32][]uint64)
+	for i := uint32(0); i < cnt; i++ {
+		var e [12]byte
+		if _, err := io.ReadFull(r, e[:]); err != nil {
+			return nil, fmt.Errorf(errFmtDecode, Uint32(IDds64), err)
+		}
+		eid := be.Uint32(e[:])
+		table[eid] = append(table[eid], le.Uint64(e[4:]))
+	}
+	if err := SkipN(r, RealSize(ds64Size)-DS64ChunkSize-cnt*12); err != nil {
+		return nil, fmt.Errorf(errFmtDecode, Uint32(IDds64), err)
+	}
+
+	// Files with size bigger than actual are read to the end.
+	skip := uint64(4 + 8 + RealSize(ds64Size))
+	if riffSize < skip {
+		return nil, fmt.Errorf(errFmtDecode, Uint32(id), ErrTooShort)
+	}
+	body := limitReader(r, int64(min(riffSize-skip, math.MaxInt64)))
+	for {
+		if err := ReadChunkID(body, &id); err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return nil, err
+		}
+		size, err := ReadChunkSize(body)
+		if err != nil {
+			return nil, fmt.Errorf(errFmtDecode, Uint32(id), err)
+		}
+
+		n := uint64(size)
+		if size == rf64SizeMax {
+			switch {
+			case id == IDdata && dataSize > 0:
+				n, dataSize = dataSize, 0
+			case len(table[id]) > 0:
+				n, table[id] = table[id][0], table[id][1:]
+			}
+		}
+
+		if n > math.MaxUint32-1 {
+			ch := W64(W64GUID(id), load)
+			if _, err = ch.readBody(body, n); err != nil {
+				return nil, err
+			}
+			rif.chunks = append(rif.chunks, ch)
+		} else {
+			src := newW64Body(body, uint32(n))
+			if _, err = rif.decodeChunk(id, src); err != nil {
+				return nil, err
+			}
+			if err = src.drain(); err != nil {
+				return nil, fmt.Errorf(errFmtDecode, Uint32(id), err)
+			}
+		}
+
+		// The last chunk may not be padded.
+		if err = SkipN(body, uint32(n%2)); err != nil && !errors.Is(err, io.EOF) &&
+			!errors.Is(err, io.ErrUnexpectedEOF) {
+			return nil, err
+		}
+	}
+	rif.size = 4 + rif.chunks.Size()
+
+	return rif, nil
+}
+
+// WriteRF64 writes the RIFF file as the RF64 (EBU Tech 3306) file to w.
+// The 64-bit sizes of the form, the first "data" chunk and the chunks of
+// 4 GiB or more are stored in the "ds64" chunk. The [ChunkW64] chunks are
+// written with the chunk ID made from the GUID (see [W64ID]), it returns
+// [ErrUnsupportedFormat] for GUIDs not mapping to the chunk ID.
+func WriteRF64(w io.Writer, rif *RIFF) (int64, error) {
+	head, err := rf64Header(rif)
+	if err != nil {
+		return 0, err
+	}
+
+	var sum int64
+	n, err := w.Write(head)
+	sum += int64(n)
+	if err != nil {
+		return sum, fmt.Errorf(errFmtEncode, Uint32(IDRF64), err)
+	}
+
+	for _, ch := range rif.chunks {
+		var in int64
+		if cw, ok := ch.(*ChunkW64); ok {
+			in, err = cw.writeRF64(w)
+		} else {
+			in, err = ch.WriteTo(w)
+		}
+		sum += in
+		if err != nil {
+			return sum, err
+		}
+	}
+
+	return sum, nil
+}
+
+// rf64Header returns the RF64 file header: the "RF64" ID, size, form type
+// and the "ds64" chunk with the 64-bit sizes of the rif chunks.
+func rf64Header(rif *RIFF) ([]byte, error) {
+	var table []ds64Entry
+	var dataSize, total uint64
+	var dataSeen bool
+	for _, ch := range rif.chunks {
+		id, size := ch.ID(), uint64(ch.Size())
+		if cw, ok := ch.(*ChunkW64); ok {
+			var known bool
+			if id, known = W64ID(cw.GUID); !known {
+				return nil, fmt.Errorf(errFmtEncode, cw.GUID, ErrUnsupportedFormat)
+			}
+			size = cw.size
+		}
+		switch {
+		case id == IDdata && !dataSeen:
+			dataSize, dataSeen = size, true
+		case size > math.MaxUint32-1:
+			table = append(table, ds64Entry{id: id, size: size})
+		}
+		total += 8 + size + size%2
+	}
+
+	// Number of samples from the "fact" chunk of the compressed formats or
+	// calculated from the block align.
+	var samples uint64
+	if fact, ok := rif.chunks.First(IDfact).(*ChunkFACT); ok {
+		samples = uint64(fact.SampleLength)
+	} else if cf, ok := rif.chunks.First(IDfmt).(*ChunkFMT); ok && cf.BlockAlign > 0 {
+		samples = dataSize / uint64(cf.BlockAlign)
+	}
+
+	ds64Size := DS64ChunkSize + uint32(len(table))*12
+	head := make([]byte, 0, 12+8+ds64Size)
+	head = be.AppendUint32(head, IDRF64)
+	head = le.AppendUint32(head, rf64SizeMax)
+	head = be.AppendUint32(head, rif.riffType)
+	head = be.AppendUint32(head, IDds64)
+	head = le.AppendUint32(head, ds64Size)
+	head = le.AppendUint64(head, 4+8+uint64(ds64Size)+total)
+	head = le.AppendUint64(head, dataSize)
+	head = le.AppendUint64(head, samples)
+	head = le.AppendUint32(head, uint32(len(table)))
+	for _, e := range table {
+		head = be.AppendUint32(head, e.id)
+		head = le.AppendUint64(head, e.size)
+	}
+	return head, nil
+}
@@ -0,0 +1,262 @@
+package riff
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"testing"
+
+	"github.com/ctx42/testing/pkg/assert"
+	"github.com/ctx42/testing/pkg/kit"
+	"github.com/ctx42/testing/pkg/kit/iokit"
+	"github.com/ctx42/testing/pkg/must"
+
+	"github.com/rzajac/riff/internal/test"
+)
+
+// rf64File returns the RF64 file with "fmt " and odd size "data" chunks.
+// The "data" chunk size is stored in the "ds64" chunk.
+func rf64File(t *testing.T) []byte {
+	src := &bytes.Buffer{}
+	test.WriteBytes(t, src, []byte("RF64"))           // ( 0)  4 - Container ID
+	test.WriteUint32LE(t, src, 0xFFFFFFFF)            // ( 4)  4 - Size
+	test.WriteBytes(t, src, []byte("WAVE"))           // ( 8)  4 - Form type
+	test.WriteBytes(t, src, []byte("ds64"))           // (12)  4 - Chunk ID
+	test.WriteUint32LE(t, src, 28)                    // (16)  4 - Chunk size
+	test.WriteBytes(t, src, le.AppendUint64(nil, 76)) // (20)  8 - RIFF size
+	test.WriteBytes(t, src, le.AppendUint64(nil, 3))  // (28)  8 - Data size
+	test.WriteBytes(t, src, le.AppendUint64(nil, 3))  // (36)  8 - Sample count
+	test.WriteUint32LE(t, src, 0)                     // (44)  4 - Table length
+	test.WriteTo(t, src, fmt8bitMono())               // (48) 24 - Format
+	test.WriteBytes(t, src, []byte("data"))           // (72)  4 - Chunk ID
+	test.WriteUint32LE(t, src, 0xFFFFFFFF)            // (76)  4 - Chunk size
+	test.WriteBytes(t, src, []byte{1, 2, 3, 0})       // (80)  4 - Data and padding
+	// Total length: 84
+	return src.Bytes()
+}
+
+func Test_ReadRF64(t *testing.T) {
+	for _, id := range []string{"RF64", "BW64"} {
+		t.Run(id, func(t *testing.T) {
+			// --- Given ---
+			b := rf64File(t)
+			copy(b, id)
+
+			// --- When ---
+			rif, err := ReadRF64(bytes.NewReader(b), LoadData)
+
+			// --- Then ---
+			assert.NoError(t, err)
+			assert.Equal(t, TypeWAVE, rif.Type())
+			assert.Equal(t, uint32(4+24+12), rif.Size())
+			chs := rif.Chunks()
+			assert.Len(t, 2, chs)
+			assert.Equal(t, fmt8bitMono(), chs[0])
+			data, ok := chs[1].(*ChunkDATA)
+			assert.True(t, ok)
+			assert.Equal(t, []byte{1, 2, 3}, must.Value(io.ReadAll(data.Data())))
+		})
+	}
+}
+
+func Test_ReadRF64_Errors(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		b   []byte
+		exp error
+	}{
+		{"not RF64", []byte("RIFF\x04\x00\x00\x00WAVE"), ErrNotRF64},
+		{"no ds64", []byte("RF64\xff\xff\xff\xffWAVEfmt \x10\x00\x00\x00"), ErrNotRF64},
+		{"short ds64", append(rf64File(t)[:16], 27, 0, 0, 0), ErrTooShort},
+		{"short ds64 table", append(append(rf64File(t)[:44], 1, 0, 0, 0), rf64File(t)[48:]...), ErrTooShort},
+		{"short RIFF size", append(append(rf64File(t)[:20], 1), rf64File(t)[21:]...), ErrTooShort},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			rif, err := ReadRF64(bytes.NewReader(tc.b), LoadData)
+
+			// --- Then ---
+			assert.ErrorIs(t, tc.exp, err)
+			assert.Nil(t, rif)
+		})
+	}
+
+	b := rf64File(t)
+	for _, i := range []int{1, 10, 30, 50, 75, 80} {
+		// --- When ---
+		_, err := ReadRF64(bytes.NewReader(b[:i]), LoadData)
+
+		// --- Then ---
+		assert.Error(t, err)
+	}
+}
+
+func Test_WriteRF64(t *testing.T) {
+	// --- Given ---
+	rif := must.Value(ReadRF64(bytes.NewReader(rf64File(t)), LoadData))
+
+	// --- When ---
+	dst := &bytes.Buffer{}
+	n, err := WriteRF64(dst, rif)
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, int64(84), n)
+	exp := rf64File(t)
+	le.PutUint32(exp[76:], 3) // The "data" size fits in the chunk header.
+	assert.Equal(t, exp, dst.Bytes())
+}
+
+func Test_WriteRF64_RoundTrip(t *testing.T) {
+	// --- Given ---
+	rif := New(LoadData)
+	must.Value(rif.ReadFrom(must.Value(os.Open("testdata/kick-16b441k.wav"))))
+
+	// --- When ---
+	rf64 := &bytes.Buffer{}
+	_, err := WriteRF64(rf64, rif)
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("RF64"), rf64.Bytes()[:4])
+	assert.Equal(t, uint64(rf64.Len()-8), le.Uint64(rf64.Bytes()[20:]))
+
+	got := must.Value(ReadRF64(bytes.NewReader(rf64.Bytes()), LoadData))
+	dst := &bytes.Buffer{}
+	n, err := got.WriteTo(dst)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(31692), n)
+	assert.Equal(t, "1d7dbd0fe12ce2f8ec33ef5f90e271125a83ea94", kit.SHA1Reader(dst))
+}
+
+func Test_WriteRF64_W64RoundTrip(t *testing.T) {
+	// --- Given ---
+	junk := RAWC(IDJUNK, LoadData)
+	junk.size, junk.data = 3, []byte{1, 2, 3}
+	data := W64(W64GUID(IDdata), LoadData)
+	must.Nil(data.SetData([]byte{4, 5, 6}))
+
+	rif := New(LoadData)
+	rif.riffType = TypeWAVE
+	rif.chunks = Chunks{fmt8bitMono(), data, junk}
+	w64 := &bytes.Buffer{}
+	must.Value(WriteW64(w64, rif))
+
+	// --- When ---
+	rf64 := &bytes.Buffer{}
+	_, err := WriteRF64(rf64, must.Value(ReadW64(bytes.NewReader(w64.Bytes()), LoadData)))
+
+	// --- Then ---
+	assert.NoError(t, err)
+	got := must.Value(ReadRF64(bytes.NewReader(rf64.Bytes()), LoadData))
+	dst := &bytes.Buffer{}
+	must.Value(WriteW64(dst, got))
+	assert.Equal(t, w64.Bytes(), dst.Bytes())
+}
+
+func Test_WriteRF64_BigDataChunk(t *testing.T) {
+	// --- Given ---
+	const dataSize = 5 << 30 // 5 GiB
+	fmtGUID, dataGUID, waveGUID := W64GUID(IDfmt), W64GUID(IDdata), W64GUID(TypeWAVE)
+
+	w64Head := &bytes.Buffer{}
+	test.WriteBytes(t, w64Head, W64GUIDRIFF[:])                    // ( 0) 16 - GUID
+	test.WriteBytes(t, w64Head, le.AppendUint64(nil, 0))           // (16)  8 - File size
+	test.WriteBytes(t, w64Head, waveGUID[:])                       // (24) 16 - Form type
+	test.WriteBytes(t, w64Head, fmtGUID[:])                        // (40) 16 - GUID
+	test.WriteBytes(t, w64Head, le.AppendUint64(nil, 24+16))       // (56)  8 - Chunk size
+	test.WriteBytes(t, w64Head, must.Value(io.ReadAll(fmtBody()))) // (64) 16 - Format
+	test.WriteBytes(t, w64Head, dataGUID[:])                       // (80) 16 - GUID
+	test.WriteBytes(t, w64Head, le.AppendUint64(nil, 24+dataSize)) // (96)  8 - Chunk size
+	size := int64(w64Head.Len()) + dataSize
+	le.PutUint64(w64Head.Bytes()[16:], uint64(size))
+	w64 := must.Value(ReadW64(&sparseFile{head: w64Head.Bytes(), size: size}, SkipData))
+
+	// --- When ---
+	head, err := rf64Header(w64)
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Len(t, 48, head)
+	assert.Equal(t, uint64(4+36+24+8+dataSize), le.Uint64(head[20:]))
+	assert.Equal(t, uint64(dataSize), le.Uint64(head[28:]))
+	assert.Equal(t, uint64(dataSize), le.Uint64(head[36:]))
+
+	rf64Head := &bytes.Buffer{}
+	test.WriteBytes(t, rf64Head, head)
+	test.WriteTo(t, rf64Head, fmt8bitMono())
+	test.WriteBytes(t, rf64Head, []byte("data\xff\xff\xff\xff"))
+	src := &sparseFile{head: rf64Head.Bytes(), size: int64(rf64Head.Len()) + dataSize}
+
+	rif, err := ReadRF64(src, SkipData)
+	assert.NoError(t, err)
+	chs := rif.Chunks()
+	assert.Len(t, 2, chs)
+	assert.Equal(t, fmt8bitMono(), chs[0])
+	data, ok := chs[1].(*ChunkW64)
+	assert.True(t, ok)
+	assert.Equal(t, dataGUID, data.GUID)
+	assert.Equal(t, uint64(dataSize), data.size)
+	assert.Equal(t, src.size, src.off)
+}
+
+func Test_WriteRF64_BigChunkTable(t *testing.T) {
+	// --- Given ---
+	big := W64(W64GUID(IDJUNK), SkipData)
+	big.size = 5 << 30
+	rif := New(SkipData)
+	rif.riffType = TypeWAVE
+	rif.chunks = Chunks{fmt8bitMono(), big}
+
+	// --- When ---
+	head, err := rf64Header(rif)
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(28+12), le.Uint32(head[16:]))
+	assert.Equal(t, uint64(0), le.Uint64(head[28:]))
+	assert.Equal(t, uint32(1), le.Uint32(head[44:]))
+	assert.Equal(t, []byte("JUNK"), head[48:52])
+	assert.Equal(t, uint64(5<<30), le.Uint64(head[52:]))
+}
+
+func Test_WriteRF64_Errors(t *testing.T) {
+	t.Run("unknown GUID", func(t *testing.T) {
+		// --- Given ---
+		rif := must.Value(ReadW64(bytes.NewReader(w64File(t)), LoadData))
+
+		// --- When ---
+		_, err := WriteRF64(&bytes.Buffer{}, rif)
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrUnsupportedFormat, err)
+	})
+
+	t.Run("skip data", func(t *testing.T) {
+		// --- Given ---
+		rif := New(SkipData)
+		rif.chunks = Chunks{W64(W64GUID(IDdata), SkipData)}
+
+		// --- When ---
+		_, err := WriteRF64(&bytes.Buffer{}, rif)
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrSkipDataMode, err)
+	})
+
+	for _, i := range []int{1, 40, 50, 75, 80} {
+		// --- Given ---
+		rif := must.Value(ReadRF64(bytes.NewReader(rf64File(t)), LoadData))
+		rif.chunks[1] = W64(W64GUID(IDdata), LoadData)
+		must.Nil(rif.chunks[1].(*ChunkW64).SetData([]byte{1, 2, 3}))
+
+		// --- When ---
+		_, err := WriteRF64(iokit.ErrWriter(&bytes.Buffer{}, i), rif)
+
+		// --- Then ---
+		assert.Error(t, err)
+	}
+}
@@ -23,6 +23,39 @@ const (
 
 	// TypeRMID represents the "RMID" file type.
 	TypeRMID uint32 = 0x524d4944
+
+	// TypeAVIX represents the "AVIX" file type of the OpenDML AVI
+	// continuation forms.
+	TypeAVIX uint32 = 0x41564958
+
+	// TypeWEBP represents the "WEBP" file type.
+	TypeWEBP uint32 = 0x57454250
+
+	// TypeACON represents the "ACON" (animated cursor) file type.
+	TypeACON uint32 = 0x41434f4e
+
+	// TypeDLS represents the "DLS " (Downloadable Sounds) file type.
+	TypeDLS uint32 = 0x444c5320
+
+	// TypeSFBK represents the "sfbk" (SoundFont 2 bank) file type.
+	TypeSFBK uint32 = 0x7366626b
+
+	// TypePAL represents the "PAL " (palette) file type.
+	TypePAL uint32 = 0x50414c20
+
+	// TypeCDDA represents the "CDDA" (audio CD track) file type.
+	TypeCDDA uint32 = 0x43444441
+
+	// TypeAIFF represents the "AIFF" form type of the IFF "FORM" file.
+	TypeAIFF uint32 = 0x41494646
+
+	// TypeAIFC represents the "AIFC" (AIFF-C) form type of the IFF "FORM"
+	// file.
+	TypeAIFC uint32 = 0x41494643
+
+	// Type8SVX represents the "8SVX" (8-bit sampled voice) form type of the
+	// IFF "FORM" file.
+	Type8SVX uint32 = 0x38535658
 )
 
 // Maker is a function signature for instantiating chunk decoder.
@@ -31,6 +64,11 @@ type Maker func() Chunk
 // IDMaker is a function signature for instantiating chunk decoders for id.
 type IDMaker func(id uint32) Chunk
 
+// withID returns [Maker] creating chunk decoders for id with mk.
+func withID(mk IDMaker, id uint32) Maker {
+	return func() Chunk { return mk(id) }
+}
+
 // RIFF represents a file in Resource Interchange File Format.
 type RIFF struct {
 	// Chunk size in bytes.
@@ -54,6 +92,13 @@ type RIFF struct {
 	// metadata.
 	// By default, it is set to false.
 	load bool
+
+	// Byte order of the file. Little-endian for "RIFF" and big-endian for
+	// "RIFX" files.
+	order Order
+
+	// Set for the big-endian EA IFF-85 "FORM" files (e.g. AIFF).
+	iff bool
 }
 
 const (
@@ -67,17 +112,136 @@ const (
 )
 
 // New returns new instance of Riff with all "out-of-the-box" chunk decoders
-// registered.
+// registered. The decoders specific to the form type (e.g. WAVE) are
+// registered with [Registry.RegisterForm].
 func New(load bool) *RIFF {
 	reg := NewRegistry(RAWCMake(load))
 
 	// Register "out of the box" chunk decoders.
-	reg.Register(IDfmt, FMTMake)
-	reg.Register(IDdata, DATAMake(load))
 	reg.Register(IDLIST, LISTMake(load, reg))
-	reg.Register(IDsmpl, SMPLMake)
+	reg.Register(IDID3, ID3Make(IDID3))
+	reg.Register(IDid3, ID3Make(IDid3))
+	reg.Register(IDXMP, XMPMake)
+	reg.RegisterListDefault(IDINFO, INFOMake(load))
+	reg.RegisterListDefault(IDexif, EXIFMake(load))
+
+	// WAVE LIST decoders.
+	reg.RegisterList(IDadtl, IDlabl, LABLMake)
+	reg.RegisterList(IDadtl, IDltxt, LTXTMake)
+	reg.RegisterList(IDwavl, IDslnt, SLNTMake)
+	reg.RegisterList(IDwavl, IDdata, DATAMake(load))
+
+	// WAVE decoders.
+	reg.RegisterForm(TypeWAVE, IDfmt, FMTMake)
+	reg.RegisterForm(TypeWAVE, IDdata, DATAMake(load))
+	reg.RegisterForm(TypeWAVE, IDsmpl, SMPLMake)
+	reg.RegisterForm(TypeWAVE, IDcue, CUEMake)
+	reg.RegisterForm(TypeWAVE, IDplst, PLSTMake)
+	reg.RegisterForm(TypeWAVE, IDlevl, LEVLMake)
+	reg.RegisterForm(TypeWAVE, IDcart, CARTMake)
+	reg.RegisterForm(TypeWAVE, IDfact, FACTMake)
+	reg.RegisterForm(TypeWAVE, IDinst, WaveINSTMake)
+
+	// AVI decoders.
+	reg.RegisterForm(TypeAVI, IDidx1, IDX1Make)
+	reg.RegisterList(IDhdrl, IDavih, AVIHMake)
+	reg.RegisterList(IDstrl, IDstrh, STRHMake)
+	reg.RegisterList(IDstrl, IDstrf, STRFMake(0))
+	reg.RegisterList(IDstrl, IDstrn, STRNMake)
+	reg.RegisterList(IDstrl, IDindx, withID(INDXMake(load), IDindx))
+	reg.RegisterList(IDodml, IDdmlh, DMLHMake)
+	for i := uint32(0); i < 100; i++ {
+		id := 0x69780000 | ('0'+i/10)<<8 | ('0' + i%10) // "ix##"
+		reg.RegisterList(IDmovi, id, withID(INDXMake(load), id))
+	}
+
+	// WEBP decoders.
+	reg.RegisterForm(TypeWEBP, IDVP8X, VP8XMake)
+	reg.RegisterForm(TypeWEBP, IDANIM, ANIMMake)
+	reg.RegisterForm(TypeWEBP, IDANMF, ANMFMake(load, reg))
+	reg.RegisterList(IDANMF, IDALPH, ALPHMake(load))
+	reg.RegisterList(IDANMF, IDVP8, VP8Make(load))
+	reg.RegisterList(IDANMF, IDVP8L, VP8LMake(load))
+	reg.RegisterForm(TypeWEBP, IDALPH, ALPHMake(load))
+	reg.RegisterForm(TypeWEBP, IDVP8, VP8Make(load))
+	reg.RegisterForm(TypeWEBP, IDVP8L, VP8LMake(load))
+	reg.RegisterForm(TypeWEBP, IDICCP, ICCPMake)
+	reg.RegisterForm(TypeWEBP, IDEXIF, WebPEXIFMake)
+	reg.RegisterForm(TypeWEBP, IDWebPXMP, WebPXMPMake)
+
+	// ACON decoders.
+	reg.RegisterForm(TypeACON, IDanih, ANIHMake)
+	reg.RegisterForm(TypeACON, IDrate, RATEMake)
+	reg.RegisterForm(TypeACON, IDseq, SEQMake)
+	reg.RegisterList(IDfram, IDicon, ICONMake(load))
+
+	// DLS decoders.
+	reg.RegisterForm(TypeDLS, IDcolh, COLHMake)
+	reg.RegisterForm(TypeDLS, IDptbl, PTBLMake)
+	reg.RegisterList(IDins, IDinsh, INSHMake)
+	for _, typ := range []uint32{IDrgn, IDrgn2} {
+		reg.RegisterList(typ, IDrgnh, RGNHMake)
+		reg.RegisterList(typ, IDwlnk, WLNKMake)
+		reg.RegisterList(typ, IDwsmp, WSMPMake)
+	}
+	for _, typ := range []uint32{IDlart, IDlar2} {
+		reg.RegisterList(typ, IDart1, withID(ARTMake(load), IDart1))
+		reg.RegisterList(typ, IDart2, withID(ARTMake(load), IDart2))
+	}
+	reg.RegisterList(IDwave, IDfmt, FMTMake)
+	reg.RegisterList(IDwave, IDfact, FACTMake)
+	reg.RegisterList(IDwave, IDdata, DATAMake(load))
+	reg.RegisterList(IDwave, IDwsmp, WSMPMake)
+
+	// RMID decoders.
+	reg.RegisterForm(TypeRMID, IDdata, MIDIMake)
+
+	// PAL decoders.
+	reg.RegisterForm(TypePAL, IDdata, PALMake)
+
+	// CDDA decoders.
+	reg.RegisterForm(TypeCDDA, IDfmt, CDDAMake)
+
+	// SoundFont 2 decoders.
+	reg.RegisterList(IDsdta, IDsmpl, withID(SDTAMake(load), IDsmpl))
+	reg.RegisterList(IDsdta, IDsm24, withID(SDTAMake(load), IDsm24))
+	reg.RegisterList(IDpdta, IDphdr, PHDRMake)
+	reg.RegisterList(IDpdta, IDinst, INSTMake)
+	reg.RegisterList(IDpdta, IDshdr, SHDRMake)
+	for _, id := range []uint32{IDpbag, IDibag} {
+		reg.RegisterList(IDpdta, id, withID(BAGMake(load), id))
+	}
+	for _, id := range []uint32{IDpmod, IDimod} {
+		reg.RegisterList(IDpdta, id, withID(MODMake(load), id))
+	}
+	for _, id := range []uint32{IDpgen, IDigen} {
+		reg.RegisterList(IDpdta, id, withID(GENMake(load), id))
+	}
 
-	return Bare(reg)
+	// AIFF and AIFF-C decoders.
+	for _, typ := range []uint32{TypeAIFF, TypeAIFC} {
+		reg.RegisterForm(typ, IDCOMM, COMMMake)
+		reg.RegisterForm(typ, IDSSND, SSNDMake(load))
+		reg.RegisterForm(typ, IDMARK, MARKMake)
+		reg.RegisterForm(typ, IDINST, AIFFINSTMake)
+		reg.RegisterForm(typ, IDCOMT, COMTMake)
+		reg.RegisterForm(typ, IDAPPL, APPLMake(load))
+		reg.RegisterForm(typ, IDNAME, TEXTMake(IDNAME))
+		reg.RegisterForm(typ, IDAUTH, TEXTMake(IDAUTH))
+		reg.RegisterForm(typ, IDCopyright, TEXTMake(IDCopyright))
+		reg.RegisterForm(typ, IDANNO, TEXTMake(IDANNO))
+	}
+
+	// 8SVX decoders.
+	reg.RegisterForm(Type8SVX, IDVHDR, VHDRMake)
+	reg.RegisterForm(Type8SVX, IDNAME, TEXTMake(IDNAME))
+	reg.RegisterForm(Type8SVX, IDAUTH, TEXTMake(IDAUTH))
+	reg.RegisterForm(Type8SVX, IDCopyright, TEXTMake(IDCopyright))
+	reg.RegisterForm(Type8SVX, IDANNO, TEXTMake(IDANNO))
+
+	rif := Bare(reg)
+	rif.load = load
+	return rif
 }
 
 // Bare returns a new instance of [RIFF] without any chunk decoders registered.
@@ -90,6 +254,7 @@ func Bare(reg *Registry) *RIFF {
 	rif := &RIFF{
 		chunks: make([]Chunk, 0, 4),
 		reg:    reg,
+		order:  le,
 	}
 	return rif
 }
@@ -104,7 +269,16 @@ func Compose(chs Chunks) *RIFF {
 	return reg
 }
 
-func (rif *RIFF) ID() uint32     { return IDRIFF }
+func (rif *RIFF) ID() uint32 {
+	if rif.iff {
+		return IDFORM
+	}
+	if rif.order == be {
+		return IDRIFX
+	}
+	return IDRIFF
+}
+
 func (rif *RIFF) Size() uint32   { return rif.size }
 func (rif *RIFF) Type() uint32   { return rif.riffType }
 func (rif *RIFF) Multi() bool    { return false }
@@ -113,11 +287,56 @@ func (rif *RIFF) Raw() bool      { return false }
 
 func (rif *RIFF) SetType(t uint32) { rif.riffType = t }
 
-// IsRegistered returns true if decoder for id is registered.
+// ByteOrder returns the byte order of the file. It's [binary.BigEndian]
+// for "RIFX" files and [binary.LittleEndian] otherwise.
+func (rif *RIFF) ByteOrder() Order { return rif.order }
+
+// SetByteOrder sets the byte order the file is written in. Setting it to
+// [binary.BigEndian] makes [RIFF.WriteTo] write the "RIFX" file. Setting it
+// to [binary.LittleEndian] turns the IFF file into the "RIFF" file.
+//
+// Only the chunk headers and the fields encoded by the chunk decoders
+// change the byte order. The sample data (e.g. the WAVE "data" chunk) and
+// the raw chunks are written as they are, the caller must swap the bytes
+// of the samples wider than one byte.
+func (rif *RIFF) SetByteOrder(order Order) {
+	if order == be {
+		rif.order = be
+		return
+	}
+	rif.order = le
+	rif.iff = false
+}
+
+// IFF returns true for the big-endian EA IFF-85 "FORM" files (e.g. AIFF).
+func (rif *RIFF) IFF() bool { return rif.iff }
+
+// SetIFF sets whether [RIFF.WriteTo] writes the "FORM" file. The IFF files
+// are always big-endian, when iff is false the file stays big-endian and
+// is written as the "RIFX" file.
+func (rif *RIFF) SetIFF(iff bool) {
+	rif.iff = iff
+	if iff {
+		rif.order = be
+	}
+}
+
+// IsRegistered returns true if decoder for id is registered for all form
+// types or for the form type of the instance (see [RIFF.SetType]).
 func (rif *RIFF) IsRegistered(id uint32) bool {
-	return rif.reg.Has(id)
+	return rif.reg.Has(id) || rif.reg.HasForm(rif.riffType, id)
 }
 
+// IsRegisteredForm returns true if decoder for id is registered for
+// given form type.
+func (rif *RIFF) IsRegisteredForm(formType, id uint32) bool {
+	return rif.reg.HasForm(formType, id)
+}
+
+// ReadFrom reads the RIFF file from r. Only the first form is decoded, the
+// forms following it (e.g. "RIFF AVIX" forms of the OpenDML AVI files) are
+// kept as raw chunks of the first form, so [RIFF.WriteTo] writes them
+// nested in it. Use [RIFF.ReadForms] to decode all the forms.
 func (rif *RIFF) ReadFrom(r io.Reader) (int64, error) {
 	rif.Reset()
 
@@ -130,7 +349,20 @@ func (rif *RIFF) ReadFrom(r io.Reader) (int64, error) {
 	}
 	sum += 4
 
-	if id != IDRIFF {
+	// The variant is detected from the ID, all following data is read in
+	// the byte order of the variant.
+	rif.iff = false
+	switch id {
+	case IDRIFF:
+		rif.order = le
+	case IDRIFX:
+		rif.order = be
+		r = OrderReader(r, be)
+	case IDFORM:
+		rif.order = be
+		rif.iff = true
+		r = OrderReader(r, be)
+	default:
 		return sum, ErrNotRIFF
 	}
 
@@ -144,19 +376,8 @@ func (rif *RIFF) ReadFrom(r io.Reader) (int64, error) {
 	}
 	sum += 4
 
-	var n int64
-	for {
-		if err = ReadChunkID(r, &id); err != nil {
-			break
-		}
-		sum += 4
-
-		n, err = rif.decodeChunk(id, r)
-		sum += n
-		if err != nil {
-			break
-		}
-	}
+	n, err := rif.readChunks(r)
+	sum += n
 
 	// Size needs to be corrected.
 	if errors.Is(err, io.EOF) && rif.size != uint32(sum-8) {
@@ -176,10 +397,14 @@ func (rif *RIFF) WriteTo(w io.Writer) (int64, error) {
 	// Recalculate chunks size and add RIFF type.
 	rif.size = 4 + rif.chunks.Size()
 
-	n, err := WriteIDAndSize(w, IDRIFF, rif.size)
+	if rif.order == be {
+		w = OrderWriter(w, be)
+	}
+
+	n, err := WriteIDAndSize(w, rif.ID(), rif.size)
 	sum += n
 	if err != nil {
-		return sum, fmt.Errorf(errFmtEncode, Uint32(IDRIFF), err)
+		return sum, fmt.Errorf(errFmtEncode, Uint32(rif.ID()), err)
 	}
 
 	if err = binary.Write(w, be, rif.riffType); err != nil {
@@ -198,18 +423,57 @@ func (rif *RIFF) WriteTo(w io.Writer) (int64, error) {
 
 // Reset resets instance so it can be reused.
 func (rif *RIFF) Reset() {
+	form := rif.reg.Form(rif.riffType)
 	for _, ch := range rif.chunks {
-		rif.reg.Put(ch)
+		// The IFF group chunks are not pooled.
+		if rif.iff && IsIFFGroup(ch.ID()) {
+			continue
+		}
+		form.Put(ch)
 	}
 	rif.chunks = rif.chunks[:0]
 }
 
+// readChunks decodes chunks from r until an error. Returns the number of
+// bytes read and the error which stopped decoding ([io.EOF] when there
+// are no more chunks).
+func (rif *RIFF) readChunks(r io.Reader) (int64, error) {
+	var sum int64
+	var id uint32
+	for {
+		if err := ReadChunkID(r, &id); err != nil {
+			return sum, err
+		}
+		sum += 4
+
+		n, err := rif.decodeChunk(id, r)
+		sum += n
+		if err != nil {
+			return sum, err
+		}
+	}
+}
+
 // decodeChunk decodes a chunk with id.
 func (rif *RIFF) decodeChunk(id uint32, r io.Reader) (int64, error) {
 	if rif.chunks.Count(id) > 0 && !rif.chunks.First(id).Multi() {
 		return 0, fmt.Errorf("chunk %s (0x%x) already seen", Uint32(id), id)
 	}
-	dec := rif.reg.Get(id)
+	// The group chunks nested in the IFF "FORM" file.
+	if rif.iff && IsIFFGroup(id) {
+		grp := IFF(id, rif.load, rif.reg)
+		n, err := grp.ReadFrom(r)
+		if err != nil {
+			return n, err
+		}
+		rif.chunks = append(rif.chunks, grp)
+		return n, nil
+	}
+	// Decoders registered for the form type take precedence.
+	dec := rif.reg.Form(rif.riffType).GetNoRaw(id)
+	if dec == nil {
+		dec = rif.reg.Get(id)
+	}
 	dec.Reset()
 	n, err := dec.ReadFrom(r)
 	if err != nil {
@@ -17,12 +17,53 @@ func Test_RIFF_New(t *testing.T) {
 
 	// --- Then ---
 	assert.Equal(t, IDRIFF, rif.ID())
+	assert.Equal(t, le, rif.ByteOrder())
 	assert.Equal(t, uint32(0), rif.Size())
 	assert.Equal(t, uint32(0), rif.Type())
 	assert.False(t, rif.Multi())
 
+	assert.True(t, rif.IsRegistered(IDLIST))
+	assert.False(t, rif.IsRegistered(IDfmt))
+	assert.False(t, rif.IsRegistered(IDdata))
+	assert.False(t, rif.IsRegistered(0))
+	assert.True(t, rif.IsRegisteredForm(TypeWAVE, IDfmt))
+	assert.True(t, rif.IsRegisteredForm(TypeWAVE, IDdata))
+	assert.True(t, rif.IsRegisteredForm(TypeWAVE, IDfact))
+	assert.True(t, rif.IsRegisteredForm(TypeRMID, IDdata))
+	assert.False(t, rif.IsRegisteredForm(TypeRMID, IDfmt))
+	assert.True(t, rif.IsRegisteredForm(TypeAVI, IDidx1))
+	assert.False(t, rif.IsRegisteredForm(TypeAVI, IDfmt))
+	assert.True(t, rif.IsRegisteredForm(TypeWEBP, IDVP8X))
+	assert.True(t, rif.IsRegisteredForm(TypeWEBP, IDANMF))
+	assert.True(t, rif.IsRegisteredForm(TypeWEBP, IDWebPXMP))
+	assert.False(t, rif.IsRegisteredForm(TypeWEBP, IDXMP))
+	assert.True(t, rif.IsRegisteredForm(TypeACON, IDanih))
+	assert.True(t, rif.IsRegisteredForm(TypeACON, IDrate))
+	assert.True(t, rif.IsRegisteredForm(TypeACON, IDseq))
+	assert.True(t, rif.IsRegisteredForm(TypeDLS, IDcolh))
+	assert.True(t, rif.IsRegisteredForm(TypeDLS, IDptbl))
+	assert.True(t, rif.IsRegisteredForm(TypePAL, IDdata))
+	assert.False(t, rif.IsRegisteredForm(TypePAL, IDfmt))
+	assert.True(t, rif.IsRegisteredForm(TypeCDDA, IDfmt))
+	assert.True(t, rif.IsRegisteredForm(TypeWAVE, IDinst))
+	assert.True(t, rif.IsRegisteredForm(TypeAIFF, IDCOMM))
+	assert.True(t, rif.IsRegisteredForm(TypeAIFC, IDSSND))
+	assert.False(t, rif.IsRegisteredForm(TypeAIFF, IDfmt))
+	assert.True(t, rif.IsRegisteredForm(Type8SVX, IDVHDR))
+}
+
+func Test_RIFF_IsRegistered_FormType(t *testing.T) {
+	// --- Given ---
+	rif := New(LoadData)
+
+	// --- When ---
+	rif.SetType(TypeWAVE)
+
+	// --- Then ---
+	assert.True(t, rif.IsRegistered(IDLIST))
 	assert.True(t, rif.IsRegistered(IDfmt))
 	assert.True(t, rif.IsRegistered(IDdata))
+	assert.False(t, rif.IsRegistered(IDidx1))
 	assert.False(t, rif.IsRegistered(0))
 }
 
@@ -116,6 +157,51 @@ func Test_RIFF_ReadFrom_SmokeTest(t *testing.T) {
 	}
 }
 
+func Test_RIFF_ReadFrom_FormDecoders(t *testing.T) {
+	tt := []struct {
+		pth string
+		exp Chunk
+	}{
+		{"testdata/kick.wav", &ChunkDATA{}},
+		{"testdata/sample.rmi", &ChunkMIDI{}},
+	}
+
+	rif := New(LoadData)
+	for _, tc := range tt {
+		t.Run(tc.pth, func(t *testing.T) {
+			// --- Given ---
+			fil := must.Value(os.Open(tc.pth))
+			t.Cleanup(func() { _ = fil.Close() })
+
+			// --- When ---
+			_, err := rif.ReadFrom(fil)
+
+			// --- Then ---
+			assert.NoError(t, err)
+			assert.Type(t, tc.exp, rif.Chunks().First(IDdata))
+		})
+	}
+}
+
+func Test_RIFF_ReadFrom_RegisterForm(t *testing.T) {
+	// --- Given ---
+	reg := NewRegistry(RAWCMake(LoadData))
+	reg.RegisterForm(TypeRMID, IDdata, func() Chunk { return INFO(IDdata) })
+	rif := Bare(reg)
+	fil := must.Value(os.Open("testdata/sample.rmi"))
+	t.Cleanup(func() { _ = fil.Close() })
+
+	// --- When ---
+	_, err := rif.ReadFrom(fil)
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Type(t, &ChunkINFO{}, rif.Chunks().First(IDdata))
+	assert.True(t, rif.IsRegisteredForm(TypeRMID, IDdata))
+	assert.True(t, rif.IsRegistered(IDdata))
+	assert.False(t, rif.IsRegistered(IDfmt))
+}
+
 func Test_RIFF_CorrectingSize(t *testing.T) {
 	// --- Given ---
 	rif := New(LoadData)
@@ -134,6 +220,76 @@ func Test_RIFF_CorrectingSize(t *testing.T) {
 	assert.Equal(t, "ebdf4fefcbbb804b44cddbc50521ba29f833e05c", kit.SHA1Reader(dst))
 }
 
+func Test_RIFF_ReadFrom_RIFX(t *testing.T) {
+	// --- Given ---
+	src := &bytes.Buffer{}
+	src.WriteString("RIFX")
+	src.Write(be.AppendUint32(nil, 4+24+10))
+	src.WriteString("WAVE")
+	src.WriteString("fmt ")
+	src.Write(be.AppendUint32(nil, 16))
+	src.Write(be.AppendUint16(nil, CompPCM))
+	src.Write(be.AppendUint16(nil, 1))
+	src.Write(be.AppendUint32(nil, 8000))
+	src.Write(be.AppendUint32(nil, 16000))
+	src.Write(be.AppendUint16(nil, 2))
+	src.Write(be.AppendUint16(nil, 16))
+	src.WriteString("data")
+	src.Write(be.AppendUint32(nil, 2))
+	src.Write([]byte{0x12, 0x34})
+	exp := bytes.Clone(src.Bytes())
+
+	// --- When ---
+	rif := New(LoadData)
+	n, err := rif.ReadFrom(src)
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, int64(46), n)
+	assert.Equal(t, IDRIFX, rif.ID())
+	assert.Equal(t, be, rif.ByteOrder())
+	assert.Equal(t, TypeWAVE, rif.Type())
+	cf := rif.Chunks().First(IDfmt).(*ChunkFMT)
+	assert.Equal(t, uint16(1), cf.ChannelCnt)
+	assert.Equal(t, uint32(8000), cf.SampleRate)
+	assert.Equal(t, uint32(16000), cf.AvgByteRate)
+	assert.Equal(t, uint16(16), cf.BitsPerSample)
+	assert.Equal(t, uint32(2), rif.Chunks().First(IDdata).Size())
+
+	dst := &bytes.Buffer{}
+	must.Value(rif.WriteTo(dst))
+	assert.Equal(t, exp, dst.Bytes())
+}
+
+func Test_RIFF_SetByteOrder(t *testing.T) {
+	// --- Given ---
+	rif := New(LoadData)
+	must.Value(rif.ReadFrom(must.Value(os.Open("testdata/kick-16b441k.wav"))))
+	assert.Equal(t, le, rif.ByteOrder())
+
+	// --- When ---
+	rif.SetByteOrder(be)
+
+	// --- Then ---
+	assert.Equal(t, IDRIFX, rif.ID())
+
+	rifx := &bytes.Buffer{}
+	must.Value(rif.WriteTo(rifx))
+	assert.Equal(t, []byte("RIFX"), rifx.Bytes()[:4])
+
+	got := New(LoadData)
+	must.Value(got.ReadFrom(rifx))
+	assert.Equal(t, be, got.ByteOrder())
+	assert.Equal(t, rif.Chunks().First(IDfmt), got.Chunks().First(IDfmt))
+
+	got.SetByteOrder(le)
+	dst := &bytes.Buffer{}
+	n, err := got.WriteTo(dst)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(31692), n)
+	assert.Equal(t, "1d7dbd0fe12ce2f8ec33ef5f90e271125a83ea94", kit.SHA1Reader(dst))
+}
+
 func Test_RIFF_WriteTo_SmokeTest(t *testing.T) {
 	rif := New(LoadData)
 
@@ -0,0 +1,634 @@
+package riff
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+)
+
+// Types of the SoundFont file LIST chunks.
+const (
+	// IDsdta represents "sdta" (sample data) type of the LIST chunk.
+	IDsdta uint32 = 0x73647461
+
+	// IDpdta represents "pdta" (preset data) type of the LIST chunk.
+	IDpdta uint32 = 0x70647461
+)
+
+// IDs of the SoundFont specific sub-chunks of the "LIST INFO" chunk.
+const (
+	// IDifil represents "ifil" (SoundFont version) sub-chunk ID.
+	IDifil uint32 = 0x6966696c
+
+	// IDisng represents "isng" (target sound engine) sub-chunk ID.
+	IDisng uint32 = 0x69736e67
+)
+
+// SF2SamplePad represents the number of zero data points written after
+// each sample in the "smpl" chunk.
+const SF2SamplePad = 46
+
+// sf2NameSize represents the size of the name field of the SoundFont
+// records.
+const sf2NameSize = 20
+
+// SF2Sample represents the SoundFont sample.
+type SF2Sample struct {
+	// Sample name. Names longer than 19 bytes are truncated when encoded.
+	Name string
+
+	// Sample rate in Hz.
+	SampleRate uint32
+
+	// MIDI key number of the recorded pitch of the sample.
+	OriginalPitch uint8
+
+	// Pitch correction in cents applied on playback.
+	PitchCorrection int8
+
+	// Sample type (see SF2Sample* constants).
+	Type uint16
+
+	// Index of the first data point of the loop.
+	LoopStart uint32
+
+	// Index of the first data point after the loop.
+	LoopEnd uint32
+
+	// The 16-bit little-endian sample data points. It's nil in SkipData
+	// mode and for the ROM samples.
+	Data []byte
+
+	// The least significant bytes of the 24-bit sample data points. Nil for
+	// 16-bit samples.
+	Data24 []byte
+
+	// The linked sample (e.g. the other channel of the stereo sample).
+	Link *SF2Sample
+}
+
+// SF2Zone represents the preset or instrument zone.
+type SF2Zone struct {
+	// Zone generators without the terminal [SF2GenInstrument] or
+	// [SF2GenSampleID] generator.
+	Generators []SF2Generator
+
+	// Zone modulators.
+	Modulators []SF2Mod
+
+	// Instrument of the preset zone. Nil for the global zone.
+	Instrument *SF2Instrument
+
+	// Sample of the instrument zone. Nil for the global zone.
+	Sample *SF2Sample
+}
+
+// Generator returns the zone generator for the operator.
+func (z *SF2Zone) Generator(oper SF2Gen) (SF2Generator, bool) {
+	for _, g := range z.Generators {
+		if g.Oper == oper {
+			return g, true
+		}
+	}
+	return SF2Generator{}, false
+}
+
+// SF2Instrument represents the SoundFont instrument.
+type SF2Instrument struct {
+	// Instrument name. Names longer than 19 bytes are truncated when
+	// encoded.
+	Name string
+
+	// Instrument zones.
+	Zones []*SF2Zone
+}
+
+// SF2Preset represents the SoundFont preset.
+type SF2Preset struct {
+	// Preset name. Names longer than 19 bytes are truncated when encoded.
+	Name string
+
+	// MIDI program number of the preset.
+	Preset uint16
+
+	// MIDI bank number of the preset.
+	Bank uint16
+
+	// Reserved for future use.
+	Library uint32
+
+	// Reserved for future use.
+	Genre uint32
+
+	// Reserved for future use.
+	Morphology uint32
+
+	// Preset zones.
+	Zones []*SF2Zone
+}
+
+// SF2 represents the SoundFont 2 bank ("RIFF sfbk" file).
+type SF2 struct {
+	// The "LIST INFO" chunk of the bank. When nil a minimal one is
+	// created by [SF2.Compose].
+	Info *ChunkLIST
+
+	// Presets of the bank.
+	Presets []*SF2Preset
+
+	// Instruments of the bank.
+	Instruments []*SF2Instrument
+
+	// Samples of the bank.
+	Samples []*SF2Sample
+}
+
+// NewSF2 returns the SoundFont bank decoded from the "RIFF sfbk" file.
+// The sample data is copied from the file, so the bank can be edited and
+// encoded with [SF2.Compose].
+func NewSF2(rif *RIFF) (*SF2, error) {
+	if rif.Type() != TypeSFBK {
+		return nil, fmt.Errorf("expected %s form got %s", Uint32(TypeSFBK), Uint32(rif.Type()))
+	}
+
+	sf := &SF2{}
+	var sdta, pdta *ChunkLIST
+	for _, lst := range lists(rif.Chunks()) {
+		switch lst.Type() {
+		case IDINFO:
+			if sf.Info == nil {
+				sf.Info = lst
+			}
+		case IDsdta:
+			sdta = lst
+		case IDpdta:
+			pdta = lst
+		}
+	}
+	if pdta == nil {
+		return nil, fmt.Errorf("missing %s list: %w", Uint32(IDpdta), ErrSF2Invalid)
+	}
+
+	chs := pdta.Chunks()
+	phdr, _ := chs.First(IDphdr).(*ChunkPHDR)
+	pbag, _ := chs.First(IDpbag).(*ChunkBAG)
+	pmod, _ := chs.First(IDpmod).(*ChunkMOD)
+	pgen, _ := chs.First(IDpgen).(*ChunkGEN)
+	inst, _ := chs.First(IDinst).(*ChunkINST)
+	ibag, _ := chs.First(IDibag).(*ChunkBAG)
+	imod, _ := chs.First(IDimod).(*ChunkMOD)
+	igen, _ := chs.First(IDigen).(*ChunkGEN)
+	shdr, _ := chs.First(IDshdr).(*ChunkSHDR)
+	required := []struct {
+		id uint32
+		ok bool
+	}{
+		{IDphdr, phdr != nil && len(phdr.Headers) > 0},
+		{IDpbag, pbag != nil},
+		{IDpmod, pmod != nil},
+		{IDpgen, pgen != nil},
+		{IDinst, inst != nil && len(inst.Headers) > 0},
+		{IDibag, ibag != nil},
+		{IDimod, imod != nil},
+		{IDigen, igen != nil},
+		{IDshdr, shdr != nil && len(shdr.Headers) > 0},
+	}
+	for _, req := range required {
+		if !req.ok {
+			return nil, fmt.Errorf("missing %s chunk: %w", Uint32(req.id), ErrSF2Invalid)
+		}
+	}
+
+	var smpl, sm24 []byte
+	if sdta != nil {
+		if ch, ok := sdta.Chunks().First(IDsmpl).(*ChunkSDTA); ok {
+			smpl = ch.data
+		}
+		if ch, ok := sdta.Chunks().First(IDsm24).(*ChunkSDTA); ok {
+			sm24 = ch.data
+		}
+	}
+
+	// Samples without the terminal record.
+	hdrs := shdr.Headers[:len(shdr.Headers)-1]
+	for i, h := range hdrs {
+		s := &SF2Sample{
+			Name:            sf2String(h.Name),
+			SampleRate:      h.SampleRate,
+			OriginalPitch:   h.OriginalPitch,
+			PitchCorrection: h.PitchCorrection,
+			Type:            h.SampleType,
+		}
+		if h.End < h.Start {
+			return nil, fmt.Errorf("sample %d bounds: %w", i, ErrSF2Invalid)
+		}
+		if h.StartLoop >= h.Start && h.EndLoop >= h.StartLoop {
+			s.LoopStart = h.StartLoop - h.Start
+			s.LoopEnd = h.EndLoop - h.Start
+		}
+		if smpl != nil && h.SampleType&SF2SampleROM == 0 {
+			if uint64(h.End)*2 > uint64(len(smpl)) {
+				return nil, fmt.Errorf("sample %d bounds: %w", i, ErrSF2Invalid)
+			}
+			s.Data = append([]byte{}, smpl[h.Start*2:h.End*2]...)
+			if uint64(h.End) <= uint64(len(sm24)) {
+				s.Data24 = append([]byte{}, sm24[h.Start:h.End]...)
+			}
+		}
+		sf.Samples = append(sf.Samples, s)
+	}
+	for i, h := range hdrs {
+		linked := h.SampleType&(SF2SampleRight|SF2SampleLeft|SF2SampleLinked) != 0
+		if linked && int(h.SampleLink) < len(sf.Samples) {
+			sf.Samples[i].Link = sf.Samples[h.SampleLink]
+		}
+	}
+
+	for i := 0; i < len(inst.Headers)-1; i++ {
+		h := inst.Headers[i]
+		zones, err := sf2Zones(ibag, igen, imod, h.BagIndex, inst.Headers[i+1].BagIndex)
+		if err != nil {
+			return nil, fmt.Errorf("instrument %d: %w", i, err)
+		}
+		for _, z := range zones {
+			idx, ok := z.link(SF2GenSampleID)
+			if !ok {
+				continue
+			}
+			if idx >= len(sf.Samples) {
+				return nil, fmt.Errorf("instrument %d sample %d: %w", i, idx, ErrOutOfRange)
+			}
+			z.Sample = sf.Samples[idx]
+		}
+		sf.Instruments = append(sf.Instruments, &SF2Instrument{
+			Name:  sf2String(h.Name),
+			Zones: zones,
+		})
+	}
+
+	for i := 0; i < len(phdr.Headers)-1; i++ {
+		h := phdr.Headers[i]
+		zones, err := sf2Zones(pbag, pgen, pmod, h.BagIndex, phdr.Headers[i+1].BagIndex)
+		if err != nil {
+			return nil, fmt.Errorf("preset %d: %w", i, err)
+		}
+		for _, z := range zones {
+			idx, ok := z.link(SF2GenInstrument)
+			if !ok {
+				continue
+			}
+			if idx >= len(sf.Instruments) {
+				return nil, fmt.Errorf("preset %d instrument %d: %w", i, idx, ErrOutOfRange)
+			}
+			z.Instrument = sf.Instruments[idx]
+		}
+		sf.Presets = append(sf.Presets, &SF2Preset{
+			Name:       sf2String(h.Name),
+			Preset:     h.Preset,
+			Bank:       h.Bank,
+			Library:    h.Library,
+			Genre:      h.Genre,
+			Morphology: h.Morphology,
+			Zones:      zones,
+		})
+	}
+
+	return sf, nil
+}
+
+// Version returns the SoundFont version from the "ifil" chunk. It returns
+// zeros if the chunk is missing.
+func (sf *SF2) Version() (major, minor uint16) {
+	if sf.Info == nil {
+		return 0, 0
+	}
+	ifil, ok := sf.Info.Chunks().First(IDifil).(*ChunkINFO)
+	if !ok || len(ifil.text) < 4 {
+		return 0, 0
+	}
+	return le.Uint16(ifil.text), le.Uint16(ifil.text[2:])
+}
+
+// Compose returns the "RIFF sfbk" file with the bank. The samples are
+// written to the "smpl" chunk in order each followed by [SF2SamplePad]
+// zero data points. The "sm24" chunk is written only when at least one
+// sample has 24-bit data. Zone generators are written in order followed
+// by the [SF2GenInstrument] or [SF2GenSampleID] generator. It returns
+// [ErrUnsupportedFormat] for the ROM samples and [ErrSkipDataMode] for
+// the samples without data.
+func (sf *SF2) Compose() (*RIFF, error) {
+	has24 := false
+	for _, s := range sf.Samples {
+		has24 = has24 || s.Data24 != nil
+	}
+
+	var smpl, sm24 []byte
+	sidx := make(map[*SF2Sample]int, len(sf.Samples))
+	shdr := SHDR()
+	for i, s := range sf.Samples {
+		if s.Type&SF2SampleROM != 0 {
+			return nil, fmt.Errorf("sample %d is ROM sample: %w", i, ErrUnsupportedFormat)
+		}
+		if s.Data == nil {
+			return nil, fmt.Errorf("sample %d: %w", i, ErrSkipDataMode)
+		}
+		cnt := len(s.Data) / 2
+		if len(s.Data)%2 != 0 || (s.Data24 != nil && len(s.Data24) != cnt) {
+			return nil, fmt.Errorf("sample %d data length: %w", i, ErrSF2Invalid)
+		}
+
+		start := uint32(len(smpl) / 2)
+		smpl = append(smpl, s.Data...)
+		smpl = append(smpl, make([]byte, 2*SF2SamplePad)...)
+		if has24 {
+			if s.Data24 != nil {
+				sm24 = append(sm24, s.Data24...)
+			} else {
+				sm24 = append(sm24, make([]byte, cnt)...)
+			}
+			sm24 = append(sm24, make([]byte, SF2SamplePad)...)
+		}
+
+		sidx[s] = i
+		shdr.Headers = append(shdr.Headers, SF2SampleHeader{
+			Name:            sf2Name(s.Name),
+			Start:           start,
+			End:             start + uint32(cnt),
+			StartLoop:       start + s.LoopStart,
+			EndLoop:         start + s.LoopEnd,
+			SampleRate:      s.SampleRate,
+			OriginalPitch:   s.OriginalPitch,
+			PitchCorrection: s.PitchCorrection,
+			SampleType:      s.Type,
+		})
+	}
+	for i, s := range sf.Samples {
+		if s.Link == nil {
+			continue
+		}
+		j, ok := sidx[s.Link]
+		if !ok {
+			return nil, fmt.Errorf("sample %d link not in bank: %w", i, ErrSF2Invalid)
+		}
+		shdr.Headers[i].SampleLink = uint16(j)
+	}
+	shdr.Headers = append(shdr.Headers, SF2SampleHeader{Name: sf2Name("EOS")})
+
+	iidx := make(map[*SF2Instrument]int, len(sf.Instruments))
+	inst, ibag, igen, imod := INST(), BAG(IDibag), GEN(IDigen), MOD(IDimod)
+	for i, ins := range sf.Instruments {
+		iidx[ins] = i
+		inst.Headers = append(inst.Headers, SF2InstHeader{
+			Name:     sf2Name(ins.Name),
+			BagIndex: uint16(len(ibag.Bags)),
+		})
+		for _, z := range ins.Zones {
+			link := -1
+			if z.Sample != nil {
+				j, ok := sidx[z.Sample]
+				if !ok {
+					return nil, fmt.Errorf("instrument %d sample not in bank: %w", i, ErrSF2Invalid)
+				}
+				link = j
+			}
+			sf2AddZone(ibag, igen, imod, z, SF2GenSampleID, link)
+		}
+	}
+	inst.Headers = append(inst.Headers, SF2InstHeader{
+		Name:     sf2Name("EOI"),
+		BagIndex: uint16(len(ibag.Bags)),
+	})
+	sf2AddZone(ibag, igen, imod, &SF2Zone{Generators: []SF2Generator{{}}, Modulators: []SF2Mod{{}}}, 0, -1)
+
+	phdr, pbag, pgen, pmod := PHDR(), BAG(IDpbag), GEN(IDpgen), MOD(IDpmod)
+	for i, p := range sf.Presets {
+		phdr.Headers = append(phdr.Headers, SF2PresetHeader{
+			Name:       sf2Name(p.Name),
+			Preset:     p.Preset,
+			Bank:       p.Bank,
+			BagIndex:   uint16(len(pbag.Bags)),
+			Library:    p.Library,
+			Genre:      p.Genre,
+			Morphology: p.Morphology,
+		})
+		for _, z := range p.Zones {
+			link := -1
+			if z.Instrument != nil {
+				j, ok := iidx[z.Instrument]
+				if !ok {
+					return nil, fmt.Errorf("preset %d instrument not in bank: %w", i, ErrSF2Invalid)
+				}
+				link = j
+			}
+			sf2AddZone(pbag, pgen, pmod, z, SF2GenInstrument, link)
+		}
+	}
+	phdr.Headers = append(phdr.Headers, SF2PresetHeader{
+		Name:     sf2Name("EOP"),
+		BagIndex: uint16(len(pbag.Bags)),
+	})
+	sf2AddZone(pbag, pgen, pmod, &SF2Zone{Generators: []SF2Generator{{}}, Modulators: []SF2Mod{{}}}, 0, -1)
+
+	for _, n := range []int{len(pbag.Bags), len(pgen.Gens), len(pmod.Mods), len(ibag.Bags), len(igen.Gens), len(imod.Mods)} {
+		if n > math.MaxUint16+1 {
+			return nil, fmt.Errorf("too many zones, generators or modulators: %w", ErrSF2Invalid)
+		}
+	}
+
+	info := copyINFO(sf.Info)
+	if sf.Info == nil {
+		info.Modify(Chunks{
+			infoText(IDifil, []byte{2, 0, 1, 0}),
+			infoText(IDisng, []byte("EMU8000\x00")),
+			infoText(LabINAM, []byte("Untitled\x00")),
+		})
+	}
+
+	sdta := LIST(LoadData, NewRegistry(RAWCMake(LoadData)))
+	sdta.ListType = IDsdta
+	data := SDTA(IDsmpl, LoadData)
+	_ = data.SetData(smpl) // Never fails in LoadData mode.
+	sdtaChs := Chunks{data}
+	if has24 {
+		data24 := SDTA(IDsm24, LoadData)
+		_ = data24.SetData(sm24) // Never fails in LoadData mode.
+		sdtaChs = append(sdtaChs, data24)
+	}
+	sdta.Modify(sdtaChs)
+
+	pdta := LIST(LoadData, NewRegistry(RAWCMake(LoadData)))
+	pdta.ListType = IDpdta
+	pdta.Modify(Chunks{phdr, pbag, pmod, pgen, inst, ibag, imod, igen, shdr})
+
+	rif := Compose(Chunks{info, sdta, pdta})
+	rif.SetType(TypeSFBK)
+	return rif, nil
+}
+
+// WAVE returns the sample as a mono PCM "RIFF WAVE" file with the "smpl"
+// chunk holding the original pitch, the pitch correction and the loop
+// (if the sample has one). It returns [ErrSkipDataMode] if the sample has
+// no data.
+func (s *SF2Sample) WAVE() (*RIFF, error) {
+	if s.Data == nil {
+		return nil, ErrSkipDataMode
+	}
+	cnt := len(s.Data) / 2
+
+	cf := FMT()
+	cf.CompCode = CompPCM
+	cf.ChannelCnt = 1
+	cf.SampleRate = s.SampleRate
+	cf.BitsPerSample = 16
+	pcm := s.Data[:cnt*2]
+	if s.Data24 != nil && len(s.Data24) == cnt {
+		cf.BitsPerSample = 24
+		pcm = make([]byte, 0, cnt*3)
+		for i := 0; i < cnt; i++ {
+			pcm = append(pcm, s.Data24[i], s.Data[i*2], s.Data[i*2+1])
+		}
+	}
+	cf.BlockAlign = cf.BitsPerSample / 8
+	cf.AvgByteRate = s.SampleRate * uint32(cf.BlockAlign)
+
+	data := DATA(LoadData)
+	_ = data.SetData(pcm) // Never fails in LoadData mode.
+
+	sm := SMPL()
+	if s.SampleRate > 0 {
+		sm.SamplePeriod = uint32(1e9 / float64(s.SampleRate))
+	}
+	sm.setPitch(s.OriginalPitch, s.PitchCorrection)
+	if s.LoopEnd > s.LoopStart {
+		sm.SampleLoops = append(sm.SampleLoops, &SampleLoop{
+			Start: s.LoopStart,
+			End:   s.LoopEnd - 1,
+		})
+	}
+	sm.SampleLoopCnt = uint32(len(sm.SampleLoops))
+	sm.SamplerDataCnt = sm.SampleLoopCnt * SampleLoopCntSize
+	sm.size = SMPLChunkSize + sm.SamplerDataCnt
+
+	rif := Compose(Chunks{cf, sm, data})
+	rif.SetType(TypeWAVE)
+	return rif, nil
+}
+
+// SF2SampleFromWAVE returns the sample with given name from the mono
+// 16-bit or 24-bit PCM "RIFF WAVE" file. The original pitch, pitch
+// correction and the first loop are taken from the "smpl" chunk if
+// present, otherwise the original pitch is 60 (middle C).
+func SF2SampleFromWAVE(name string, rif *RIFF) (*SF2Sample, error) {
+	if rif.Type() != TypeWAVE {
+		return nil, fmt.Errorf("expected %s form got %s", Uint32(TypeWAVE), Uint32(rif.Type()))
+	}
+	cf, ok := rif.Chunks().First(IDfmt).(*ChunkFMT)
+	if !ok {
+		return nil, fmt.Errorf("missing %s chunk: %w", Uint32(IDfmt), ErrUnsupportedFormat)
+	}
+	data, ok := rif.Chunks().First(IDdata).(*ChunkDATA)
+	if !ok {
+		return nil, fmt.Errorf("missing %s chunk: %w", Uint32(IDdata), ErrUnsupportedFormat)
+	}
+	if data.data == nil {
+		return nil, ErrSkipDataMode
+	}
+	pcm := cf.CompCode == CompPCM || cf.CompCode == CompExtensible
+	if !pcm || cf.ChannelCnt != 1 || (cf.BitsPerSample != 16 && cf.BitsPerSample != 24) {
+		return nil, fmt.Errorf(
+			"expected mono 16 or 24 bit PCM got %d channels %d bits: %w",
+			cf.ChannelCnt,
+			cf.BitsPerSample,
+			ErrUnsupportedFormat,
+		)
+	}
+
+	s := &SF2Sample{
+		Name:          name,
+		SampleRate:    cf.SampleRate,
+		OriginalPitch: 60,
+		Type:          SF2SampleMono,
+	}
+	if cf.BitsPerSample == 16 {
+		s.Data = append([]byte{}, data.data[:len(data.data)/2*2]...)
+	} else {
+		cnt := len(data.data) / 3
+		s.Data = make([]byte, 0, cnt*2)
+		s.Data24 = make([]byte, 0, cnt)
+		for i := 0; i < cnt; i++ {
+			b := data.data[i*3:]
+			s.Data24 = append(s.Data24, b[0])
+			s.Data = append(s.Data, b[1], b[2])
+		}
+	}
+
+	if sm, ok := rif.Chunks().First(IDsmpl).(*ChunkSMPL); ok {
+		s.OriginalPitch, s.PitchCorrection = sm.pitch()
+		if len(sm.SampleLoops) > 0 {
+			loop := sm.SampleLoops[0]
+			s.LoopStart = loop.Start
+			s.LoopEnd = loop.End + 1
+		}
+	}
+
+	return s, nil
+}
+
+// link removes the terminal generator with the operator from the zone
+// and returns its amount.
+func (z *SF2Zone) link(oper SF2Gen) (int, bool) {
+	last := len(z.Generators) - 1
+	if last < 0 || z.Generators[last].Oper != oper {
+		return 0, false
+	}
+	idx := int(z.Generators[last].Amount)
+	z.Generators = z.Generators[:last]
+	return idx, true
+}
+
+// sf2Zones returns the zones from the bags [from, to).
+func sf2Zones(bag *ChunkBAG, gen *ChunkGEN, mod *ChunkMOD, from, to uint16) ([]*SF2Zone, error) {
+	if from > to || int(to) >= len(bag.Bags) {
+		return nil, fmt.Errorf("zones %d-%d: %w", from, to, ErrSF2Invalid)
+	}
+	var zones []*SF2Zone
+	for i := from; i < to; i++ {
+		cur, next := bag.Bags[i], bag.Bags[i+1]
+		if cur.GenIndex > next.GenIndex || int(next.GenIndex) > len(gen.Gens) ||
+			cur.ModIndex > next.ModIndex || int(next.ModIndex) > len(mod.Mods) {
+			return nil, fmt.Errorf("zone %d: %w", i, ErrSF2Invalid)
+		}
+		zones = append(zones, &SF2Zone{
+			Generators: append([]SF2Generator{}, gen.Gens[cur.GenIndex:next.GenIndex]...),
+			Modulators: append([]SF2Mod{}, mod.Mods[cur.ModIndex:next.ModIndex]...),
+		})
+	}
+	return zones, nil
+}
+
+// sf2AddZone appends the zone records to the chunks. When link is not
+// negative the generator with the operator and the link as the amount is
+// appended after the zone generators.
+func sf2AddZone(bag *ChunkBAG, gen *ChunkGEN, mod *ChunkMOD, z *SF2Zone, oper SF2Gen, link int) {
+	bag.Bags = append(bag.Bags, SF2Bag{
+		GenIndex: uint16(len(gen.Gens)),
+		ModIndex: uint16(len(mod.Mods)),
+	})
+	gen.Gens = append(gen.Gens, z.Generators...)
+	if link >= 0 {
+		gen.Gens = append(gen.Gens, SF2Generator{Oper: oper, Amount: uint16(link)})
+	}
+	mod.Mods = append(mod.Mods, z.Modulators...)
+}
+
+// sf2Name returns the zero terminated name field of the SoundFont record.
+func sf2Name(name string) [sf2NameSize]byte {
+	var b [sf2NameSize]byte
+	copy(b[:sf2NameSize-1], name)
+	return b
+}
+
+// sf2String returns the name from the name field of the SoundFont record.
+func sf2String(b [sf2NameSize]byte) string {
+	return string(TrimZeroRight(bytes.TrimRight(b[:], " ")))
+}
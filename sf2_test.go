@@ -0,0 +1,605 @@
+package riff
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/ctx42/testing/pkg/assert"
+	"github.com/ctx42/testing/pkg/must"
+)
+
+// sf2Bank returns the SoundFont bank with one preset playing one
+// instrument with a global zone and two zones playing a stereo sample.
+func sf2Bank() *SF2 {
+	left := &SF2Sample{
+		Name:            "Piano L",
+		SampleRate:      22050,
+		OriginalPitch:   60,
+		PitchCorrection: -5,
+		Type:            SF2SampleLeft,
+		LoopStart:       1,
+		LoopEnd:         3,
+		Data:            []byte{1, 0, 2, 0, 3, 0, 4, 0},
+	}
+	right := &SF2Sample{
+		Name:          "Piano R",
+		SampleRate:    22050,
+		OriginalPitch: 60,
+		Type:          SF2SampleRight,
+		Data:          []byte{5, 0, 6, 0},
+	}
+	left.Link, right.Link = right, left
+
+	ins := &SF2Instrument{
+		Name: "Piano",
+		Zones: []*SF2Zone{
+			{
+				Generators: []SF2Generator{
+					{Oper: SF2GenSampleModes, Amount: SF2LoopContinuous},
+				},
+			},
+			{
+				Generators: []SF2Generator{
+					{Oper: SF2GenKeyRange, Amount: SF2RangeAmount(0, 127)},
+					{Oper: SF2GenPan, Amount: 0xfe0c},
+				},
+				Sample: left,
+			},
+			{
+				Generators: []SF2Generator{
+					{Oper: SF2GenKeyRange, Amount: SF2RangeAmount(0, 127)},
+					{Oper: SF2GenPan, Amount: 500},
+				},
+				Modulators: []SF2Mod{{Src: 0x0502, Dest: SF2GenInitialAttenuation, Amount: 960}},
+				Sample:     right,
+			},
+		},
+	}
+
+	prs := &SF2Preset{
+		Name:  "Grand Piano",
+		Bank:  1,
+		Zones: []*SF2Zone{{Instrument: ins}},
+	}
+
+	return &SF2{
+		Presets:     []*SF2Preset{prs},
+		Instruments: []*SF2Instrument{ins},
+		Samples:     []*SF2Sample{left, right},
+	}
+}
+
+// sf2File returns the encoded SoundFont bank.
+func sf2File(t *testing.T, sf *SF2) []byte {
+	t.Helper()
+	rif := must.Value(sf.Compose())
+	buf := &bytes.Buffer{}
+	must.Value(rif.WriteTo(buf))
+	return buf.Bytes()
+}
+
+// readSF2 decodes the SoundFont bank.
+func readSF2(t *testing.T, b []byte, load bool) *SF2 {
+	t.Helper()
+	rif := New(load)
+	must.Value(rif.ReadFrom(bytes.NewReader(b)))
+	return must.Value(NewSF2(rif))
+}
+
+func Test_SF2Zone_Generator(t *testing.T) {
+	// --- Given ---
+	z := &SF2Zone{
+		Generators: []SF2Generator{{Oper: SF2GenPan, Amount: 500}},
+	}
+
+	t.Run("found", func(t *testing.T) {
+		// --- When ---
+		g, ok := z.Generator(SF2GenPan)
+
+		// --- Then ---
+		assert.True(t, ok)
+		assert.Equal(t, int16(500), g.Int())
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		// --- When ---
+		g, ok := z.Generator(SF2GenKeyRange)
+
+		// --- Then ---
+		assert.False(t, ok)
+		assert.Equal(t, SF2Generator{}, g)
+	})
+}
+
+func Test_NewSF2(t *testing.T) {
+	// --- Given ---
+	rif := New(LoadData)
+	must.Value(rif.ReadFrom(bytes.NewReader(sf2File(t, sf2Bank()))))
+
+	// --- When ---
+	sf, err := NewSF2(rif)
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.NotNil(t, sf.Info)
+	major, minor := sf.Version()
+	assert.Equal(t, uint16(2), major)
+	assert.Equal(t, uint16(1), minor)
+
+	assert.Len(t, 1, sf.Presets)
+	prs := sf.Presets[0]
+	assert.Equal(t, "Grand Piano", prs.Name)
+	assert.Equal(t, uint16(1), prs.Bank)
+	assert.Len(t, 1, prs.Zones)
+	assert.Len(t, 0, prs.Zones[0].Generators)
+	assert.Same(t, sf.Instruments[0], prs.Zones[0].Instrument)
+
+	assert.Len(t, 1, sf.Instruments)
+	ins := sf.Instruments[0]
+	assert.Equal(t, "Piano", ins.Name)
+	assert.Len(t, 3, ins.Zones)
+	assert.Nil(t, ins.Zones[0].Sample)
+	assert.Len(t, 1, ins.Zones[0].Generators)
+	assert.Same(t, sf.Samples[0], ins.Zones[1].Sample)
+	assert.Len(t, 2, ins.Zones[1].Generators)
+	assert.Same(t, sf.Samples[1], ins.Zones[2].Sample)
+	assert.Len(t, 1, ins.Zones[2].Modulators)
+	pan, _ := ins.Zones[1].Generator(SF2GenPan)
+	assert.Equal(t, int16(-500), pan.Int())
+
+	assert.Len(t, 2, sf.Samples)
+	left := sf.Samples[0]
+	assert.Equal(t, "Piano L", left.Name)
+	assert.Equal(t, uint32(22050), left.SampleRate)
+	assert.Equal(t, uint8(60), left.OriginalPitch)
+	assert.Equal(t, int8(-5), left.PitchCorrection)
+	assert.Equal(t, SF2SampleLeft, left.Type)
+	assert.Equal(t, uint32(1), left.LoopStart)
+	assert.Equal(t, uint32(3), left.LoopEnd)
+	assert.Equal(t, []byte{1, 0, 2, 0, 3, 0, 4, 0}, left.Data)
+	assert.Nil(t, left.Data24)
+	assert.Same(t, sf.Samples[1], left.Link)
+	assert.Equal(t, []byte{5, 0, 6, 0}, sf.Samples[1].Data)
+	assert.Same(t, left, sf.Samples[1].Link)
+}
+
+func Test_NewSF2_SkipData(t *testing.T) {
+	// --- When ---
+	sf := readSF2(t, sf2File(t, sf2Bank()), SkipData)
+
+	// --- Then ---
+	assert.Len(t, 2, sf.Samples)
+	assert.Nil(t, sf.Samples[0].Data)
+	_, err := sf.Compose()
+	assert.ErrorIs(t, ErrSkipDataMode, err)
+}
+
+func Test_NewSF2_Errors(t *testing.T) {
+	t.Run("not sfbk", func(t *testing.T) {
+		// --- Given ---
+		rif := Compose(Chunks{FMT()})
+		rif.SetType(TypeWAVE)
+
+		// --- When ---
+		sf, err := NewSF2(rif)
+
+		// --- Then ---
+		assert.ErrorEqual(t, "expected sfbk form got WAVE", err)
+		assert.Nil(t, sf)
+	})
+
+	t.Run("missing pdta", func(t *testing.T) {
+		// --- Given ---
+		rif := Compose(Chunks{dlsInfo("Bank")})
+		rif.SetType(TypeSFBK)
+
+		// --- When ---
+		_, err := NewSF2(rif)
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrSF2Invalid, err)
+		assert.ErrorContain(t, "missing pdta list", err)
+	})
+
+	t.Run("missing pdta chunk", func(t *testing.T) {
+		// --- Given ---
+		pdta := dlsList(IDpdta, PHDR())
+		rif := Compose(Chunks{pdta})
+		rif.SetType(TypeSFBK)
+
+		// --- When ---
+		_, err := NewSF2(rif)
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrSF2Invalid, err)
+		assert.ErrorContain(t, "missing phdr chunk", err)
+	})
+
+	t.Run("instrument out of range", func(t *testing.T) {
+		// --- Given ---
+		rif := must.Value(sf2Bank().Compose())
+		pdta := rif.Chunks()[2].(*ChunkLIST)
+		pgen := pdta.Chunks().First(IDpgen).(*ChunkGEN)
+		pgen.Gens[0].Amount = 5
+
+		// --- When ---
+		_, err := NewSF2(rif)
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrOutOfRange, err)
+	})
+
+	t.Run("sample out of range", func(t *testing.T) {
+		// --- Given ---
+		rif := must.Value(sf2Bank().Compose())
+		pdta := rif.Chunks()[2].(*ChunkLIST)
+		igen := pdta.Chunks().First(IDigen).(*ChunkGEN)
+		igen.Gens[3].Amount = 2
+
+		// --- When ---
+		_, err := NewSF2(rif)
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrOutOfRange, err)
+	})
+
+	t.Run("invalid bag index", func(t *testing.T) {
+		// --- Given ---
+		rif := must.Value(sf2Bank().Compose())
+		pdta := rif.Chunks()[2].(*ChunkLIST)
+		inst := pdta.Chunks().First(IDinst).(*ChunkINST)
+		inst.Headers[1].BagIndex = 10
+
+		// --- When ---
+		_, err := NewSF2(rif)
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrSF2Invalid, err)
+	})
+
+	t.Run("sample bounds", func(t *testing.T) {
+		// --- Given ---
+		rif := must.Value(sf2Bank().Compose())
+		pdta := rif.Chunks()[2].(*ChunkLIST)
+		shdr := pdta.Chunks().First(IDshdr).(*ChunkSHDR)
+		shdr.Headers[1].End = 1000
+
+		// --- When ---
+		_, err := NewSF2(rif)
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrSF2Invalid, err)
+	})
+}
+
+func Test_SF2_Version_NoInfo(t *testing.T) {
+	// --- When ---
+	major, minor := (&SF2{}).Version()
+
+	// --- Then ---
+	assert.Equal(t, uint16(0), major)
+	assert.Equal(t, uint16(0), minor)
+}
+
+func Test_SF2_Compose(t *testing.T) {
+	// --- When ---
+	rif, err := sf2Bank().Compose()
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, TypeSFBK, rif.Type())
+	chs := rif.Chunks()
+	assert.Len(t, 3, chs)
+
+	sdta := chs[1].(*ChunkLIST)
+	assert.Equal(t, IDsdta, sdta.Type())
+	assert.Len(t, 1, sdta.Chunks())
+	smpl := sdta.Chunks().First(IDsmpl).(*ChunkSDTA)
+	assert.Equal(t, uint32(2*(4+2+2*SF2SamplePad)), smpl.Size())
+
+	pdta := chs[2].(*ChunkLIST)
+	ids := make([]uint32, 0, 9)
+	for _, ch := range pdta.Chunks() {
+		ids = append(ids, ch.ID())
+	}
+	exp := []uint32{
+		IDphdr, IDpbag, IDpmod, IDpgen,
+		IDinst, IDibag, IDimod, IDigen,
+		IDshdr,
+	}
+	assert.Equal(t, exp, ids)
+
+	shdr := pdta.Chunks().First(IDshdr).(*ChunkSHDR)
+	assert.Len(t, 3, shdr.Headers)
+	assert.Equal(t, uint32(0), shdr.Headers[0].Start)
+	assert.Equal(t, uint32(4), shdr.Headers[0].End)
+	assert.Equal(t, uint32(1), shdr.Headers[0].StartLoop)
+	assert.Equal(t, uint32(3), shdr.Headers[0].EndLoop)
+	assert.Equal(t, uint16(1), shdr.Headers[0].SampleLink)
+	assert.Equal(t, uint32(4+SF2SamplePad), shdr.Headers[1].Start)
+	assert.Equal(t, uint16(0), shdr.Headers[1].SampleLink)
+	assert.Equal(t, "EOS", sf2String(shdr.Headers[2].Name))
+
+	igen := pdta.Chunks().First(IDigen).(*ChunkGEN)
+	assert.Equal(t, SF2Generator{Oper: SF2GenSampleID, Amount: 1}, igen.Gens[6])
+	ibag := pdta.Chunks().First(IDibag).(*ChunkBAG)
+	assert.Equal(t, []SF2Bag{{0, 0}, {1, 0}, {4, 0}, {7, 1}}, ibag.Bags)
+}
+
+func Test_SF2_Compose_RoundTrip(t *testing.T) {
+	// --- Given ---
+	b := sf2File(t, sf2Bank())
+	sf := readSF2(t, b, LoadData)
+
+	// --- When ---
+	have := sf2File(t, sf)
+
+	// --- Then ---
+	assert.Equal(t, b, have)
+}
+
+func Test_SF2_Compose_Reuse(t *testing.T) {
+	// --- Given ---
+	rif := must.Value(sf2Bank().Compose())
+	buf := &bytes.Buffer{}
+	must.Value(rif.WriteTo(buf))
+	src := buf.Bytes()
+
+	// --- When ---
+	_, err := rif.ReadFrom(bytes.NewReader(src))
+
+	// --- Then ---
+	assert.NoError(t, err)
+	dst := &bytes.Buffer{}
+	must.Value(rif.WriteTo(dst))
+	assert.Equal(t, src, dst.Bytes())
+}
+
+func Test_SF2_Compose_Edit(t *testing.T) {
+	// --- Given ---
+	sf := readSF2(t, sf2File(t, sf2Bank()), LoadData)
+	kick := &SF2Sample{
+		Name:          "A very long kick sample name",
+		SampleRate:    44100,
+		OriginalPitch: 36,
+		Type:          SF2SampleMono,
+		Data:          []byte{1, 0x10, 2, 0x20},
+		Data24:        []byte{0xaa, 0xbb},
+	}
+	ins := &SF2Instrument{Name: "Kick", Zones: []*SF2Zone{{Sample: kick}}}
+	sf.Samples = append(sf.Samples, kick)
+	sf.Instruments = append(sf.Instruments, ins)
+	sf.Presets[0].Zones = append(sf.Presets[0].Zones, &SF2Zone{Instrument: ins})
+
+	// --- When ---
+	got := readSF2(t, sf2File(t, sf), LoadData)
+
+	// --- Then ---
+	assert.Len(t, 3, got.Samples)
+	assert.Equal(t, "A very long kick sa", got.Samples[2].Name)
+	assert.Equal(t, []byte{1, 0x10, 2, 0x20}, got.Samples[2].Data)
+	assert.Equal(t, []byte{0xaa, 0xbb}, got.Samples[2].Data24)
+	assert.Equal(t, make([]byte, 4), got.Samples[0].Data24)
+	assert.Len(t, 2, got.Presets[0].Zones)
+	assert.Same(t, got.Instruments[1], got.Presets[0].Zones[1].Instrument)
+	assert.Same(t, got.Samples[2], got.Instruments[1].Zones[0].Sample)
+}
+
+func Test_SF2_Compose_Errors(t *testing.T) {
+	t.Run("ROM sample", func(t *testing.T) {
+		// --- Given ---
+		sf := sf2Bank()
+		sf.Samples[0].Type |= SF2SampleROM
+
+		// --- When ---
+		rif, err := sf.Compose()
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrUnsupportedFormat, err)
+		assert.Nil(t, rif)
+	})
+
+	t.Run("odd data length", func(t *testing.T) {
+		// --- Given ---
+		sf := sf2Bank()
+		sf.Samples[0].Data = []byte{1, 2, 3}
+
+		// --- When ---
+		_, err := sf.Compose()
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrSF2Invalid, err)
+	})
+
+	t.Run("sample not in bank", func(t *testing.T) {
+		// --- Given ---
+		sf := sf2Bank()
+		sf.Samples = sf.Samples[:1]
+
+		// --- When ---
+		_, err := sf.Compose()
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrSF2Invalid, err)
+	})
+
+	t.Run("instrument not in bank", func(t *testing.T) {
+		// --- Given ---
+		sf := sf2Bank()
+		sf.Instruments = nil
+
+		// --- When ---
+		_, err := sf.Compose()
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrSF2Invalid, err)
+	})
+}
+
+func Test_SF2Sample_WAVE(t *testing.T) {
+	// --- Given ---
+	s := sf2Bank().Samples[0]
+
+	// --- When ---
+	rif, err := s.WAVE()
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, TypeWAVE, rif.Type())
+
+	cf := rif.Chunks().First(IDfmt).(*ChunkFMT)
+	assert.Equal(t, CompPCM, cf.CompCode)
+	assert.Equal(t, uint16(1), cf.ChannelCnt)
+	assert.Equal(t, uint32(22050), cf.SampleRate)
+	assert.Equal(t, uint16(16), cf.BitsPerSample)
+	assert.Equal(t, uint32(44100), cf.AvgByteRate)
+
+	sm := rif.Chunks().First(IDsmpl).(*ChunkSMPL)
+	assert.Equal(t, uint32(60), sm.MIDIUnityNote)
+	assert.Equal(t, uint32(0x0ccccccc), sm.MIDIPitchFraction)
+	assert.Len(t, 1, sm.SampleLoops)
+	assert.Equal(t, uint32(1), sm.SampleLoops[0].Start)
+	assert.Equal(t, uint32(2), sm.SampleLoops[0].End)
+
+	buf := &bytes.Buffer{}
+	must.Value(rif.WriteTo(buf))
+	got := New(LoadData)
+	must.Value(got.ReadFrom(bytes.NewReader(buf.Bytes())))
+	have := must.Value(SF2SampleFromWAVE("Piano L", got))
+	assert.Equal(t, s.Data, have.Data)
+	assert.Equal(t, s.OriginalPitch, have.OriginalPitch)
+	assert.Equal(t, s.PitchCorrection, have.PitchCorrection)
+	assert.Equal(t, s.LoopStart, have.LoopStart)
+	assert.Equal(t, s.LoopEnd, have.LoopEnd)
+}
+
+func Test_SF2Sample_WAVE_24bit(t *testing.T) {
+	// --- Given ---
+	s := &SF2Sample{
+		SampleRate:      44100,
+		OriginalPitch:   60,
+		PitchCorrection: 10,
+		Data:            []byte{0x01, 0x02, 0x03, 0x04},
+		Data24:          []byte{0xaa, 0xbb},
+	}
+
+	// --- When ---
+	rif, err := s.WAVE()
+
+	// --- Then ---
+	assert.NoError(t, err)
+	cf := rif.Chunks().First(IDfmt).(*ChunkFMT)
+	assert.Equal(t, uint16(24), cf.BitsPerSample)
+	assert.Equal(t, uint16(3), cf.BlockAlign)
+	data := rif.Chunks().First(IDdata).(*ChunkDATA)
+	assert.Equal(t, []byte{0xaa, 0x01, 0x02, 0xbb, 0x03, 0x04}, data.data)
+	sm := rif.Chunks().First(IDsmpl).(*ChunkSMPL)
+	assert.Equal(t, uint32(59), sm.MIDIUnityNote)
+	assert.Len(t, 0, sm.SampleLoops)
+
+	have := must.Value(SF2SampleFromWAVE("Kick", rif))
+	assert.Equal(t, "Kick", have.Name)
+	assert.Equal(t, s.Data, have.Data)
+	assert.Equal(t, s.Data24, have.Data24)
+	assert.Equal(t, uint8(60), have.OriginalPitch)
+	assert.Equal(t, int8(10), have.PitchCorrection)
+}
+
+func Test_SF2Sample_WAVE_SkipData(t *testing.T) {
+	// --- When ---
+	rif, err := (&SF2Sample{}).WAVE()
+
+	// --- Then ---
+	assert.ErrorIs(t, ErrSkipDataMode, err)
+	assert.Nil(t, rif)
+}
+
+func Test_SF2SampleFromWAVE(t *testing.T) {
+	// --- Given ---
+	cf := FMT()
+	cf.CompCode = CompPCM
+	cf.ChannelCnt = 1
+	cf.SampleRate = 8000
+	cf.BitsPerSample = 16
+	dat := DATA(LoadData)
+	must.Nil(dat.SetData([]byte{1, 2, 3, 4}))
+	rif := Compose(Chunks{cf, dat})
+	rif.SetType(TypeWAVE)
+
+	// --- When ---
+	s, err := SF2SampleFromWAVE("Snare", rif)
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, "Snare", s.Name)
+	assert.Equal(t, uint32(8000), s.SampleRate)
+	assert.Equal(t, uint8(60), s.OriginalPitch)
+	assert.Equal(t, int8(0), s.PitchCorrection)
+	assert.Equal(t, SF2SampleMono, s.Type)
+	assert.Equal(t, []byte{1, 2, 3, 4}, s.Data)
+	assert.Nil(t, s.Data24)
+	assert.Equal(t, uint32(0), s.LoopEnd)
+}
+
+func Test_SF2SampleFromWAVE_Errors(t *testing.T) {
+	t.Run("not WAVE", func(t *testing.T) {
+		// --- Given ---
+		rif := Compose(Chunks{dlsInfo("Bank")})
+		rif.SetType(TypeSFBK)
+
+		// --- When ---
+		_, err := SF2SampleFromWAVE("Kick", rif)
+
+		// --- Then ---
+		assert.ErrorEqual(t, "expected WAVE form got sfbk", err)
+	})
+
+	t.Run("missing fmt", func(t *testing.T) {
+		// --- Given ---
+		rif := Compose(Chunks{DATA(LoadData)})
+		rif.SetType(TypeWAVE)
+
+		// --- When ---
+		_, err := SF2SampleFromWAVE("Kick", rif)
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrUnsupportedFormat, err)
+	})
+
+	t.Run("missing data", func(t *testing.T) {
+		// --- Given ---
+		rif := Compose(Chunks{fmt8bitMono()})
+		rif.SetType(TypeWAVE)
+
+		// --- When ---
+		_, err := SF2SampleFromWAVE("Kick", rif)
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrUnsupportedFormat, err)
+	})
+
+	t.Run("skip data", func(t *testing.T) {
+		// --- Given ---
+		rif := Compose(Chunks{fmt8bitMono(), DATA(SkipData)})
+		rif.SetType(TypeWAVE)
+
+		// --- When ---
+		_, err := SF2SampleFromWAVE("Kick", rif)
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrSkipDataMode, err)
+	})
+
+	t.Run("8 bit", func(t *testing.T) {
+		// --- Given ---
+		rif := Compose(Chunks{fmt8bitMono(), DATA(LoadData)})
+		rif.SetType(TypeWAVE)
+
+		// --- When ---
+		_, err := SF2SampleFromWAVE("Kick", rif)
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrUnsupportedFormat, err)
+		assert.ErrorContain(t, "expected mono 16 or 24 bit PCM got 1 channels 8 bits", err)
+	})
+}
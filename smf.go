@@ -0,0 +1,427 @@
+package riff
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"time"
+)
+
+// Standard MIDI File chunk IDs.
+const (
+	// IDMThd represents Standard MIDI File header chunk ID "MThd".
+	IDMThd uint32 = 0x4d546864
+
+	// IDMTrk represents Standard MIDI File track chunk ID "MTrk".
+	IDMTrk uint32 = 0x4d54726b
+)
+
+// MThdChunkSize represents the size of the MThd chunk data in bytes.
+const MThdChunkSize uint32 = 6
+
+// MIDI event status bytes which are not channel messages.
+const (
+	// MIDISysEx represents the status byte of the system exclusive event.
+	MIDISysEx byte = 0xf0
+
+	// MIDISysExEscape represents the status byte of the system exclusive
+	// continuation (escape) event.
+	MIDISysExEscape byte = 0xf7
+
+	// MIDIMeta represents the status byte of the meta event.
+	MIDIMeta byte = 0xff
+)
+
+// MIDI meta event types.
+const (
+	// MetaTrackName represents the sequence or track name meta event.
+	MetaTrackName byte = 0x03
+
+	// MetaEndOfTrack represents the end of track meta event.
+	MetaEndOfTrack byte = 0x2f
+
+	// MetaTempo represents the set tempo meta event.
+	MetaTempo byte = 0x51
+
+	// MetaTimeSignature represents the time signature meta event.
+	MetaTimeSignature byte = 0x58
+)
+
+// DefaultMIDITempo represents the default tempo in microseconds per
+// quarter note (120 BPM) used until the first tempo event.
+const DefaultMIDITempo uint32 = 500000
+
+// MIDIEvent represents an event in the MIDI track.
+type MIDIEvent struct {
+	// Delta time in ticks from the previous event.
+	Delta uint32
+
+	// Status byte. For channel messages it's in range 0x80-0xef, for
+	// system exclusive events it's [MIDISysEx] or [MIDISysExEscape] and
+	// for meta events it's [MIDIMeta].
+	Status byte
+
+	// Meta event type (only when Status is [MIDIMeta]).
+	Meta byte
+
+	// Event data bytes without the status, meta type and length.
+	Data []byte
+}
+
+// IsMeta returns true if the event is the meta event of given type.
+func (ev MIDIEvent) IsMeta(typ byte) bool {
+	return ev.Status == MIDIMeta && ev.Meta == typ
+}
+
+// Tempo returns tempo in microseconds per quarter note for the
+// [MetaTempo] event.
+func (ev MIDIEvent) Tempo() (uint32, bool) {
+	if !ev.IsMeta(MetaTempo) || len(ev.Data) != 3 {
+		return 0, false
+	}
+	return uint32(ev.Data[0])<<16 | uint32(ev.Data[1])<<8 | uint32(ev.Data[2]), true
+}
+
+// TimeSignature returns numerator and denominator of the time signature
+// for the [MetaTimeSignature] event.
+func (ev MIDIEvent) TimeSignature() (int, int, bool) {
+	if !ev.IsMeta(MetaTimeSignature) || len(ev.Data) != 4 || ev.Data[1] > 31 {
+		return 0, 0, false
+	}
+	return int(ev.Data[0]), 1 << ev.Data[1], true
+}
+
+// MIDITrack represents MTrk chunk of the Standard MIDI File.
+type MIDITrack struct {
+	Events []MIDIEvent
+}
+
+// Name returns the name of the track from the first [MetaTrackName] event.
+func (tr *MIDITrack) Name() string {
+	for _, ev := range tr.Events {
+		if ev.IsMeta(MetaTrackName) {
+			return string(ev.Data)
+		}
+	}
+	return ""
+}
+
+// Ticks returns the length of the track in ticks.
+func (tr *MIDITrack) Ticks() uint64 {
+	var tick uint64
+	for _, ev := range tr.Events {
+		tick += uint64(ev.Delta)
+	}
+	return tick
+}
+
+// TempoChange represents a tempo change in the tempo map.
+type TempoChange struct {
+	// Absolute time in ticks.
+	Tick uint64
+
+	// Absolute time from the beginning of the file.
+	Time time.Duration
+
+	// Tempo in microseconds per quarter note.
+	Tempo uint32
+}
+
+// BPM returns the tempo in beats (quarter notes) per minute.
+func (tc TempoChange) BPM() float64 {
+	return 60e6 / float64(tc.Tempo)
+}
+
+// SMF represents Standard MIDI File.
+type SMF struct {
+	// File format (0 - single track, 1 - simultaneous tracks,
+	// 2 - independent tracks).
+	Format uint16
+
+	// Time division. When bit 15 is zero it's the number of ticks per
+	// quarter note, otherwise bits 8-14 hold negative SMPTE frame rate
+	// and bits 0-7 the number of ticks per frame.
+	Division uint16
+
+	// Tracks in the order they appear in the file.
+	Tracks []MIDITrack
+}
+
+// ParseSMF parses Standard MIDI File.
+func ParseSMF(b []byte) (*SMF, error) {
+	if len(b) < 8 || be.Uint32(b) != IDMThd {
+		return nil, ErrSMFInvalid
+	}
+	hl := be.Uint32(b[4:])
+	if hl < MThdChunkSize || uint64(hl) > uint64(len(b)-8) {
+		return nil, ErrSMFInvalid
+	}
+	smf := &SMF{
+		Format:   be.Uint16(b[8:]),
+		Division: be.Uint16(b[12:]),
+	}
+	cnt := int(be.Uint16(b[10:]))
+	b = b[8+hl:]
+
+	for len(b) >= 8 {
+		id, size := be.Uint32(b), be.Uint32(b[4:])
+		if uint64(size) > uint64(len(b)-8) {
+			return nil, fmt.Errorf("%s: %w", Uint32(id), ErrSMFInvalid)
+		}
+		data := b[8 : 8+size]
+		b = b[8+size:]
+		if id != IDMTrk {
+			continue // Unknown chunks must be ignored.
+		}
+		tr, err := parseMIDITrack(data)
+		if err != nil {
+			return nil, fmt.Errorf("track %d: %w", len(smf.Tracks), err)
+		}
+		smf.Tracks = append(smf.Tracks, tr)
+	}
+
+	if len(smf.Tracks) != cnt {
+		return nil, fmt.Errorf("expected %d tracks got %d: %w", cnt, len(smf.Tracks), ErrSMFInvalid)
+	}
+	return smf, nil
+}
+
+// parseMIDITrack parses events of MTrk chunk.
+func parseMIDITrack(b []byte) (MIDITrack, error) {
+	var tr MIDITrack
+	var running byte
+	var pos int
+
+	for pos < len(b) {
+		var ev MIDIEvent
+		var ok bool
+		if ev.Delta, pos, ok = readVLQ(b, pos); !ok || pos >= len(b) {
+			return tr, ErrSMFInvalid
+		}
+
+		status := b[pos]
+		if status >= 0x80 {
+			pos++
+		} else if running == 0 {
+			return tr, fmt.Errorf("data byte without running status: %w", ErrSMFInvalid)
+		} else {
+			status = running
+		}
+		ev.Status = status
+
+		var n uint32
+		switch {
+		case status == MIDIMeta:
+			if pos >= len(b) {
+				return tr, ErrSMFInvalid
+			}
+			ev.Meta = b[pos]
+			if n, pos, ok = readVLQ(b, pos+1); !ok {
+				return tr, ErrSMFInvalid
+			}
+			running = 0
+
+		case status == MIDISysEx || status == MIDISysExEscape:
+			if n, pos, ok = readVLQ(b, pos); !ok {
+				return tr, ErrSMFInvalid
+			}
+			running = 0
+
+		case status >= 0xf0:
+			return tr, fmt.Errorf("unexpected status 0x%02x: %w", status, ErrSMFInvalid)
+
+		default:
+			n = midiDataLen(status)
+			running = status
+		}
+
+		if uint64(pos)+uint64(n) > uint64(len(b)) {
+			return tr, ErrSMFInvalid
+		}
+		ev.Data = append([]byte(nil), b[pos:pos+int(n)]...)
+		pos += int(n)
+		tr.Events = append(tr.Events, ev)
+	}
+	return tr, nil
+}
+
+// Bytes encodes the Standard MIDI File. Channel messages are written
+// using running status.
+func (smf *SMF) Bytes() []byte {
+	buf := &bytes.Buffer{}
+	buf.Write(be.AppendUint32(nil, IDMThd))
+	buf.Write(be.AppendUint32(nil, MThdChunkSize))
+	buf.Write(be.AppendUint16(nil, smf.Format))
+	buf.Write(be.AppendUint16(nil, uint16(len(smf.Tracks))))
+	buf.Write(be.AppendUint16(nil, smf.Division))
+
+	for _, tr := range smf.Tracks {
+		var data []byte
+		var running byte
+		for _, ev := range tr.Events {
+			data = appendVLQ(data, ev.Delta)
+			switch {
+			case ev.Status == MIDIMeta:
+				data = append(data, ev.Status, ev.Meta)
+				data = appendVLQ(data, uint32(len(ev.Data)))
+				running = 0
+
+			case ev.Status >= 0xf0:
+				data = append(data, ev.Status)
+				data = appendVLQ(data, uint32(len(ev.Data)))
+				running = 0
+
+			default:
+				if ev.Status != running {
+					data = append(data, ev.Status)
+					running = ev.Status
+				}
+			}
+			data = append(data, ev.Data...)
+		}
+		buf.Write(be.AppendUint32(nil, IDMTrk))
+		buf.Write(be.AppendUint32(nil, uint32(len(data))))
+		buf.Write(data)
+	}
+	return buf.Bytes()
+}
+
+// TempoMap returns tempo changes sorted by time. The first entry is always
+// at tick zero; if the file doesn't set the tempo at the beginning
+// [DefaultMIDITempo] is used. For format 0 and 1 files the tempo changes
+// of all tracks are merged. For format 2 files, where each track is an
+// independent sequence, it returns the tempo map of the first track (see
+// [SMF.TrackTempoMap]).
+func (smf *SMF) TempoMap() []TempoChange {
+	if smf.Format == 2 {
+		return smf.TrackTempoMap(0)
+	}
+	return smf.tempoMap(smf.Tracks)
+}
+
+// TrackTempoMap returns tempo changes of the track with given index. For
+// format 0 and 1 files it's the same as [SMF.TempoMap] because the tempo
+// is shared by all the tracks.
+func (smf *SMF) TrackTempoMap(track int) []TempoChange {
+	if smf.Format != 2 {
+		return smf.tempoMap(smf.Tracks)
+	}
+	if track < 0 || track >= len(smf.Tracks) {
+		return smf.tempoMap(nil)
+	}
+	return smf.tempoMap(smf.Tracks[track : track+1])
+}
+
+// tempoMap returns tempo changes from the tracks sorted by time.
+func (smf *SMF) tempoMap(tracks []MIDITrack) []TempoChange {
+	var tcs []TempoChange
+	for _, tr := range tracks {
+		var tick uint64
+		for _, ev := range tr.Events {
+			tick += uint64(ev.Delta)
+			if tempo, ok := ev.Tempo(); ok {
+				tcs = append(tcs, TempoChange{Tick: tick, Tempo: tempo})
+			}
+		}
+	}
+	sort.SliceStable(tcs, func(i, j int) bool { return tcs[i].Tick < tcs[j].Tick })
+	if len(tcs) == 0 || tcs[0].Tick != 0 {
+		tcs = append([]TempoChange{{Tempo: DefaultMIDITempo}}, tcs...)
+	}
+
+	for i := 1; i < len(tcs); i++ {
+		prv := tcs[i-1]
+		tcs[i].Time = prv.Time + smf.ticksDuration(tcs[i].Tick-prv.Tick, prv.Tempo)
+	}
+	return tcs
+}
+
+// Time returns absolute time of given tick. For format 2 files it uses
+// the tempo map of the first track.
+func (smf *SMF) Time(tick uint64) time.Duration {
+	return smf.tickTime(smf.TempoMap(), tick)
+}
+
+// tickTime returns absolute time of given tick in the tempo map.
+func (smf *SMF) tickTime(tcs []TempoChange, tick uint64) time.Duration {
+	idx := sort.Search(len(tcs), func(i int) bool { return tcs[i].Tick > tick }) - 1
+	tc := tcs[idx]
+	return tc.Time + smf.ticksDuration(tick-tc.Tick, tc.Tempo)
+}
+
+// Duration returns the duration of the longest track.
+func (smf *SMF) Duration() time.Duration {
+	if smf.Format == 2 {
+		var dur time.Duration
+		for i := range smf.Tracks {
+			dur = max(dur, smf.tickTime(smf.TrackTempoMap(i), smf.Tracks[i].Ticks()))
+		}
+		return dur
+	}
+
+	var ticks uint64
+	for i := range smf.Tracks {
+		ticks = max(ticks, smf.Tracks[i].Ticks())
+	}
+	return smf.Time(ticks)
+}
+
+// ticksDuration returns duration of given number of ticks at the tempo.
+func (smf *SMF) ticksDuration(ticks uint64, tempo uint32) time.Duration {
+	if smf.Division&0x8000 != 0 {
+		fps := float64(-int8(smf.Division >> 8))
+		if fps == 29 {
+			fps = 29.97
+		}
+		tpf := float64(smf.Division & 0xff)
+		if fps <= 0 || tpf == 0 {
+			return 0
+		}
+		return time.Duration(float64(ticks) / (fps * tpf) * float64(time.Second))
+	}
+	if smf.Division == 0 {
+		return 0
+	}
+	us := float64(ticks) * float64(tempo) / float64(smf.Division)
+	return time.Duration(us * float64(time.Microsecond))
+}
+
+// midiDataLen returns the number of data bytes of channel message.
+func midiDataLen(status byte) uint32 {
+	switch status & 0xf0 {
+	case 0xc0, 0xd0:
+		return 1
+	default:
+		return 2
+	}
+}
+
+// readVLQ reads variable-length quantity from b at pos. Returns the value,
+// the position after it and false if the quantity is invalid.
+func readVLQ(b []byte, pos int) (uint32, int, bool) {
+	var v uint32
+	for i := 0; i < 4; i++ {
+		if pos >= len(b) {
+			return 0, pos, false
+		}
+		c := b[pos]
+		pos++
+		v = v<<7 | uint32(c&0x7f)
+		if c&0x80 == 0 {
+			return v, pos, true
+		}
+	}
+	return 0, pos, false
+}
+
+// appendVLQ appends v encoded as variable-length quantity to b.
+func appendVLQ(b []byte, v uint32) []byte {
+	var tmp [5]byte
+	i := len(tmp) - 1
+	tmp[i] = byte(v & 0x7f)
+	for v >>= 7; v > 0; v >>= 7 {
+		i--
+		tmp[i] = byte(v&0x7f) | 0x80
+	}
+	return append(b, tmp[i:]...)
+}
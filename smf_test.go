@@ -0,0 +1,290 @@
+package riff
+
+import (
+	"bytes"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/ctx42/testing/pkg/assert"
+	"github.com/ctx42/testing/pkg/must"
+
+	"github.com/rzajac/riff/internal/test"
+)
+
+// smfFile returns format 0 Standard MIDI File with one track.
+func smfFile(t *testing.T) []byte {
+	src := &bytes.Buffer{}
+	test.WriteUint32BE(t, src, IDMThd)                      // ( 0) 4 - Chunk ID
+	test.WriteUint32BE(t, src, MThdChunkSize)               // ( 4) 4 - Chunk size
+	test.WriteBytes(t, src, []byte{0x00, 0x00})             // ( 8) 2 - Format
+	test.WriteBytes(t, src, []byte{0x00, 0x01})             // (10) 2 - Number of tracks
+	test.WriteBytes(t, src, []byte{0x00, 0x60})             // (12) 2 - Division (96)
+	test.WriteUint32BE(t, src, IDMTrk)                      // (14) 4 - Chunk ID
+	test.WriteUint32BE(t, src, 47)                          // (18) 4 - Chunk size
+	test.WriteBytes(t, src, []byte{0x00, 0xff, 0x03, 0x04}) // (22) 4 - Track name
+	test.WriteBytes(t, src, []byte("Lead"))                 // (26) 4 -
+	test.WriteBytes(t, src, []byte{0x00, 0xff, 0x51, 0x03}) // (30) 4 - Tempo
+	test.WriteBytes(t, src, []byte{0x07, 0xa1, 0x20})       // (34) 3 - 500000
+	test.WriteBytes(t, src, []byte{0x00, 0xff, 0x58, 0x04}) // (37) 4 - Time signature
+	test.WriteBytes(t, src, []byte{0x03, 0x02, 0x18, 0x08}) // (41) 4 - 3/4
+	test.WriteBytes(t, src, []byte{0x00, 0x90, 0x3c, 0x40}) // (45) 4 - Note on
+	test.WriteBytes(t, src, []byte{0x60, 0x3c, 0x00})       // (49) 3 - Note off (running status)
+	test.WriteBytes(t, src, []byte{0x00, 0xf0, 0x02, 0x7e}) // (52) 4 - SysEx
+	test.WriteBytes(t, src, []byte{0xf7})                   // (56) 1 -
+	test.WriteBytes(t, src, []byte{0x81, 0x40, 0xff, 0x51}) // (57) 4 - Tempo at 288
+	test.WriteBytes(t, src, []byte{0x03, 0x0f, 0x42, 0x40}) // (61) 4 - 1000000
+	test.WriteBytes(t, src, []byte{0x60, 0xff, 0x2f, 0x00}) // (65) 4 - End of track
+	// Total length: 14+8+47=69
+	return src.Bytes()
+}
+
+func Test_ParseSMF(t *testing.T) {
+	// --- When ---
+	smf, err := ParseSMF(smfFile(t))
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, uint16(0), smf.Format)
+	assert.Equal(t, uint16(96), smf.Division)
+	assert.Len(t, 1, smf.Tracks)
+
+	tr := smf.Tracks[0]
+	assert.Equal(t, "Lead", tr.Name())
+	assert.Equal(t, uint64(384), tr.Ticks())
+	assert.Len(t, 8, tr.Events)
+
+	exp := MIDIEvent{Delta: 96, Status: 0x90, Data: []byte{0x3c, 0x00}}
+	assert.Equal(t, exp, tr.Events[4])
+	exp = MIDIEvent{Delta: 0, Status: MIDISysEx, Data: []byte{0x7e, 0xf7}}
+	assert.Equal(t, exp, tr.Events[5])
+	assert.True(t, tr.Events[7].IsMeta(MetaEndOfTrack))
+
+	num, den, ok := tr.Events[2].TimeSignature()
+	assert.True(t, ok)
+	assert.Equal(t, 3, num)
+	assert.Equal(t, 4, den)
+}
+
+func Test_ParseSMF_UnknownChunk(t *testing.T) {
+	// --- Given ---
+	b := smfFile(t)
+	b = append(b[:14:14], append([]byte("XFIH\x00\x00\x00\x02ab"), b[14:]...)...)
+
+	// --- When ---
+	smf, err := ParseSMF(b)
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Len(t, 1, smf.Tracks)
+}
+
+func Test_ParseSMF_Errors(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		b func(b []byte) []byte
+	}{
+		{"empty", func(b []byte) []byte { return nil }},
+		{"not MThd", func(b []byte) []byte { b[0] = 'X'; return b }},
+		{"short header", func(b []byte) []byte { b[7] = 5; return b }},
+		{"track count", func(b []byte) []byte { b[11] = 2; return b }},
+		{"truncated track", func(b []byte) []byte { return b[:60] }},
+		{"no running status", func(b []byte) []byte { b[23] = 0x03; return b }},
+		{"truncated event", func(b []byte) []byte { b[25] = 0x40; return b }},
+		{"invalid status", func(b []byte) []byte { b[46] = 0xf1; return b }},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			smf, err := ParseSMF(tc.b(smfFile(t)))
+
+			// --- Then ---
+			assert.ErrorIs(t, ErrSMFInvalid, err)
+			assert.Nil(t, smf)
+		})
+	}
+}
+
+func Test_SMF_Bytes(t *testing.T) {
+	// --- Given ---
+	smf := must.Value(ParseSMF(smfFile(t)))
+
+	// --- When ---
+	have := smf.Bytes()
+
+	// --- Then ---
+	assert.Equal(t, smfFile(t), have)
+}
+
+func Test_SMF_Bytes_Edited(t *testing.T) {
+	// --- Given ---
+	smf := must.Value(ParseSMF(smfFile(t)))
+	tr := &smf.Tracks[0]
+	tr.Events = append(tr.Events[:6:6], MIDIEvent{
+		Delta:  10,
+		Status: 0x80,
+		Data:   []byte{0x3c, 0x40},
+	})
+	smf.Tracks = append(smf.Tracks, MIDITrack{
+		Events: []MIDIEvent{{Status: MIDIMeta, Meta: MetaEndOfTrack}},
+	})
+
+	// --- When ---
+	have := smf.Bytes()
+
+	// --- Then ---
+	got := must.Value(ParseSMF(have))
+	assert.Len(t, 2, got.Tracks)
+	assert.Len(t, 7, got.Tracks[0].Events)
+	assert.Equal(t, smf.Tracks[0].Events[6], got.Tracks[0].Events[6])
+	assert.Equal(t, uint64(106), got.Tracks[0].Ticks())
+}
+
+func Test_SMF_TempoMap(t *testing.T) {
+	// --- Given ---
+	smf := must.Value(ParseSMF(smfFile(t)))
+
+	// --- When ---
+	have := smf.TempoMap()
+
+	// --- Then ---
+	exp := []TempoChange{
+		{Tick: 0, Time: 0, Tempo: 500000},
+		{Tick: 288, Time: 1500 * time.Millisecond, Tempo: 1000000},
+	}
+	assert.Equal(t, exp, have)
+	assert.Equal(t, 120.0, have[0].BPM())
+	assert.Equal(t, 60.0, have[1].BPM())
+}
+
+func Test_SMF_TempoMap_Default(t *testing.T) {
+	// --- Given ---
+	smf := &SMF{Division: 96}
+
+	// --- When ---
+	have := smf.TempoMap()
+
+	// --- Then ---
+	assert.Equal(t, []TempoChange{{Tempo: DefaultMIDITempo}}, have)
+}
+
+func Test_SMF_TempoMap_Format2(t *testing.T) {
+	// --- Given ---
+	smf := &SMF{
+		Format:   2,
+		Division: 96,
+		Tracks: []MIDITrack{
+			{Events: []MIDIEvent{{Delta: 96, Status: MIDIMeta, Meta: MetaTempo, Data: []byte{0x0f, 0x42, 0x40}}}},
+			{Events: []MIDIEvent{{Delta: 0, Status: MIDIMeta, Meta: MetaTempo, Data: []byte{0x03, 0xd0, 0x90}}, {Delta: 192}}},
+		},
+	}
+
+	// --- When ---
+	have := smf.TempoMap()
+
+	// --- Then ---
+	exp := []TempoChange{
+		{Tick: 0, Time: 0, Tempo: 500000},
+		{Tick: 96, Time: 500 * time.Millisecond, Tempo: 1000000},
+	}
+	assert.Equal(t, exp, have)
+	assert.Equal(t, []TempoChange{{Tempo: 250000}}, smf.TrackTempoMap(1))
+	assert.Equal(t, []TempoChange{{Tempo: DefaultMIDITempo}}, smf.TrackTempoMap(2))
+	assert.Equal(t, 500*time.Millisecond, smf.Duration())
+}
+
+func Test_SMF_TrackTempoMap_Format1(t *testing.T) {
+	// --- Given ---
+	smf := must.Value(ParseSMF(smfFile(t)))
+
+	// --- When ---
+	have := smf.TrackTempoMap(1)
+
+	// --- Then ---
+	assert.Equal(t, smf.TempoMap(), have)
+}
+
+func Test_SMF_Time(t *testing.T) {
+	// --- Given ---
+	smf := must.Value(ParseSMF(smfFile(t)))
+
+	// --- Then ---
+	assert.Equal(t, time.Duration(0), smf.Time(0))
+	assert.Equal(t, 500*time.Millisecond, smf.Time(96))
+	assert.Equal(t, 2*time.Second, smf.Time(336))
+	assert.Equal(t, 2500*time.Millisecond, smf.Duration())
+}
+
+func Test_SMF_Duration_SMPTE(t *testing.T) {
+	// --- Given ---
+	smf := &SMF{
+		Division: 0xe728, // 25 fps, 40 ticks per frame.
+		Tracks:   []MIDITrack{{Events: []MIDIEvent{{Delta: 2000}}}},
+	}
+
+	// --- When ---
+	have := smf.Duration()
+
+	// --- Then ---
+	assert.Equal(t, 2*time.Second, have)
+}
+
+func Test_SMF_sample_rmi(t *testing.T) {
+	// --- Given ---
+	rif := New(LoadData)
+	fil := must.Value(os.Open("testdata/sample.rmi"))
+	t.Cleanup(func() { _ = fil.Close() })
+	must.Value(rif.ReadFrom(fil))
+
+	// --- When ---
+	ch, ok := rif.Chunks().First(IDdata).(*ChunkMIDI)
+
+	// --- Then ---
+	assert.True(t, ok)
+	assert.Equal(t, uint16(1), ch.SMF.Format)
+	assert.Equal(t, uint16(240), ch.SMF.Division)
+	assert.Len(t, 17, ch.SMF.Tracks)
+	assert.True(t, ch.SMF.Duration() > 0)
+	assert.True(t, len(ch.SMF.TempoMap()) > 0)
+}
+
+func Test_appendVLQ(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		v   uint32
+		exp []byte
+	}{
+		{"zero", 0, []byte{0x00}},
+		{"one byte", 0x7f, []byte{0x7f}},
+		{"two bytes", 0x80, []byte{0x81, 0x00}},
+		{"three bytes", 0x3fff + 1, []byte{0x81, 0x80, 0x00}},
+		{"max", 0x0fffffff, []byte{0xff, 0xff, 0xff, 0x7f}},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			have := appendVLQ(nil, tc.v)
+
+			// --- Then ---
+			assert.Equal(t, tc.exp, have)
+			v, pos, ok := readVLQ(have, 0)
+			assert.True(t, ok)
+			assert.Equal(t, tc.v, v)
+			assert.Equal(t, len(have), pos)
+		})
+	}
+}
+
+func Test_readVLQ_Errors(t *testing.T) {
+	// --- When ---
+	_, _, ok0 := readVLQ([]byte{0x81}, 0)
+	_, _, ok1 := readVLQ([]byte{0xff, 0xff, 0xff, 0xff, 0x7f}, 0)
+
+	// --- Then ---
+	assert.False(t, ok0)
+	assert.False(t, ok1)
+}
@@ -0,0 +1,71 @@
+package riff
+
+import (
+	"fmt"
+)
+
+// IDBODY represents "BODY" (sample data) chunk ID of the 8SVX file.
+const IDBODY uint32 = 0x424f4459
+
+// fibDelta is the table of deltas used by the Fibonacci-delta encoding.
+var fibDelta = [16]int8{-34, -21, -13, -8, -5, -3, -2, -1, 0, 1, 2, 3, 5, 8, 13, 21}
+
+// SVXVoice returns the voice header and the signed 8-bit samples of the
+// "FORM 8SVX" chunk. The voice header may be inherited from the "PROP 8SVX"
+// chunk (see [ChunkIFF.Property]). The Fibonacci-delta compressed samples
+// are decompressed. It returns [ErrSkipDataMode] if the "BODY" chunk was
+// decoded in [SkipData] mode.
+func SVXVoice(form *ChunkIFF) (*ChunkVHDR, []int8, error) {
+	if form.ID() != IDFORM || form.Type() != Type8SVX {
+		return nil, nil, fmt.Errorf(
+			"expected %s %s got %s %s",
+			Uint32(IDFORM),
+			Uint32(Type8SVX),
+			Uint32(form.ID()),
+			Uint32(form.Type()),
+		)
+	}
+	vhdr, ok := form.Property(IDVHDR).(*ChunkVHDR)
+	if !ok {
+		return nil, nil, fmt.Errorf("missing %s chunk: %w", Uint32(IDVHDR), ErrUnsupportedFormat)
+	}
+	body, ok := form.Chunks().First(IDBODY).(*ChunkRAWC)
+	if !ok {
+		return nil, nil, fmt.Errorf("missing %s chunk: %w", Uint32(IDBODY), ErrUnsupportedFormat)
+	}
+	if body.data == nil {
+		return nil, nil, ErrSkipDataMode
+	}
+
+	switch vhdr.Compression {
+	case SVXCompNone:
+		smp := make([]int8, len(body.data))
+		for i, b := range body.data {
+			smp[i] = int8(b)
+		}
+		return vhdr, smp, nil
+	case SVXCompFibDelta:
+		return vhdr, FibDeltaDecode(body.data), nil
+	default:
+		return nil, nil, fmt.Errorf("%w: compression %d", ErrUnsupportedFormat, vhdr.Compression)
+	}
+}
+
+// FibDeltaDecode decodes the Fibonacci-delta encoded samples. The first
+// byte of src is the pad byte, the second is the initial sample value and
+// each of the following bytes holds two 4-bit delta codes, the high one
+// first.
+func FibDeltaDecode(src []byte) []int8 {
+	if len(src) < 2 {
+		return nil
+	}
+	dst := make([]int8, 0, 2*(len(src)-2))
+	x := int8(src[1])
+	for _, b := range src[2:] {
+		x += fibDelta[b>>4]
+		dst = append(dst, x)
+		x += fibDelta[b&0x0f]
+		dst = append(dst, x)
+	}
+	return dst
+}
@@ -0,0 +1,135 @@
+package riff
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/ctx42/testing/pkg/assert"
+	"github.com/ctx42/testing/pkg/must"
+)
+
+func Test_SVXVoice(t *testing.T) {
+	t.Run("inherited header", func(t *testing.T) {
+		// --- Given ---
+		lst := must.Value(ReadIFF(bytes.NewReader(iffList()), LoadData))
+		form := lst.Forms(Type8SVX)[0]
+
+		// --- When ---
+		vhdr, smp, err := SVXVoice(form)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, uint32(3), vhdr.OneShotHiSamples)
+		assert.Equal(t, []int8{1, 2, -1}, smp)
+	})
+
+	t.Run("Fibonacci-delta", func(t *testing.T) {
+		// --- Given ---
+		lst := must.Value(ReadIFF(bytes.NewReader(iffList()), LoadData))
+		form := lst.Forms(Type8SVX)[1]
+
+		// --- When ---
+		vhdr, smp, err := SVXVoice(form)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, SVXCompFibDelta, vhdr.Compression)
+		assert.Equal(t, []int8{10, 11}, smp)
+	})
+
+	t.Run("top level form", func(t *testing.T) {
+		// --- Given ---
+		src := iffGroup("FORM", "8SVX", svxVHDR(1, SVXCompNone), iffChunk("BODY", []byte{0x80}))
+		form := must.Value(ReadIFF(bytes.NewReader(src), LoadData))
+
+		// --- When ---
+		_, smp, err := SVXVoice(form)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, []int8{-128}, smp)
+	})
+}
+
+func Test_SVXVoice_Errors(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		src  []byte
+		load bool
+		exp  error
+	}{
+		{
+			"missing VHDR",
+			iffGroup("FORM", "8SVX", iffChunk("BODY", []byte{1, 2})),
+			LoadData,
+			ErrUnsupportedFormat,
+		},
+		{
+			"missing BODY",
+			iffGroup("FORM", "8SVX", svxVHDR(1, SVXCompNone)),
+			LoadData,
+			ErrUnsupportedFormat,
+		},
+		{
+			"unknown compression",
+			iffGroup("FORM", "8SVX", svxVHDR(1, 7), iffChunk("BODY", []byte{1, 2})),
+			LoadData,
+			ErrUnsupportedFormat,
+		},
+		{
+			"skip data",
+			iffGroup("FORM", "8SVX", svxVHDR(1, SVXCompNone), iffChunk("BODY", []byte{1, 2})),
+			SkipData,
+			ErrSkipDataMode,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- Given ---
+			form := must.Value(ReadIFF(bytes.NewReader(tc.src), tc.load))
+
+			// --- When ---
+			_, _, err := SVXVoice(form)
+
+			// --- Then ---
+			assert.ErrorIs(t, tc.exp, err)
+		})
+	}
+}
+
+func Test_SVXVoice_NotSVX(t *testing.T) {
+	// --- Given ---
+	form := IFF(IDFORM, LoadData, nil)
+	form.GroupType = TypeAIFF
+
+	// --- When ---
+	_, _, err := SVXVoice(form)
+
+	// --- Then ---
+	assert.Error(t, err)
+}
+
+func Test_FibDeltaDecode(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		src []byte
+		exp []int8
+	}{
+		{"nil", nil, nil},
+		{"initial only", []byte{0, 5}, []int8{}},
+		{"deltas", []byte{0, 0, 0x8f, 0x07}, []int8{0, 21, -13, -14}},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			have := FibDeltaDecode(tc.src)
+
+			// --- Then ---
+			assert.Equal(t, tc.exp, have)
+		})
+	}
+}
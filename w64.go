@@ -0,0 +1,345 @@
+package riff
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+)
+
+// GUID represents the 16 byte globally unique identifier in the byte order
+// it is stored in the file (the first three fields are little-endian).
+type GUID [16]byte
+
+// String returns the GUID in the canonical
+// "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx" form.
+func (g GUID) String() string {
+	return fmt.Sprintf(
+		"%08x-%04x-%04x-%x-%x",
+		le.Uint32(g[:]),
+		le.Uint16(g[4:]),
+		le.Uint16(g[6:]),
+		g[8:10],
+		g[10:],
+	)
+}
+
+// Wave64 GUIDs which don't follow the FourCC pattern (see [W64GUID]).
+var (
+	// W64GUIDRIFF represents the Wave64 file header GUID
+	// {66666972-912E-11CF-A5D6-28DB04C10000}.
+	W64GUIDRIFF = GUID{
+		'r', 'i', 'f', 'f', 0x2e, 0x91, 0xcf, 0x11,
+		0xa5, 0xd6, 0x28, 0xdb, 0x04, 0xc1, 0x00, 0x00,
+	}
+
+	// W64GUIDLIST represents the Wave64 "list" chunk GUID
+	// {7473696C-912F-11CF-A5D6-28DB04C10000}.
+	W64GUIDLIST = GUID{
+		'l', 'i', 's', 't', 0x2f, 0x91, 0xcf, 0x11,
+		0xa5, 0xd6, 0x28, 0xdb, 0x04, 0xc1, 0x00, 0x00,
+	}
+)
+
+// w64Suffix represents the last 12 bytes of the Wave64 GUIDs made from
+// the FourCC.
+var w64Suffix = [12]byte{
+	0xf3, 0xac, 0xd3, 0x11, 0x8c, 0xd1, 0x00, 0xc0, 0x4f, 0x8e, 0xdb, 0x8a,
+}
+
+// idW64junk represents Wave64 "junk" FourCC.
+const idW64junk uint32 = 0x6a756e6b
+
+// W64HeaderSize represents the size of the Wave64 chunk header (GUID and
+// 64-bit size) in bytes.
+const W64HeaderSize = 24
+
+// W64Align represents the Wave64 chunk alignment in bytes.
+const W64Align = 8
+
+// W64GUID returns the Wave64 GUID for the RIFF chunk ID or form type. The
+// GUID is made of the FourCC followed by the
+// "F3ACD311-8CD1-00C0-4F8E-DB8A" suffix, except for the "RIFF" and "LIST"
+// IDs which have their own GUIDs and "JUNK" which is lowercased.
+func W64GUID(id uint32) GUID {
+	switch id {
+	case IDRIFF:
+		return W64GUIDRIFF
+	case IDLIST:
+		return W64GUIDLIST
+	case IDJUNK:
+		id = idW64junk
+	}
+	var g GUID
+	be.PutUint32(g[:], id)
+	copy(g[4:], w64Suffix[:])
+	return g
+}
+
+// W64ID returns the RIFF chunk ID or form type for the Wave64 GUID. It
+// returns false if the GUID doesn't map to the FourCC (see [W64GUID]).
+func W64ID(g GUID) (uint32, bool) {
+	switch {
+	case g == W64GUIDRIFF:
+		return IDRIFF, true
+	case g == W64GUIDLIST:
+		return IDLIST, true
+	case [12]byte(g[4:]) != w64Suffix:
+		return 0, false
+	}
+	id := be.Uint32(g[:])
+	if id == idW64junk {
+		return IDJUNK, true
+	}
+	return id, true
+}
+
+// ReadW64 reads the Sony Wave64 file from r. The chunks with GUIDs mapping
+// to the FourCC (see [W64ID]) are decoded with decoders registered by
+// [New], so the returned [RIFF] can be written as the RIFF file without
+// loss. Other chunks are kept as [ChunkW64]. The "LIST" chunk bodies keep
+// the RIFF sub-chunk layout. Chunks of 4 GiB or more (e.g. "data" chunks
+// of long recordings) don't fit the RIFF chunk types and are kept as
+// [ChunkW64] too, so such files can be written back only with [WriteW64]
+// or [WriteRF64].
+func ReadW64(r io.Reader, load bool) (*RIFF, error) {
+	var g GUID
+	if _, err := io.ReadFull(r, g[:]); err != nil {
+		return nil, err
+	}
+	if g != W64GUIDRIFF {
+		return nil, ErrNotW64
+	}
+
+	var size uint64
+	if err := binary.Read(r, le, &size); err != nil {
+		return nil, fmt.Errorf(errFmtDecode, g, err)
+	}
+	if size < W64HeaderSize+16 {
+		return nil, fmt.Errorf(errFmtDecode, g, ErrTooShort)
+	}
+
+	if _, err := io.ReadFull(r, g[:]); err != nil {
+		return nil, fmt.Errorf(errFmtDecode, W64GUIDRIFF, err)
+	}
+	typ, ok := W64ID(g)
+	if !ok {
+		return nil, fmt.Errorf("unknown form type %s: %w", g, ErrNotW64)
+	}
+
+	rif := New(load)
+	rif.riffType = typ
+
+	// Files with size bigger than actual are read to the end.
+	body := limitReader(r, int64(min(size-W64HeaderSize-16, math.MaxInt64)))
+	for {
+		if _, err := io.ReadFull(body, g[:]); err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return nil, err
+		}
+
+		var csize uint64
+		if err := binary.Read(body, le, &csize); err != nil {
+			return nil, fmt.Errorf(errFmtDecode, g, err)
+		}
+		if csize < W64HeaderSize {
+			return nil, fmt.Errorf(errFmtDecode, g, ErrTooShort)
+		}
+		n := csize - W64HeaderSize
+
+		id, ok := W64ID(g)
+		switch {
+		case !ok, n > math.MaxUint32-1:
+			ch := W64(g, load)
+			if _, err := ch.readBody(body, n); err != nil {
+				return nil, err
+			}
+			rif.chunks = append(rif.chunks, ch)
+
+		default:
+			src := newW64Body(body, uint32(n))
+			if _, err := rif.decodeChunk(id, src); err != nil {
+				return nil, err
+			}
+			if err := src.drain(); err != nil {
+				return nil, fmt.Errorf(errFmtDecode, Uint32(id), err)
+			}
+		}
+
+		// The last chunk may not be padded.
+		if err := SkipN(body, w64Pad(n)); err != nil && !errors.Is(err, io.EOF) &&
+			!errors.Is(err, io.ErrUnexpectedEOF) {
+			return nil, err
+		}
+	}
+	rif.size = 4 + rif.chunks.Size()
+
+	return rif, nil
+}
+
+// WriteW64 writes the RIFF file as the Sony Wave64 file to w. The chunks
+// are encoded as for the RIFF file and their headers are replaced with the
+// Wave64 GUIDs (see [W64GUID]) and 64-bit sizes.
+func WriteW64(w io.Writer, rif *RIFF) (int64, error) {
+	var sum int64
+
+	size := uint64(W64HeaderSize + 16)
+	for _, ch := range rif.chunks {
+		n := uint64(ch.Size())
+		if cw, ok := ch.(*ChunkW64); ok {
+			n = cw.size
+		}
+		size += W64HeaderSize + n + uint64(w64Pad(n))
+	}
+
+	head := make([]byte, 0, W64HeaderSize+16)
+	head = append(head, W64GUIDRIFF[:]...)
+	head = le.AppendUint64(head, size)
+	form := W64GUID(rif.riffType)
+	head = append(head, form[:]...)
+	n, err := w.Write(head)
+	sum += int64(n)
+	if err != nil {
+		return sum, fmt.Errorf(errFmtEncode, W64GUIDRIFF, err)
+	}
+
+	buf := &bytes.Buffer{}
+	for _, ch := range rif.chunks {
+		var in int64
+		if cw, ok := ch.(*ChunkW64); ok {
+			in, err = cw.writeW64(w)
+		} else {
+			in, err = writeW64Chunk(w, buf, ch)
+		}
+		sum += in
+		if err != nil {
+			return sum, err
+		}
+	}
+
+	return sum, nil
+}
+
+// writeW64Chunk encodes the chunk to buf and writes it to w with the
+// Wave64 header.
+func writeW64Chunk(w io.Writer, buf *bytes.Buffer, ch Chunk) (int64, error) {
+	buf.Reset()
+	if _, err := ch.WriteTo(buf); err != nil {
+		return 0, err
+	}
+	b := buf.Bytes()
+	id := be.Uint32(b)
+	body := b[8 : 8+le.Uint32(b[4:])]
+
+	g := W64GUID(id)
+	out := make([]byte, 0, W64HeaderSize)
+	out = append(out, g[:]...)
+	out = le.AppendUint64(out, W64HeaderSize+uint64(len(body)))
+
+	var sum int64
+	for _, p := range [][]byte{out, body, make([]byte, w64Pad(uint64(len(body))))} {
+		n, err := w.Write(p)
+		sum += int64(n)
+		if err != nil {
+			return sum, fmt.Errorf(errFmtEncode, g, err)
+		}
+	}
+	return sum, nil
+}
+
+// w64Pad returns the number of padding bytes following the Wave64 chunk
+// body of size n.
+func w64Pad(n uint64) uint32 {
+	return uint32((W64Align - n%W64Align) % W64Align)
+}
+
+// w64Body is a reader presenting the Wave64 chunk body as the RIFF chunk
+// body: the 32-bit little-endian size followed by the body and the
+// padding byte if the size is odd.
+type w64Body struct {
+	// Remaining size bytes.
+	head []byte
+
+	// Underlying reader.
+	r io.Reader
+
+	// Remaining body bytes.
+	left uint32
+
+	// Remaining padding bytes.
+	pad uint32
+
+	// Number of bytes read or skipped.
+	pos int64
+}
+
+// newW64Body returns a new instance of w64Body for the body of size bytes.
+func newW64Body(r io.Reader, size uint32) *w64Body {
+	return &w64Body{
+		head: le.AppendUint32(nil, size),
+		r:    r,
+		left: size,
+		pad:  size % 2,
+	}
+}
+
+func (b *w64Body) Read(p []byte) (int, error) {
+	switch {
+	case len(p) == 0:
+		return 0, nil
+
+	case len(b.head) > 0:
+		n := copy(p, b.head)
+		b.head = b.head[n:]
+		b.pos += int64(n)
+		return n, nil
+
+	case b.left > 0:
+		if uint64(len(p)) > uint64(b.left) {
+			p = p[:b.left]
+		}
+		n, err := b.r.Read(p)
+		b.left -= uint32(n)
+		b.pos += int64(n)
+		if errors.Is(err, io.EOF) && b.left > 0 {
+			err = io.ErrUnexpectedEOF
+		}
+		return n, err
+
+	case b.pad > 0:
+		p[0] = 0
+		b.pad--
+		b.pos++
+		return 1, nil
+	}
+	return 0, io.EOF
+}
+
+// Seek supports only seeking forward relative to the current position
+// within the body and padding. It returns the new offset relative to the
+// start of the size bytes or [ErrUnsupportedSeek].
+func (b *w64Body) Seek(offset int64, whence int) (int64, error) {
+	if whence != io.SeekCurrent || offset < 0 || len(b.head) > 0 ||
+		offset > int64(b.left)+int64(b.pad) {
+		return b.pos, ErrUnsupportedSeek
+	}
+	n := uint32(min(offset, int64(b.left)))
+	if err := SkipN(b.r, n); err != nil {
+		return b.pos, err
+	}
+	b.left -= n
+	b.pad -= uint32(offset) - n
+	b.pos += offset
+	return b.pos, nil
+}
+
+// drain skips the body bytes not read by the decoder.
+func (b *w64Body) drain() error {
+	n := b.left
+	b.left = 0
+	return SkipN(b.r, n)
+}
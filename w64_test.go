@@ -0,0 +1,408 @@
+package riff
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"testing"
+
+	"github.com/ctx42/testing/pkg/assert"
+	"github.com/ctx42/testing/pkg/kit"
+	"github.com/ctx42/testing/pkg/kit/iokit"
+	"github.com/ctx42/testing/pkg/must"
+
+	"github.com/rzajac/riff/internal/test"
+)
+
+// w64File returns Wave64 file with "fmt ", odd size "data" and "marker"
+// chunks.
+func w64File(t *testing.T) []byte {
+	fmtGUID, dataGUID, waveGUID := W64GUID(IDfmt), W64GUID(IDdata), W64GUID(TypeWAVE)
+
+	src := &bytes.Buffer{}
+	test.WriteBytes(t, src, W64GUIDRIFF[:])                      // (  0) 16 - GUID
+	test.WriteBytes(t, src, le.AppendUint64(nil, 140))           // ( 16)  8 - File size
+	test.WriteBytes(t, src, waveGUID[:])                         // ( 24) 16 - Form type
+	test.WriteBytes(t, src, fmtGUID[:])                          // ( 40) 16 - GUID
+	test.WriteBytes(t, src, le.AppendUint64(nil, 24+16))         // ( 56)  8 - Chunk size
+	test.WriteBytes(t, src, must.Value(io.ReadAll(fmtBody())))   // ( 64) 16 - Format
+	test.WriteBytes(t, src, dataGUID[:])                         // ( 80) 16 - GUID
+	test.WriteBytes(t, src, le.AppendUint64(nil, 24+3))          // ( 96)  8 - Chunk size
+	test.WriteBytes(t, src, []byte{1, 2, 3})                     // (104)  3 - Data
+	test.WriteBytes(t, src, make([]byte, 5))                     // (107)  5 - Padding
+	test.WriteBytes(t, src, must.Value(io.ReadAll(w64Chunk(t)))) // (112) 32 - Marker
+	// Total length: 140+4 = 144
+	b := src.Bytes()
+	le.PutUint64(b[16:], uint64(len(b)))
+	return b
+}
+
+// fmtBody returns the body of the 8-bit mono "fmt " chunk.
+func fmtBody() io.Reader {
+	buf := &bytes.Buffer{}
+	must.Value(fmt8bitMono().WriteTo(buf))
+	return bytes.NewReader(buf.Bytes()[8:])
+}
+
+func Test_GUID_String(t *testing.T) {
+	// --- When ---
+	have := W64GUIDRIFF.String()
+
+	// --- Then ---
+	assert.Equal(t, "66666972-912e-11cf-a5d6-28db04c10000", have)
+}
+
+func Test_W64GUID(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		id  uint32
+		exp string
+	}{
+		{"RIFF", IDRIFF, "66666972-912e-11cf-a5d6-28db04c10000"},
+		{"LIST", IDLIST, "7473696c-912f-11cf-a5d6-28db04c10000"},
+		{"WAVE", TypeWAVE, "45564157-acf3-11d3-8cd1-00c04f8edb8a"},
+		{"fmt", IDfmt, "20746d66-acf3-11d3-8cd1-00c04f8edb8a"},
+		{"data", IDdata, "61746164-acf3-11d3-8cd1-00c04f8edb8a"},
+		{"JUNK", IDJUNK, "6b6e756a-acf3-11d3-8cd1-00c04f8edb8a"},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			g := W64GUID(tc.id)
+
+			// --- Then ---
+			assert.Equal(t, tc.exp, g.String())
+			id, ok := W64ID(g)
+			assert.True(t, ok)
+			assert.Equal(t, tc.id, id)
+		})
+	}
+}
+
+func Test_W64ID_Unknown(t *testing.T) {
+	// --- When ---
+	id, ok := W64ID(w64Marker)
+
+	// --- Then ---
+	assert.False(t, ok)
+	assert.Equal(t, uint32(0), id)
+}
+
+func Test_ReadW64(t *testing.T) {
+	// --- Given ---
+	b := w64File(t)
+
+	// --- When ---
+	rif, err := ReadW64(bytes.NewReader(b), LoadData)
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, TypeWAVE, rif.Type())
+	chs := rif.Chunks()
+	assert.Len(t, 3, chs)
+	assert.Equal(t, fmt8bitMono(), chs[0])
+	assert.Equal(t, []byte{1, 2, 3}, chs[1].(*ChunkDATA).data)
+	assert.Equal(t, w64Marker, chs[2].(*ChunkW64).GUID)
+	assert.Equal(t, []byte{1, 2, 3, 4}, chs[2].(*ChunkW64).Data())
+
+	dst := &bytes.Buffer{}
+	n, err := WriteW64(dst, rif)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(len(b)), n)
+	assert.Equal(t, b, dst.Bytes())
+}
+
+func Test_ReadW64_SkipData(t *testing.T) {
+	// --- Given ---
+	b := w64File(t)
+
+	// --- When ---
+	rif, err := ReadW64(bytes.NewBuffer(b), SkipData)
+
+	// --- Then ---
+	assert.NoError(t, err)
+	chs := rif.Chunks()
+	assert.Len(t, 3, chs)
+	assert.Equal(t, uint32(3), chs[1].Size())
+	assert.Nil(t, chs[1].(*ChunkDATA).data)
+	assert.Nil(t, chs[2].(*ChunkW64).Data())
+}
+
+func Test_ReadW64_Truncated(t *testing.T) {
+	// --- Given ---
+	b := w64File(t)
+	b = b[:108] // The "data" chunk padding is cut.
+
+	// --- When ---
+	rif, err := ReadW64(bytes.NewReader(b), LoadData)
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Len(t, 2, rif.Chunks())
+}
+
+func Test_ReadW64_Errors(t *testing.T) {
+	t.Run("not W64", func(t *testing.T) {
+		// --- Given ---
+		src := must.Value(os.Open("testdata/kick-16b441k.wav"))
+		t.Cleanup(func() { _ = src.Close() })
+
+		// --- When ---
+		_, err := ReadW64(src, LoadData)
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrNotW64, err)
+	})
+
+	t.Run("file too short", func(t *testing.T) {
+		// --- Given ---
+		b := w64File(t)
+		le.PutUint64(b[16:], 39)
+
+		// --- When ---
+		_, err := ReadW64(bytes.NewReader(b), LoadData)
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrTooShort, err)
+	})
+
+	t.Run("unknown form type", func(t *testing.T) {
+		// --- Given ---
+		b := w64File(t)
+		copy(b[24:], w64Marker[:])
+
+		// --- When ---
+		_, err := ReadW64(bytes.NewReader(b), LoadData)
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrNotW64, err)
+	})
+
+	t.Run("chunk too short", func(t *testing.T) {
+		// --- Given ---
+		b := w64File(t)
+		le.PutUint64(b[56:], 23)
+
+		// --- When ---
+		_, err := ReadW64(bytes.NewReader(b), LoadData)
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrTooShort, err)
+	})
+
+	t.Run("chunk bigger than file", func(t *testing.T) {
+		// --- Given ---
+		b := w64File(t)
+		le.PutUint64(b[16:], 1<<34)
+		le.PutUint64(b[96:], 1<<33)
+
+		// --- When ---
+		_, err := ReadW64(bytes.NewReader(b), LoadData)
+
+		// --- Then ---
+		assert.ErrorIs(t, io.ErrUnexpectedEOF, err)
+	})
+
+	t.Run("chunk truncated", func(t *testing.T) {
+		// --- Given ---
+		b := w64File(t)
+
+		// --- When ---
+		_, err := ReadW64(bytes.NewReader(b[:100]), LoadData)
+
+		// --- Then ---
+		assert.ErrorIs(t, io.ErrUnexpectedEOF, err)
+	})
+
+	// Reading less than 40 bytes should always result in an error.
+	for _, i := range []int{0, 15, 16, 23, 24, 39} {
+		// --- Given ---
+		b := w64File(t)
+
+		// --- When ---
+		_, err := ReadW64(bytes.NewReader(b[:i]), LoadData)
+
+		// --- Then ---
+		assert.Error(t, err)
+	}
+}
+
+// sparseFile is an [io.ReadSeeker] of given size with the head and tail
+// bytes and zeros in between.
+type sparseFile struct {
+	head, tail []byte
+	size, off  int64
+}
+
+func (f *sparseFile) Read(p []byte) (int, error) {
+	if f.off >= f.size {
+		return 0, io.EOF
+	}
+	p = p[:min(int64(len(p)), f.size-f.off)]
+	tailOff := f.size - int64(len(f.tail))
+	for i := range p {
+		switch off := f.off + int64(i); {
+		case off < int64(len(f.head)):
+			p[i] = f.head[off]
+		case off >= tailOff:
+			p[i] = f.tail[off-tailOff]
+		default:
+			p[i] = 0
+		}
+	}
+	f.off += int64(len(p))
+	return len(p), nil
+}
+
+func (f *sparseFile) Seek(offset int64, whence int) (int64, error) {
+	switch whence {
+	case io.SeekStart:
+		f.off = offset
+	case io.SeekCurrent:
+		f.off += offset
+	case io.SeekEnd:
+		f.off = f.size + offset
+	}
+	return f.off, nil
+}
+
+func Test_ReadW64_BigDataChunk(t *testing.T) {
+	// --- Given ---
+	const dataSize = 5 << 30 // 5 GiB
+	fmtGUID, dataGUID, waveGUID := W64GUID(IDfmt), W64GUID(IDdata), W64GUID(TypeWAVE)
+
+	head := &bytes.Buffer{}
+	test.WriteBytes(t, head, W64GUIDRIFF[:])                    // ( 0) 16 - GUID
+	test.WriteBytes(t, head, le.AppendUint64(nil, 0))           // (16)  8 - File size
+	test.WriteBytes(t, head, waveGUID[:])                       // (24) 16 - Form type
+	test.WriteBytes(t, head, fmtGUID[:])                        // (40) 16 - GUID
+	test.WriteBytes(t, head, le.AppendUint64(nil, 24+16))       // (56)  8 - Chunk size
+	test.WriteBytes(t, head, must.Value(io.ReadAll(fmtBody()))) // (64) 16 - Format
+	test.WriteBytes(t, head, dataGUID[:])                       // (80) 16 - GUID
+	test.WriteBytes(t, head, le.AppendUint64(nil, 24+dataSize)) // (96)  8 - Chunk size
+	tail := must.Value(io.ReadAll(w64Chunk(t)))
+	size := int64(head.Len()) + dataSize + int64(len(tail))
+	le.PutUint64(head.Bytes()[16:], uint64(size))
+
+	src := &sparseFile{head: head.Bytes(), tail: tail, size: size}
+
+	// --- When ---
+	rif, err := ReadW64(src, SkipData)
+
+	// --- Then ---
+	assert.NoError(t, err)
+	chs := rif.Chunks()
+	assert.Len(t, 3, chs)
+	assert.Equal(t, fmt8bitMono(), chs[0])
+	data, ok := chs[1].(*ChunkW64)
+	assert.True(t, ok)
+	assert.Equal(t, dataGUID, data.GUID)
+	assert.Equal(t, uint64(dataSize), data.size)
+	assert.Equal(t, IDdata, data.ID())
+	assert.Equal(t, w64Marker, chs[2].(*ChunkW64).GUID)
+	assert.Equal(t, size, src.off)
+}
+
+func Test_WriteW64_RoundTrip(t *testing.T) {
+	// --- Given ---
+	rif := New(LoadData)
+	must.Value(rif.ReadFrom(must.Value(os.Open("testdata/kick-16b441k.wav"))))
+
+	// --- When ---
+	w64 := &bytes.Buffer{}
+	_, err := WriteW64(w64, rif)
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, W64GUIDRIFF[:], w64.Bytes()[:16])
+	assert.Equal(t, uint64(w64.Len()), le.Uint64(w64.Bytes()[16:]))
+
+	got := must.Value(ReadW64(bytes.NewReader(w64.Bytes()), LoadData))
+	dst := &bytes.Buffer{}
+	n, err := got.WriteTo(dst)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(31692), n)
+	assert.Equal(t, "1d7dbd0fe12ce2f8ec33ef5f90e271125a83ea94", kit.SHA1Reader(dst))
+}
+
+func Test_WriteW64_Errors(t *testing.T) {
+	t.Run("skip data", func(t *testing.T) {
+		// --- Given ---
+		rif := must.Value(ReadW64(bytes.NewReader(w64File(t)), SkipData))
+
+		// --- When ---
+		_, err := WriteW64(&bytes.Buffer{}, rif)
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrSkipDataMode, err)
+	})
+
+	for _, i := range []int{1, 40, 50, 80, 110, 120} {
+		// --- Given ---
+		rif := must.Value(ReadW64(bytes.NewReader(w64File(t)), LoadData))
+
+		// --- When ---
+		_, err := WriteW64(iokit.ErrWriter(&bytes.Buffer{}, i), rif)
+
+		// --- Then ---
+		assert.Error(t, err)
+	}
+}
+
+func Test_w64Body(t *testing.T) {
+	t.Run("read", func(t *testing.T) {
+		// --- Given ---
+		src := bytes.NewReader([]byte{1, 2, 3, 4})
+
+		// --- When ---
+		have := must.Value(io.ReadAll(newW64Body(src, 3)))
+
+		// --- Then ---
+		assert.Equal(t, []byte{3, 0, 0, 0, 1, 2, 3, 0}, have)
+		assert.Equal(t, 1, src.Len())
+	})
+
+	t.Run("seek", func(t *testing.T) {
+		// --- Given ---
+		src := bytes.NewReader([]byte{1, 2, 3, 4})
+		b := newW64Body(src, 3)
+		must.Value(io.ReadFull(b, make([]byte, 5)))
+
+		// --- When ---
+		pos, err := b.Seek(3, io.SeekCurrent)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, int64(8), pos)
+		assert.Equal(t, 1, src.Len())
+		assert.Equal(t, []byte{}, must.Value(io.ReadAll(b)))
+	})
+
+	t.Run("seek errors", func(t *testing.T) {
+		// --- Given ---
+		b := newW64Body(bytes.NewReader([]byte{1, 2, 3, 4}), 3)
+
+		// --- Then ---
+		_, err := b.Seek(1, io.SeekCurrent)
+		assert.ErrorIs(t, ErrUnsupportedSeek, err)
+		must.Value(io.ReadFull(b, make([]byte, 4)))
+		_, err = b.Seek(1, io.SeekStart)
+		assert.ErrorIs(t, ErrUnsupportedSeek, err)
+		pos, err := b.Seek(5, io.SeekCurrent)
+		assert.ErrorIs(t, ErrUnsupportedSeek, err)
+		assert.Equal(t, int64(4), pos)
+	})
+
+	t.Run("unexpected EOF", func(t *testing.T) {
+		// --- Given ---
+		b := newW64Body(bytes.NewReader([]byte{1}), 3)
+
+		// --- When ---
+		_, err := io.ReadAll(b)
+
+		// --- Then ---
+		assert.ErrorIs(t, io.ErrUnexpectedEOF, err)
+	})
+}
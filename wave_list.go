@@ -0,0 +1,139 @@
+package riff
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+)
+
+// ExpandWaveList expands the "LIST wavl" chunk of alternating "data" and
+// "slnt" chunks into a single contiguous [ChunkDATA]. Silent sections are
+// filled with samples representing silence in the format described by cf.
+// Chunks other than "data" and "slnt" are ignored.
+func ExpandWaveList(lst *ChunkLIST, cf *ChunkFMT) (*ChunkDATA, error) {
+	if lst.ListType != IDwavl {
+		return nil, fmt.Errorf("expected %s list got %s", Uint32(IDwavl), Uint32(lst.ListType))
+	}
+
+	silence, err := cf.Silence()
+	if err != nil {
+		return nil, err
+	}
+
+	// Compute the expanded size first, so the silence from the file
+	// can't make us allocate more than fits in the chunk.
+	var size uint64
+	for _, ch := range lst.Chunks() {
+		switch sub := ch.(type) {
+		case *ChunkDATA:
+			if sub.data == nil {
+				return nil, ErrSkipDataMode
+			}
+			size += uint64(len(sub.data))
+
+		case *ChunkRAWC:
+			if sub.ID() != IDdata {
+				continue
+			}
+			if sub.data == nil {
+				return nil, ErrSkipDataMode
+			}
+			size += uint64(len(sub.data))
+
+		case *ChunkSLNT:
+			size += uint64(sub.Samples) * uint64(len(silence))
+		}
+	}
+	if size > math.MaxUint32 {
+		return nil, fmt.Errorf("expanded wave list size %d: %w", size, ErrOutOfRange)
+	}
+
+	buf := make([]byte, 0, size)
+	for _, ch := range lst.Chunks() {
+		switch sub := ch.(type) {
+		case *ChunkDATA:
+			buf = append(buf, sub.data...)
+
+		case *ChunkRAWC:
+			if sub.ID() == IDdata {
+				buf = append(buf, sub.data...)
+			}
+
+		case *ChunkSLNT:
+			off := len(buf)
+			buf = buf[:off+int(sub.Samples)*len(silence)]
+			fillRepeat(buf[off:], silence)
+		}
+	}
+
+	dst := DATA(LoadData)
+	if err = dst.SetData(buf); err != nil {
+		return nil, err
+	}
+	return dst, nil
+}
+
+// fillRepeat fills dst with repeated pattern.
+func fillRepeat(dst, pattern []byte) {
+	if len(dst) == 0 {
+		return
+	}
+	n := copy(dst, pattern)
+	for n < len(dst) {
+		n += copy(dst[n:], dst[:n])
+	}
+}
+
+// CompactWaveList converts data into the "LIST wavl" chunk. Runs of at
+// least minSamples silent samples are replaced with "slnt" chunks, the rest
+// of the samples is stored in "data" chunks.
+func CompactWaveList(data *ChunkDATA, cf *ChunkFMT, minSamples uint32) (*ChunkLIST, error) {
+	if data.data == nil {
+		return nil, ErrSkipDataMode
+	}
+	if minSamples == 0 {
+		return nil, fmt.Errorf("minimum silence length: %w", ErrOutOfRange)
+	}
+
+	silence, err := cf.Silence()
+	if err != nil {
+		return nil, err
+	}
+	ba := len(silence)
+	if len(data.data)%ba != 0 {
+		return nil, fmt.Errorf("data size not multiple of block align: %w", ErrChunkSizeMismatch)
+	}
+
+	var chs Chunks
+	addData := func(b []byte) {
+		if len(b) == 0 {
+			return
+		}
+		ch := DATA(LoadData)
+		_ = ch.SetData(b) // Never fails in LoadData mode.
+		chs = append(chs, ch)
+	}
+
+	var start, run int // Start of not yet emitted samples and silent run length.
+	cnt := len(data.data) / ba
+	for i := 0; i <= cnt; i++ {
+		if i < cnt && bytes.Equal(data.data[i*ba:(i+1)*ba], silence) {
+			run++
+			continue
+		}
+		if run >= int(minSamples) {
+			addData(data.data[start*ba : (i-run)*ba])
+			slnt := SLNT()
+			slnt.Samples = uint32(run)
+			chs = append(chs, slnt)
+			start = i
+		}
+		run = 0
+	}
+	addData(data.data[start*ba:])
+
+	lst := LIST(LoadData, NewRegistry(RAWCMake(LoadData)))
+	lst.ListType = IDwavl
+	lst.Modify(chs)
+	return lst, nil
+}
@@ -0,0 +1,210 @@
+package riff
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/ctx42/testing/pkg/assert"
+	"github.com/ctx42/testing/pkg/must"
+
+	"github.com/rzajac/riff/internal/test"
+)
+
+func listChunkType_wavl(t *testing.T) io.Reader {
+	src := &bytes.Buffer{}
+	test.ReadFrom(t, src, Uint32(IDLIST))       // ( 0) 4 - Chunk ID
+	test.WriteUint32LE(t, src, 36)              // ( 4) 4 - Chunk size
+	test.WriteUint32BE(t, src, IDwavl)          // ( 8) 4 - Type
+	test.ReadFrom(t, src, Uint32(IDdata))       // (12) 4 - Chunk ID
+	test.WriteUint32LE(t, src, 2)               // (16) 4 - Chunk size
+	test.WriteBytes(t, src, []byte{0x01, 0x02}) // (20) 2 - Samples
+	test.ReadFrom(t, src, Uint32(IDslnt))       // (22) 4 - Chunk ID
+	test.WriteUint32LE(t, src, 4)               // (26) 4 - Chunk size
+	test.WriteUint32LE(t, src, 3)               // (30) 4 - Silent samples
+	test.ReadFrom(t, src, Uint32(IDdata))       // (34) 4 - Chunk ID
+	test.WriteUint32LE(t, src, 1)               // (38) 4 - Chunk size
+	test.WriteBytes(t, src, []byte{0x03})       // (42) 1 - Samples
+	test.WriteByte(t, src, 0)                   // (43) 1 - Padding byte
+	// Total length: 8+36=44
+	return src
+}
+
+// fmt8bitMono returns fmt chunk for 8-bit mono PCM.
+func fmt8bitMono() *ChunkFMT {
+	ch := FMT()
+	ch.CompCode = CompPCM
+	ch.ChannelCnt = 1
+	ch.SampleRate = 8000
+	ch.AvgByteRate = 8000
+	ch.BlockAlign = 1
+	ch.BitsPerSample = 8
+	return ch
+}
+
+func Test_ChunkLIST_Type_wavl(t *testing.T) {
+	// --- Given ---
+	reg := New(LoadData).reg
+	src := listChunkType_wavl(t)
+	test.Skip4B(t, src) // Skip chunk ID.
+
+	// --- When ---
+	ch := LIST(LoadData, reg)
+	n, err := ch.ReadFrom(src)
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, int64(40), n)
+	assert.Equal(t, IDwavl, ch.Type())
+	assert.Equal(t, []uint32{IDdata, IDslnt, IDdata}, ch.Chunks().IDs())
+	assert.Type(t, &ChunkDATA{}, ch.Chunks()[0])
+	assert.Type(t, &ChunkSLNT{}, ch.Chunks()[1])
+	assert.True(t, test.IsAllRead(src))
+
+	dst := &bytes.Buffer{}
+	_, err = ch.WriteTo(dst)
+	assert.NoError(t, err)
+	assert.Equal(t, must.Value(io.ReadAll(listChunkType_wavl(t))), dst.Bytes())
+}
+
+func Test_ExpandWaveList(t *testing.T) {
+	// --- Given ---
+	src := listChunkType_wavl(t)
+	test.Skip4B(t, src) // Skip chunk ID.
+	lst := LIST(LoadData, New(LoadData).reg)
+	_, err := lst.ReadFrom(src)
+	assert.NoError(t, err)
+
+	t.Run("8-bit", func(t *testing.T) {
+		// --- When ---
+		have, err := ExpandWaveList(lst, fmt8bitMono())
+
+		// --- Then ---
+		assert.NoError(t, err)
+		exp := []byte{0x01, 0x02, 0x80, 0x80, 0x80, 0x03}
+		assert.Equal(t, exp, must.Value(io.ReadAll(have.Data())))
+		assert.Equal(t, uint32(6), have.Size())
+	})
+
+	t.Run("16-bit", func(t *testing.T) {
+		// --- Given ---
+		cf := fmt8bitMono()
+		cf.BitsPerSample = 16
+		cf.BlockAlign = 2
+
+		// --- When ---
+		have, err := ExpandWaveList(lst, cf)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		exp := []byte{0x01, 0x02, 0, 0, 0, 0, 0, 0, 0x03}
+		assert.Equal(t, exp, must.Value(io.ReadAll(have.Data())))
+	})
+}
+
+func Test_ExpandWaveList_Errors(t *testing.T) {
+	t.Run("not wave list", func(t *testing.T) {
+		// --- Given ---
+		lst := LIST(LoadData, NewRegistry(RAWCMake(LoadData)))
+		lst.ListType = IDINFO
+
+		// --- When ---
+		have, err := ExpandWaveList(lst, fmt8bitMono())
+
+		// --- Then ---
+		assert.ErrorEqual(t, "expected wavl list got INFO", err)
+		assert.Nil(t, have)
+	})
+
+	t.Run("skip data mode", func(t *testing.T) {
+		// --- Given ---
+		src := listChunkType_wavl(t)
+		test.Skip4B(t, src) // Skip chunk ID.
+		reg := NewRegistry(RAWCMake(SkipData))
+		lst := LIST(SkipData, reg)
+		_, err := lst.ReadFrom(src)
+		assert.NoError(t, err)
+
+		// --- When ---
+		have, err := ExpandWaveList(lst, fmt8bitMono())
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrSkipDataMode, err)
+		assert.Nil(t, have)
+	})
+
+	t.Run("too big", func(t *testing.T) {
+		// --- Given ---
+		slnt := SLNT()
+		slnt.Samples = 0xFFFFFFFF
+		lst := LIST(LoadData, NewRegistry(RAWCMake(LoadData)))
+		lst.ListType = IDwavl
+		lst.Modify(Chunks{slnt, slnt})
+
+		// --- When ---
+		have, err := ExpandWaveList(lst, fmt8bitMono())
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrOutOfRange, err)
+		assert.Nil(t, have)
+	})
+}
+
+func Test_CompactWaveList(t *testing.T) {
+	// --- Given ---
+	data := DATA(LoadData)
+	body := []byte{0x01, 0x80, 0x02, 0x80, 0x80, 0x80, 0x03, 0x80, 0x80, 0x80}
+	assert.NoError(t, data.SetData(body))
+
+	// --- When ---
+	have, err := CompactWaveList(data, fmt8bitMono(), 3)
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, IDwavl, have.Type())
+	exp := []uint32{IDdata, IDslnt, IDdata, IDslnt}
+	assert.Equal(t, exp, have.Chunks().IDs())
+	assert.Equal(t, uint32(3), have.Chunks()[1].(*ChunkSLNT).Samples)
+	assert.Equal(t, uint32(3), have.Chunks()[3].(*ChunkSLNT).Samples)
+	assert.Equal(t, []byte{0x01, 0x80, 0x02}, have.Chunks()[0].(*ChunkDATA).data)
+
+	back, err := ExpandWaveList(have, fmt8bitMono())
+	assert.NoError(t, err)
+	assert.Equal(t, body, must.Value(io.ReadAll(back.Data())))
+}
+
+func Test_CompactWaveList_Errors(t *testing.T) {
+	t.Run("skip data mode", func(t *testing.T) {
+		// --- When ---
+		have, err := CompactWaveList(DATA(SkipData), fmt8bitMono(), 1)
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrSkipDataMode, err)
+		assert.Nil(t, have)
+	})
+
+	t.Run("zero minimum", func(t *testing.T) {
+		// --- When ---
+		have, err := CompactWaveList(DATA(LoadData), fmt8bitMono(), 0)
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrOutOfRange, err)
+		assert.Nil(t, have)
+	})
+
+	t.Run("not aligned", func(t *testing.T) {
+		// --- Given ---
+		data := DATA(LoadData)
+		assert.NoError(t, data.SetData([]byte{0, 0, 0}))
+		cf := fmt8bitMono()
+		cf.BitsPerSample = 16
+		cf.BlockAlign = 2
+
+		// --- When ---
+		have, err := CompactWaveList(data, cf, 1)
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrChunkSizeMismatch, err)
+		assert.Nil(t, have)
+	})
+}
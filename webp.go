@@ -0,0 +1,203 @@
+package riff
+
+import (
+	"fmt"
+	"io"
+)
+
+// WebP represents the "RIFF WEBP" image. It provides access to the image
+// dimensions and the metadata chunks without decoding the pixels.
+type WebP struct {
+	*RIFF
+}
+
+// NewWebP returns a new instance of [WebP] for the decoded image.
+func NewWebP(rif *RIFF) (*WebP, error) {
+	if rif.Type() != TypeWEBP {
+		return nil, fmt.Errorf("expected %s form got %s", Uint32(TypeWEBP), Uint32(rif.Type()))
+	}
+	chs := rif.Chunks()
+	if chs.First(IDVP8X) == nil && chs.First(IDVP8) == nil && chs.First(IDVP8L) == nil {
+		return nil, fmt.Errorf("missing image data: %w", ErrWebPInvalid)
+	}
+	return &WebP{RIFF: rif}, nil
+}
+
+// Size returns the canvas dimensions in pixels. For the simple format
+// images it's the dimensions of the "VP8 " or "VP8L" bitstream.
+func (wp *WebP) Size() (width, height uint32) {
+	for _, ch := range wp.Chunks() {
+		switch ch := ch.(type) {
+		case *ChunkVP8X:
+			return ch.CanvasWidth, ch.CanvasHeight
+		case *ChunkVP8:
+			return ch.Width(), ch.Height()
+		case *ChunkVP8L:
+			return ch.Width(), ch.Height()
+		}
+	}
+	return 0, 0
+}
+
+// Animated returns true if the image is animated.
+func (wp *WebP) Animated() bool {
+	vp8x, ok := wp.Chunks().First(IDVP8X).(*ChunkVP8X)
+	return ok && vp8x.Has(VP8XAnimation)
+}
+
+// Frames returns animation frames of the image.
+func (wp *WebP) Frames() []*ChunkANMF {
+	var frs []*ChunkANMF
+	for _, ch := range wp.Chunks() {
+		if anmf, ok := ch.(*ChunkANMF); ok {
+			frs = append(frs, anmf)
+		}
+	}
+	return frs
+}
+
+// ICC returns the ICC color profile or nil if the image doesn't have one.
+func (wp *WebP) ICC() []byte {
+	if ch, ok := wp.Chunks().First(IDICCP).(*ChunkICCP); ok {
+		return ch.Profile()
+	}
+	return nil
+}
+
+// SetICC sets the ICC color profile. The "ICCP" chunk is removed when the
+// profile is empty. Images in the simple format are converted to the
+// extended format.
+func (wp *WebP) SetICC(profile []byte) {
+	vp8x, chs := wp.extended()
+	chs = chs.Remove(IDICCP)
+	if len(profile) > 0 {
+		ch := ICCP()
+		ch.SetProfile(profile)
+		// The "ICCP" chunk must follow the "VP8X" chunk.
+		chs = append(chs[:1], append(Chunks{ch}, chs[1:]...)...)
+	}
+	vp8x.Set(VP8XICC, len(profile) > 0)
+	wp.Modify(chs)
+}
+
+// EXIF returns the Exif metadata or nil if the image doesn't have one.
+func (wp *WebP) EXIF() []byte {
+	if ch, ok := wp.Chunks().First(IDEXIF).(*ChunkWebPEXIF); ok {
+		return ch.Data()
+	}
+	return nil
+}
+
+// SetEXIF sets the Exif metadata. The "EXIF" chunk is removed when the data
+// is empty. Images in the simple format are converted to the extended
+// format.
+func (wp *WebP) SetEXIF(data []byte) {
+	vp8x, chs := wp.extended()
+	chs = chs.Remove(IDEXIF)
+	if len(data) > 0 {
+		ch := WebPEXIF()
+		ch.SetData(data)
+		// The "EXIF" chunk goes after the image data and before the
+		// "XMP " chunk.
+		i := len(chs)
+		for j, sub := range chs {
+			if sub.ID() == IDWebPXMP {
+				i = j
+				break
+			}
+		}
+		chs = append(chs[:i], append(Chunks{ch}, chs[i:]...)...)
+	}
+	vp8x.Set(VP8XEXIF, len(data) > 0)
+	wp.Modify(chs)
+}
+
+// extended returns the "VP8X" chunk and the image chunks starting with
+// it. The "VP8X" chunk is created for the simple format images.
+func (wp *WebP) extended() (*ChunkVP8X, Chunks) {
+	chs := wp.Chunks()
+	if vp8x, ok := chs.First(IDVP8X).(*ChunkVP8X); ok {
+		return vp8x, chs
+	}
+	vp8x := VP8X()
+	vp8x.CanvasWidth, vp8x.CanvasHeight = wp.Size()
+	vp8x.Set(VP8XAlpha, wp.hasAlpha())
+	return vp8x, append(Chunks{vp8x}, chs...)
+}
+
+// hasAlpha returns true if the simple format image has transparency.
+func (wp *WebP) hasAlpha() bool {
+	if wp.Chunks().First(IDALPH) != nil {
+		return true
+	}
+	vp8l, ok := wp.Chunks().First(IDVP8L).(*ChunkVP8L)
+	return ok && vp8l.HasAlpha()
+}
+
+// uint24 decodes 24-bit little-endian unsigned integer.
+func uint24(b []byte) uint32 {
+	return uint32(b[0]) | uint32(b[1])<<8 | uint32(b[2])<<16
+}
+
+// putUint24 encodes v as 24-bit little-endian unsigned integer.
+func putUint24(b []byte, v uint32) {
+	b[0] = byte(v)
+	b[1] = byte(v >> 8)
+	b[2] = byte(v >> 16)
+}
+
+// readBitstream reads the chunk body of given size. The head is always
+// filled with the beginning of the body. The whole body is read into data
+// only when data is not nil, otherwise the rest of the body is skipped.
+// It returns the data, number of bytes read (including the padding byte)
+// and error if any.
+func readBitstream(r io.Reader, size uint32, head, data []byte) ([]byte, int64, error) {
+	var sum int64
+	in, err := io.ReadFull(r, head)
+	sum += int64(in)
+	if err != nil {
+		return data, sum, err
+	}
+
+	rest := RealSize(size) - uint32(len(head))
+	if data == nil {
+		if err = SkipN(r, rest); err != nil {
+			return nil, sum, err
+		}
+		return nil, sum + int64(rest), nil
+	}
+
+	data = grow(data, int(size))
+	copy(data, head)
+	in, err = io.ReadFull(r, data[len(head):])
+	sum += int64(in)
+	if err != nil {
+		return data, sum, err
+	}
+
+	n, err := ReadPaddingIf(r, size)
+	sum += n
+	return data, sum, err
+}
+
+// writeBitstream writes the chunk with id and body data.
+func writeBitstream(w io.Writer, id uint32, data []byte) (int64, error) {
+	var sum int64
+	size := uint32(len(data))
+
+	n, err := WriteIDAndSize(w, id, size)
+	sum += n
+	if err != nil {
+		return sum, err
+	}
+
+	in, err := w.Write(data)
+	sum += int64(in)
+	if err != nil {
+		return sum, err
+	}
+
+	n, err = WritePaddingIf(w, size)
+	sum += n
+	return sum, err
+}
@@ -0,0 +1,240 @@
+package riff
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/ctx42/testing/pkg/assert"
+	"github.com/ctx42/testing/pkg/must"
+)
+
+// webpFile returns the "RIFF WEBP" file composed of chs.
+func webpFile(t *testing.T, chs ...Chunk) []byte {
+	t.Helper()
+	rif := Compose(chs)
+	rif.SetType(TypeWEBP)
+	buf := &bytes.Buffer{}
+	must.Value(rif.WriteTo(buf))
+	return buf.Bytes()
+}
+
+// webpSimple returns the simple format lossy WebP file of 400x300 pixels.
+func webpSimple(t *testing.T) []byte {
+	t.Helper()
+	vp8 := VP8(LoadData)
+	must.Nil(vp8.SetData(vp8Bitstream()))
+	return webpFile(t, vp8)
+}
+
+// webpAnimated returns the animated WebP file with two frames and XMP
+// metadata.
+func webpAnimated(t *testing.T) []byte {
+	t.Helper()
+	vp8x := VP8X()
+	vp8x.Flags = VP8XAnimation | VP8XXMP
+	vp8x.CanvasWidth, vp8x.CanvasHeight = 400, 300
+	anim := ANIM()
+	anim.LoopCount = 1
+
+	frame := func(dur uint32) *ChunkANMF {
+		vp8 := VP8(LoadData)
+		must.Nil(vp8.SetData(vp8Bitstream()))
+		ch := ANMF(LoadData, nil)
+		ch.Width, ch.Height, ch.Duration = 400, 300, dur
+		ch.Modify(Chunks{vp8})
+		return ch
+	}
+
+	xmp := WebPXMP()
+	xmp.Packet.SetTitle("Animation")
+
+	return webpFile(t, vp8x, anim, frame(100), frame(200), xmp)
+}
+
+// readWebP decodes the WebP file.
+func readWebP(t *testing.T, b []byte, load bool) *WebP {
+	t.Helper()
+	rif := New(load)
+	must.Value(rif.ReadFrom(bytes.NewReader(b)))
+	return must.Value(NewWebP(rif))
+}
+
+func Test_NewWebP(t *testing.T) {
+	// --- Given ---
+	rif := New(SkipData)
+	must.Value(rif.ReadFrom(bytes.NewReader(webpSimple(t))))
+
+	// --- When ---
+	wp, err := NewWebP(rif)
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Same(t, rif, wp.RIFF)
+	assert.Type(t, &ChunkVP8{}, wp.Chunks()[0])
+	assert.False(t, wp.Animated())
+	assert.Nil(t, wp.ICC())
+	assert.Nil(t, wp.EXIF())
+
+	w, h := wp.Size()
+	assert.Equal(t, uint32(400), w)
+	assert.Equal(t, uint32(300), h)
+}
+
+func Test_NewWebP_Errors(t *testing.T) {
+	t.Run("not WEBP", func(t *testing.T) {
+		// --- Given ---
+		rif := Compose(nil)
+		rif.SetType(TypeWAVE)
+
+		// --- When ---
+		wp, err := NewWebP(rif)
+
+		// --- Then ---
+		assert.ErrorEqual(t, "expected WEBP form got WAVE", err)
+		assert.Nil(t, wp)
+	})
+
+	t.Run("missing image data", func(t *testing.T) {
+		// --- Given ---
+		rif := Compose(Chunks{ICCP()})
+		rif.SetType(TypeWEBP)
+
+		// --- When ---
+		wp, err := NewWebP(rif)
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrWebPInvalid, err)
+		assert.Nil(t, wp)
+	})
+}
+
+func Test_WebP_Animated(t *testing.T) {
+	// --- Given ---
+	b := webpAnimated(t)
+
+	// --- When ---
+	wp := readWebP(t, b, SkipData)
+
+	// --- Then ---
+	assert.True(t, wp.Animated())
+	assert.Equal(t, []uint32{IDVP8X, IDANIM, IDANMF, IDANMF, IDWebPXMP}, wp.Chunks().IDs())
+	assert.Equal(t, uint16(1), wp.Chunks().First(IDANIM).(*ChunkANIM).LoopCount)
+	assert.Equal(t, "Animation", wp.Chunks().First(IDWebPXMP).(*ChunkXMP).Packet.Title())
+
+	frs := wp.Frames()
+	assert.Len(t, 2, frs)
+	assert.Equal(t, uint32(100), frs[0].Duration)
+	assert.Equal(t, uint32(200), frs[1].Duration)
+	assert.Type(t, &ChunkVP8{}, frs[1].Chunks()[0])
+
+	w, h := wp.Size()
+	assert.Equal(t, uint32(400), w)
+	assert.Equal(t, uint32(300), h)
+}
+
+func Test_WebP_WriteTo(t *testing.T) {
+	// --- Given ---
+	b := webpAnimated(t)
+	wp := readWebP(t, b, LoadData)
+
+	// --- When ---
+	dst := &bytes.Buffer{}
+	n, err := wp.WriteTo(dst)
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, int64(len(b)), n)
+	assert.Equal(t, b, dst.Bytes())
+}
+
+func Test_WebP_SetICC(t *testing.T) {
+	t.Run("simple format", func(t *testing.T) {
+		// --- Given ---
+		vp8l := VP8L(LoadData)
+		must.Nil(vp8l.SetData(vp8lBitstream()))
+		wp := readWebP(t, webpFile(t, vp8l), LoadData)
+
+		// --- When ---
+		wp.SetICC([]byte("prof1"))
+
+		// --- Then ---
+		assert.Equal(t, []uint32{IDVP8X, IDICCP, IDVP8L}, wp.Chunks().IDs())
+		vp8x := wp.Chunks().First(IDVP8X).(*ChunkVP8X)
+		assert.Equal(t, VP8XICC|VP8XAlpha, vp8x.Flags)
+		assert.Equal(t, uint32(400), vp8x.CanvasWidth)
+		assert.Equal(t, uint32(300), vp8x.CanvasHeight)
+
+		buf := &bytes.Buffer{}
+		must.Value(wp.WriteTo(buf))
+		got := readWebP(t, buf.Bytes(), SkipData)
+		assert.Equal(t, []byte("prof1"), got.ICC())
+	})
+
+	t.Run("replace and remove", func(t *testing.T) {
+		// --- Given ---
+		wp := readWebP(t, webpAnimated(t), LoadData)
+		wp.SetICC([]byte("prof1"))
+
+		// --- When ---
+		wp.SetICC([]byte("prof2"))
+
+		// --- Then ---
+		assert.Equal(t, []byte("prof2"), wp.ICC())
+		assert.Equal(t, 1, wp.Chunks().Count(IDICCP))
+		assert.Equal(t, IDICCP, wp.Chunks()[1].ID())
+
+		wp.SetICC(nil)
+		assert.Nil(t, wp.ICC())
+		vp8x := wp.Chunks().First(IDVP8X).(*ChunkVP8X)
+		assert.Equal(t, VP8XAnimation|VP8XXMP, vp8x.Flags)
+	})
+}
+
+func Test_WebP_SetEXIF(t *testing.T) {
+	t.Run("before XMP", func(t *testing.T) {
+		// --- Given ---
+		wp := readWebP(t, webpAnimated(t), LoadData)
+
+		// --- When ---
+		wp.SetEXIF([]byte("II*\x00"))
+
+		// --- Then ---
+		exp := []uint32{IDVP8X, IDANIM, IDANMF, IDANMF, IDEXIF, IDWebPXMP}
+		assert.Equal(t, exp, wp.Chunks().IDs())
+		assert.Equal(t, []byte("II*\x00"), wp.EXIF())
+		vp8x := wp.Chunks().First(IDVP8X).(*ChunkVP8X)
+		assert.True(t, vp8x.Has(VP8XEXIF))
+
+		buf := &bytes.Buffer{}
+		must.Value(wp.WriteTo(buf))
+		got := readWebP(t, buf.Bytes(), SkipData)
+		assert.Equal(t, []byte("II*\x00"), got.EXIF())
+	})
+
+	t.Run("simple format", func(t *testing.T) {
+		// --- Given ---
+		wp := readWebP(t, webpSimple(t), LoadData)
+
+		// --- When ---
+		wp.SetEXIF([]byte("II*\x00"))
+
+		// --- Then ---
+		assert.Equal(t, []uint32{IDVP8X, IDVP8, IDEXIF}, wp.Chunks().IDs())
+		vp8x := wp.Chunks().First(IDVP8X).(*ChunkVP8X)
+		assert.Equal(t, VP8XEXIF, vp8x.Flags)
+	})
+
+	t.Run("remove", func(t *testing.T) {
+		// --- Given ---
+		wp := readWebP(t, webpAnimated(t), LoadData)
+		wp.SetEXIF([]byte("II*\x00"))
+
+		// --- When ---
+		wp.SetEXIF(nil)
+
+		// --- Then ---
+		assert.Nil(t, wp.EXIF())
+		assert.Equal(t, 0, wp.Chunks().Count(IDEXIF))
+		assert.False(t, wp.Chunks().First(IDVP8X).(*ChunkVP8X).Has(VP8XEXIF))
+	})
+}
@@ -0,0 +1,577 @@
+package riff
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// XMP namespaces.
+const (
+	// XMPNsRDF represents RDF namespace URI.
+	XMPNsRDF = "http://www.w3.org/1999/02/22-rdf-syntax-ns#"
+
+	// XMPNsDC represents Dublin Core namespace URI.
+	XMPNsDC = "http://purl.org/dc/elements/1.1/"
+
+	// XMPNsDM represents XMP Dynamic Media namespace URI.
+	XMPNsDM = "http://ns.adobe.com/xmp/1.0/DynamicMedia/"
+)
+
+// xmpPrefixes maps namespace URIs to prefixes used when the namespace
+// must be declared.
+var xmpPrefixes = map[string]string{
+	XMPNsRDF: "rdf",
+	XMPNsDC:  "dc",
+	XMPNsDM:  "xmpDM",
+}
+
+// xmpSkeleton is the XMP packet used by [NewXMP].
+const xmpSkeleton = `<?xpacket begin="` + "\ufeff" + `" id="W5M0MpCehiHzreSzNTczkc9d"?>` +
+	`<x:xmpmeta xmlns:x="adobe:ns:meta/">` +
+	`<rdf:RDF xmlns:rdf="` + XMPNsRDF + `">` +
+	`<rdf:Description rdf:about=""/>` +
+	`</rdf:RDF>` +
+	`</x:xmpmeta>` +
+	`<?xpacket end="w"?>`
+
+// xmlNode represents XML element. The names keep the prefixes as they
+// appear in the document, so the document can be serialized without
+// changing them.
+type xmlNode struct {
+	name xml.Name
+	attr []xml.Attr
+
+	// Child nodes: *xmlNode, xml.CharData, xml.Comment, xml.ProcInst
+	// or xml.Directive.
+	kids []any
+}
+
+// elements returns child elements.
+func (n *xmlNode) elements() []*xmlNode {
+	var els []*xmlNode
+	for _, k := range n.kids {
+		if el, ok := k.(*xmlNode); ok {
+			els = append(els, el)
+		}
+	}
+	return els
+}
+
+// text returns concatenated character data of the node.
+func (n *xmlNode) text() string {
+	var sb strings.Builder
+	for _, k := range n.kids {
+		if cd, ok := k.(xml.CharData); ok {
+			sb.Write(cd)
+		}
+	}
+	return sb.String()
+}
+
+// setText replaces node children with character data.
+func (n *xmlNode) setText(s string) {
+	n.kids = []any{xml.CharData(s)}
+}
+
+// attrValue returns the value of the attribute.
+func (n *xmlNode) attrValue(name xml.Name) (string, bool) {
+	for _, a := range n.attr {
+		if a.Name == name {
+			return a.Value, true
+		}
+	}
+	return "", false
+}
+
+// walk calls fn for the node and all its descendant elements.
+func (n *xmlNode) walk(fn func(*xmlNode)) {
+	fn(n)
+	for _, el := range n.elements() {
+		el.walk(fn)
+	}
+}
+
+// XMPMarker represents a marker stored in xmpDM:markers property.
+type XMPMarker struct {
+	// Marker start time as stored (in track frame rate units).
+	StartTime string
+
+	// Marker duration as stored (in track frame rate units).
+	Duration string
+
+	// Marker name.
+	Name string
+
+	// Marker comment.
+	Comment string
+}
+
+// XMPPacket represents XMP packet. Properties not accessed with the
+// helper methods are kept intact when the packet is serialized.
+type XMPPacket struct {
+	doc *xmlNode
+}
+
+// NewXMP returns a new XMP packet with an empty rdf:Description.
+func NewXMP() *XMPPacket {
+	x, _ := ParseXMP([]byte(xmpSkeleton)) // Never fails.
+	return x
+}
+
+// ParseXMP parses XMP packet.
+func ParseXMP(b []byte) (*XMPPacket, error) {
+	doc := &xmlNode{}
+	stack := []*xmlNode{doc}
+
+	dec := xml.NewDecoder(bytes.NewReader(b))
+	for {
+		tok, err := dec.RawToken()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		top := stack[len(stack)-1]
+		switch t := tok.(type) {
+		case xml.StartElement:
+			el := &xmlNode{name: t.Name, attr: t.Copy().Attr}
+			top.kids = append(top.kids, el)
+			stack = append(stack, el)
+
+		case xml.EndElement:
+			if len(stack) == 1 {
+				return nil, ErrXMPInvalid
+			}
+			stack = stack[:len(stack)-1]
+
+		default:
+			top.kids = append(top.kids, xml.CopyToken(tok))
+		}
+	}
+	if len(stack) != 1 {
+		return nil, ErrXMPInvalid
+	}
+
+	x := &XMPPacket{doc: doc}
+	if len(x.descriptions()) == 0 {
+		return nil, ErrXMPInvalid
+	}
+	return x, nil
+}
+
+// Bytes serializes the XMP packet.
+func (x *XMPPacket) Bytes() []byte {
+	buf := &bytes.Buffer{}
+	for _, k := range x.doc.kids {
+		writeXMLNode(buf, k)
+	}
+	return buf.Bytes()
+}
+
+// prefix returns the prefix declared for the namespace URI.
+func (x *XMPPacket) prefix(uri string) (string, bool) {
+	var pfx string
+	var found bool
+	x.doc.walk(func(n *xmlNode) {
+		for _, a := range n.attr {
+			if !found && a.Name.Space == "xmlns" && a.Value == uri {
+				pfx, found = a.Name.Local, true
+			}
+		}
+	})
+	return pfx, found
+}
+
+// declared returns true if the prefix is declared in the packet.
+func (x *XMPPacket) declared(pfx string) bool {
+	var found bool
+	x.doc.walk(func(n *xmlNode) {
+		for _, a := range n.attr {
+			if a.Name.Space == "xmlns" && a.Name.Local == pfx {
+				found = true
+			}
+		}
+	})
+	return found
+}
+
+// declare returns the prefix for the namespace URI declaring it on
+// rdf:Description if needed.
+func (x *XMPPacket) declare(uri string) string {
+	if pfx, ok := x.prefix(uri); ok {
+		return pfx
+	}
+	pfx, ok := xmpPrefixes[uri]
+	for i := 1; !ok || x.declared(pfx); i++ {
+		pfx, ok = "ns"+strconv.Itoa(i), true
+	}
+	desc := x.descriptions()[0]
+	desc.attr = append(desc.attr, xml.Attr{
+		Name:  xml.Name{Space: "xmlns", Local: pfx},
+		Value: uri,
+	})
+	return pfx
+}
+
+// name returns the element name for the namespace URI and local name.
+func (x *XMPPacket) name(uri, local string) (xml.Name, bool) {
+	pfx, ok := x.prefix(uri)
+	return xml.Name{Space: pfx, Local: local}, ok
+}
+
+// descriptions returns all rdf:Description elements.
+func (x *XMPPacket) descriptions() []*xmlNode {
+	name, ok := x.name(XMPNsRDF, "Description")
+	if !ok {
+		return nil
+	}
+	var descs []*xmlNode
+	x.doc.walk(func(n *xmlNode) {
+		if n.name == name {
+			descs = append(descs, n)
+		}
+	})
+	return descs
+}
+
+// find returns the first property element with given name which is a
+// direct child of rdf:Description.
+func (x *XMPPacket) find(name xml.Name) *xmlNode {
+	for _, desc := range x.descriptions() {
+		for _, el := range desc.elements() {
+			if el.name == name {
+				return el
+			}
+		}
+	}
+	return nil
+}
+
+// Property returns the value of a simple property from the namespace.
+// The property may be stored as rdf:Description attribute or element.
+func (x *XMPPacket) Property(uri, local string) (string, bool) {
+	name, ok := x.name(uri, local)
+	if !ok {
+		return "", false
+	}
+	for _, desc := range x.descriptions() {
+		if val, ok := desc.attrValue(name); ok {
+			return val, true
+		}
+	}
+	if el := x.find(name); el != nil {
+		return el.text(), true
+	}
+	return "", false
+}
+
+// SetProperty sets the value of a simple property from the namespace.
+func (x *XMPPacket) SetProperty(uri, local, val string) {
+	name := xml.Name{Space: x.declare(uri), Local: local}
+	for _, desc := range x.descriptions() {
+		for i := range desc.attr {
+			if desc.attr[i].Name == name {
+				desc.attr[i].Value = val
+				return
+			}
+		}
+	}
+	x.property(name).setText(val)
+}
+
+// property returns the property element creating it if needed.
+func (x *XMPPacket) property(name xml.Name) *xmlNode {
+	if el := x.find(name); el != nil {
+		return el
+	}
+	el := &xmlNode{name: name}
+	desc := x.descriptions()[0]
+	desc.kids = append(desc.kids, el)
+	return el
+}
+
+// items returns rdf:li elements of the rdf:Alt, rdf:Seq or rdf:Bag array
+// which is the value of the property element.
+func (x *XMPPacket) items(el *xmlNode) []*xmlNode {
+	li, _ := x.name(XMPNsRDF, "li")
+	var lis []*xmlNode
+	for _, arr := range el.elements() {
+		for _, it := range arr.elements() {
+			if it.name == li {
+				lis = append(lis, it)
+			}
+		}
+	}
+	return lis
+}
+
+// setItems sets the property element value to the array of given kind
+// (Alt, Seq or Bag) with given items.
+func (x *XMPPacket) setItems(el *xmlNode, kind string, items ...*xmlNode) {
+	rdf := x.declare(XMPNsRDF)
+	arr := &xmlNode{name: xml.Name{Space: rdf, Local: kind}}
+	for _, it := range items {
+		it.name = xml.Name{Space: rdf, Local: "li"}
+		arr.kids = append(arr.kids, it)
+	}
+	el.kids = []any{arr}
+}
+
+// Title returns the default language value of the dc:title property.
+func (x *XMPPacket) Title() string {
+	name, ok := x.name(XMPNsDC, "title")
+	if !ok {
+		return ""
+	}
+	el := x.find(name)
+	if el == nil {
+		return ""
+	}
+	lis := x.items(el)
+	lang := xml.Name{Space: "xml", Local: "lang"}
+	for _, li := range lis {
+		if v, _ := li.attrValue(lang); v == "x-default" {
+			return li.text()
+		}
+	}
+	if len(lis) > 0 {
+		return lis[0].text()
+	}
+	return el.text()
+}
+
+// SetTitle sets the default language value of the dc:title property.
+// Other language alternatives are removed.
+func (x *XMPPacket) SetTitle(title string) {
+	el := x.property(xml.Name{Space: x.declare(XMPNsDC), Local: "title"})
+	li := &xmlNode{
+		attr: []xml.Attr{{
+			Name:  xml.Name{Space: "xml", Local: "lang"},
+			Value: "x-default",
+		}},
+	}
+	li.setText(title)
+	x.setItems(el, "Alt", li)
+}
+
+// Creators returns the values of the dc:creator property.
+func (x *XMPPacket) Creators() []string {
+	name, ok := x.name(XMPNsDC, "creator")
+	if !ok {
+		return nil
+	}
+	el := x.find(name)
+	if el == nil {
+		return nil
+	}
+	var vals []string
+	for _, li := range x.items(el) {
+		vals = append(vals, li.text())
+	}
+	return vals
+}
+
+// SetCreators sets the values of the dc:creator property.
+func (x *XMPPacket) SetCreators(creators ...string) {
+	el := x.property(xml.Name{Space: x.declare(XMPNsDC), Local: "creator"})
+	lis := make([]*xmlNode, 0, len(creators))
+	for _, c := range creators {
+		li := &xmlNode{}
+		li.setText(c)
+		lis = append(lis, li)
+	}
+	x.setItems(el, "Seq", lis...)
+}
+
+// Tempo returns the value of the xmpDM:tempo property in beats per minute.
+func (x *XMPPacket) Tempo() (float64, bool) {
+	val, ok := x.Property(XMPNsDM, "tempo")
+	if !ok {
+		return 0, false
+	}
+	bpm, err := strconv.ParseFloat(strings.TrimSpace(val), 64)
+	if err != nil {
+		return 0, false
+	}
+	return bpm, true
+}
+
+// SetTempo sets the value of the xmpDM:tempo property in beats per minute.
+func (x *XMPPacket) SetTempo(bpm float64) {
+	x.SetProperty(XMPNsDM, "tempo", strconv.FormatFloat(bpm, 'f', -1, 64))
+}
+
+// Markers returns all markers from xmpDM:markers properties including
+// the ones nested in xmpDM:Tracks.
+func (x *XMPPacket) Markers() []XMPMarker {
+	name, ok := x.name(XMPNsDM, "markers")
+	if !ok {
+		return nil
+	}
+	desc, _ := x.name(XMPNsRDF, "Description")
+
+	var mks []XMPMarker
+	x.doc.walk(func(n *xmlNode) {
+		if n.name != name {
+			return
+		}
+		for _, li := range x.items(n) {
+			fields := map[string]string{}
+			collect := func(el *xmlNode) {
+				for _, a := range el.attr {
+					if a.Name.Space == name.Space {
+						fields[a.Name.Local] = a.Value
+					}
+				}
+				for _, c := range el.elements() {
+					if c.name.Space == name.Space {
+						fields[c.name.Local] = c.text()
+					}
+				}
+			}
+			collect(li)
+			for _, el := range li.elements() {
+				if el.name == desc {
+					collect(el)
+				}
+			}
+			mks = append(mks, XMPMarker{
+				StartTime: fields["startTime"],
+				Duration:  fields["duration"],
+				Name:      fields["name"],
+				Comment:   fields["comment"],
+			})
+		}
+	})
+	return mks
+}
+
+// SetMarkers replaces the markers in the first xmpDM:markers property.
+// When the packet has no markers, a new "CuePoint Markers" track is
+// added to xmpDM:Tracks.
+func (x *XMPPacket) SetMarkers(mks ...XMPMarker) {
+	dm := x.declare(XMPNsDM)
+	rdf := x.declare(XMPNsRDF)
+	dmName := func(local string) xml.Name { return xml.Name{Space: dm, Local: local} }
+	resource := xml.Attr{
+		Name:  xml.Name{Space: rdf, Local: "parseType"},
+		Value: "Resource",
+	}
+
+	var el *xmlNode
+	x.doc.walk(func(n *xmlNode) {
+		if el == nil && n.name == dmName("markers") {
+			el = n
+		}
+	})
+	if el == nil {
+		trk := &xmlNode{attr: []xml.Attr{resource}}
+		for _, f := range [][2]string{{"trackName", "CuePoint Markers"}, {"trackType", "Cue"}} {
+			c := &xmlNode{name: dmName(f[0])}
+			c.setText(f[1])
+			trk.kids = append(trk.kids, c)
+		}
+		el = &xmlNode{name: dmName("markers")}
+		trk.kids = append(trk.kids, el)
+
+		tracks := x.property(dmName("Tracks"))
+		lis := x.items(tracks)
+		x.setItems(tracks, "Bag", append(lis, trk)...)
+	}
+
+	lis := make([]*xmlNode, 0, len(mks))
+	for _, mk := range mks {
+		li := &xmlNode{attr: []xml.Attr{resource}}
+		for _, f := range [][2]string{
+			{"startTime", mk.StartTime},
+			{"duration", mk.Duration},
+			{"name", mk.Name},
+			{"comment", mk.Comment},
+		} {
+			if f[1] == "" {
+				continue
+			}
+			c := &xmlNode{name: dmName(f[0])}
+			c.setText(f[1])
+			li.kids = append(li.kids, c)
+		}
+		lis = append(lis, li)
+	}
+	x.setItems(el, "Seq", lis...)
+}
+
+// writeXMLNode writes XML node to buf.
+func writeXMLNode(buf *bytes.Buffer, node any) {
+	switch n := node.(type) {
+	case *xmlNode:
+		buf.WriteByte('<')
+		buf.WriteString(xmlName(n.name))
+		for _, a := range n.attr {
+			buf.WriteByte(' ')
+			buf.WriteString(xmlName(a.Name))
+			buf.WriteString(`="`)
+			buf.WriteString(xmlAttrEscaper.Replace(a.Value))
+			buf.WriteByte('"')
+		}
+		if len(n.kids) == 0 {
+			buf.WriteString("/>")
+			return
+		}
+		buf.WriteByte('>')
+		for _, k := range n.kids {
+			writeXMLNode(buf, k)
+		}
+		buf.WriteString("</")
+		buf.WriteString(xmlName(n.name))
+		buf.WriteByte('>')
+
+	case xml.CharData:
+		buf.WriteString(xmlTextEscaper.Replace(string(n)))
+
+	case xml.Comment:
+		buf.WriteString("<!--")
+		buf.Write(n)
+		buf.WriteString("-->")
+
+	case xml.ProcInst:
+		buf.WriteString("<?")
+		buf.WriteString(n.Target)
+		if len(n.Inst) > 0 {
+			buf.WriteByte(' ')
+			buf.Write(n.Inst)
+		}
+		buf.WriteString("?>")
+
+	case xml.Directive:
+		buf.WriteString("<!")
+		buf.Write(n)
+		buf.WriteByte('>')
+	}
+}
+
+// xmlName returns prefixed XML name.
+func xmlName(name xml.Name) string {
+	if name.Space == "" {
+		return name.Local
+	}
+	return name.Space + ":" + name.Local
+}
+
+// Escapers for XML character data and attribute values.
+var (
+	xmlTextEscaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;")
+	xmlAttrEscaper = strings.NewReplacer(
+		"&", "&amp;",
+		"<", "&lt;",
+		`"`, "&quot;",
+		"\t", "&#x9;",
+		"\n", "&#xA;",
+		"\r", "&#xD;",
+	)
+)
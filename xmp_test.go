@@ -0,0 +1,260 @@
+package riff
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/ctx42/testing/pkg/assert"
+	"github.com/ctx42/testing/pkg/must"
+)
+
+// xmpPacket is an XMP packet as written by Adobe Audition.
+const xmpPacket = `<?xpacket begin="` + "\ufeff" + `" id="W5M0MpCehiHzreSzNTczkc9d"?>
+<x:xmpmeta xmlns:x="adobe:ns:meta/" x:xmptk="Adobe XMP Core 5.6">
+ <rdf:RDF xmlns:rdf="http://www.w3.org/1999/02/22-rdf-syntax-ns#">
+  <rdf:Description rdf:about=""
+    xmlns:dc="http://purl.org/dc/elements/1.1/"
+    xmlns:xmpDM="http://ns.adobe.com/xmp/1.0/DynamicMedia/"
+    xmlns:xmp="http://ns.adobe.com/xap/1.0/"
+   xmpDM:tempo="120"
+   xmp:CreatorTool="Adobe Audition &amp; Co">
+   <dc:title>
+    <rdf:Alt>
+     <rdf:li xml:lang="pl">Tytuł</rdf:li>
+     <rdf:li xml:lang="x-default">Title</rdf:li>
+    </rdf:Alt>
+   </dc:title>
+   <dc:creator>
+    <rdf:Seq>
+     <rdf:li>Artist 1</rdf:li>
+     <rdf:li>Artist 2</rdf:li>
+    </rdf:Seq>
+   </dc:creator>
+   <xmpDM:Tracks>
+    <rdf:Bag>
+     <rdf:li rdf:parseType="Resource">
+      <xmpDM:trackName>CuePoint Markers</xmpDM:trackName>
+      <xmpDM:markers>
+       <rdf:Seq>
+        <rdf:li xmpDM:startTime="100" xmpDM:name="M1"/>
+        <rdf:li rdf:parseType="Resource">
+         <xmpDM:startTime>200</xmpDM:startTime>
+         <xmpDM:duration>50</xmpDM:duration>
+         <xmpDM:name>M2</xmpDM:name>
+         <xmpDM:comment>a &lt; b</xmpDM:comment>
+        </rdf:li>
+       </rdf:Seq>
+      </xmpDM:markers>
+     </rdf:li>
+    </rdf:Bag>
+   </xmpDM:Tracks>
+  </rdf:Description>
+ </rdf:RDF>
+</x:xmpmeta>
+<!-- comment -->
+<?xpacket end="w"?>`
+
+func Test_NewXMP(t *testing.T) {
+	// --- When ---
+	x := NewXMP()
+
+	// --- Then ---
+	assert.Equal(t, xmpSkeleton, string(x.Bytes()))
+	assert.Equal(t, "", x.Title())
+	assert.Nil(t, x.Creators())
+	assert.Nil(t, x.Markers())
+}
+
+func Test_ParseXMP(t *testing.T) {
+	// --- When ---
+	x, err := ParseXMP([]byte(xmpPacket))
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, "Title", x.Title())
+	assert.Equal(t, []string{"Artist 1", "Artist 2"}, x.Creators())
+	bpm, ok := x.Tempo()
+	assert.True(t, ok)
+	assert.Equal(t, 120.0, bpm)
+	val, ok := x.Property("http://ns.adobe.com/xap/1.0/", "CreatorTool")
+	assert.True(t, ok)
+	assert.Equal(t, "Adobe Audition & Co", val)
+
+	exp := []XMPMarker{
+		{StartTime: "100", Name: "M1"},
+		{StartTime: "200", Duration: "50", Name: "M2", Comment: "a < b"},
+	}
+	assert.Equal(t, exp, x.Markers())
+}
+
+func Test_ParseXMP_Errors(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		xml string
+	}{
+		{"empty", ""},
+		{"not XML", "<a"},
+		{"unbalanced", "<a></a></b>"},
+		{"not closed", "<a>"},
+		{"no description", "<x:xmpmeta xmlns:x=\"adobe:ns:meta/\"/>"},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			x, err := ParseXMP([]byte(tc.xml))
+
+			// --- Then ---
+			assert.Error(t, err)
+			assert.Nil(t, x)
+		})
+	}
+}
+
+func Test_XMPPacket_Bytes(t *testing.T) {
+	// --- Given ---
+	x := must.Value(ParseXMP([]byte(xmpPacket)))
+
+	// --- When ---
+	have := x.Bytes()
+
+	// --- Then ---
+	assert.True(t, strings.HasPrefix(string(have), `<?xpacket begin="`))
+	assert.True(t, strings.HasSuffix(string(have), "<!-- comment -->\n<?xpacket end=\"w\"?>"))
+	assert.Contain(t, `xmp:CreatorTool="Adobe Audition &amp; Co"`, string(have))
+	assert.Contain(t, `<rdf:li xmpDM:startTime="100" xmpDM:name="M1"/>`, string(have))
+	assert.Contain(t, `<xmpDM:comment>a &lt; b</xmpDM:comment>`, string(have))
+	assert.Equal(t, have, must.Value(ParseXMP(have)).Bytes())
+}
+
+func Test_XMPPacket_Edit(t *testing.T) {
+	// --- Given ---
+	x := must.Value(ParseXMP([]byte(xmpPacket)))
+
+	// --- When ---
+	x.SetTitle("New & Title")
+	x.SetCreators("Me")
+	x.SetTempo(98.5)
+	x.SetMarkers(XMPMarker{StartTime: "1", Name: "A"})
+
+	// --- Then ---
+	got := must.Value(ParseXMP(x.Bytes()))
+	assert.Equal(t, "New & Title", got.Title())
+	assert.Equal(t, []string{"Me"}, got.Creators())
+	bpm, _ := got.Tempo()
+	assert.Equal(t, 98.5, bpm)
+	assert.Equal(t, []XMPMarker{{StartTime: "1", Name: "A"}}, got.Markers())
+
+	val, ok := got.Property("http://ns.adobe.com/xap/1.0/", "CreatorTool")
+	assert.True(t, ok)
+	assert.Equal(t, "Adobe Audition & Co", val)
+	assert.Contain(t, "<xmpDM:trackName>CuePoint Markers</xmpDM:trackName>", string(x.Bytes()))
+}
+
+func Test_XMPPacket_Edit_NewPacket(t *testing.T) {
+	// --- Given ---
+	x := NewXMP()
+
+	// --- When ---
+	x.SetTitle("Title")
+	x.SetCreators("A", "B")
+	x.SetTempo(120)
+	x.SetMarkers(XMPMarker{StartTime: "10", Duration: "5", Name: "M", Comment: "C"})
+
+	// --- Then ---
+	got := must.Value(ParseXMP(x.Bytes()))
+	assert.Equal(t, "Title", got.Title())
+	assert.Equal(t, []string{"A", "B"}, got.Creators())
+	bpm, _ := got.Tempo()
+	assert.Equal(t, 120.0, bpm)
+	exp := []XMPMarker{{StartTime: "10", Duration: "5", Name: "M", Comment: "C"}}
+	assert.Equal(t, exp, got.Markers())
+	assert.Contain(t, `xmlns:dc="`+XMPNsDC+`"`, string(x.Bytes()))
+	assert.Contain(t, `xmlns:xmpDM="`+XMPNsDM+`"`, string(x.Bytes()))
+}
+
+func Test_XMPPacket_Property(t *testing.T) {
+	t.Run("undeclared namespace", func(t *testing.T) {
+		// --- Given ---
+		x := NewXMP()
+
+		// --- When ---
+		val, ok := x.Property(XMPNsDM, "tempo")
+
+		// --- Then ---
+		assert.False(t, ok)
+		assert.Equal(t, "", val)
+	})
+
+	t.Run("element", func(t *testing.T) {
+		// --- Given ---
+		x := NewXMP()
+		x.SetProperty(XMPNsDM, "genre", "Rock")
+
+		// --- When ---
+		val, ok := x.Property(XMPNsDM, "genre")
+
+		// --- Then ---
+		assert.True(t, ok)
+		assert.Equal(t, "Rock", val)
+	})
+
+	t.Run("custom namespaces get unique prefixes", func(t *testing.T) {
+		// --- Given ---
+		x := NewXMP()
+
+		// --- When ---
+		x.SetProperty("http://example.com/a/", "one", "1")
+		x.SetProperty("http://example.com/b/", "two", "2")
+
+		// --- Then ---
+		got := must.Value(ParseXMP(x.Bytes()))
+		val, _ := got.Property("http://example.com/a/", "one")
+		assert.Equal(t, "1", val)
+		val, _ = got.Property("http://example.com/b/", "two")
+		assert.Equal(t, "2", val)
+		assert.Contain(t, `xmlns:ns1="http://example.com/a/"`, string(x.Bytes()))
+		assert.Contain(t, `xmlns:ns2="http://example.com/b/"`, string(x.Bytes()))
+	})
+
+	t.Run("known prefix taken by other namespace", func(t *testing.T) {
+		// --- Given ---
+		src := strings.Replace(xmpSkeleton, `rdf:about=""`,
+			`rdf:about="" xmlns:dc="http://example.com/dc/"`, 1)
+		x := must.Value(ParseXMP([]byte(src)))
+
+		// --- When ---
+		x.SetTitle("Title")
+
+		// --- Then ---
+		got := must.Value(ParseXMP(x.Bytes()))
+		assert.Equal(t, "Title", got.Title())
+		assert.Contain(t, `xmlns:ns1="`+XMPNsDC+`"`, string(x.Bytes()))
+	})
+
+	t.Run("attribute is updated in place", func(t *testing.T) {
+		// --- Given ---
+		x := must.Value(ParseXMP([]byte(xmpPacket)))
+
+		// --- When ---
+		x.SetProperty(XMPNsDM, "tempo", "90")
+
+		// --- Then ---
+		assert.Contain(t, `xmpDM:tempo="90"`, string(x.Bytes()))
+		assert.NotContain(t, "<xmpDM:tempo>", string(x.Bytes()))
+	})
+}
+
+func Test_XMPPacket_Tempo_Invalid(t *testing.T) {
+	// --- Given ---
+	x := NewXMP()
+	x.SetProperty(XMPNsDM, "tempo", "fast")
+
+	// --- When ---
+	bpm, ok := x.Tempo()
+
+	// --- Then ---
+	assert.False(t, ok)
+	assert.Equal(t, 0.0, bpm)
+}